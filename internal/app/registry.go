@@ -18,7 +18,9 @@ import (
 	"go-gadget-api/internal/product"
 	"go-gadget-api/internal/product/adapters"
 	"go-gadget-api/internal/review"
+	"go-gadget-api/internal/shared/cache"
 	"go-gadget-api/internal/shared/database/dbgen"
+	"go-gadget-api/internal/warehouse"
 	"go-gadget-api/internal/wishlist"
 
 	"github.com/gin-gonic/gin"
@@ -48,6 +50,7 @@ func registerModules(
 	customerRepo := customer.NewRepository(queries)
 	wishlistRepo := wishlist.NewRepository(queries)
 	dashboardRepo := dashboard.NewRepository(queries)
+	warehouseRepo := warehouse.NewRepository(queries)
 
 	// --- Services ---
 	emailService, err := email.NewResendServiceFromEnv()
@@ -56,10 +59,13 @@ func registerModules(
 	}
 
 	authService := auth.NewService(authRepo, emailService)
-	categoryService := category.NewService(db, categoryRepo, cloudinaryService)
-	brandService := brand.NewService(db, brandRepo, cloudinaryService)
-	reviewService := review.NewService(db, reviewRepo, productRepo)
-	productService := product.NewService(db, productRepo, categoryRepo, reviewRepo, cloudinaryService)
+	// Read-through cache katalog, mutasi tiap modul menaikkan versi entity-nya
+	catalogCache := cache.New(rdb)
+
+	categoryService := category.NewCachedService(category.NewService(db, categoryRepo, cloudinaryService), catalogCache)
+	brandService := brand.NewCachedService(brand.NewService(db, brandRepo, cloudinaryService), catalogCache)
+	reviewService := review.NewCachedService(review.NewService(db, reviewRepo, productRepo), catalogCache)
+	productService := product.NewCachedService(product.NewService(db, productRepo, categoryRepo, reviewRepo, cloudinaryService, product.NewViewHistory(rdb)), catalogCache)
 	cartService := cart.NewService(db, cartRepo)
 	addressService := address.NewService(db, addressRepo)
 	midtransService := midtrans.NewService()
@@ -73,12 +79,13 @@ func registerModules(
 	customerService := customer.NewService(db, customerRepo, addressRepo, orderRepo)
 	wishlistService := wishlist.NewService(db, wishlistRepo)
 	dashboardService := dashboard.NewService(dashboardRepo)
+	warehouseService := warehouse.NewService(db, warehouseRepo)
 
 	// --- Adapters ---
 	reviewEligibilityAdapter := adapters.NewReviewEligibilityAdapter(reviewService)
 
 	// --- Handlers ---
-	authHandler := auth.NewHandler(authService)
+	authHandler := auth.NewHandler(authService, cartService)
 	categoryHandler := category.NewHandler(categoryService)
 	brandHandler := brand.NewHandler(brandService)
 	reviewHandler := review.NewHandler(reviewService)
@@ -89,6 +96,7 @@ func registerModules(
 	customerHandler := customer.NewHandler(customerService)
 	wishlistHandler := wishlist.NewHandler(wishlistService)
 	dashboardHandler := dashboard.NewHandler(dashboardService)
+	warehouseHandler := warehouse.NewHandler(warehouseService)
 
 	// --- Routes Registration ---
 	api := router.Group("/api/v1")
@@ -104,5 +112,7 @@ func registerModules(
 		customer.RegisterRoutes(api, customerHandler)
 		wishlist.RegisterRoutes(api, wishlistHandler, logger)
 		dashboard.RegisterRoutes(api, dashboardHandler)
+		warehouse.RegisterRoutes(api, warehouseHandler)
+		cache.RegisterRoutes(api, catalogCache)
 	}
 }
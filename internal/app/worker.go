@@ -2,8 +2,12 @@ package app
 
 import (
 	"context"
+	"go-gadget-api/internal/cart"
+	"go-gadget-api/internal/jobs"
 	"go-gadget-api/internal/messaging/kafka/producer"
 	"go-gadget-api/internal/outbox"
+	"go-gadget-api/internal/product"
+	"go-gadget-api/internal/shared/cache"
 	"go-gadget-api/internal/shared/connection"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"log"
@@ -49,7 +53,43 @@ func RunWorker() error {
 
 	go producer.ProcessOutboxEvents(ctx, outboxRepo, kafkaWriter)
 
-	// 5. Graceful shutdown
+	// 5. Scheduled jobs
+	cartService := cart.NewService(db, cart.NewRepository(queries))
+	go jobs.RunPeriodic(ctx, "guest-cart-cleanup", time.Hour,
+		jobs.CleanupGuestCarts(cartService, jobs.GuestCartRetention()))
+	go jobs.RunPeriodic(ctx, "abandoned-cart-reminder", 15*time.Minute,
+		jobs.NotifyAbandonedCarts(db, queries, outboxRepo, jobs.AbandonedCartConfigFromEnv()))
+	go jobs.RunPeriodic(ctx, "bought-together-refresh", time.Hour,
+		jobs.RefreshBoughtTogether(db, queries, jobs.BoughtTogetherConfigFromEnv()))
+
+	// Job produk hanya butuh repository produk; Redis dipakai untuk invalidasi katalog dan
+	// hitungan view trending (tanpa Redis, perubahan harga tetap terlihat setelah TTL cache
+	// habis dan skor trending hanya dari penjualan)
+	var (
+		catalogCache *cache.Cache
+		viewHistory  product.ViewHistory
+	)
+	if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+		rdb, err := connection.ConnectRedisWithRetry(addr, 5)
+		if err != nil {
+			log.Printf("[WORKER] Redis unavailable, catalog cache will not be invalidated: %v", err)
+		} else {
+			defer rdb.Close()
+			catalogCache = cache.New(rdb)
+			viewHistory = product.NewViewHistory(rdb)
+		}
+	}
+	productService := product.NewCachedService(product.NewService(db, product.NewRepository(queries), nil, nil, nil, viewHistory), catalogCache)
+	go jobs.RunPeriodic(ctx, "price-schedules", time.Minute,
+		jobs.ApplyPriceSchedules(productService))
+	go jobs.RunPeriodic(ctx, "product-popularity", 15*time.Minute,
+		jobs.RefreshProductPopularity(productService))
+	go jobs.RunPeriodic(ctx, "product-publication", time.Minute,
+		jobs.PublishScheduledProducts(productService))
+	go jobs.RunPeriodic(ctx, "product-import-cleanup", 15*time.Minute,
+		jobs.FailStaleProductImports(productService, jobs.ProductImportTimeout))
+
+	// 6. Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
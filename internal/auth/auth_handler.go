@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	autherrors "go-gadget-api/internal/auth/errors"
 	"go-gadget-api/internal/pkg/apperror"
 	platform "go-gadget-api/internal/pkg/request"
@@ -13,17 +14,52 @@ import (
 	"go.uber.org/zap"
 )
 
+// CartMerger memindahkan cart guest (sebelum login) ke cart milik user
+type CartMerger interface {
+	MergeGuestCart(ctx context.Context, userID, cartToken string) error
+}
+
 type Handler struct {
-	service *Service
-	logger  *zap.Logger
+	service    *Service
+	cartMerger CartMerger
+	logger     *zap.Logger
 }
 
-func NewHandler(s *Service, logger ...*zap.Logger) *Handler {
+func NewHandler(s *Service, cartMerger CartMerger, logger ...*zap.Logger) *Handler {
 	l := zap.L().Named("auth.handler")
 	if len(logger) > 0 && logger[0] != nil {
 		l = logger[0].Named("auth.handler")
 	}
-	return &Handler{service: s, logger: l}
+	return &Handler{service: s, cartMerger: cartMerger, logger: l}
+}
+
+// mergeGuestCart tidak fatal: login/register tetap sukses walau merge gagal
+func (h *Handler) mergeGuestCart(c *gin.Context, userID string) {
+	if h.cartMerger == nil {
+		return
+	}
+
+	cookie, _ := c.Cookie(platform.CartTokenCookie)
+	cartToken := platform.ResolveCartToken(c.GetHeader(platform.CartTokenHeader), cookie)
+	if cartToken == "" {
+		return
+	}
+
+	if err := h.cartMerger.MergeGuestCart(c.Request.Context(), userID, cartToken); err != nil {
+		h.logger.Warn("merge guest cart failed", zap.String("user_id", userID), zap.Error(err))
+		return
+	}
+
+	// Cart guest sudah dipindah, hapus cookie-nya
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     platform.CartTokenCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   os.Getenv("APP_ENV") == "production",
+		SameSite: http.SameSiteLaxMode,
+	})
 }
 
 func (h *Handler) Login(c *gin.Context) {
@@ -47,6 +83,8 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
+	h.mergeGuestCart(c, userResp.ID)
+
 	isProd := os.Getenv("APP_ENV") == "production"
 
 	// Logic Set Cookie (Tetap sama)
@@ -160,6 +198,8 @@ func (h *Handler) Register(c *gin.Context) {
 		return
 	}
 
+	h.mergeGuestCart(c, res.ID)
+
 	clientHeader := c.GetHeader("X-Client-Type")
 	userAgent := c.GetHeader("User-Agent")
 	clientType := platform.ResolveClientType(clientHeader, userAgent)
@@ -0,0 +1,73 @@
+package brand
+
+import (
+	"context"
+	"go-gadget-api/internal/shared/cache"
+	"mime/multipart"
+	"time"
+)
+
+const brandCacheTTL = 10 * time.Minute
+
+var brandCacheDeps = []string{cache.EntityBrand}
+
+type publicListPage struct {
+	Items []BrandPublicResponse `json:"items"`
+	Total int64                 `json:"total"`
+}
+
+// cachedService read-through cache untuk endpoint publik brand, mutasi menaikkan versi cache brand
+type cachedService struct {
+	Service
+	cache *cache.Cache
+}
+
+func NewCachedService(svc Service, c *cache.Cache) Service {
+	return &cachedService{Service: svc, cache: c}
+}
+
+func (s *cachedService) ListPublic(ctx context.Context, page, limit int) ([]BrandPublicResponse, int64, error) {
+	res, err := cache.Remember(ctx, s.cache, "brand:list", brandCacheDeps, brandCacheTTL, []int{page, limit},
+		func(ctx context.Context) (publicListPage, error) {
+			items, total, err := s.Service.ListPublic(ctx, page, limit)
+			return publicListPage{Items: items, Total: total}, err
+		})
+	return res.Items, res.Total, err
+}
+
+func (s *cachedService) GetBySlug(ctx context.Context, slug string) (BrandPublicResponse, error) {
+	return cache.Remember(ctx, s.cache, "brand:detail", brandCacheDeps, brandCacheTTL, slug,
+		func(ctx context.Context) (BrandPublicResponse, error) {
+			return s.Service.GetBySlug(ctx, slug)
+		})
+}
+
+func (s *cachedService) invalidate(ctx context.Context, err error) {
+	if err == nil {
+		s.cache.Invalidate(ctx, cache.EntityBrand)
+	}
+}
+
+func (s *cachedService) Create(ctx context.Context, req CreateBrandRequest, file multipart.File, filename string) (BrandAdminResponse, error) {
+	res, err := s.Service.Create(ctx, req, file, filename)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Update(ctx context.Context, id string, req UpdateBrandRequest, file multipart.File, filename string) (BrandAdminResponse, error) {
+	res, err := s.Service.Update(ctx, id, req, file, filename)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Delete(ctx context.Context, id string) error {
+	err := s.Service.Delete(ctx, id)
+	s.invalidate(ctx, err)
+	return err
+}
+
+func (s *cachedService) Restore(ctx context.Context, id string) (BrandAdminResponse, error) {
+	res, err := s.Service.Restore(ctx, id)
+	s.invalidate(ctx, err)
+	return res, err
+}
@@ -1,12 +1,15 @@
 package brand
 
 import (
+	"errors"
+	"go-gadget-api/internal/pkg/apperror"
 	"go-gadget-api/internal/pkg/response"
 	"go-gadget-api/internal/pkg/utils"
 	"log"
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -35,12 +38,12 @@ func (h *Handler) ListPublic(c *gin.Context) {
 		totalPages = int((total + int64(limit) - 1) / int64(limit))
 	}
 
-	response.Success(c, http.StatusOK, data, &response.PaginationMeta{
+	response.SuccessConditional(c, data, &response.PaginationMeta{
 		Total:      total,
 		TotalPages: totalPages,
 		Page:       page,
 		Limit:      limit,
-	})
+	}, time.Time{})
 }
 
 func (h *Handler) ListAdmin(c *gin.Context) {
@@ -101,7 +104,7 @@ func (h *Handler) GetByID(c *gin.Context) {
 			c,
 			http.StatusNotFound,
 			"NOT_FOUND",
-			"Category not found",
+			"Brand not found",
 			nil,
 		)
 		return
@@ -115,17 +118,22 @@ func (h *Handler) GetBySlug(c *gin.Context) {
 
 	res, err := h.service.GetBySlug(c.Request.Context(), slug)
 	if err != nil {
+		var moved *apperror.MovedError
+		if errors.As(err, &moved) {
+			response.MovedPermanently(c, slug, moved.Slug)
+			return
+		}
 		response.Error(
 			c,
 			http.StatusNotFound,
 			"NOT_FOUND",
-			"Category not found",
+			"Brand not found",
 			nil,
 		)
 		return
 	}
 
-	response.Success(c, http.StatusOK, res, nil)
+	response.SuccessConditional(c, res, nil, time.Time{})
 }
 
 // 3. CREATE BRAND
@@ -195,13 +203,8 @@ func (h *Handler) Create(c *gin.Context) {
 	// 5. Call service
 	result, err := h.service.Create(ctx, req, file, filename)
 	if err != nil {
-		response.Error(
-			c,
-			http.StatusInternalServerError,
-			"CREATE_ERROR",
-			"Failed to create brand",
-			err.Error(),
-		)
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
@@ -269,13 +272,8 @@ func (h *Handler) Update(c *gin.Context) {
 	// Pastikan Service.Update sudah diupdate signature-nya untuk menerima (ctx, id, req, file, filename)
 	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req, file, filename)
 	if err != nil {
-		response.Error(
-			c,
-			http.StatusInternalServerError,
-			"UPDATE_ERROR",
-			"Failed to update brand",
-			err.Error(),
-		)
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
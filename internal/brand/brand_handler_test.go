@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"go-gadget-api/internal/brand"
+	"go-gadget-api/internal/pkg/apperror"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -397,6 +398,43 @@ func TestBrandHandler_GetBrandBySlug(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("positive - old slug redirects", func(t *testing.T) {
+		svc := &fakeBrandService{
+			GetBySlugFn: func(ctx context.Context, bid string) (brand.BrandPublicResponse, error) {
+				return brand.BrandPublicResponse{}, &apperror.MovedError{Slug: "apple-inc"}
+			},
+		}
+
+		r := setupTestRouter()
+		ctrl := brand.NewHandler(svc)
+		r.GET("/brands/:slug", ctrl.GetBySlug)
+
+		req := httptest.NewRequest(http.MethodGet, "/brands/"+slug+"?page=2", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "/brands/apple-inc?page=2", w.Header().Get("Location"))
+	})
+
+	t.Run("negative - not found", func(t *testing.T) {
+		svc := &fakeBrandService{
+			GetBySlugFn: func(ctx context.Context, bid string) (brand.BrandPublicResponse, error) {
+				return brand.BrandPublicResponse{}, errors.New("not found")
+			},
+		}
+
+		r := setupTestRouter()
+		ctrl := brand.NewHandler(svc)
+		r.GET("/brands/:slug", ctrl.GetBySlug)
+
+		req := httptest.NewRequest(http.MethodGet, "/brands/nokia", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
 	t.Run("negative - invalid uuid", func(t *testing.T) {
 		svc := &fakeBrandService{}
 
@@ -3,6 +3,7 @@ package brand
 import (
 	"context"
 	"database/sql"
+	"go-gadget-api/internal/pkg/constants"
 	"go-gadget-api/internal/shared/database/dbgen"
 
 	"github.com/google/uuid"
@@ -19,6 +20,11 @@ type Repository interface {
 	Update(ctx context.Context, arg dbgen.UpdateBrandParams) (dbgen.Brand, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) (dbgen.Brand, error)
+
+	// Slug history (redirect slug lama & cegah pemakaian ulang)
+	FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error)
+	IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error)
+	RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error
 }
 
 type repository struct {
@@ -49,6 +55,22 @@ func (r *repository) GetBySlug(ctx context.Context, slug string) (dbgen.Brand, e
 	return r.queries.GetBrandBySlug(ctx, slug)
 }
 
+func (r *repository) FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error) {
+	return r.queries.GetSlugHistoryOwner(ctx, dbgen.GetSlugHistoryOwnerParams{EntityType: constants.SlugEntityBrand, Slug: slug})
+}
+
+func (r *repository) IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error) {
+	return r.queries.IsSlugRetired(ctx, dbgen.IsSlugRetiredParams{EntityType: constants.SlugEntityBrand, Slug: slug, EntityID: id})
+}
+
+// RecordSlugChange menyimpan slug lama ke riwayat; slug baru yang dulu milik entitas ini dikeluarkan dari riwayat
+func (r *repository) RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error {
+	if err := r.queries.DeleteSlugHistory(ctx, dbgen.DeleteSlugHistoryParams{EntityType: constants.SlugEntityBrand, EntityID: id, Slug: newSlug}); err != nil {
+		return err
+	}
+	return r.queries.InsertSlugHistory(ctx, dbgen.InsertSlugHistoryParams{EntityType: constants.SlugEntityBrand, EntityID: id, Slug: oldSlug})
+}
+
 func (r *repository) Update(ctx context.Context, arg dbgen.UpdateBrandParams) (dbgen.Brand, error) {
 	return r.queries.UpdateBrand(ctx, arg)
 }
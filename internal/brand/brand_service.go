@@ -3,6 +3,7 @@ package brand
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	branderrors "go-gadget-api/internal/brand/errors"
 	"go-gadget-api/internal/cloudinary"
@@ -139,6 +140,9 @@ func (s *service) GetBySlug(ctx context.Context, slug string) (BrandPublicRespon
 
 	brand, err := s.repo.GetBySlug(ctx, slug)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return BrandPublicResponse{}, s.redirectFromHistory(ctx, slug)
+		}
 		return BrandPublicResponse{}, branderrors.ErrBrandNotFound
 	}
 	return mapToPublicResponse(brand), err
@@ -148,6 +152,9 @@ func (s *service) Create(ctx context.Context, req CreateBrandRequest, file multi
 	if err := s.validate.Struct(req); err != nil {
 		return BrandAdminResponse{}, apperror.MapValidationError(err)
 	}
+	if err := s.ensureSlugAvailable(ctx, req.Slug, uuid.Nil); err != nil {
+		return BrandAdminResponse{}, err
+	}
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return BrandAdminResponse{}, err
@@ -227,6 +234,13 @@ func (s *service) Update(
 		return BrandAdminResponse{}, err
 	}
 
+	slugChanged := req.Slug != brand.Slug
+	if slugChanged {
+		if err := s.ensureSlugAvailable(ctx, req.Slug, brand.ID); err != nil {
+			return BrandAdminResponse{}, err
+		}
+	}
+
 	var newImageURL sql.NullString
 
 	// 2. Kalau upload image baru
@@ -264,8 +278,15 @@ func (s *service) Update(
 		newImageURL = brand.ImageUrl
 	}
 
-	// 3. Update DB
-	_, err = s.repo.Update(ctx, dbgen.UpdateBrandParams{
+	// 3. Update DB (+ catat slug lama dalam transaksi yang sama)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return BrandAdminResponse{}, branderrors.ErrBrandFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	_, err = qtx.Update(ctx, dbgen.UpdateBrandParams{
 		ID:          brand.ID,
 		Name:        req.Name,
 		Slug:        req.Slug,
@@ -277,6 +298,16 @@ func (s *service) Update(
 		return BrandAdminResponse{}, branderrors.ErrBrandFailed
 	}
 
+	if slugChanged {
+		if err := qtx.RecordSlugChange(ctx, brand.ID, brand.Slug, req.Slug); err != nil {
+			return BrandAdminResponse{}, branderrors.ErrBrandFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return BrandAdminResponse{}, branderrors.ErrBrandFailed
+	}
+
 	return s.GetByID(ctx, brand.ID.String())
 }
 
@@ -317,6 +348,35 @@ func (s *service) Restore(ctx context.Context, idStr string) (BrandAdminResponse
 	return mapToResponse(brand), err
 }
 
+// ensureSlugAvailable menolak slug yang pernah dipakai brand lain agar redirect lamanya tidak terputus
+func (s *service) ensureSlugAvailable(ctx context.Context, slug string, id uuid.UUID) error {
+	retired, err := s.repo.IsSlugRetired(ctx, slug, id)
+	if err != nil {
+		return branderrors.ErrBrandFailed
+	}
+	if retired {
+		return branderrors.ErrSlugUnavailable
+	}
+	return nil
+}
+
+// redirectFromHistory mengubah slug lama brand menjadi MovedError berisi slug kanonik
+func (s *service) redirectFromHistory(ctx context.Context, slug string) error {
+	id, err := s.repo.FindSlugOwner(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return branderrors.ErrBrandNotFound
+		}
+		return branderrors.ErrBrandFailed
+	}
+
+	brand, err := s.repo.GetByID(ctx, id)
+	if err != nil || brand.Slug == slug {
+		return branderrors.ErrBrandNotFound
+	}
+	return &apperror.MovedError{Slug: brand.Slug}
+}
+
 func mapToResponse(brand dbgen.Brand) BrandAdminResponse {
 	return BrandAdminResponse{
 		ID:        brand.ID.String(),
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"go-gadget-api/internal/brand"
+	branderrors "go-gadget-api/internal/brand/errors"
+	"go-gadget-api/internal/pkg/apperror"
 	"go-gadget-api/internal/pkg/constants"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"go-gadget-api/internal/shared/database/helper"
@@ -201,6 +203,7 @@ func TestBrandService_Create(t *testing.T) {
 		filename := "logo.png"
 		imgURL := "https://cloudinary.com/apple.png"
 
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, true)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -225,6 +228,7 @@ func TestBrandService_Create(t *testing.T) {
 
 	t.Run("negative - upload image failed (rollback)", func(t *testing.T) {
 		fakeFile := &mockFile{}
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false) // Expect Rollback
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -240,6 +244,7 @@ func TestBrandService_Create(t *testing.T) {
 	})
 
 	t.Run("negative - database create failed", func(t *testing.T) {
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -248,6 +253,13 @@ func TestBrandService_Create(t *testing.T) {
 		_, err := deps.service.Create(ctx, req, nil, "")
 		assert.Error(t, err)
 	})
+
+	t.Run("negative - slug retired by another brand", func(t *testing.T) {
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(true, nil)
+
+		_, err := deps.service.Create(ctx, req, nil, "")
+		assert.ErrorIs(t, err, branderrors.ErrSlugUnavailable)
+	})
 }
 
 func TestBrandService_Update(t *testing.T) {
@@ -268,6 +280,8 @@ func TestBrandService_Update(t *testing.T) {
 				IsActive: helper.RawBoolToNull(true),
 			}, nil)
 
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(dbgen.Brand{ID: id}, nil)
@@ -304,6 +318,8 @@ func TestBrandService_Update(t *testing.T) {
 			UploadImage(ctx, fakeFile, gomock.Any(), constants.CloudinaryBrandFolder).
 			Return(imgURL, nil)
 
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(dbgen.Brand{ID: id}, nil)
@@ -321,6 +337,40 @@ func TestBrandService_Update(t *testing.T) {
 		assert.Equal(t, imgURL, res.ImageUrl)
 	})
 
+	t.Run("positive - slug change is recorded", func(t *testing.T) {
+		renamed := brand.UpdateBrandRequest{Name: "Apple Inc", Slug: "apple-inc"}
+
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Brand{ID: id, Name: "Apple", Slug: "apple"}, nil)
+		deps.repo.EXPECT().IsSlugRetired(ctx, "apple-inc", id).Return(false, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Brand{ID: id}, nil)
+		deps.repo.EXPECT().RecordSlugChange(ctx, id, "apple", "apple-inc").Return(nil)
+
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Brand{ID: id, Name: renamed.Name, Slug: renamed.Slug}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), renamed, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "apple-inc", res.Slug)
+	})
+
+	t.Run("negative - slug retired by another brand", func(t *testing.T) {
+		renamed := brand.UpdateBrandRequest{Name: "Apple Inc", Slug: "apple-inc"}
+
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Brand{ID: id, Slug: "apple"}, nil)
+		deps.repo.EXPECT().IsSlugRetired(ctx, "apple-inc", id).Return(true, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), renamed, nil, "")
+		assert.ErrorIs(t, err, branderrors.ErrSlugUnavailable)
+	})
+
 	t.Run("negative - invalid uuid format", func(t *testing.T) {
 		_, err := deps.service.Update(ctx, "invalid-uuid", req, nil, "")
 		assert.Error(t, err)
@@ -336,6 +386,42 @@ func TestBrandService_Update(t *testing.T) {
 	})
 }
 
+func TestBrandService_GetBySlug(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("positive - current slug", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(ctx, "apple").Return(dbgen.Brand{ID: id, Slug: "apple"}, nil)
+
+		res, err := deps.service.GetBySlug(ctx, "apple")
+		assert.NoError(t, err)
+		assert.Equal(t, "apple", res.Slug)
+	})
+
+	t.Run("positive - old slug returns moved", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(ctx, "apple").Return(dbgen.Brand{}, sql.ErrNoRows)
+		deps.repo.EXPECT().FindSlugOwner(ctx, "apple").Return(id, nil)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Brand{ID: id, Slug: "apple-inc"}, nil)
+
+		_, err := deps.service.GetBySlug(ctx, "apple")
+
+		var moved *apperror.MovedError
+		assert.True(t, errors.As(err, &moved))
+		assert.Equal(t, "apple-inc", moved.Slug)
+	})
+
+	t.Run("negative - unknown slug", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(ctx, "nokia").Return(dbgen.Brand{}, sql.ErrNoRows)
+		deps.repo.EXPECT().FindSlugOwner(ctx, "nokia").Return(uuid.Nil, sql.ErrNoRows)
+
+		_, err := deps.service.GetBySlug(ctx, "nokia")
+		assert.ErrorIs(t, err, branderrors.ErrBrandNotFound)
+	})
+}
+
 func TestBrandService_Delete(t *testing.T) {
 	deps := setupServiceTest(t)
 	defer deps.db.Close()
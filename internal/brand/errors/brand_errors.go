@@ -42,4 +42,11 @@ var (
 		"Invalid brand image URL",
 		http.StatusBadRequest,
 	)
+
+	// Slug bentrok dengan slug lama brand lain (slug_history)
+	ErrSlugUnavailable = apperror.New(
+		apperror.CodeConflict,
+		"Brand slug was previously used by another brand",
+		http.StatusConflict,
+	)
 )
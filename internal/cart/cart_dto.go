@@ -6,6 +6,7 @@ type CreateCartRequest struct {
 
 type AddItemRequest struct {
 	ProductID string `json:"productId" validate:"required"`
+	VariantID string `json:"variantId"` // wajib jika produk punya variant
 	Qty       int32  `json:"qty" validate:"required,min=1"`
 	Price     int32  `json:"price" validate:"required"`
 }
@@ -24,6 +25,8 @@ type CartItemDetailResponse struct {
 	ProductName     string `json:"name"`
 	ProductSlug     string `json:"slug"`
 	ProductImageUrl string `json:"imageUrl"`
+	VariantID       string `json:"variantId,omitempty"`
+	VariantName     string `json:"variantName,omitempty"`
 	Qty             int32  `json:"qty"`
 	Price           int32  `json:"price"`
 	CreatedAt       string `json:"createdAt"`
@@ -31,4 +34,43 @@ type CartItemDetailResponse struct {
 
 type CartDetailResponse struct {
 	Items []CartItemDetailResponse `json:"items"`
+	// Item yang disimpan untuk nanti, tidak ikut checkout
+	SavedItems []CartItemDetailResponse `json:"savedItems"`
+}
+
+// Status per baris hasil validasi cart
+const (
+	ItemStatusOK                = "ok"
+	ItemStatusPriceChanged      = "price_changed"
+	ItemStatusInsufficientStock = "insufficient_stock"
+	ItemStatusUnavailable       = "unavailable"
+	ItemStatusQtyLimitExceeded  = "qty_limit_exceeded"
+)
+
+type CartValidationItemResponse struct {
+	ID           string `json:"id"`
+	ProductID    string `json:"productId"`
+	ProductName  string `json:"name"`
+	ProductSlug  string `json:"slug"`
+	VariantID    string `json:"variantId,omitempty"`
+	VariantName  string `json:"variantName,omitempty"`
+	Qty          int32  `json:"qty"`
+	PriceAtAdd   int32  `json:"priceAtAdd"`
+	CurrentPrice int32  `json:"currentPrice"`
+	Stock        int32  `json:"stock"`
+	MaxQty       int32  `json:"maxQty,omitempty"`
+	Subtotal     int64  `json:"subtotal"`
+	Status       string `json:"status"`
+}
+
+type CartValidationResponse struct {
+	Items             []CartValidationItemResponse `json:"items"`
+	TotalQty          int32                        `json:"totalQty"`
+	TotalPrice        int64                        `json:"totalPrice"`
+	MaxQtyPerOrder    int32                        `json:"maxQtyPerOrder"`
+	HasBlockingIssues bool                         `json:"hasBlockingIssues"`
+}
+
+type GuestCartResponse struct {
+	CartToken string `json:"cartToken"`
 }
@@ -1,8 +1,13 @@
 package cart
 
 import (
+	"errors"
 	"net/http"
+	"os"
 
+	carterrors "go-gadget-api/internal/cart/errors"
+	"go-gadget-api/internal/pkg/apperror"
+	platform "go-gadget-api/internal/pkg/request"
 	"go-gadget-api/internal/pkg/response"
 	"go-gadget-api/internal/shared/contextutil"
 
@@ -61,6 +66,9 @@ func (h *Handler) AddItem(ctx *gin.Context) {
 	logger.Debug("adding item to cart", zap.String("product_id", productID))
 
 	if err := h.service.AddItem(ctx.Request.Context(), userID, req); err != nil {
+		if respondQtyLimitError(ctx, err) || respondVariantError(ctx, err) {
+			return
+		}
 		logger.Error("http cart add item service failed", zap.Error(err))
 		response.Error(ctx, http.StatusInternalServerError, "ADD_ITEM_ERROR", "Gagal menambah item ke cart", err.Error())
 		return
@@ -97,10 +105,25 @@ func (h *Handler) Detail(ctx *gin.Context) {
 	response.Success(ctx, http.StatusOK, res, nil)
 }
 
+func (h *Handler) Validate(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+	userID := getUserIDFromContext(ctx)
+
+	res, err := h.service.Validate(ctx.Request.Context(), userID)
+	if err != nil {
+		logger.Error("http cart validate service failed", zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "VALIDATE_ERROR", "Gagal memvalidasi cart", err.Error())
+		return
+	}
+
+	response.Success(ctx, http.StatusOK, res, nil)
+}
+
 func (h *Handler) UpdateQty(ctx *gin.Context) {
 	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
 	userID := getUserIDFromContext(ctx)
 	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
 
 	var req UpdateQtyRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -109,7 +132,10 @@ func (h *Handler) UpdateQty(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.service.UpdateQty(ctx.Request.Context(), userID, productID, req); err != nil {
+	if err := h.service.UpdateQty(ctx.Request.Context(), userID, productID, variantID, req); err != nil {
+		if respondQtyLimitError(ctx, err) {
+			return
+		}
 		logger.Error("http cart update qty service failed", zap.String("product_id", productID), zap.Error(err))
 		response.Error(ctx, http.StatusInternalServerError, "UPDATE_ERROR", "Gagal update quantity", err.Error())
 		return
@@ -122,8 +148,12 @@ func (h *Handler) Increment(ctx *gin.Context) {
 	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
 	userID := getUserIDFromContext(ctx)
 	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
 
-	if err := h.service.Increment(ctx.Request.Context(), userID, productID); err != nil {
+	if err := h.service.Increment(ctx.Request.Context(), userID, productID, variantID); err != nil {
+		if respondQtyLimitError(ctx, err) {
+			return
+		}
 		logger.Error("http cart increment failed", zap.String("product_id", productID), zap.Error(err))
 		response.Error(ctx, http.StatusInternalServerError, "INCREMENT_ERROR", "Gagal menambah item", err.Error())
 		return
@@ -135,8 +165,9 @@ func (h *Handler) Decrement(ctx *gin.Context) {
 	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
 	userID := getUserIDFromContext(ctx)
 	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
 
-	if err := h.service.Decrement(ctx.Request.Context(), userID, productID); err != nil {
+	if err := h.service.Decrement(ctx.Request.Context(), userID, productID, variantID); err != nil {
 		logger.Error("http cart decrement failed", zap.String("product_id", productID), zap.Error(err))
 		response.Error(ctx, http.StatusInternalServerError, "DECREMENT_ERROR", "Gagal mengurangi item", err.Error())
 		return
@@ -144,12 +175,44 @@ func (h *Handler) Decrement(ctx *gin.Context) {
 	response.Success(ctx, http.StatusOK, nil, nil)
 }
 
+func (h *Handler) SaveForLater(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+	userID := getUserIDFromContext(ctx)
+	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
+
+	if err := h.service.SaveForLater(ctx.Request.Context(), userID, productID, variantID); err != nil {
+		logger.Error("http cart save for later failed", zap.String("product_id", productID), zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "SAVE_FOR_LATER_ERROR", "Gagal menyimpan item untuk nanti", err.Error())
+		return
+	}
+	response.Success(ctx, http.StatusOK, nil, nil)
+}
+
+func (h *Handler) MoveToCart(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+	userID := getUserIDFromContext(ctx)
+	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
+
+	if err := h.service.MoveToCart(ctx.Request.Context(), userID, productID, variantID); err != nil {
+		if respondQtyLimitError(ctx, err) {
+			return
+		}
+		logger.Error("http cart move to cart failed", zap.String("product_id", productID), zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "MOVE_TO_CART_ERROR", "Gagal memindahkan item ke cart", err.Error())
+		return
+	}
+	response.Success(ctx, http.StatusOK, nil, nil)
+}
+
 func (h *Handler) DeleteItem(ctx *gin.Context) {
 	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
 	userID := getUserIDFromContext(ctx)
 	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
 
-	if err := h.service.DeleteItem(ctx.Request.Context(), userID, productID); err != nil {
+	if err := h.service.DeleteItem(ctx.Request.Context(), userID, productID, variantID); err != nil {
 		logger.Error("http cart delete item failed", zap.String("product_id", productID), zap.Error(err))
 		response.Error(ctx, http.StatusInternalServerError, "DELETE_ITEM_ERROR", "Gagal menghapus item", err.Error())
 		return
@@ -170,3 +233,153 @@ func (h *Handler) ClearCart(ctx *gin.Context) {
 
 	response.Success(ctx, http.StatusOK, nil, nil)
 }
+
+// ========================
+// guest cart
+// ========================
+
+// respondQtyLimitError menulis response 400 beserta batas maksimum
+// jika err adalah pelanggaran batas pembelian.
+func respondQtyLimitError(ctx *gin.Context, err error) bool {
+	var limitErr *carterrors.QtyLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+	response.Error(ctx, limitErr.HTTPStatus, limitErr.Code, limitErr.Message, gin.H{"maxQty": limitErr.MaxQty})
+	return true
+}
+
+// respondVariantError menulis response sesuai status error jika variant
+// tidak dipilih, tidak valid, atau tidak ditemukan.
+func respondVariantError(ctx *gin.Context, err error) bool {
+	if !errors.Is(err, carterrors.ErrVariantRequired) &&
+		!errors.Is(err, carterrors.ErrVariantNotFound) &&
+		!errors.Is(err, carterrors.ErrInvalidVariantID) {
+		return false
+	}
+	httpErr := apperror.ToHTTP(err)
+	response.Error(ctx, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+	return true
+}
+
+func getCartTokenFromRequest(ctx *gin.Context) string {
+	cookie, _ := ctx.Cookie(platform.CartTokenCookie)
+	return platform.ResolveCartToken(ctx.GetHeader(platform.CartTokenHeader), cookie)
+}
+
+func setCartTokenCookie(ctx *gin.Context, token string) {
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     platform.CartTokenCookie,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   3600 * 24 * 30,
+		HttpOnly: true,
+		Secure:   os.Getenv("APP_ENV") == "production",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (h *Handler) GuestCreate(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+
+	token, err := h.service.CreateGuest(ctx.Request.Context())
+	if err != nil {
+		logger.Error("http guest cart create failed", zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "CREATE_ERROR", "Gagal membuat cart", err.Error())
+		return
+	}
+
+	setCartTokenCookie(ctx, token)
+	response.Success(ctx, http.StatusCreated, GuestCartResponse{CartToken: token}, nil)
+}
+
+func (h *Handler) GuestCount(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+
+	count, err := h.service.GuestCount(ctx.Request.Context(), getCartTokenFromRequest(ctx))
+	if err != nil {
+		logger.Error("http guest cart count failed", zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "COUNT_ERROR", "Gagal hitung cart", err.Error())
+		return
+	}
+
+	response.Success(ctx, http.StatusOK, CartCountResponse{Count: count}, nil)
+}
+
+func (h *Handler) GuestDetail(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+
+	res, err := h.service.GuestDetail(ctx.Request.Context(), getCartTokenFromRequest(ctx))
+	if err != nil {
+		logger.Error("http guest cart detail failed", zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "DETAIL_ERROR", "Gagal mengambil detail cart", err.Error())
+		return
+	}
+
+	response.Success(ctx, http.StatusOK, res, nil)
+}
+
+func (h *Handler) GuestAddItem(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+	productID := ctx.Param("productId")
+
+	var req AddItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Warn("http guest cart add item validation failed", zap.Error(err))
+		response.Error(ctx, http.StatusBadRequest, "BAD_REQUEST", "Input tidak valid", err.Error())
+		return
+	}
+	req.ProductID = productID
+
+	token, err := h.service.GuestAddItem(ctx.Request.Context(), getCartTokenFromRequest(ctx), req)
+	if err != nil {
+		if respondQtyLimitError(ctx, err) || respondVariantError(ctx, err) {
+			return
+		}
+		logger.Error("http guest cart add item failed", zap.String("product_id", productID), zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "ADD_ITEM_ERROR", "Gagal menambah item ke cart", err.Error())
+		return
+	}
+
+	// token bisa baru jika cart sebelumnya sudah tidak ada
+	setCartTokenCookie(ctx, token)
+	response.Success(ctx, http.StatusCreated, GuestCartResponse{CartToken: token}, nil)
+}
+
+func (h *Handler) GuestUpdateQty(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
+
+	var req UpdateQtyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		logger.Warn("http guest cart update qty validation failed", zap.Error(err))
+		response.Error(ctx, http.StatusBadRequest, "BAD_REQUEST", "Input tidak valid", err.Error())
+		return
+	}
+
+	if err := h.service.GuestUpdateQty(ctx.Request.Context(), getCartTokenFromRequest(ctx), productID, variantID, req); err != nil {
+		if respondQtyLimitError(ctx, err) {
+			return
+		}
+		logger.Error("http guest cart update qty failed", zap.String("product_id", productID), zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "UPDATE_ERROR", "Gagal update quantity", err.Error())
+		return
+	}
+
+	response.Success(ctx, http.StatusOK, nil, nil)
+}
+
+func (h *Handler) GuestDeleteItem(ctx *gin.Context) {
+	logger := contextutil.GetLogger(ctx.Request.Context(), h.logger)
+	productID := ctx.Param("productId")
+	variantID := ctx.Query("variantId")
+
+	if err := h.service.GuestDeleteItem(ctx.Request.Context(), getCartTokenFromRequest(ctx), productID, variantID); err != nil {
+		logger.Error("http guest cart delete item failed", zap.String("product_id", productID), zap.Error(err))
+		response.Error(ctx, http.StatusInternalServerError, "DELETE_ITEM_ERROR", "Gagal menghapus item", err.Error())
+		return
+	}
+
+	response.Success(ctx, http.StatusOK, nil, nil)
+}
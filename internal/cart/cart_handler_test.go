@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -18,12 +19,16 @@ type fakeCartService struct {
 	CreateFn     func(ctx context.Context, userID string) error
 	CountFn      func(ctx context.Context, userID string) (int64, error)
 	DetailFn     func(ctx context.Context, userID string) (cart.CartDetailResponse, error)
+	ValidateFn   func(ctx context.Context, userID string) (cart.CartValidationResponse, error)
 	AddItemFn    func(ctx context.Context, userID string, req cart.AddItemRequest) error
-	UpdateQtyFn  func(ctx context.Context, userID, productID string, req cart.UpdateQtyRequest) error
-	IncrementFn  func(ctx context.Context, userID, productID string) error
-	DecrementFn  func(ctx context.Context, userID, productID string) error
-	DeleteItemFn func(ctx context.Context, userID, productID string) error
+	UpdateQtyFn  func(ctx context.Context, userID, productID, variantID string, req cart.UpdateQtyRequest) error
+	IncrementFn  func(ctx context.Context, userID, productID, variantID string) error
+	DecrementFn  func(ctx context.Context, userID, productID, variantID string) error
+	DeleteItemFn func(ctx context.Context, userID, productID, variantID string) error
 	DeleteFn     func(ctx context.Context, userID string) error
+
+	GuestAddItemFn func(ctx context.Context, cartToken string, req cart.AddItemRequest) (string, error)
+	MergeGuestFn   func(ctx context.Context, userID, cartToken string) error
 }
 
 func (f *fakeCartService) Create(ctx context.Context, userID string) error {
@@ -35,23 +40,26 @@ func (f *fakeCartService) Count(ctx context.Context, userID string) (int64, erro
 func (f *fakeCartService) Detail(ctx context.Context, userID string) (cart.CartDetailResponse, error) {
 	return f.DetailFn(ctx, userID)
 }
+func (f *fakeCartService) Validate(ctx context.Context, userID string) (cart.CartValidationResponse, error) {
+	return f.ValidateFn(ctx, userID)
+}
 func (f *fakeCartService) AddItem(ctx context.Context, userID string, req cart.AddItemRequest) error {
 	if f.AddItemFn == nil {
 		return nil
 	}
 	return f.AddItemFn(ctx, userID, req)
 }
-func (f *fakeCartService) UpdateQty(ctx context.Context, userID, productID string, req cart.UpdateQtyRequest) error {
-	return f.UpdateQtyFn(ctx, userID, productID, req)
+func (f *fakeCartService) UpdateQty(ctx context.Context, userID, productID, variantID string, req cart.UpdateQtyRequest) error {
+	return f.UpdateQtyFn(ctx, userID, productID, variantID, req)
 }
-func (f *fakeCartService) Increment(ctx context.Context, userID, productID string) error {
-	return f.IncrementFn(ctx, userID, productID)
+func (f *fakeCartService) Increment(ctx context.Context, userID, productID, variantID string) error {
+	return f.IncrementFn(ctx, userID, productID, variantID)
 }
-func (f *fakeCartService) Decrement(ctx context.Context, userID, productID string) error {
-	return f.DecrementFn(ctx, userID, productID)
+func (f *fakeCartService) Decrement(ctx context.Context, userID, productID, variantID string) error {
+	return f.DecrementFn(ctx, userID, productID, variantID)
 }
-func (f *fakeCartService) DeleteItem(ctx context.Context, userID, productID string) error {
-	return f.DeleteItemFn(ctx, userID, productID)
+func (f *fakeCartService) DeleteItem(ctx context.Context, userID, productID, variantID string) error {
+	return f.DeleteItemFn(ctx, userID, productID, variantID)
 }
 func (f *fakeCartService) Delete(ctx context.Context, userID string) error {
 	return f.DeleteFn(ctx, userID)
@@ -61,6 +69,44 @@ func (f *fakeCartService) ClearCart(ctx context.Context, cartID string) error {
 	return f.DeleteFn(ctx, cartID)
 }
 
+func (f *fakeCartService) SaveForLater(ctx context.Context, userID, productID, variantID string) error {
+	return nil
+}
+func (f *fakeCartService) MoveToCart(ctx context.Context, userID, productID, variantID string) error {
+	return nil
+}
+
+func (f *fakeCartService) CreateGuest(ctx context.Context) (string, error) {
+	return "guest-token", nil
+}
+func (f *fakeCartService) GuestCount(ctx context.Context, cartToken string) (int64, error) {
+	return 0, nil
+}
+func (f *fakeCartService) GuestDetail(ctx context.Context, cartToken string) (cart.CartDetailResponse, error) {
+	return cart.CartDetailResponse{}, nil
+}
+func (f *fakeCartService) GuestAddItem(ctx context.Context, cartToken string, req cart.AddItemRequest) (string, error) {
+	if f.GuestAddItemFn == nil {
+		return cartToken, nil
+	}
+	return f.GuestAddItemFn(ctx, cartToken, req)
+}
+func (f *fakeCartService) GuestUpdateQty(ctx context.Context, cartToken, productID, variantID string, req cart.UpdateQtyRequest) error {
+	return nil
+}
+func (f *fakeCartService) GuestDeleteItem(ctx context.Context, cartToken, productID, variantID string) error {
+	return nil
+}
+func (f *fakeCartService) MergeGuestCart(ctx context.Context, userID, cartToken string) error {
+	if f.MergeGuestFn == nil {
+		return nil
+	}
+	return f.MergeGuestFn(ctx, userID, cartToken)
+}
+func (f *fakeCartService) CleanupGuestCarts(ctx context.Context, olderThan time.Duration) (int64, error) {
+	return 0, nil
+}
+
 // ==================== HELPER FUNCTIONS ====================
 
 func setupTestRouter() *gin.Engine {
@@ -142,7 +188,7 @@ func TestCartHandler_Count(t *testing.T) {
 func TestCartHandler_UpdateQty(t *testing.T) {
 	t.Run("success_update_qty", func(t *testing.T) {
 		svc := &fakeCartService{
-			UpdateQtyFn: func(ctx context.Context, userID, productID string, req cart.UpdateQtyRequest) error {
+			UpdateQtyFn: func(ctx context.Context, userID, productID, variantID string, req cart.UpdateQtyRequest) error {
 				assert.Equal(t, int32(2), req.Qty)
 				return nil
 			},
@@ -187,8 +233,8 @@ func TestCartHandler_UpdateQty(t *testing.T) {
 
 func TestCartHandler_IncrementDecrement(t *testing.T) {
 	svc := &fakeCartService{
-		IncrementFn: func(ctx context.Context, userID, productID string) error { return nil },
-		DecrementFn: func(ctx context.Context, userID, productID string) error { return nil },
+		IncrementFn: func(ctx context.Context, userID, productID, variantID string) error { return nil },
+		DecrementFn: func(ctx context.Context, userID, productID, variantID string) error { return nil },
 	}
 
 	ctrl := newTestHandler(svc)
@@ -224,7 +270,7 @@ func TestCartHandler_IncrementDecrement(t *testing.T) {
 
 func TestCartHandler_Delete(t *testing.T) {
 	svc := &fakeCartService{
-		DeleteItemFn: func(ctx context.Context, userID, productID string) error { return nil },
+		DeleteItemFn: func(ctx context.Context, userID, productID, variantID string) error { return nil },
 		DeleteFn:     func(ctx context.Context, userID string) error { return nil },
 	}
 
@@ -256,3 +302,29 @@ func TestCartHandler_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 }
+
+func TestCartHandler_GuestAddItem(t *testing.T) {
+	t.Run("uses_token_from_header_and_sets_cookie", func(t *testing.T) {
+		svc := &fakeCartService{
+			GuestAddItemFn: func(ctx context.Context, cartToken string, req cart.AddItemRequest) (string, error) {
+				assert.Equal(t, "guest-abc", cartToken)
+				assert.Equal(t, "prod-1", req.ProductID)
+				return cartToken, nil
+			},
+		}
+
+		ctrl := newTestHandler(svc)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/carts/guest/items/prod-1", strings.NewReader(`{"qty":1,"price":1000}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Request.Header.Set("X-Cart-Token", "guest-abc")
+		c.Params = gin.Params{{Key: "productId", Value: "prod-1"}}
+
+		ctrl.GuestAddItem(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"cartToken":"guest-abc"`)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "cart_token=guest-abc")
+	})
+}
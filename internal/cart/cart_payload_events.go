@@ -0,0 +1,10 @@
+package cart
+
+// Event outbox yang dipublish worker ketika cart user lama tidak disentuh
+const EventCartAbandoned = "CART_ABANDONED"
+
+type CartAbandonedPayload struct {
+	CartID         string `json:"cart_id"`
+	UserID         string `json:"user_id"`
+	LastActivityAt string `json:"last_activity_at"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-gadget-api/internal/shared/database/dbgen"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,21 +15,46 @@ type Repository interface {
 
 	CreateCart(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error)
+	// Row lock (FOR UPDATE), hanya bermakna di dalam transaksi
+	Lock(ctx context.Context, cartID uuid.UUID) error
 
 	Count(ctx context.Context, cartID uuid.UUID) (int64, error)
 	GetDetail(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartDetailRow, error)
+	GetValidation(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartValidationRow, error)
 
 	// ⬇️ TAMBAHAN
-	GetItemByCartAndProduct(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error)
+	// variantID kosong (Valid=false) = produk tanpa variant
+	GetItemByCartAndProduct(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error)
 
 	AddItem(ctx context.Context, arg dbgen.AddCartItemParams) error
 	UpdateQty(ctx context.Context, arg dbgen.UpdateCartItemQtyParams) (dbgen.CartItem, error)
-	IncrementQty(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error)
-	DecrementQty(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error)
+	IncrementQty(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error)
+	DecrementQty(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error)
 
-	DeleteItem(ctx context.Context, cartID, productID uuid.UUID) error
+	DeleteItem(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) error
 	Delete(ctx context.Context, cartID uuid.UUID) error
 	DeleteAllItems(ctx context.Context, cartID uuid.UUID) error
+	DeleteActiveItems(ctx context.Context, cartID uuid.UUID) error
+
+	// Batas pembelian
+	GetProductPurchaseLimit(ctx context.Context, productID uuid.UUID) (sql.NullInt32, error)
+	SumProductQty(ctx context.Context, cartID, productID uuid.UUID) (int64, error)
+
+	// Variant produk
+	ProductHasVariants(ctx context.Context, productID uuid.UUID) (bool, error)
+	GetVariant(ctx context.Context, productID, variantID uuid.UUID) (dbgen.ProductVariant, error)
+
+	// Save for later
+	SetSavedForLater(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID, saved bool) (dbgen.CartItem, error)
+
+	// Guest cart (anonim, diidentifikasi lewat cart token)
+	CreateGuestCart(ctx context.Context, cartToken string) (dbgen.Cart, error)
+	GetByToken(ctx context.Context, cartToken string) (dbgen.Cart, error)
+	GetDetailByToken(ctx context.Context, cartToken string) ([]dbgen.GetCartDetailByTokenRow, error)
+	ListItemsWithStock(ctx context.Context, cartID uuid.UUID) ([]dbgen.ListCartItemsWithStockRow, error)
+	SetItemQty(ctx context.Context, arg dbgen.UpsertCartItemQtyParams) error
+	Touch(ctx context.Context, cartID uuid.UUID) error
+	DeleteStaleGuestCarts(ctx context.Context, before time.Time) (int64, error)
 }
 
 type repository struct {
@@ -50,11 +76,11 @@ func (r *repository) WithTx(tx dbgen.DBTX) Repository {
 }
 
 func (r *repository) CreateCart(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error) {
-	return r.queries.CreateCart(ctx, userID)
+	return r.queries.CreateCart(ctx, uuid.NullUUID{UUID: userID, Valid: true})
 }
 
 func (r *repository) GetByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Cart, error) {
-	return r.queries.GetCartByUserID(ctx, userID)
+	return r.queries.GetCartByUserID(ctx, uuid.NullUUID{UUID: userID, Valid: true})
 }
 
 func (r *repository) Count(ctx context.Context, cartID uuid.UUID) (int64, error) {
@@ -62,16 +88,22 @@ func (r *repository) Count(ctx context.Context, cartID uuid.UUID) (int64, error)
 }
 
 func (r *repository) GetDetail(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartDetailRow, error) {
-	return r.queries.GetCartDetail(ctx, userID)
+	return r.queries.GetCartDetail(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+}
+
+func (r *repository) GetValidation(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartValidationRow, error) {
+	return r.queries.GetCartValidation(ctx, uuid.NullUUID{UUID: userID, Valid: true})
 }
 
 func (r *repository) GetItemByCartAndProduct(
 	ctx context.Context,
 	cartID, productID uuid.UUID,
+	variantID uuid.NullUUID,
 ) (dbgen.CartItem, error) {
 	return r.queries.GetCartItemByCartAndProduct(ctx, dbgen.GetCartItemByCartAndProductParams{
 		CartID:    cartID,
 		ProductID: productID,
+		VariantID: variantID,
 	})
 }
 
@@ -86,27 +118,32 @@ func (r *repository) UpdateQty(ctx context.Context, arg dbgen.UpdateCartItemQtyP
 func (r *repository) IncrementQty(
 	ctx context.Context,
 	cartID, productID uuid.UUID,
+	variantID uuid.NullUUID,
 ) (dbgen.CartItem, error) {
 	return r.queries.IncrementCartItemQty(ctx, dbgen.IncrementCartItemQtyParams{
 		CartID:    cartID,
 		ProductID: productID,
+		VariantID: variantID,
 	})
 }
 
 func (r *repository) DecrementQty(
 	ctx context.Context,
 	cartID, productID uuid.UUID,
+	variantID uuid.NullUUID,
 ) (dbgen.CartItem, error) {
 	return r.queries.DecrementCartItemQty(ctx, dbgen.DecrementCartItemQtyParams{
 		CartID:    cartID,
 		ProductID: productID,
+		VariantID: variantID,
 	})
 }
 
-func (r *repository) DeleteItem(ctx context.Context, cartID, productID uuid.UUID) error {
+func (r *repository) DeleteItem(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	return r.queries.DeleteCartItem(ctx, dbgen.DeleteCartItemParams{
 		CartID:    cartID,
 		ProductID: productID,
+		VariantID: variantID,
 	})
 }
 
@@ -117,3 +154,76 @@ func (r *repository) Delete(ctx context.Context, cartID uuid.UUID) error {
 func (r *repository) DeleteAllItems(ctx context.Context, cartID uuid.UUID) error {
 	return r.queries.DeleteAllCartItems(ctx, cartID)
 }
+
+func (r *repository) DeleteActiveItems(ctx context.Context, cartID uuid.UUID) error {
+	return r.queries.DeleteActiveCartItems(ctx, cartID)
+}
+
+func (r *repository) GetProductPurchaseLimit(ctx context.Context, productID uuid.UUID) (sql.NullInt32, error) {
+	return r.queries.GetProductPurchaseLimit(ctx, productID)
+}
+
+func (r *repository) SumProductQty(ctx context.Context, cartID, productID uuid.UUID) (int64, error) {
+	return r.queries.SumCartProductQty(ctx, dbgen.SumCartProductQtyParams{
+		CartID:    cartID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) ProductHasVariants(ctx context.Context, productID uuid.UUID) (bool, error) {
+	return r.queries.ProductHasVariants(ctx, productID)
+}
+
+func (r *repository) GetVariant(ctx context.Context, productID, variantID uuid.UUID) (dbgen.ProductVariant, error) {
+	return r.queries.GetProductVariantByID(ctx, dbgen.GetProductVariantByIDParams{
+		ID:        variantID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) SetSavedForLater(
+	ctx context.Context,
+	cartID, productID uuid.UUID,
+	variantID uuid.NullUUID,
+	saved bool,
+) (dbgen.CartItem, error) {
+	return r.queries.SetCartItemSavedForLater(ctx, dbgen.SetCartItemSavedForLaterParams{
+		CartID:        cartID,
+		ProductID:     productID,
+		SavedForLater: saved,
+		VariantID:     variantID,
+	})
+}
+
+func (r *repository) CreateGuestCart(ctx context.Context, cartToken string) (dbgen.Cart, error) {
+	return r.queries.CreateGuestCart(ctx, sql.NullString{String: cartToken, Valid: true})
+}
+
+func (r *repository) Lock(ctx context.Context, cartID uuid.UUID) error {
+	_, err := r.queries.LockCart(ctx, cartID)
+	return err
+}
+
+func (r *repository) GetByToken(ctx context.Context, cartToken string) (dbgen.Cart, error) {
+	return r.queries.GetCartByToken(ctx, sql.NullString{String: cartToken, Valid: true})
+}
+
+func (r *repository) GetDetailByToken(ctx context.Context, cartToken string) ([]dbgen.GetCartDetailByTokenRow, error) {
+	return r.queries.GetCartDetailByToken(ctx, sql.NullString{String: cartToken, Valid: true})
+}
+
+func (r *repository) ListItemsWithStock(ctx context.Context, cartID uuid.UUID) ([]dbgen.ListCartItemsWithStockRow, error) {
+	return r.queries.ListCartItemsWithStock(ctx, cartID)
+}
+
+func (r *repository) SetItemQty(ctx context.Context, arg dbgen.UpsertCartItemQtyParams) error {
+	return r.queries.UpsertCartItemQty(ctx, arg)
+}
+
+func (r *repository) Touch(ctx context.Context, cartID uuid.UUID) error {
+	return r.queries.TouchCart(ctx, cartID)
+}
+
+func (r *repository) DeleteStaleGuestCarts(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteStaleGuestCarts(ctx, before)
+}
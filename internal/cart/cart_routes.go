@@ -8,6 +8,22 @@ import (
 )
 
 func RegisterRoutes(r *gin.RouterGroup, handler *Handler, logger *zap.Logger) {
+	// 0. Guest Cart (tanpa login)
+	// Diidentifikasi lewat header X-Cart-Token / cookie cart_token.
+	// Isi cart ini di-merge ke cart user saat login/register.
+	guest := r.Group("/carts/guest")
+	guest.Use(middleware.ContextLogger(logger))
+	{
+		guest.GET("/detail", middleware.RateLimitByIP(5, 10), handler.GuestDetail)
+		guest.GET("/count", middleware.RateLimitByIP(5, 10), handler.GuestCount)
+		guest.POST("", middleware.RateLimitByIP(1, 2), handler.GuestCreate)
+
+		guestItemLimit := middleware.RateLimitByIP(2, 4)
+		guest.POST("/items/:productId", guestItemLimit, handler.GuestAddItem)
+		guest.PATCH("/items/:productId", guestItemLimit, handler.GuestUpdateQty)
+		guest.DELETE("/items/:productId", guestItemLimit, handler.GuestDeleteItem)
+	}
+
 	carts := r.Group("/carts")
 	carts.Use(middleware.AuthMiddleware())
 	carts.Use(middleware.ContextLogger(logger))
@@ -18,6 +34,8 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler, logger *zap.Logger) {
 		// Diberi kelonggaran: 5 req/sec.
 		carts.GET("/detail", middleware.RateLimitByUser(5, 10), handler.Detail)
 		carts.GET("/count", middleware.RateLimitByUser(5, 10), handler.Count)
+		// Cek harga/stok/ketersediaan terkini sebelum checkout
+		carts.GET("/validate", middleware.RateLimitByUser(5, 10), handler.Validate)
 
 		// 2. Inisialisasi/Hapus Cart
 		// Operasi ini cukup berat dan jarang dilakukan berturut-turut.
@@ -29,6 +47,7 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler, logger *zap.Logger) {
 		// 3. Item Management (Sub-Group)
 		// Operasi penambahan/pengurangan qty sangat rawan spamming.
 		// Dibatasi: 2 req/sec (cukup untuk user yang mengklik cepat tombol +/-)
+		// Produk bervariant: sertakan ?variantId= untuk menunjuk baris cart yang tepat.
 		items := carts.Group("/items/:productId")
 		{
 			itemMutationLimit := middleware.RateLimitByUser(2, 4)
@@ -38,6 +57,10 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler, logger *zap.Logger) {
 			items.POST("/increment", itemMutationLimit, handler.Increment)
 			items.POST("/decrement", itemMutationLimit, handler.Decrement)
 			items.DELETE("", itemMutationLimit, handler.DeleteItem)
+
+			// Pindah antara cart aktif dan daftar "simpan untuk nanti"
+			items.POST("/save-for-later", itemMutationLimit, handler.SaveForLater)
+			items.POST("/move-to-cart", itemMutationLimit, handler.MoveToCart)
 		}
 	}
 }
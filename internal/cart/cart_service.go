@@ -2,7 +2,13 @@ package cart
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
+	"math"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	autherrors "go-gadget-api/internal/auth/errors"
@@ -19,30 +25,60 @@ type Service interface {
 	Create(ctx context.Context, userID string) error
 	Count(ctx context.Context, userID string) (int64, error)
 	Detail(ctx context.Context, userID string) (CartDetailResponse, error)
+	Validate(ctx context.Context, userID string) (CartValidationResponse, error)
 
 	AddItem(ctx context.Context, userID string, req AddItemRequest) error
-	UpdateQty(ctx context.Context, userID, productID string, req UpdateQtyRequest) error
+	// variantID kosong untuk produk tanpa variant
+	UpdateQty(ctx context.Context, userID, productID, variantID string, req UpdateQtyRequest) error
 
-	Increment(ctx context.Context, userID, productID string) error
-	Decrement(ctx context.Context, userID, productID string) error
+	Increment(ctx context.Context, userID, productID, variantID string) error
+	Decrement(ctx context.Context, userID, productID, variantID string) error
 
-	DeleteItem(ctx context.Context, userID, productID string) error
+	DeleteItem(ctx context.Context, userID, productID, variantID string) error
 	Delete(ctx context.Context, userID string) error
 	ClearCart(ctx context.Context, userID string) error
+
+	SaveForLater(ctx context.Context, userID, productID, variantID string) error
+	MoveToCart(ctx context.Context, userID, productID, variantID string) error
+
+	// Guest cart (sebelum login), diidentifikasi lewat cart token
+	CreateGuest(ctx context.Context) (string, error)
+	GuestCount(ctx context.Context, cartToken string) (int64, error)
+	GuestDetail(ctx context.Context, cartToken string) (CartDetailResponse, error)
+	GuestAddItem(ctx context.Context, cartToken string, req AddItemRequest) (string, error)
+	GuestUpdateQty(ctx context.Context, cartToken, productID, variantID string, req UpdateQtyRequest) error
+	GuestDeleteItem(ctx context.Context, cartToken, productID, variantID string) error
+
+	MergeGuestCart(ctx context.Context, userID, cartToken string) error
+	CleanupGuestCarts(ctx context.Context, olderThan time.Duration) (int64, error)
 }
 
 type service struct {
-	repo     Repository
-	validate *validator.Validate
-	db       *sql.DB
+	repo        Repository
+	validate    *validator.Validate
+	db          *sql.DB
+	maxOrderQty int32
 }
 
 func NewService(db *sql.DB, r Repository) Service {
 	return &service{
-		db:       db,
-		repo:     r,
-		validate: validator.New(),
+		db:          db,
+		repo:        r,
+		validate:    validator.New(),
+		maxOrderQty: MaxQtyPerOrder(),
+	}
+}
+
+// defaultMaxQtyPerOrder dipakai jika CART_MAX_QTY_PER_ORDER kosong/invalid
+const defaultMaxQtyPerOrder = 50
+
+// MaxQtyPerOrder adalah batas total qty (semua produk) dalam satu order
+func MaxQtyPerOrder() int32 {
+	v, err := strconv.Atoi(os.Getenv("CART_MAX_QTY_PER_ORDER"))
+	if err != nil || v <= 0 {
+		return defaultMaxQtyPerOrder
 	}
+	return int32(v)
 }
 
 // ========================
@@ -65,6 +101,57 @@ func (s *service) parseProductID(productID string) (uuid.UUID, error) {
 	return id, nil
 }
 
+// parseVariantID: string kosong berarti item tanpa variant
+func (s *service) parseVariantID(variantID string) (uuid.NullUUID, error) {
+	if strings.TrimSpace(variantID) == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(variantID)
+	if err != nil {
+		return uuid.NullUUID{}, carterrors.ErrInvalidVariantID
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+func (s *service) parseItemKey(productID, variantID string) (uuid.UUID, uuid.NullUUID, error) {
+	pid, err := s.parseProductID(productID)
+	if err != nil {
+		return uuid.Nil, uuid.NullUUID{}, err
+	}
+	vid, err := s.parseVariantID(variantID)
+	if err != nil {
+		return uuid.Nil, uuid.NullUUID{}, err
+	}
+	return pid, vid, nil
+}
+
+// checkVariant memastikan produk bervariant hanya bisa masuk cart
+// dengan variant yang valid & aktif milik produk tersebut.
+func checkVariant(ctx context.Context, repo Repository, productID uuid.UUID, variantID uuid.NullUUID) error {
+	if !variantID.Valid {
+		hasVariants, err := repo.ProductHasVariants(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if hasVariants {
+			return carterrors.ErrVariantRequired
+		}
+		return nil
+	}
+
+	variant, err := repo.GetVariant(ctx, productID, variantID.UUID)
+	if err == sql.ErrNoRows {
+		return carterrors.ErrVariantNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !variant.IsActive {
+		return carterrors.ErrVariantNotFound
+	}
+	return nil
+}
+
 func (s *service) getCartOnly(ctx context.Context, uid uuid.UUID) (uuid.UUID, error) {
 	cart, err := s.repo.GetByUserID(ctx, uid)
 	if err != nil {
@@ -89,6 +176,102 @@ func (s *service) getOrCreateCart(ctx context.Context, uid uuid.UUID) (uuid.UUID
 	return cart.ID, nil
 }
 
+// lockCart mengunci row cart (FOR UPDATE) sebelum cek batas qty, supaya dua request
+// pada cart yang sama tidak sama-sama lolos cek lalu melewati batas. repo harus hasil WithTx.
+func lockCart(ctx context.Context, repo Repository, cartID uuid.UUID) error {
+	if err := repo.Lock(ctx, cartID); err != nil {
+		if err == sql.ErrNoRows {
+			return carterrors.ErrCartNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// lockUserCart seperti getCartOnly, tetapi lewat repo transaksi dan row cart dikunci
+func lockUserCart(ctx context.Context, repo Repository, uid uuid.UUID) (uuid.UUID, error) {
+	cart, err := repo.GetByUserID(ctx, uid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, carterrors.ErrCartNotFound
+		}
+		return uuid.Nil, err
+	}
+	return cart.ID, lockCart(ctx, repo, cart.ID)
+}
+
+// checkQtyLimits memastikan perubahan qty aktif sebesar delta tidak membuat qty satu produk
+// (semua variant digabung) maupun total qty cart melebihi batas per produk / per order.
+func (s *service) checkQtyLimits(ctx context.Context, repo Repository, cartID, productID uuid.UUID, delta int64) error {
+	limit, err := repo.GetProductPurchaseLimit(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return producterrors.ErrProductNotFound
+		}
+		return err
+	}
+
+	if limit.Valid {
+		productQty, err := repo.SumProductQty(ctx, cartID, productID)
+		if err != nil {
+			return err
+		}
+		if productQty+delta > int64(limit.Int32) {
+			return carterrors.NewQtyLimitError(carterrors.ErrQtyExceedsProductLimit, limit.Int32)
+		}
+	}
+
+	totalQty, err := repo.Count(ctx, cartID)
+	if err != nil {
+		return err
+	}
+	if s.maxOrderQty > 0 && totalQty+delta > int64(s.maxOrderQty) {
+		return carterrors.NewQtyLimitError(carterrors.ErrQtyExceedsOrderLimit, s.maxOrderQty)
+	}
+
+	return nil
+}
+
+// qtyRoom menghitung sisa qty aktif yang masih boleh ditambahkan untuk productID
+// (minimum dari sisa batas produk dan sisa batas per order), dipakai saat merge cart guest.
+func (s *service) qtyRoom(ctx context.Context, repo Repository, cartID, productID uuid.UUID) (int64, error) {
+	room := int64(math.MaxInt32)
+
+	limit, err := repo.GetProductPurchaseLimit(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if limit.Valid {
+		productQty, err := repo.SumProductQty(ctx, cartID, productID)
+		if err != nil {
+			return 0, err
+		}
+		room = int64(limit.Int32) - productQty
+	}
+
+	if s.maxOrderQty > 0 {
+		totalQty, err := repo.Count(ctx, cartID)
+		if err != nil {
+			return 0, err
+		}
+		room = min(room, int64(s.maxOrderQty)-totalQty)
+	}
+
+	return max(room, 0), nil
+}
+
+// getItemOrEmpty mengembalikan item kosong jika produk (variant) belum ada di cart
+func getItemOrEmpty(ctx context.Context, repo Repository, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error) {
+	item, err := repo.GetItemByCartAndProduct(ctx, cartID, productID, variantID)
+	if err == sql.ErrNoRows {
+		return dbgen.CartItem{}, nil
+	}
+	return item, err
+}
+
 func (s *service) Create(ctx context.Context, userID string) error {
 	uid, err := s.parseUserID(userID)
 	if err != nil {
@@ -109,7 +292,7 @@ func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest
 		return err
 	}
 
-	pid, err := s.parseProductID(req.ProductID)
+	pid, vid, err := s.parseItemKey(req.ProductID, req.VariantID)
 	if err != nil {
 		return err
 	}
@@ -122,6 +305,10 @@ func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest
 
 	repo := s.repo.WithTx(tx)
 
+	if err := checkVariant(ctx, repo, pid, vid); err != nil {
+		return err
+	}
+
 	// get or create cart (LOCKED inside tx)
 	cartID, err := func() (uuid.UUID, error) {
 		cart, err := repo.GetByUserID(ctx, uid)
@@ -141,6 +328,23 @@ func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest
 	if err != nil {
 		return err
 	}
+	if err := lockCart(ctx, repo, cartID); err != nil {
+		return err
+	}
+
+	// cek batas pembelian (qty lama + qty baru)
+	existing, err := getItemOrEmpty(ctx, repo, cartID, pid, vid)
+	if err != nil {
+		return err
+	}
+	delta := int64(req.Qty)
+	if existing.SavedForLater {
+		// item "simpan untuk nanti" akan kembali aktif
+		delta += int64(existing.Quantity)
+	}
+	if err := s.checkQtyLimits(ctx, repo, cartID, pid, delta); err != nil {
+		return err
+	}
 
 	// insert new item
 	if err := repo.AddItem(ctx, dbgen.AddCartItemParams{
@@ -148,6 +352,7 @@ func (s *service) AddItem(ctx context.Context, userID string, req AddItemRequest
 		ProductID:  pid,
 		Quantity:   req.Qty,
 		PriceAtAdd: req.Price,
+		VariantID:  vid,
 	}); err != nil {
 		return err
 	}
@@ -184,23 +389,124 @@ func (s *service) Detail(ctx context.Context, userID string) (CartDetailResponse
 	}
 
 	items := make([]CartItemDetailResponse, 0, len(rows))
+	savedItems := make([]CartItemDetailResponse, 0)
 	for _, r := range rows {
-		items = append(items, CartItemDetailResponse{
+		item := CartItemDetailResponse{
 			ID:              r.ID.String(),
 			ProductID:       r.ProductID.String(),
 			ProductName:     r.ProductName,
 			ProductSlug:     r.ProductSlug,
 			ProductImageUrl: r.ProductImageUrl.String,
+			VariantID:       nullUUIDString(r.VariantID),
+			VariantName:     r.VariantName.String,
 			Qty:             r.Quantity,
 			Price:           r.PriceAtAdd,
 			CreatedAt:       r.CreatedAt.Format(time.RFC3339),
-		})
+		}
+
+		if r.SavedForLater {
+			savedItems = append(savedItems, item)
+			continue
+		}
+		items = append(items, item)
 	}
 
-	return CartDetailResponse{Items: items}, nil
+	return CartDetailResponse{Items: items, SavedItems: savedItems}, nil
+}
+
+// Validate membandingkan isi cart dengan kondisi produk terkini
+// (aktif/terhapus, harga, stok) dan menghitung ulang total cart.
+func (s *service) Validate(ctx context.Context, userID string) (CartValidationResponse, error) {
+	uid, err := s.parseUserID(userID)
+	if err != nil {
+		return CartValidationResponse{}, err
+	}
+
+	rows, err := s.repo.GetValidation(ctx, uid)
+	if err != nil {
+		return CartValidationResponse{}, err
+	}
+
+	res := CartValidationResponse{
+		Items:          make([]CartValidationItemResponse, 0, len(rows)),
+		MaxQtyPerOrder: s.maxOrderQty,
+	}
+
+	// batas per produk berlaku untuk gabungan semua variant-nya
+	productQty := make(map[uuid.UUID]int32, len(rows))
+	for _, r := range rows {
+		productQty[r.ProductID] += r.Quantity
+	}
+
+	for _, r := range rows {
+		item := CartValidationItemResponse{
+			ID:           r.ID.String(),
+			ProductID:    r.ProductID.String(),
+			ProductName:  r.ProductName,
+			ProductSlug:  r.ProductSlug,
+			VariantID:    nullUUIDString(r.VariantID),
+			VariantName:  r.VariantName.String,
+			Qty:          r.Quantity,
+			PriceAtAdd:   r.PriceAtAdd,
+			CurrentPrice: effectivePrice(r.CurrentPrice, r.CurrentDiscountPrice),
+			Stock:        r.Stock,
+			MaxQty:       r.MaxQtyPerOrder.Int32,
+			Status:       ItemStatusOK,
+		}
+
+		switch {
+		case r.DeletedAt.Valid || (r.IsActive.Valid && !r.IsActive.Bool) || r.Stock <= 0:
+			item.Status = ItemStatusUnavailable
+		case r.Quantity > r.Stock:
+			item.Status = ItemStatusInsufficientStock
+		case r.MaxQtyPerOrder.Valid && productQty[r.ProductID] > r.MaxQtyPerOrder.Int32:
+			item.Status = ItemStatusQtyLimitExceeded
+		case item.CurrentPrice != r.PriceAtAdd:
+			item.Status = ItemStatusPriceChanged
+		}
+
+		switch item.Status {
+		case ItemStatusUnavailable, ItemStatusInsufficientStock, ItemStatusQtyLimitExceeded:
+			res.HasBlockingIssues = true
+		}
+
+		// produk yang tidak tersedia tidak ikut dihitung ke total
+		if item.Status != ItemStatusUnavailable {
+			item.Subtotal = int64(item.CurrentPrice) * int64(item.Qty)
+			res.TotalQty += item.Qty
+			res.TotalPrice += item.Subtotal
+		}
+
+		res.Items = append(res.Items, item)
+	}
+
+	if res.MaxQtyPerOrder > 0 && int64(res.TotalQty) > int64(res.MaxQtyPerOrder) {
+		res.HasBlockingIssues = true
+	}
+
+	return res, nil
+}
+
+// effectivePrice mengembalikan harga jual produk saat ini,
+// memakai harga diskon jika ada dan lebih murah dari harga normal.
+func effectivePrice(price string, discount sql.NullString) int32 {
+	p, _ := strconv.ParseFloat(price, 64)
+	if discount.Valid {
+		if d, err := strconv.ParseFloat(discount.String, 64); err == nil && d > 0 && d < p {
+			p = d
+		}
+	}
+	return int32(math.Round(p))
+}
+
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.UUID.String()
 }
 
-func (s *service) UpdateQty(ctx context.Context, userID, productID string, req UpdateQtyRequest) error {
+func (s *service) UpdateQty(ctx context.Context, userID, productID, variantID string, req UpdateQtyRequest) error {
 	if err := s.validate.Struct(req); err != nil {
 		return carterrors.MapValidationError(err)
 	}
@@ -214,7 +520,7 @@ func (s *service) UpdateQty(ctx context.Context, userID, productID string, req U
 		return err
 	}
 
-	pid, err := s.parseProductID(productID)
+	pid, vid, err := s.parseItemKey(productID, variantID)
 	if err != nil {
 		return err
 	}
@@ -227,15 +533,31 @@ func (s *service) UpdateQty(ctx context.Context, userID, productID string, req U
 
 	repo := s.repo.WithTx(tx)
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	cartID, err := lockUserCart(ctx, repo, uid)
+	if err != nil {
+		return err
+	}
+
+	existing, err := repo.GetItemByCartAndProduct(ctx, cartID, pid, vid)
+	if err == sql.ErrNoRows {
+		return carterrors.ErrCartItemNotFound
+	}
 	if err != nil {
 		return err
 	}
+	var delta int64
+	if !existing.SavedForLater {
+		delta = int64(req.Qty - existing.Quantity)
+	}
+	if err := s.checkQtyLimits(ctx, repo, cartID, pid, delta); err != nil {
+		return err
+	}
 
 	_, err = repo.UpdateQty(ctx, dbgen.UpdateCartItemQtyParams{
 		CartID:    cartID,
 		ProductID: pid,
 		Quantity:  req.Qty,
+		VariantID: vid,
 	})
 	if err == sql.ErrNoRows {
 		return carterrors.ErrCartItemNotFound
@@ -247,39 +569,65 @@ func (s *service) UpdateQty(ctx context.Context, userID, productID string, req U
 	return tx.Commit()
 }
 
-func (s *service) Increment(ctx context.Context, userID, productID string) error {
+func (s *service) Increment(ctx context.Context, userID, productID, variantID string) error {
 	uid, err := s.parseUserID(userID)
 	if err != nil {
 		return err
 	}
 
-	pid, err := s.parseProductID(productID)
+	pid, vid, err := s.parseItemKey(productID, variantID)
 	if err != nil {
 		return err
 	}
 
-	cartID, err := s.getCartOnly(ctx, uid)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	cartID, err := lockUserCart(ctx, repo, uid)
+	if err != nil {
+		return err
+	}
+
+	existing, err := repo.GetItemByCartAndProduct(ctx, cartID, pid, vid)
+	if err == sql.ErrNoRows {
+		return carterrors.ErrCartItemNotFound
+	}
 	if err != nil {
 		return err
 	}
+	var delta int64
+	if !existing.SavedForLater {
+		delta = 1
+	}
+	if err := s.checkQtyLimits(ctx, repo, cartID, pid, delta); err != nil {
+		return err
+	}
 
 	// komentar: increment = qty + 1 via UpdateQty
-	_, err = s.repo.IncrementQty(ctx, cartID, pid)
+	_, err = repo.IncrementQty(ctx, cartID, pid, vid)
 
 	if err == sql.ErrNoRows {
 		return carterrors.ErrCartItemNotFound
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
 
-func (s *service) Decrement(ctx context.Context, userID, productID string) error {
+func (s *service) Decrement(ctx context.Context, userID, productID, variantID string) error {
 	uid, err := s.parseUserID(userID)
 	if err != nil {
 		return err
 	}
 
-	pid, err := s.parseProductID(productID)
+	pid, vid, err := s.parseItemKey(productID, variantID)
 	if err != nil {
 		return err
 	}
@@ -289,26 +637,26 @@ func (s *service) Decrement(ctx context.Context, userID, productID string) error
 		return err
 	}
 
-	item, err := s.repo.DecrementQty(ctx, cartID, pid)
+	item, err := s.repo.DecrementQty(ctx, cartID, pid, vid)
 
 	if err == sql.ErrNoRows {
 		return carterrors.ErrCartItemNotFound
 	}
 
 	if item.Quantity <= 0 {
-		return s.repo.DeleteItem(ctx, cartID, pid)
+		return s.repo.DeleteItem(ctx, cartID, pid, vid)
 	}
 
 	return nil
 }
 
-func (s *service) DeleteItem(ctx context.Context, userID, productID string) error {
+func (s *service) DeleteItem(ctx context.Context, userID, productID, variantID string) error {
 	uid, err := s.parseUserID(userID)
 	if err != nil {
 		return err
 	}
 
-	pid, err := s.parseProductID(productID)
+	pid, vid, err := s.parseItemKey(productID, variantID)
 	if err != nil {
 		return err
 	}
@@ -318,7 +666,7 @@ func (s *service) DeleteItem(ctx context.Context, userID, productID string) erro
 		return err
 	}
 
-	return s.repo.DeleteItem(ctx, cartID, pid)
+	return s.repo.DeleteItem(ctx, cartID, pid, vid)
 }
 
 func (s *service) Delete(ctx context.Context, userID string) error {
@@ -361,5 +709,431 @@ func (s *service) ClearCart(ctx context.Context, userID string) error {
 		return err
 	}
 
-	return s.repo.DeleteAllItems(ctx, cartID)
+	// item "simpan untuk nanti" tetap dipertahankan
+	return s.repo.DeleteActiveItems(ctx, cartID)
+}
+
+func (s *service) SaveForLater(ctx context.Context, userID, productID, variantID string) error {
+	return s.setSavedForLater(ctx, userID, productID, variantID, true)
+}
+
+func (s *service) MoveToCart(ctx context.Context, userID, productID, variantID string) error {
+	return s.setSavedForLater(ctx, userID, productID, variantID, false)
+}
+
+func (s *service) setSavedForLater(ctx context.Context, userID, productID, variantID string, saved bool) error {
+	uid, err := s.parseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	pid, vid, err := s.parseItemKey(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	cartID, err := lockUserCart(ctx, repo, uid)
+	if err != nil {
+		return err
+	}
+
+	// item yang kembali aktif ikut dihitung ke batas pembelian
+	if !saved {
+		existing, err := repo.GetItemByCartAndProduct(ctx, cartID, pid, vid)
+		if err == sql.ErrNoRows {
+			return carterrors.ErrCartItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if existing.SavedForLater {
+			if err := s.checkQtyLimits(ctx, repo, cartID, pid, int64(existing.Quantity)); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = repo.SetSavedForLater(ctx, cartID, pid, vid, saved)
+	if err == sql.ErrNoRows {
+		return carterrors.ErrCartItemNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ========================
+// guest cart
+// ========================
+
+func generateCartToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *service) getGuestCart(ctx context.Context, cartToken string) (uuid.UUID, error) {
+	cartToken = strings.TrimSpace(cartToken)
+	if cartToken == "" {
+		return uuid.Nil, carterrors.ErrInvalidCartToken
+	}
+
+	cart, err := s.repo.GetByToken(ctx, cartToken)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, carterrors.ErrCartNotFound
+		}
+		return uuid.Nil, err
+	}
+	return cart.ID, nil
+}
+
+// lockGuestCart seperti getGuestCart, tetapi lewat repo transaksi dan row cart dikunci
+func lockGuestCart(ctx context.Context, repo Repository, cartToken string) (uuid.UUID, error) {
+	cartToken = strings.TrimSpace(cartToken)
+	if cartToken == "" {
+		return uuid.Nil, carterrors.ErrInvalidCartToken
+	}
+
+	cart, err := repo.GetByToken(ctx, cartToken)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, carterrors.ErrCartNotFound
+		}
+		return uuid.Nil, err
+	}
+	return cart.ID, lockCart(ctx, repo, cart.ID)
+}
+
+func (s *service) CreateGuest(ctx context.Context) (string, error) {
+	token, err := generateCartToken()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := s.repo.CreateGuestCart(ctx, token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *service) GuestCount(ctx context.Context, cartToken string) (int64, error) {
+	cartID, err := s.getGuestCart(ctx, cartToken)
+	if err != nil {
+		if err == carterrors.ErrCartNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return s.repo.Count(ctx, cartID)
+}
+
+func (s *service) GuestDetail(ctx context.Context, cartToken string) (CartDetailResponse, error) {
+	cartToken = strings.TrimSpace(cartToken)
+	if cartToken == "" {
+		return CartDetailResponse{Items: []CartItemDetailResponse{}}, nil
+	}
+
+	rows, err := s.repo.GetDetailByToken(ctx, cartToken)
+	if err != nil {
+		return CartDetailResponse{}, err
+	}
+
+	items := make([]CartItemDetailResponse, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, CartItemDetailResponse{
+			ID:              r.ID.String(),
+			ProductID:       r.ProductID.String(),
+			ProductName:     r.ProductName,
+			ProductSlug:     r.ProductSlug,
+			ProductImageUrl: r.ProductImageUrl.String,
+			VariantID:       nullUUIDString(r.VariantID),
+			VariantName:     r.VariantName.String,
+			Qty:             r.Quantity,
+			Price:           r.PriceAtAdd,
+			CreatedAt:       r.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return CartDetailResponse{Items: items}, nil
+}
+
+// GuestAddItem membuat cart guest baru jika token kosong / sudah tidak berlaku,
+// lalu mengembalikan token yang dipakai.
+func (s *service) GuestAddItem(ctx context.Context, cartToken string, req AddItemRequest) (string, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return "", carterrors.MapValidationError(err)
+	}
+
+	pid, vid, err := s.parseItemKey(req.ProductID, req.VariantID)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	if err := checkVariant(ctx, repo, pid, vid); err != nil {
+		return "", err
+	}
+
+	cartToken = strings.TrimSpace(cartToken)
+	var cartID uuid.UUID
+	if cartToken != "" {
+		cart, err := repo.GetByToken(ctx, cartToken)
+		if err != nil && err != sql.ErrNoRows {
+			return "", err
+		}
+		if err == nil {
+			cartID = cart.ID
+		}
+	}
+
+	if cartID == uuid.Nil {
+		cartToken, err = generateCartToken()
+		if err != nil {
+			return "", err
+		}
+		cart, err := repo.CreateGuestCart(ctx, cartToken)
+		if err != nil {
+			return "", err
+		}
+		cartID = cart.ID
+	}
+	if err := lockCart(ctx, repo, cartID); err != nil {
+		return "", err
+	}
+
+	// cek batas pembelian, sama seperti cart user
+	existing, err := getItemOrEmpty(ctx, repo, cartID, pid, vid)
+	if err != nil {
+		return "", err
+	}
+	delta := int64(req.Qty)
+	if existing.SavedForLater {
+		delta += int64(existing.Quantity)
+	}
+	if err := s.checkQtyLimits(ctx, repo, cartID, pid, delta); err != nil {
+		return "", err
+	}
+
+	if err := repo.AddItem(ctx, dbgen.AddCartItemParams{
+		CartID:     cartID,
+		ProductID:  pid,
+		Quantity:   req.Qty,
+		PriceAtAdd: req.Price,
+		VariantID:  vid,
+	}); err != nil {
+		return "", err
+	}
+
+	if err := repo.Touch(ctx, cartID); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return cartToken, nil
+}
+
+func (s *service) GuestUpdateQty(ctx context.Context, cartToken, productID, variantID string, req UpdateQtyRequest) error {
+	if err := s.validate.Struct(req); err != nil {
+		return carterrors.MapValidationError(err)
+	}
+
+	pid, vid, err := s.parseItemKey(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	cartID, err := lockGuestCart(ctx, repo, cartToken)
+	if err != nil {
+		return err
+	}
+
+	existing, err := repo.GetItemByCartAndProduct(ctx, cartID, pid, vid)
+	if err == sql.ErrNoRows {
+		return carterrors.ErrCartItemNotFound
+	}
+	if err != nil {
+		return err
+	}
+	var delta int64
+	if !existing.SavedForLater {
+		delta = int64(req.Qty - existing.Quantity)
+	}
+	if err := s.checkQtyLimits(ctx, repo, cartID, pid, delta); err != nil {
+		return err
+	}
+
+	_, err = repo.UpdateQty(ctx, dbgen.UpdateCartItemQtyParams{
+		CartID:    cartID,
+		ProductID: pid,
+		Quantity:  req.Qty,
+		VariantID: vid,
+	})
+	if err == sql.ErrNoRows {
+		return carterrors.ErrCartItemNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := repo.Touch(ctx, cartID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *service) GuestDeleteItem(ctx context.Context, cartToken, productID, variantID string) error {
+	pid, vid, err := s.parseItemKey(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	cartID, err := s.getGuestCart(ctx, cartToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteItem(ctx, cartID, pid, vid); err != nil {
+		return err
+	}
+
+	return s.repo.Touch(ctx, cartID)
+}
+
+// MergeGuestCart memindahkan isi cart guest ke cart user setelah login/register.
+// Qty dijumlahkan per produk/variant lalu dibatasi stok dan batas pembelian; cart guest dihapus setelahnya.
+func (s *service) MergeGuestCart(ctx context.Context, userID, cartToken string) error {
+	if strings.TrimSpace(cartToken) == "" {
+		return nil
+	}
+
+	uid, err := s.parseUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	repo := s.repo.WithTx(tx)
+
+	// cart guest dikunci agar dua merge dengan token yang sama tidak memindahkan item dua kali
+	guestCartID, err := lockGuestCart(ctx, repo, cartToken)
+	if err != nil {
+		if err == carterrors.ErrCartNotFound {
+			// token kadaluarsa / sudah di-merge → tidak ada yang perlu dipindah
+			return nil
+		}
+		return err
+	}
+
+	cart, err := repo.GetByUserID(ctx, uid)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		cart, err = repo.CreateCart(ctx, uid)
+		if err != nil {
+			return err
+		}
+	}
+	if err := lockCart(ctx, repo, cart.ID); err != nil {
+		return err
+	}
+
+	guestItems, err := repo.ListItemsWithStock(ctx, guestCartID)
+	if err != nil {
+		return err
+	}
+
+	for _, gi := range guestItems {
+		var existingQty int32
+		existing, err := repo.GetItemByCartAndProduct(ctx, cart.ID, gi.ProductID, gi.VariantID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil {
+			existingQty = existing.Quantity
+		}
+
+		qty := existingQty + gi.Quantity
+		if qty > gi.Stock {
+			qty = gi.Stock
+		}
+		// baris hasil merge selalu aktif; baris "simpan untuk nanti" milik user ikut aktif
+		// sehingga seluruh qty-nya dihitung ke batas pembelian
+		added := qty - existingQty
+		if existing.SavedForLater {
+			added = qty
+		}
+		if added > 0 {
+			room, err := s.qtyRoom(ctx, repo, cart.ID, gi.ProductID)
+			if err != nil {
+				return err
+			}
+			if int64(added) > room {
+				qty -= added - int32(room)
+			}
+		}
+		// jangan kurangi qty yang sudah ada di cart user
+		if qty <= existingQty {
+			continue
+		}
+
+		if err := repo.SetItemQty(ctx, dbgen.UpsertCartItemQtyParams{
+			CartID:     cart.ID,
+			ProductID:  gi.ProductID,
+			Quantity:   qty,
+			PriceAtAdd: gi.PriceAtAdd,
+			VariantID:  gi.VariantID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// cart_items ikut terhapus (ON DELETE CASCADE)
+	if err := repo.Delete(ctx, guestCartID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CleanupGuestCarts menghapus cart guest yang tidak disentuh lebih lama dari olderThan.
+func (s *service) CleanupGuestCarts(ctx context.Context, olderThan time.Duration) (int64, error) {
+	return s.repo.DeleteStaleGuestCarts(ctx, time.Now().Add(-olderThan))
 }
@@ -101,8 +101,13 @@ func TestCartService_AddItem(t *testing.T) {
 		mockDB.ExpectCommit()
 
 		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(false, nil)
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{}, sql.ErrNoRows)
 		repo.EXPECT().CreateCart(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(0), nil)
 		repo.EXPECT().AddItem(ctx, gomock.Any()).Return(nil)
 
 		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
@@ -117,13 +122,19 @@ func TestCartService_AddItem(t *testing.T) {
 
 	t.Run("repo_error_should_rollback", func(t *testing.T) {
 		userID := uuid.New()
+		cartID := uuid.New()
 		productID := uuid.New()
 
 		mockDB.ExpectBegin()
 		mockDB.ExpectRollback()
 
 		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
-		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: uuid.New()}, nil)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(false, nil)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(0), nil)
 		repo.EXPECT().AddItem(ctx, gomock.Any()).Return(assert.AnError)
 
 		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
@@ -135,6 +146,121 @@ func TestCartService_AddItem(t *testing.T) {
 		assert.Error(t, err)
 		assert.NoError(t, mockDB.ExpectationsWereMet())
 	})
+
+	t.Run("exceeds_product_limit", func(t *testing.T) {
+		userID := uuid.New()
+		cartID := uuid.New()
+		productID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(false, nil)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 2}, nil)
+		repo.EXPECT().
+			GetProductPurchaseLimit(ctx, productID).
+			Return(sql.NullInt32{Int32: 3, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(2), nil)
+
+		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
+			ProductID: productID.String(),
+			Qty:       2,
+			Price:     1000,
+		})
+
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsProductLimit)
+		var limitErr *carterrors.QtyLimitError
+		assert.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, int32(3), limitErr.MaxQty)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("variant_required", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(true, nil)
+
+		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
+			ProductID: productID.String(),
+			Qty:       1,
+			Price:     1000,
+		})
+
+		assert.ErrorIs(t, err, carterrors.ErrVariantRequired)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("inactive_variant_not_found", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		variantID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().
+			GetVariant(ctx, productID, variantID).
+			Return(dbgen.ProductVariant{ID: variantID, IsActive: false}, nil)
+
+		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
+			ProductID: productID.String(),
+			VariantID: variantID.String(),
+			Qty:       1,
+			Price:     1000,
+		})
+
+		assert.ErrorIs(t, err, carterrors.ErrVariantNotFound)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("success_with_variant", func(t *testing.T) {
+		userID := uuid.New()
+		cartID := uuid.New()
+		productID := uuid.New()
+		variantID := uuid.New()
+		vid := uuid.NullUUID{UUID: variantID, Valid: true}
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().
+			GetVariant(ctx, productID, variantID).
+			Return(dbgen.ProductVariant{ID: variantID, IsActive: true}, nil)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, vid).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(0), nil)
+		repo.EXPECT().AddItem(ctx, dbgen.AddCartItemParams{
+			CartID:     cartID,
+			ProductID:  productID,
+			Quantity:   1,
+			PriceAtAdd: 1000,
+			VariantID:  vid,
+		}).Return(nil)
+
+		err := svc.AddItem(ctx, userID.String(), cart.AddItemRequest{
+			ProductID: productID.String(),
+			VariantID: variantID.String(),
+			Qty:       1,
+			Price:     1000,
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
 }
 
 func TestCartService_Count(t *testing.T) {
@@ -216,7 +342,7 @@ func TestCartService_Detail(t *testing.T) {
 	})
 }
 
-func TestCartService_Increment(t *testing.T) {
+func TestCartService_Validate(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -227,28 +353,341 @@ func TestCartService_Increment(t *testing.T) {
 	svc := cart.NewService(db, repo)
 	ctx := context.Background()
 
+	t.Run("mixed_statuses", func(t *testing.T) {
+		userID := uuid.New()
+
+		repo.EXPECT().
+			GetValidation(ctx, userID).
+			Return([]dbgen.GetCartValidationRow{
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 2, PriceAtAdd: 10000, CurrentPrice: "10000.00", Stock: 5, IsActive: sql.NullBool{Bool: true, Valid: true}},
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 10000, CurrentPrice: "12000.00", CurrentDiscountPrice: sql.NullString{String: "11000.00", Valid: true}, Stock: 5, IsActive: sql.NullBool{Bool: true, Valid: true}},
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 3, PriceAtAdd: 5000, CurrentPrice: "5000.00", Stock: 2, IsActive: sql.NullBool{Bool: true, Valid: true}},
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 5000, CurrentPrice: "5000.00", Stock: 10, IsActive: sql.NullBool{Bool: false, Valid: true}},
+			}, nil)
+
+		res, err := svc.Validate(ctx, userID.String())
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, 4)
+		assert.Equal(t, cart.ItemStatusOK, res.Items[0].Status)
+		assert.Equal(t, cart.ItemStatusPriceChanged, res.Items[1].Status)
+		assert.Equal(t, int32(11000), res.Items[1].CurrentPrice)
+		assert.Equal(t, cart.ItemStatusInsufficientStock, res.Items[2].Status)
+		assert.Equal(t, cart.ItemStatusUnavailable, res.Items[3].Status)
+		assert.True(t, res.HasBlockingIssues)
+		// item unavailable tidak ikut dihitung
+		assert.Equal(t, int32(6), res.TotalQty)
+		assert.Equal(t, int64(2*10000+11000+3*5000), res.TotalPrice)
+	})
+
+	t.Run("deleted_product_unavailable", func(t *testing.T) {
+		userID := uuid.New()
+
+		repo.EXPECT().
+			GetValidation(ctx, userID).
+			Return([]dbgen.GetCartValidationRow{
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 5000, CurrentPrice: "5000.00", Stock: 10, DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}},
+			}, nil)
+
+		res, err := svc.Validate(ctx, userID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, cart.ItemStatusUnavailable, res.Items[0].Status)
+		assert.True(t, res.HasBlockingIssues)
+		assert.Equal(t, int64(0), res.TotalPrice)
+	})
+
+	t.Run("all_ok", func(t *testing.T) {
+		userID := uuid.New()
+
+		repo.EXPECT().
+			GetValidation(ctx, userID).
+			Return([]dbgen.GetCartValidationRow{
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 5000, CurrentPrice: "5000.00", Stock: 1, IsActive: sql.NullBool{Bool: true, Valid: true}},
+			}, nil)
+
+		res, err := svc.Validate(ctx, userID.String())
+		assert.NoError(t, err)
+		assert.False(t, res.HasBlockingIssues)
+	})
+
+	t.Run("variants_share_product_limit", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		limit := sql.NullInt32{Int32: 3, Valid: true}
+		active := sql.NullBool{Bool: true, Valid: true}
+
+		// 2 + 2 dari variant berbeda melebihi batas produk 3
+		repo.EXPECT().
+			GetValidation(ctx, userID).
+			Return([]dbgen.GetCartValidationRow{
+				{ID: uuid.New(), ProductID: productID, VariantID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, VariantName: sql.NullString{String: "Warna: Hitam", Valid: true}, Quantity: 2, PriceAtAdd: 5000, CurrentPrice: "5000.00", Stock: 10, IsActive: active, MaxQtyPerOrder: limit},
+				{ID: uuid.New(), ProductID: productID, VariantID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, VariantName: sql.NullString{String: "Warna: Putih", Valid: true}, Quantity: 2, PriceAtAdd: 5000, CurrentPrice: "5000.00", Stock: 10, IsActive: active, MaxQtyPerOrder: limit},
+			}, nil)
+
+		res, err := svc.Validate(ctx, userID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, cart.ItemStatusQtyLimitExceeded, res.Items[0].Status)
+		assert.Equal(t, cart.ItemStatusQtyLimitExceeded, res.Items[1].Status)
+		assert.Equal(t, "Warna: Hitam", res.Items[0].VariantName)
+		assert.True(t, res.HasBlockingIssues)
+	})
+}
+
+func TestCartService_SaveForLater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mockDB, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(db, repo)
+	ctx := context.Background()
+
+	t.Run("save_success", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		cartID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			SetSavedForLater(ctx, cartID, productID, uuid.NullUUID{}, true).
+			Return(dbgen.CartItem{SavedForLater: true}, nil)
+
+		err := svc.SaveForLater(ctx, userID.String(), productID.String(), "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("move_to_cart_item_not_found", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		cartID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{}, sql.ErrNoRows)
+
+		err := svc.MoveToCart(ctx, userID.String(), productID.String(), "")
+		assert.ErrorIs(t, err, carterrors.ErrCartItemNotFound)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("move_to_cart_success", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		cartID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 2, SavedForLater: true}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 5, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(3), nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(3), nil)
+		repo.EXPECT().
+			SetSavedForLater(ctx, cartID, productID, uuid.NullUUID{}, false).
+			Return(dbgen.CartItem{Quantity: 2}, nil)
+
+		err := svc.MoveToCart(ctx, userID.String(), productID.String(), "")
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("move_to_cart_exceeds_product_limit", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+		cartID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 2, SavedForLater: true}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 3, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(2), nil)
+
+		// SetSavedForLater tidak boleh dipanggil
+		err := svc.MoveToCart(ctx, userID.String(), productID.String(), "")
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsProductLimit)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("detail_splits_saved_items", func(t *testing.T) {
+		userID := uuid.New()
+
+		repo.EXPECT().
+			GetDetail(ctx, userID).
+			Return([]dbgen.GetCartDetailRow{
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 1, PriceAtAdd: 1000},
+				{ID: uuid.New(), ProductID: uuid.New(), Quantity: 2, PriceAtAdd: 2000, SavedForLater: true},
+			}, nil)
+
+		res, err := svc.Detail(ctx, userID.String())
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, 1)
+		assert.Len(t, res.SavedItems, 1)
+	})
+
+	t.Run("clear_cart_keeps_saved_items", func(t *testing.T) {
+		userID := uuid.New()
+		cartID := uuid.New()
+
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().DeleteActiveItems(ctx, cartID).Return(nil)
+
+		err := svc.ClearCart(ctx, userID.String())
+		assert.NoError(t, err)
+	})
+}
+
+func TestCartService_UpdateQty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mockDB, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(db, repo)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	cartID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("success_locks_cart_before_limit_check", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		gomock.InOrder(
+			repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil),
+			repo.EXPECT().Lock(ctx, cartID).Return(nil),
+			repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{Quantity: 1}, nil),
+			repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil),
+			repo.EXPECT().Count(ctx, cartID).Return(int64(1), nil),
+			repo.EXPECT().UpdateQty(ctx, gomock.Any()).Return(dbgen.CartItem{}, nil),
+		)
+
+		err := svc.UpdateQty(ctx, userID.String(), productID.String(), "", cart.UpdateQtyRequest{Qty: 3})
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("cart_not_found", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{}, sql.ErrNoRows)
+
+		err := svc.UpdateQty(ctx, userID.String(), productID.String(), "", cart.UpdateQtyRequest{Qty: 3})
+		assert.ErrorIs(t, err, carterrors.ErrCartNotFound)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("exceeds_product_limit", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{Quantity: 1}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 2, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(1), nil)
+
+		err := svc.UpdateQty(ctx, userID.String(), productID.String(), "", cart.UpdateQtyRequest{Qty: 3})
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsProductLimit)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+}
+
+func TestCartService_Increment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mockDB, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(db, repo)
+	ctx := context.Background()
+
 	userID := uuid.New()
 	cartID := uuid.New()
 	productID := uuid.New()
 
 	t.Run("success", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
 
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 1}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(1), nil)
 		repo.EXPECT().
-			IncrementQty(ctx, cartID, productID).
+			IncrementQty(ctx, cartID, productID, uuid.NullUUID{}).
 			Return(dbgen.CartItem{CartID: cartID, ProductID: productID}, nil)
 
-		err := svc.Increment(ctx, userID.String(), productID.String())
+		err := svc.Increment(ctx, userID.String(), productID.String(), "")
 		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
 	})
 
 	t.Run("item_not_found", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
 
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
-		repo.EXPECT().IncrementQty(ctx, cartID, productID).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{}, sql.ErrNoRows)
 
-		err := svc.Increment(ctx, userID.String(), productID.String())
+		err := svc.Increment(ctx, userID.String(), productID.String(), "")
 		assert.Equal(t, carterrors.ErrCartItemNotFound, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("exceeds_order_limit", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().
+			GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 5}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(cart.MaxQtyPerOrder()), nil)
+
+		err := svc.Increment(ctx, userID.String(), productID.String(), "")
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsOrderLimit)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
 	})
 }
 
@@ -270,11 +709,11 @@ func TestCartService_Decrement(t *testing.T) {
 	t.Run("decrement_to_zero_should_delete", func(t *testing.T) {
 
 		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: cartID}, nil)
-		repo.EXPECT().DecrementQty(ctx, cartID, productID).
+		repo.EXPECT().DecrementQty(ctx, cartID, productID, uuid.NullUUID{}).
 			Return(dbgen.CartItem{Quantity: 0}, nil)
-		repo.EXPECT().DeleteItem(ctx, cartID, productID).Return(nil)
+		repo.EXPECT().DeleteItem(ctx, cartID, productID, uuid.NullUUID{}).Return(nil)
 
-		err := svc.Decrement(ctx, userID.String(), productID.String())
+		err := svc.Decrement(ctx, userID.String(), productID.String(), "")
 		assert.NoError(t, err)
 	})
 }
@@ -353,3 +792,301 @@ func TestCartService_Delete(t *testing.T) {
 		// Sesuaikan dengan error handling di getCartOnly Anda
 	})
 }
+
+func TestCartService_GuestAddItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mockDB, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(db, repo)
+	ctx := context.Background()
+
+	t.Run("existing_token", func(t *testing.T) {
+		cartID := uuid.New()
+		productID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(false, nil)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(0), nil)
+		repo.EXPECT().AddItem(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().Touch(ctx, cartID).Return(nil)
+
+		token, err := svc.GuestAddItem(ctx, "guest-token", cart.AddItemRequest{
+			ProductID: productID.String(),
+			Qty:       1,
+			Price:     1000,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "guest-token", token)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("empty_token_creates_guest_cart", func(t *testing.T) {
+		cartID := uuid.New()
+		productID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(false, nil)
+		repo.EXPECT().CreateGuestCart(ctx, gomock.Any()).Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(0), nil)
+		repo.EXPECT().AddItem(ctx, gomock.Any()).Return(nil)
+		repo.EXPECT().Touch(ctx, cartID).Return(nil)
+
+		token, err := svc.GuestAddItem(ctx, "", cart.AddItemRequest{
+			ProductID: productID.String(),
+			Qty:       1,
+			Price:     1000,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, token, 64)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("exceeds_product_limit", func(t *testing.T) {
+		cartID := uuid.New()
+		productID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().ProductHasVariants(ctx, productID).Return(false, nil)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{Quantity: 2}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 3, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(2), nil)
+
+		_, err := svc.GuestAddItem(ctx, "guest-token", cart.AddItemRequest{
+			ProductID: productID.String(),
+			Qty:       2,
+			Price:     1000,
+		})
+
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsProductLimit)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+}
+
+func TestCartService_GuestUpdateQty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mockDB, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(db, repo)
+	ctx := context.Background()
+
+	cartID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{Quantity: 1}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 3, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(1), nil)
+		repo.EXPECT().Count(ctx, cartID).Return(int64(1), nil)
+		repo.EXPECT().UpdateQty(ctx, gomock.Any()).Return(dbgen.CartItem{}, nil)
+		repo.EXPECT().Touch(ctx, cartID).Return(nil)
+
+		err := svc.GuestUpdateQty(ctx, "guest-token", productID.String(), "", cart.UpdateQtyRequest{Qty: 3})
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("exceeds_product_limit", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: cartID}, nil)
+		repo.EXPECT().Lock(ctx, cartID).Return(nil)
+		repo.EXPECT().GetItemByCartAndProduct(ctx, cartID, productID, uuid.NullUUID{}).Return(dbgen.CartItem{Quantity: 1}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 3, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, cartID, productID).Return(int64(1), nil)
+
+		err := svc.GuestUpdateQty(ctx, "guest-token", productID.String(), "", cart.UpdateQtyRequest{Qty: 4})
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsProductLimit)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+}
+
+func TestCartService_MergeGuestCart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mockDB, _ := sqlmock.New()
+	defer db.Close()
+
+	repo := mock.NewMockRepository(ctrl)
+	svc := cart.NewService(db, repo)
+	ctx := context.Background()
+
+	t.Run("sum_qty_capped_by_stock", func(t *testing.T) {
+		userID := uuid.New()
+		guestCartID := uuid.New()
+		userCartID := uuid.New()
+		productA := uuid.New()
+		productB := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: guestCartID}, nil)
+		repo.EXPECT().Lock(ctx, guestCartID).Return(nil)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: userCartID}, nil)
+		repo.EXPECT().Lock(ctx, userCartID).Return(nil)
+		repo.EXPECT().ListItemsWithStock(ctx, guestCartID).Return([]dbgen.ListCartItemsWithStockRow{
+			{ProductID: productA, Quantity: 3, PriceAtAdd: 1000, Stock: 4},
+			{ProductID: productB, Quantity: 2, PriceAtAdd: 2000, Stock: 10},
+		}, nil)
+
+		// product A: 2 (user) + 3 (guest) = 5 → dibatasi stok 4
+		repo.EXPECT().GetItemByCartAndProduct(ctx, userCartID, productA, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 2}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productA).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, userCartID).Return(int64(2), nil)
+		repo.EXPECT().SetItemQty(ctx, dbgen.UpsertCartItemQtyParams{
+			CartID: userCartID, ProductID: productA, Quantity: 4, PriceAtAdd: 1000,
+		}).Return(nil)
+
+		// product B: belum ada di cart user
+		repo.EXPECT().GetItemByCartAndProduct(ctx, userCartID, productB, uuid.NullUUID{}).
+			Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productB).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, userCartID).Return(int64(4), nil)
+		repo.EXPECT().SetItemQty(ctx, dbgen.UpsertCartItemQtyParams{
+			CartID: userCartID, ProductID: productB, Quantity: 2, PriceAtAdd: 2000,
+		}).Return(nil)
+
+		repo.EXPECT().Delete(ctx, guestCartID).Return(nil)
+
+		err := svc.MergeGuestCart(ctx, userID.String(), "guest-token")
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("sum_qty_capped_by_purchase_limits", func(t *testing.T) {
+		userID := uuid.New()
+		guestCartID := uuid.New()
+		userCartID := uuid.New()
+		productA := uuid.New()
+		productB := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: guestCartID}, nil)
+		repo.EXPECT().Lock(ctx, guestCartID).Return(nil)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: userCartID}, nil)
+		repo.EXPECT().Lock(ctx, userCartID).Return(nil)
+		repo.EXPECT().ListItemsWithStock(ctx, guestCartID).Return([]dbgen.ListCartItemsWithStockRow{
+			{ProductID: productA, Quantity: 3, PriceAtAdd: 1000, Stock: 10},
+			{ProductID: productB, Quantity: 5, PriceAtAdd: 2000, Stock: 10},
+		}, nil)
+
+		// product A: 1 (user) + 3 (guest) → batas produk 2
+		repo.EXPECT().GetItemByCartAndProduct(ctx, userCartID, productA, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 1}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productA).Return(sql.NullInt32{Int32: 2, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, userCartID, productA).Return(int64(1), nil)
+		repo.EXPECT().Count(ctx, userCartID).Return(int64(1), nil)
+		repo.EXPECT().SetItemQty(ctx, dbgen.UpsertCartItemQtyParams{
+			CartID: userCartID, ProductID: productA, Quantity: 2, PriceAtAdd: 1000,
+		}).Return(nil)
+
+		// product B: sisa batas per order hanya 1
+		repo.EXPECT().GetItemByCartAndProduct(ctx, userCartID, productB, uuid.NullUUID{}).
+			Return(dbgen.CartItem{}, sql.ErrNoRows)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productB).Return(sql.NullInt32{}, nil)
+		repo.EXPECT().Count(ctx, userCartID).Return(int64(cart.MaxQtyPerOrder()-1), nil)
+		repo.EXPECT().SetItemQty(ctx, dbgen.UpsertCartItemQtyParams{
+			CartID: userCartID, ProductID: productB, Quantity: 1, PriceAtAdd: 2000,
+		}).Return(nil)
+
+		repo.EXPECT().Delete(ctx, guestCartID).Return(nil)
+
+		err := svc.MergeGuestCart(ctx, userID.String(), "guest-token")
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("saved_user_line_becomes_active", func(t *testing.T) {
+		userID := uuid.New()
+		guestCartID := uuid.New()
+		userCartID := uuid.New()
+		productID := uuid.New()
+
+		mockDB.ExpectBegin()
+		mockDB.ExpectCommit()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByToken(ctx, "guest-token").Return(dbgen.Cart{ID: guestCartID}, nil)
+		repo.EXPECT().Lock(ctx, guestCartID).Return(nil)
+		repo.EXPECT().GetByUserID(ctx, userID).Return(dbgen.Cart{ID: userCartID}, nil)
+		repo.EXPECT().Lock(ctx, userCartID).Return(nil)
+		repo.EXPECT().ListItemsWithStock(ctx, guestCartID).Return([]dbgen.ListCartItemsWithStockRow{
+			{ProductID: productID, Quantity: 2, PriceAtAdd: 1000, Stock: 10},
+		}, nil)
+
+		// 2 (user, disimpan untuk nanti) + 2 (guest) = 4, seluruhnya jadi aktif → batas produk 3
+		repo.EXPECT().GetItemByCartAndProduct(ctx, userCartID, productID, uuid.NullUUID{}).
+			Return(dbgen.CartItem{Quantity: 2, SavedForLater: true}, nil)
+		repo.EXPECT().GetProductPurchaseLimit(ctx, productID).Return(sql.NullInt32{Int32: 3, Valid: true}, nil)
+		repo.EXPECT().SumProductQty(ctx, userCartID, productID).Return(int64(0), nil)
+		repo.EXPECT().Count(ctx, userCartID).Return(int64(0), nil)
+		repo.EXPECT().SetItemQty(ctx, dbgen.UpsertCartItemQtyParams{
+			CartID: userCartID, ProductID: productID, Quantity: 3, PriceAtAdd: 1000,
+		}).Return(nil)
+
+		repo.EXPECT().Delete(ctx, guestCartID).Return(nil)
+
+		err := svc.MergeGuestCart(ctx, userID.String(), "guest-token")
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("guest_cart_not_found_is_noop", func(t *testing.T) {
+		mockDB.ExpectBegin()
+		mockDB.ExpectRollback()
+
+		repo.EXPECT().WithTx(gomock.Any()).Return(repo)
+		repo.EXPECT().GetByToken(ctx, "expired").Return(dbgen.Cart{}, sql.ErrNoRows)
+
+		err := svc.MergeGuestCart(ctx, uuid.New().String(), "expired")
+		assert.NoError(t, err)
+		assert.NoError(t, mockDB.ExpectationsWereMet())
+	})
+
+	t.Run("empty_token_is_noop", func(t *testing.T) {
+		err := svc.MergeGuestCart(ctx, uuid.New().String(), "")
+		assert.NoError(t, err)
+	})
+}
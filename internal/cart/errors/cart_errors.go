@@ -29,6 +29,34 @@ var (
 		http.StatusNotFound,
 	)
 
+	ErrInvalidCartToken = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid cart token",
+		http.StatusBadRequest,
+	)
+
+	// ========================
+	// Variant Errors
+	// ========================
+
+	ErrInvalidVariantID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid variant ID",
+		http.StatusBadRequest,
+	)
+
+	ErrVariantRequired = apperror.New(
+		apperror.CodeInvalidInput,
+		"Please select a product variant",
+		http.StatusBadRequest,
+	)
+
+	ErrVariantNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Product variant not found",
+		http.StatusNotFound,
+	)
+
 	// ========================
 	// Quantity Errors
 	// ========================
@@ -60,4 +88,20 @@ var (
 		"Cart is empty",
 		http.StatusBadRequest,
 	)
+
+	// ========================
+	// Purchase Limit Errors
+	// ========================
+
+	ErrQtyExceedsProductLimit = apperror.New(
+		apperror.CodeInvalidInput,
+		"Quantity exceeds the purchase limit for this product",
+		http.StatusBadRequest,
+	)
+
+	ErrQtyExceedsOrderLimit = apperror.New(
+		apperror.CodeInvalidInput,
+		"Total quantity exceeds the maximum allowed per order",
+		http.StatusBadRequest,
+	)
 )
@@ -0,0 +1,36 @@
+package carterrors
+
+import (
+	"fmt"
+
+	"go-gadget-api/internal/pkg/apperror"
+)
+
+// QtyLimitError dikembalikan saat qty melebihi batas pembelian.
+// MaxQty berisi jumlah maksimum yang masih diizinkan.
+type QtyLimitError struct {
+	*apperror.AppError
+	MaxQty int32
+}
+
+// NewQtyLimitError membungkus ErrQtyExceedsProductLimit / ErrQtyExceedsOrderLimit
+// dengan pesan yang menyebutkan batas maksimum. errors.Is ke base error tetap berlaku.
+func NewQtyLimitError(base *apperror.AppError, maxQty int32) *QtyLimitError {
+	return &QtyLimitError{
+		AppError: apperror.Wrap(
+			base,
+			base.Code,
+			fmt.Sprintf("%s (max %d)", base.Message, maxQty),
+			base.HTTPStatus,
+		),
+		MaxQty: maxQty,
+	}
+}
+
+func (e *QtyLimitError) Unwrap() error {
+	return e.AppError
+}
+
+func (e *QtyLimitError) Error() string {
+	return e.Message
+}
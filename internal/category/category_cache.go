@@ -0,0 +1,98 @@
+package category
+
+import (
+	"context"
+	"go-gadget-api/internal/shared/cache"
+	"mime/multipart"
+	"time"
+)
+
+const categoryCacheTTL = 10 * time.Minute
+
+var categoryCacheDeps = []string{cache.EntityCategory}
+
+type publicListPage struct {
+	Items []CategoryPublicResponse `json:"items"`
+	Total int64                    `json:"total"`
+}
+
+// cachedService read-through cache untuk endpoint publik kategori, mutasi menaikkan versi cache kategori
+type cachedService struct {
+	Service
+	cache *cache.Cache
+}
+
+func NewCachedService(svc Service, c *cache.Cache) Service {
+	return &cachedService{Service: svc, cache: c}
+}
+
+func (s *cachedService) ListPublic(ctx context.Context, page, limit int) ([]CategoryPublicResponse, int64, error) {
+	res, err := cache.Remember(ctx, s.cache, "category:list", categoryCacheDeps, categoryCacheTTL, []int{page, limit},
+		func(ctx context.Context) (publicListPage, error) {
+			items, total, err := s.Service.ListPublic(ctx, page, limit)
+			return publicListPage{Items: items, Total: total}, err
+		})
+	return res.Items, res.Total, err
+}
+
+func (s *cachedService) ListAttributes(ctx context.Context, categoryID string) ([]CategoryAttributeResponse, error) {
+	return cache.Remember(ctx, s.cache, "category:attributes", categoryCacheDeps, categoryCacheTTL, categoryID,
+		func(ctx context.Context) ([]CategoryAttributeResponse, error) {
+			return s.Service.ListAttributes(ctx, categoryID)
+		})
+}
+
+func (s *cachedService) GetTree(ctx context.Context) ([]CategoryTreeNode, error) {
+	return cache.Remember(ctx, s.cache, "category:tree", categoryCacheDeps, categoryCacheTTL, "all",
+		func(ctx context.Context) ([]CategoryTreeNode, error) {
+			return s.Service.GetTree(ctx)
+		})
+}
+
+func (s *cachedService) invalidate(ctx context.Context, err error) {
+	if err == nil {
+		s.cache.Invalidate(ctx, cache.EntityCategory)
+	}
+}
+
+func (s *cachedService) Create(ctx context.Context, req CreateCategoryRequest, file multipart.File, filename string) (CategoryAdminResponse, error) {
+	res, err := s.Service.Create(ctx, req, file, filename)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Update(ctx context.Context, id string, req UpdateCategoryRequest, file multipart.File, filename string) (CategoryAdminResponse, error) {
+	res, err := s.Service.Update(ctx, id, req, file, filename)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Delete(ctx context.Context, id string) error {
+	err := s.Service.Delete(ctx, id)
+	s.invalidate(ctx, err)
+	return err
+}
+
+func (s *cachedService) Restore(ctx context.Context, id string) (CategoryAdminResponse, error) {
+	res, err := s.Service.Restore(ctx, id)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) CreateAttribute(ctx context.Context, categoryID string, req CreateAttributeRequest) (CategoryAttributeResponse, error) {
+	res, err := s.Service.CreateAttribute(ctx, categoryID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) UpdateAttribute(ctx context.Context, categoryID, attributeID string, req UpdateAttributeRequest) (CategoryAttributeResponse, error) {
+	res, err := s.Service.UpdateAttribute(ctx, categoryID, attributeID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) DeleteAttribute(ctx context.Context, categoryID, attributeID string) error {
+	err := s.Service.DeleteAttribute(ctx, categoryID, attributeID)
+	s.invalidate(ctx, err)
+	return err
+}
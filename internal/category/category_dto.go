@@ -9,14 +9,34 @@ type CreateCategoryRequest struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description" validate:"max=500"`
 	ImageUrl    string `json:"imageUrl" validate:"omitempty,url"`
+	ParentID    string `json:"parentId"` // kosong = kategori root
 }
 
 type UpdateCategoryRequest struct {
-	Name        string `json:"name" validate:"required,min=2,max=100"`
-	Slug        string `json:"slug"`
-	Description string `json:"description" validate:"max=500"`
-	ImageUrl    string `json:"imageUrl" validate:"omitempty,url"`
-	IsActive    *bool  `json:"isActive"`
+	Name        string  `json:"name" validate:"required,min=2,max=100"`
+	Slug        string  `json:"slug"`
+	Description string  `json:"description" validate:"max=500"`
+	ImageUrl    string  `json:"imageUrl" validate:"omitempty,url"`
+	IsActive    *bool   `json:"isActive"`
+	ParentID    *string `json:"parentId"` // nil = tidak diubah, "" = pindah ke root
+}
+
+// CreateAttributeRequest: code dipakai sebagai key filter produk, mis. "ram" -> ?attr[ram]=8GB
+type CreateAttributeRequest struct {
+	Code         string `json:"code" validate:"required,max=50"`
+	Name         string `json:"name" validate:"required,max=100"`
+	DataType     string `json:"dataType" validate:"required,oneof=text number boolean"`
+	Unit         string `json:"unit" validate:"max=20"`
+	IsFilterable *bool  `json:"isFilterable"` // default true
+	SortOrder    int32  `json:"sortOrder"`
+}
+
+// UpdateAttributeRequest: code & dataType tidak bisa diubah agar nilai produk yang ada tetap valid
+type UpdateAttributeRequest struct {
+	Name         string `json:"name" validate:"required,max=100"`
+	Unit         string `json:"unit" validate:"max=20"`
+	IsFilterable *bool  `json:"isFilterable"`
+	SortOrder    *int32 `json:"sortOrder"`
 }
 
 type ListCategoryRequest struct {
@@ -34,6 +54,26 @@ type CategoryPublicResponse struct {
 	Slug        string `json:"slug"`
 	Description string `json:"description,omitempty"`
 	ImageUrl    string `json:"imageUrl,omitempty"`
+	ParentID    string `json:"parentId,omitempty"`
+}
+
+// CategoryTreeNode satu kategori beserta subkategorinya (GET /categories/tree)
+type CategoryTreeNode struct {
+	ID       string             `json:"id"`
+	Name     string             `json:"name"`
+	Slug     string             `json:"slug"`
+	ImageUrl string             `json:"imageUrl,omitempty"`
+	Children []CategoryTreeNode `json:"children"`
+}
+
+type CategoryAttributeResponse struct {
+	ID           string `json:"id"`
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	DataType     string `json:"dataType"`
+	Unit         string `json:"unit,omitempty"`
+	IsFilterable bool   `json:"isFilterable"`
+	SortOrder    int32  `json:"sortOrder"`
 }
 
 type CategoryAdminResponse struct {
@@ -43,6 +83,7 @@ type CategoryAdminResponse struct {
 	Description string     `json:"description"`
 	ImageUrl    string     `json:"imageUrl"`
 	IsActive    bool       `json:"isActive"`
+	ParentID    string     `json:"parentId,omitempty"`
 	CreatedAt   time.Time  `json:"createdAt"`
 	UpdatedAt   time.Time  `json:"updatedAt"`
 	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
@@ -1,12 +1,14 @@
 package category
 
 import (
+	"go-gadget-api/internal/pkg/apperror"
 	"go-gadget-api/internal/pkg/response"
 	"go-gadget-api/internal/pkg/utils"
 	"log"
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -35,12 +37,12 @@ func (h *Handler) ListPublic(c *gin.Context) {
 		totalPages = int((total + int64(limit) - 1) / int64(limit))
 	}
 
-	response.Success(c, http.StatusOK, data, &response.PaginationMeta{
+	response.SuccessConditional(c, data, &response.PaginationMeta{
 		Total:      total,
 		TotalPages: totalPages,
 		Page:       page,
 		Limit:      limit,
-	})
+	}, time.Time{})
 }
 
 func (h *Handler) ListAdmin(c *gin.Context) {
@@ -107,7 +109,19 @@ func (h *Handler) GetByID(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, http.StatusOK, res, nil)
+	response.SuccessConditional(c, res, nil, res.UpdatedAt)
+}
+
+// GET /categories/tree
+func (h *Handler) GetTree(c *gin.Context) {
+	res, err := h.service.GetTree(c.Request.Context())
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.SuccessConditional(c, res, nil, time.Time{})
 }
 
 // 3. CREATE BRAND
@@ -134,6 +148,7 @@ func (h *Handler) Create(c *gin.Context) {
 		Name:        name,
 		Slug:        utils.GenerateSlug(name),
 		Description: description,
+		ParentID:    c.PostForm("parentId"),
 	}
 	// 3. Validate required fields
 	if req.Name == "" {
@@ -177,13 +192,8 @@ func (h *Handler) Create(c *gin.Context) {
 	// 5. Call service
 	result, err := h.service.Create(ctx, req, file, filename)
 	if err != nil {
-		response.Error(
-			c,
-			http.StatusInternalServerError,
-			"CREATE_ERROR",
-			"Failed to create category",
-			err.Error(),
-		)
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
@@ -231,6 +241,10 @@ func (h *Handler) Update(c *gin.Context) {
 		Slug:        utils.GenerateSlug(name),
 		Description: description,
 	}
+	// parentId kosong = jadikan root, field tidak dikirim = parent tetap
+	if parentID, ok := c.GetPostForm("parentId"); ok {
+		req.ParentID = &parentID
+	}
 
 	// 3. Get uploaded file (optional)
 	var file multipart.File
@@ -251,13 +265,8 @@ func (h *Handler) Update(c *gin.Context) {
 	// Pastikan Service.Update sudah diupdate signature-nya untuk menerima (ctx, id, req, file, filename)
 	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req, file, filename)
 	if err != nil {
-		response.Error(
-			c,
-			http.StatusInternalServerError,
-			"UPDATE_ERROR",
-			"Failed to update category",
-			err.Error(),
-		)
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
@@ -281,13 +290,8 @@ func (h *Handler) Delete(c *gin.Context) {
 
 	// 2. Panggil service
 	if err := h.service.Delete(c.Request.Context(), id); err != nil {
-		response.Error(
-			c,
-			http.StatusInternalServerError,
-			"DELETE_ERROR",
-			"Failed to delete category",
-			err.Error(),
-		)
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
@@ -297,15 +301,71 @@ func (h *Handler) Delete(c *gin.Context) {
 func (h *Handler) Restore(c *gin.Context) {
 	res, err := h.service.Restore(c.Request.Context(), c.Param("id"))
 	if err != nil {
-		response.Error(
-			c,
-			http.StatusInternalServerError,
-			"RESTORE_ERROR",
-			"Failed to restore category",
-			err.Error(),
-		)
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ==================== ATTRIBUTES ====================
+
+// GET /categories/:id/attributes & /admin/categories/:id/attributes
+func (h *Handler) ListAttributes(c *gin.Context) {
+	res, err := h.service.ListAttributes(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.SuccessConditional(c, res, nil, time.Time{})
+}
+
+// POST /admin/categories/:id/attributes
+func (h *Handler) CreateAttribute(c *gin.Context) {
+	var req CreateAttributeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Invalid attribute payload", err.Error())
+		return
+	}
+
+	res, err := h.service.CreateAttribute(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// PATCH /admin/categories/:id/attributes/:attributeId
+func (h *Handler) UpdateAttribute(c *gin.Context) {
+	var req UpdateAttributeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Invalid attribute payload", err.Error())
+		return
+	}
+
+	res, err := h.service.UpdateAttribute(c.Request.Context(), c.Param("id"), c.Param("attributeId"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
 		return
 	}
 
 	response.Success(c, http.StatusOK, res, nil)
 }
+
+// DELETE /admin/categories/:id/attributes/:attributeId
+func (h *Handler) DeleteAttribute(c *gin.Context) {
+	if err := h.service.DeleteAttribute(c.Request.Context(), c.Param("id"), c.Param("attributeId")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"go-gadget-api/internal/category"
+	categoryerrors "go-gadget-api/internal/category/errors"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -28,6 +29,12 @@ type fakeCategoryService struct {
 	UpdateFn     func(ctx context.Context, id string, req category.UpdateCategoryRequest, file multipart.File, filename string) (category.CategoryAdminResponse, error)
 	DeleteFn     func(ctx context.Context, id string) error
 	RestoreFn    func(ctx context.Context, id string) (category.CategoryAdminResponse, error)
+	GetTreeFn    func(ctx context.Context) ([]category.CategoryTreeNode, error)
+
+	ListAttributesFn  func(ctx context.Context, categoryID string) ([]category.CategoryAttributeResponse, error)
+	CreateAttributeFn func(ctx context.Context, categoryID string, req category.CreateAttributeRequest) (category.CategoryAttributeResponse, error)
+	UpdateAttributeFn func(ctx context.Context, categoryID, attributeID string, req category.UpdateAttributeRequest) (category.CategoryAttributeResponse, error)
+	DeleteAttributeFn func(ctx context.Context, categoryID, attributeID string) error
 }
 
 func (f *fakeCategoryService) Create(ctx context.Context, req category.CreateCategoryRequest, file multipart.File, filename string) (category.CategoryAdminResponse, error) {
@@ -51,6 +58,21 @@ func (f *fakeCategoryService) Delete(ctx context.Context, id string) error {
 func (f *fakeCategoryService) Restore(ctx context.Context, id string) (category.CategoryAdminResponse, error) {
 	return f.RestoreFn(ctx, id)
 }
+func (f *fakeCategoryService) GetTree(ctx context.Context) ([]category.CategoryTreeNode, error) {
+	return f.GetTreeFn(ctx)
+}
+func (f *fakeCategoryService) ListAttributes(ctx context.Context, categoryID string) ([]category.CategoryAttributeResponse, error) {
+	return f.ListAttributesFn(ctx, categoryID)
+}
+func (f *fakeCategoryService) CreateAttribute(ctx context.Context, categoryID string, req category.CreateAttributeRequest) (category.CategoryAttributeResponse, error) {
+	return f.CreateAttributeFn(ctx, categoryID, req)
+}
+func (f *fakeCategoryService) UpdateAttribute(ctx context.Context, categoryID, attributeID string, req category.UpdateAttributeRequest) (category.CategoryAttributeResponse, error) {
+	return f.UpdateAttributeFn(ctx, categoryID, attributeID, req)
+}
+func (f *fakeCategoryService) DeleteAttribute(ctx context.Context, categoryID, attributeID string) error {
+	return f.DeleteAttributeFn(ctx, categoryID, attributeID)
+}
 
 // ==================== HELPERS ====================
 
@@ -170,6 +192,8 @@ func TestUpdateCategory(t *testing.T) {
 		svc := &fakeCategoryService{
 			UpdateFn: func(ctx context.Context, bid string, req category.UpdateCategoryRequest, file multipart.File, filename string) (category.CategoryAdminResponse, error) {
 				assert.Equal(t, id, bid)
+				// parentId tidak dikirim = parent tidak diubah
+				assert.Nil(t, req.ParentID)
 				return category.CategoryAdminResponse{ID: id, Name: req.Name}, nil
 			},
 		}
@@ -194,6 +218,31 @@ func TestUpdateCategory(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("move to root and cycle error", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			UpdateFn: func(ctx context.Context, bid string, req category.UpdateCategoryRequest, file multipart.File, filename string) (category.CategoryAdminResponse, error) {
+				if assert.NotNil(t, req.ParentID) && *req.ParentID == "" {
+					return category.CategoryAdminResponse{ID: bid}, nil
+				}
+				return category.CategoryAdminResponse{}, categoryerrors.ErrCategoryCycle
+			},
+		}
+
+		r := setupTestRouter()
+		r.PUT("/categorys/:id", category.NewHandler(svc).Update)
+
+		for parentID, code := range map[string]int{"": http.StatusOK, uuid.NewString(): http.StatusBadRequest} {
+			body, ct, _ := createMultipartForm(map[string]string{"name": "Charger", "parentId": parentID}, "", "", nil)
+			req := httptest.NewRequest(http.MethodPut, "/categorys/"+id, body)
+			req.Header.Set("Content-Type", ct)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, code, w.Code)
+		}
+	})
+
 	t.Run("negative - invalid uuid", func(t *testing.T) {
 		svc := &fakeCategoryService{}
 
@@ -406,6 +455,23 @@ func TestDeleteCategory(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
+	t.Run("negative - has subcategories", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			DeleteFn: func(ctx context.Context, id string) error {
+				return categoryerrors.ErrCategoryHasChildren
+			},
+		}
+
+		r := setupTestRouter()
+		r.DELETE("/categorys/:id", category.NewHandler(svc).Delete)
+
+		req := httptest.NewRequest(http.MethodDelete, "/categorys/"+id, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
 	t.Run("negative - service error", func(t *testing.T) {
 		svc := &fakeCategoryService{
 			DeleteFn: func(ctx context.Context, id string) error {
@@ -425,3 +491,90 @@ func TestDeleteCategory(t *testing.T) {
 	})
 
 }
+
+func TestGetCategoryTree(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			GetTreeFn: func(ctx context.Context) ([]category.CategoryTreeNode, error) {
+				return []category.CategoryTreeNode{{
+					Name: "Accessories", Slug: "accessories",
+					Children: []category.CategoryTreeNode{{Name: "Charger", Slug: "charger", Children: []category.CategoryTreeNode{}}},
+				}}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/categories/tree", category.NewHandler(svc).GetTree)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"children":[{"id":"","name":"Charger","slug":"charger","children":[]}]`)
+	})
+
+	t.Run("negative - service error", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			GetTreeFn: func(ctx context.Context) ([]category.CategoryTreeNode, error) {
+				return nil, categoryerrors.ErrCategoryFailed
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/categories/tree", category.NewHandler(svc).GetTree)
+
+		req := httptest.NewRequest(http.MethodGet, "/categories/tree", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+// ==================== ATTRIBUTES ====================
+
+func TestCreateCategoryAttribute(t *testing.T) {
+	categoryID := uuid.NewString()
+
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			CreateAttributeFn: func(ctx context.Context, cid string, req category.CreateAttributeRequest) (category.CategoryAttributeResponse, error) {
+				assert.Equal(t, categoryID, cid)
+				assert.Equal(t, "ram", req.Code)
+				assert.Equal(t, "number", req.DataType)
+				return category.CategoryAttributeResponse{ID: uuid.NewString(), Code: req.Code}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/categories/:id/attributes", category.NewHandler(svc).CreateAttribute)
+
+		body := `{"code":"ram","name":"RAM","dataType":"number","unit":"GB"}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/categories/"+categoryID+"/attributes", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("duplicate_code", func(t *testing.T) {
+		svc := &fakeCategoryService{
+			CreateAttributeFn: func(ctx context.Context, cid string, req category.CreateAttributeRequest) (category.CategoryAttributeResponse, error) {
+				return category.CategoryAttributeResponse{}, categoryerrors.ErrAttributeCodeExists
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/categories/:id/attributes", category.NewHandler(svc).CreateAttribute)
+
+		body := `{"code":"ram","name":"RAM","dataType":"number"}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/categories/"+categoryID+"/attributes", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -3,6 +3,7 @@ package category
 import (
 	"context"
 	"database/sql"
+	"go-gadget-api/internal/pkg/constants"
 	"go-gadget-api/internal/shared/database/dbgen"
 
 	"github.com/google/uuid"
@@ -19,6 +20,25 @@ type Repository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) (dbgen.Category, error)
 	GetIDsBySlugs(ctx context.Context, slugs []string) ([]uuid.UUID, error)
+
+	// Hirarki kategori
+	ListTree(ctx context.Context) ([]dbgen.ListCategoryTreeRow, error)
+	ListAncestors(ctx context.Context, id uuid.UUID) ([]dbgen.ListCategoryAncestorsRow, error)
+	GetDescendantIDsBySlugs(ctx context.Context, slugs []string) ([]uuid.UUID, error)
+	CountDependents(ctx context.Context, id uuid.UUID) (dbgen.CountCategoryDependentsRow, error)
+	LockForUpdate(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+
+	// Slug history (redirect slug lama & cegah pemakaian ulang)
+	FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error)
+	IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error)
+	RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error
+
+	// Attribute schema (spesifikasi produk per kategori)
+	ListAttributes(ctx context.Context, categoryID uuid.UUID) ([]dbgen.CategoryAttribute, error)
+	GetAttribute(ctx context.Context, categoryID, attributeID uuid.UUID) (dbgen.CategoryAttribute, error)
+	CreateAttribute(ctx context.Context, arg dbgen.CreateCategoryAttributeParams) (dbgen.CategoryAttribute, error)
+	UpdateAttribute(ctx context.Context, arg dbgen.UpdateCategoryAttributeParams) (dbgen.CategoryAttribute, error)
+	DeleteAttribute(ctx context.Context, categoryID, attributeID uuid.UUID) (int64, error)
 }
 
 type repository struct {
@@ -51,6 +71,41 @@ func (r *repository) GetIDsBySlugs(ctx context.Context, slugs []string) ([]uuid.
 	return r.queries.GetIDsBySlugs(ctx, slugs)
 }
 
+func (r *repository) ListTree(ctx context.Context) ([]dbgen.ListCategoryTreeRow, error) {
+	return r.queries.ListCategoryTree(ctx)
+}
+
+func (r *repository) ListAncestors(ctx context.Context, id uuid.UUID) ([]dbgen.ListCategoryAncestorsRow, error) {
+	return r.queries.ListCategoryAncestors(ctx, id)
+}
+
+func (r *repository) GetDescendantIDsBySlugs(ctx context.Context, slugs []string) ([]uuid.UUID, error) {
+	if len(slugs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+	return r.queries.GetCategoryDescendantIDsBySlugs(ctx, slugs)
+}
+
+func (r *repository) CountDependents(ctx context.Context, id uuid.UUID) (dbgen.CountCategoryDependentsRow, error) {
+	return r.queries.CountCategoryDependents(ctx, id)
+}
+
+func (r *repository) FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error) {
+	return r.queries.GetSlugHistoryOwner(ctx, dbgen.GetSlugHistoryOwnerParams{EntityType: constants.SlugEntityCategory, Slug: slug})
+}
+
+func (r *repository) IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error) {
+	return r.queries.IsSlugRetired(ctx, dbgen.IsSlugRetiredParams{EntityType: constants.SlugEntityCategory, Slug: slug, EntityID: id})
+}
+
+// RecordSlugChange menyimpan slug lama ke riwayat; slug baru yang dulu milik entitas ini dikeluarkan dari riwayat
+func (r *repository) RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error {
+	if err := r.queries.DeleteSlugHistory(ctx, dbgen.DeleteSlugHistoryParams{EntityType: constants.SlugEntityCategory, EntityID: id, Slug: newSlug}); err != nil {
+		return err
+	}
+	return r.queries.InsertSlugHistory(ctx, dbgen.InsertSlugHistoryParams{EntityType: constants.SlugEntityCategory, EntityID: id, Slug: oldSlug})
+}
+
 func (r *repository) Update(ctx context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error) {
 	return r.queries.UpdateCategory(ctx, arg)
 }
@@ -59,10 +114,40 @@ func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.queries.SoftDeleteCategory(ctx, id)
 }
 
+func (r *repository) LockForUpdate(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.LockCategoriesForUpdate(ctx, ids)
+}
+
 func (r *repository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Category, error) {
 	return r.queries.RestoreCategory(ctx, id)
 }
 
+func (r *repository) ListAttributes(ctx context.Context, categoryID uuid.UUID) ([]dbgen.CategoryAttribute, error) {
+	return r.queries.ListCategoryAttributes(ctx, categoryID)
+}
+
+func (r *repository) GetAttribute(ctx context.Context, categoryID, attributeID uuid.UUID) (dbgen.CategoryAttribute, error) {
+	return r.queries.GetCategoryAttributeByID(ctx, dbgen.GetCategoryAttributeByIDParams{
+		ID:         attributeID,
+		CategoryID: categoryID,
+	})
+}
+
+func (r *repository) CreateAttribute(ctx context.Context, arg dbgen.CreateCategoryAttributeParams) (dbgen.CategoryAttribute, error) {
+	return r.queries.CreateCategoryAttribute(ctx, arg)
+}
+
+func (r *repository) UpdateAttribute(ctx context.Context, arg dbgen.UpdateCategoryAttributeParams) (dbgen.CategoryAttribute, error) {
+	return r.queries.UpdateCategoryAttribute(ctx, arg)
+}
+
+func (r *repository) DeleteAttribute(ctx context.Context, categoryID, attributeID uuid.UUID) (int64, error) {
+	return r.queries.DeleteCategoryAttribute(ctx, dbgen.DeleteCategoryAttributeParams{
+		ID:         attributeID,
+		CategoryID: categoryID,
+	})
+}
+
 func (r *repository) WithTx(tx dbgen.DBTX) Repository {
 	if sqlTx, ok := tx.(*sql.Tx); ok {
 		return &repository{
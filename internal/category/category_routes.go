@@ -17,6 +17,12 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 			handler.ListPublic,
 		)
 
+		// Pohon kategori (menu navigasi), jarang berubah & di-cache
+		categories.GET("/tree",
+			middleware.RateLimitByIP(10, 20),
+			handler.GetTree,
+		)
+
 		// 2. Get Detail Category (Includ. List Product per Category)
 		// Endpoint ini biasanya menarik data produk dalam jumlah banyak.
 		// Limit 5 rps, burst 10.
@@ -24,9 +30,15 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 			middleware.RateLimitByIP(5, 10),
 			handler.GetByID,
 		)
+
+		// 3. Schema atribut kategori (dipakai frontend untuk membangun filter spesifikasi)
+		categories.GET("/:id/attributes",
+			middleware.RateLimitByIP(10, 20),
+			handler.ListAttributes,
+		)
 	}
 
-	// 3. Admin Categories (Management)
+	// 4. Admin Categories (Management)
 	adminCategories := r.Group("/admin/categories")
 	adminCategories.Use(
 		middleware.AuthMiddleware(),
@@ -48,5 +60,11 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 		adminCategories.PATCH("/:id", categoryMutationLimit, handler.Update)
 		adminCategories.DELETE("/:id", categoryMutationLimit, handler.Delete)
 		adminCategories.PATCH("/:id/restore", categoryMutationLimit, handler.Restore)
+
+		// Attribute schema per kategori (mis. RAM, Chipset, Baterai)
+		adminCategories.GET("/:id/attributes", middleware.RateLimitByUser(10, 20), handler.ListAttributes)
+		adminCategories.POST("/:id/attributes", categoryMutationLimit, handler.CreateAttribute)
+		adminCategories.PATCH("/:id/attributes/:attributeId", categoryMutationLimit, handler.UpdateAttribute)
+		adminCategories.DELETE("/:id/attributes/:attributeId", categoryMutationLimit, handler.DeleteAttribute)
 	}
 }
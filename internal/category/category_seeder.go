@@ -7,38 +7,61 @@ import (
 	"go-gadget-api/internal/shared/database/helper"
 	"log"
 	"strings"
+
+	"github.com/google/uuid"
 )
 
+type seedCategory struct {
+	CreateCategoryRequest
+	Children []seedCategory
+}
+
 func SeedCategories(repo Repository) {
 	ctx := context.Background()
 
-	categories := []CreateCategoryRequest{
-		{Name: "Handphone", Description: "Smartphone dan ponsel fitur terbaru", ImageUrl: "https://example.com/hp.jpg"},
-		{Name: "Tablet", Description: "Tablet Android dan iPad untuk produktivitas", ImageUrl: "https://example.com/tablet.jpg"},
-		{Name: "Laptop", Description: "Laptop gaming, kantor, dan ultrabook", ImageUrl: "https://example.com/laptop.jpg"},
-		{Name: "Wearable", Description: "Smartwatch dan TWS", ImageUrl: "https://example.com/wearable.jpg"},
-		{Name: "Accessories", Description: "Charger, kabel, dan casing", ImageUrl: "https://example.com/acc.jpg"},
+	categories := []seedCategory{
+		{CreateCategoryRequest: CreateCategoryRequest{Name: "Handphone", Description: "Smartphone dan ponsel fitur terbaru", ImageUrl: "https://example.com/hp.jpg"}},
+		{CreateCategoryRequest: CreateCategoryRequest{Name: "Tablet", Description: "Tablet Android dan iPad untuk produktivitas", ImageUrl: "https://example.com/tablet.jpg"}},
+		{CreateCategoryRequest: CreateCategoryRequest{Name: "Laptop", Description: "Laptop gaming, kantor, dan ultrabook", ImageUrl: "https://example.com/laptop.jpg"}},
+		{CreateCategoryRequest: CreateCategoryRequest{Name: "Wearable", Description: "Smartwatch dan TWS", ImageUrl: "https://example.com/wearable.jpg"}},
+		{
+			CreateCategoryRequest: CreateCategoryRequest{Name: "Accessories", Description: "Charger, kabel, dan casing", ImageUrl: "https://example.com/acc.jpg"},
+			Children: []seedCategory{
+				{CreateCategoryRequest: CreateCategoryRequest{Name: "Charger", Description: "Adaptor dan wireless charger", ImageUrl: "https://example.com/charger.jpg"}},
+				{CreateCategoryRequest: CreateCategoryRequest{Name: "Kabel", Description: "Kabel data USB-C, Lightning, dan micro USB", ImageUrl: "https://example.com/kabel.jpg"}},
+				{CreateCategoryRequest: CreateCategoryRequest{Name: "Casing", Description: "Casing dan pelindung layar", ImageUrl: "https://example.com/casing.jpg"}},
+			},
+		},
 	}
 
 	fmt.Println("Seeding categories for gadget-api...")
 
+	seedCategoryLevel(ctx, repo, categories, uuid.NullUUID{})
+
+	fmt.Println("Seeding selesai!")
+}
+
+// seedCategoryLevel membuat kategori satu level lalu turun ke subkategorinya
+func seedCategoryLevel(ctx context.Context, repo Repository, categories []seedCategory, parentID uuid.NullUUID) {
 	for _, cat := range categories {
 		// Logika slug sederhana: Handphone -> handphone
 		slug := strings.ToLower(cat.Name)
 
-		_, err := repo.Create(ctx, dbgen.CreateCategoryParams{
+		created, err := repo.Create(ctx, dbgen.CreateCategoryParams{
 			Name:        cat.Name,
 			Slug:        slug,
 			Description: helper.StringToNull(&cat.Description),
 			ImageUrl:    helper.StringToNull(&cat.ImageUrl),
+			ParentID:    parentID,
 		})
 
 		if err != nil {
+			// Subkategori ikut dilewati karena parent-nya tidak ada
 			log.Printf("Gagal seed kategori %s: %v", cat.Name, err)
 			continue
 		}
 		fmt.Printf("Berhasil seed: %s\n", cat.Name)
-	}
 
-	fmt.Println("Seeding selesai!")
+		seedCategoryLevel(ctx, repo, cat.Children, uuid.NullUUID{UUID: created.ID, Valid: true})
+	}
 }
@@ -3,6 +3,7 @@ package category
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	categoryerrors "go-gadget-api/internal/category/errors"
 	"go-gadget-api/internal/cloudinary"
@@ -31,6 +32,12 @@ type Service interface {
 	Update(ctx context.Context, id string, req UpdateCategoryRequest, file multipart.File, filename string) (CategoryAdminResponse, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) (CategoryAdminResponse, error)
+	GetTree(ctx context.Context) ([]CategoryTreeNode, error)
+
+	ListAttributes(ctx context.Context, categoryID string) ([]CategoryAttributeResponse, error)
+	CreateAttribute(ctx context.Context, categoryID string, req CreateAttributeRequest) (CategoryAttributeResponse, error)
+	UpdateAttribute(ctx context.Context, categoryID, attributeID string, req UpdateAttributeRequest) (CategoryAttributeResponse, error)
+	DeleteAttribute(ctx context.Context, categoryID, attributeID string) error
 }
 
 type service struct {
@@ -67,6 +74,7 @@ func (s *service) ListPublic(ctx context.Context, page, limit int) ([]CategoryPu
 			Name:     row.Name,
 			Slug:     row.Slug,
 			ImageUrl: row.ImageUrl.String,
+			ParentID: nullUUIDString(row.ParentID),
 		})
 	}
 	return res, total, nil
@@ -137,6 +145,14 @@ func (s *service) Create(ctx context.Context, req CreateCategoryRequest, file mu
 	if err := s.validate.Struct(req); err != nil {
 		return CategoryAdminResponse{}, apperror.MapValidationError(err)
 	}
+	parentID, err := s.resolveParent(ctx, uuid.Nil, req.ParentID)
+	if err != nil {
+		return CategoryAdminResponse{}, err
+	}
+	if err := s.ensureSlugAvailable(ctx, req.Slug, uuid.Nil); err != nil {
+		return CategoryAdminResponse{}, err
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return CategoryAdminResponse{}, err
@@ -149,6 +165,7 @@ func (s *service) Create(ctx context.Context, req CreateCategoryRequest, file mu
 		Slug:        req.Slug,
 		Description: helper.StringToNull(&req.Description),
 		ImageUrl:    sql.NullString{},
+		ParentID:    parentID,
 	})
 	if err != nil {
 		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
@@ -174,6 +191,7 @@ func (s *service) Create(ctx context.Context, req CreateCategoryRequest, file mu
 			Description: category.Description,
 			ImageUrl:    helper.StringToNull(&imageURL),
 			IsActive:    category.IsActive,
+			ParentID:    category.ParentID,
 		})
 		if err != nil {
 			// Update gagal, hapus image yang sudah terlanjur diupload
@@ -216,6 +234,22 @@ func (s *service) Update(
 		return CategoryAdminResponse{}, categoryerrors.ErrCategoryNotFound
 	}
 
+	// 1b. Pindah parent hanya jika field parentId dikirim
+	parentID := category.ParentID
+	if req.ParentID != nil {
+		parentID, err = s.resolveParent(ctx, category.ID, *req.ParentID)
+		if err != nil {
+			return CategoryAdminResponse{}, err
+		}
+	}
+
+	slugChanged := req.Slug != category.Slug
+	if slugChanged {
+		if err := s.ensureSlugAvailable(ctx, req.Slug, category.ID); err != nil {
+			return CategoryAdminResponse{}, err
+		}
+	}
+
 	var newImageURL sql.NullString
 
 	// 2. Kalau upload image baru
@@ -253,22 +287,60 @@ func (s *service) Update(
 		newImageURL = category.ImageUrl
 	}
 
-	// 3. Update DB
-	_, err = s.repo.Update(ctx, dbgen.UpdateCategoryParams{
+	// 3. Update DB (+ catat slug lama dalam transaksi yang sama)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	// Cek siklus diulang di bawah lock, cek di atas hanya agar gagal cepat sebelum upload image
+	if req.ParentID != nil && parentID.Valid {
+		if err := lockParentChain(ctx, qtx, category.ID, parentID.UUID); err != nil {
+			return CategoryAdminResponse{}, err
+		}
+	}
+
+	_, err = qtx.Update(ctx, dbgen.UpdateCategoryParams{
 		ID:          category.ID,
 		Name:        req.Name,
 		Slug:        req.Slug,
 		Description: helper.StringToNull(&req.Description),
 		ImageUrl:    newImageURL,
 		IsActive:    category.IsActive,
+		ParentID:    parentID,
 	})
 	if err != nil {
 		return CategoryAdminResponse{}, err
 	}
 
+	if slugChanged {
+		if err := qtx.RecordSlugChange(ctx, category.ID, category.Slug, req.Slug); err != nil {
+			return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
 	return s.GetByID(ctx, category.ID.String())
 }
 
+// ensureSlugAvailable menolak slug yang pernah dipakai kategori lain agar URL filter lamanya tetap mengarah benar
+func (s *service) ensureSlugAvailable(ctx context.Context, slug string, id uuid.UUID) error {
+	retired, err := s.repo.IsSlugRetired(ctx, slug, id)
+	if err != nil {
+		return categoryerrors.ErrCategoryFailed
+	}
+	if retired {
+		return categoryerrors.ErrSlugUnavailable
+	}
+	return nil
+}
+
 func (s *service) Delete(ctx context.Context, idStr string) error {
 	id, err := uuid.Parse(idStr)
 	if err != nil {
@@ -281,7 +353,19 @@ func (s *service) Delete(ctx context.Context, idStr string) error {
 		return err
 	}
 
-	// 2. delete image jika ada
+	// 2. tolak jika masih punya subkategori / produk, agar tidak ada data yatim
+	deps, err := s.repo.CountDependents(ctx, id)
+	if err != nil {
+		return categoryerrors.ErrCategoryFailed
+	}
+	if deps.ChildCount > 0 {
+		return categoryerrors.ErrCategoryHasChildren
+	}
+	if deps.ProductCount > 0 {
+		return categoryerrors.ErrCategoryHasProducts
+	}
+
+	// 3. delete image jika ada
 	if category.ImageUrl.Valid && category.ImageUrl.String != "" {
 		publicID, err := cloudinary.ExtractPublicID(category.ImageUrl.String, constants.CloudinaryCategoryFolder)
 		if err != nil {
@@ -293,17 +377,198 @@ func (s *service) Delete(ctx context.Context, idStr string) error {
 		}
 	}
 
-	// 3. delete category di database
+	// 4. delete category di database
 	return s.repo.Delete(ctx, id)
 }
 
 func (s *service) Restore(ctx context.Context, idStr string) (CategoryAdminResponse, error) {
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		return CategoryAdminResponse{}, err
+		return CategoryAdminResponse{}, categoryerrors.ErrInvalidUUID
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	category, err := qtx.Restore(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryNotFound
+	}
+	if err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
+	// Parent yang masih terhapus akan membuat kategori ini yatim, minta parent di-restore dulu
+	if category.ParentID.Valid {
+		parents, err := qtx.LockForUpdate(ctx, []uuid.UUID{category.ParentID.UUID})
+		if err != nil {
+			return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+		}
+		if len(parents) == 0 {
+			return CategoryAdminResponse{}, categoryerrors.ErrParentCategoryDeleted
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return CategoryAdminResponse{}, categoryerrors.ErrCategoryFailed
+	}
+	return mapToResponse(category), nil
+}
+
+// ==================== ATTRIBUTES ====================
+
+func (s *service) ListAttributes(ctx context.Context, categoryID string) ([]CategoryAttributeResponse, error) {
+	id, err := uuid.Parse(categoryID)
+	if err != nil {
+		return nil, categoryerrors.ErrInvalidUUID
+	}
+
+	rows, err := s.repo.ListAttributes(ctx, id)
+	if err != nil {
+		return nil, categoryerrors.ErrCategoryFailed
+	}
+
+	res := make([]CategoryAttributeResponse, 0, len(rows))
+	for _, a := range rows {
+		res = append(res, mapAttributeToResponse(a))
+	}
+	return res, nil
+}
+
+func (s *service) CreateAttribute(ctx context.Context, categoryID string, req CreateAttributeRequest) (CategoryAttributeResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return CategoryAttributeResponse{}, apperror.MapValidationError(err)
+	}
+	id, err := uuid.Parse(categoryID)
+	if err != nil {
+		return CategoryAttributeResponse{}, categoryerrors.ErrInvalidUUID
+	}
+
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return CategoryAttributeResponse{}, categoryerrors.ErrCategoryNotFound
+	}
+
+	// Code dinormalisasi agar konsisten dipakai di query string
+	code := normalizeAttributeCode(req.Code)
+	existing, err := s.repo.ListAttributes(ctx, id)
+	if err != nil {
+		return CategoryAttributeResponse{}, categoryerrors.ErrCategoryFailed
+	}
+	for _, a := range existing {
+		if a.Code == code {
+			return CategoryAttributeResponse{}, categoryerrors.ErrAttributeCodeExists
+		}
+	}
+
+	isFilterable := true
+	if req.IsFilterable != nil {
+		isFilterable = *req.IsFilterable
+	}
+
+	attr, err := s.repo.CreateAttribute(ctx, dbgen.CreateCategoryAttributeParams{
+		CategoryID:   id,
+		Code:         code,
+		Name:         strings.TrimSpace(req.Name),
+		DataType:     req.DataType,
+		Unit:         helper.StringToNull(&req.Unit),
+		IsFilterable: isFilterable,
+		SortOrder:    req.SortOrder,
+	})
+	if err != nil {
+		return CategoryAttributeResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
+	return mapAttributeToResponse(attr), nil
+}
+
+func (s *service) UpdateAttribute(ctx context.Context, categoryID, attributeID string, req UpdateAttributeRequest) (CategoryAttributeResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return CategoryAttributeResponse{}, apperror.MapValidationError(err)
+	}
+	cid, aid, err := parseAttributeIDs(categoryID, attributeID)
+	if err != nil {
+		return CategoryAttributeResponse{}, err
+	}
+
+	existing, err := s.repo.GetAttribute(ctx, cid, aid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return CategoryAttributeResponse{}, categoryerrors.ErrAttributeNotFound
+		}
+		return CategoryAttributeResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
+	params := dbgen.UpdateCategoryAttributeParams{
+		ID:           existing.ID,
+		CategoryID:   existing.CategoryID,
+		Name:         strings.TrimSpace(req.Name),
+		Unit:         helper.StringToNull(&req.Unit),
+		IsFilterable: existing.IsFilterable,
+		SortOrder:    existing.SortOrder,
+	}
+	if req.IsFilterable != nil {
+		params.IsFilterable = *req.IsFilterable
+	}
+	if req.SortOrder != nil {
+		params.SortOrder = *req.SortOrder
+	}
+
+	attr, err := s.repo.UpdateAttribute(ctx, params)
+	if err != nil {
+		return CategoryAttributeResponse{}, categoryerrors.ErrCategoryFailed
+	}
+
+	return mapAttributeToResponse(attr), nil
+}
+
+// DeleteAttribute ikut menghapus nilai atribut di semua produk (ON DELETE CASCADE)
+func (s *service) DeleteAttribute(ctx context.Context, categoryID, attributeID string) error {
+	cid, aid, err := parseAttributeIDs(categoryID, attributeID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := s.repo.DeleteAttribute(ctx, cid, aid)
+	if err != nil {
+		return categoryerrors.ErrCategoryFailed
+	}
+	if affected == 0 {
+		return categoryerrors.ErrAttributeNotFound
+	}
+	return nil
+}
+
+func parseAttributeIDs(categoryID, attributeID string) (uuid.UUID, uuid.UUID, error) {
+	cid, err := uuid.Parse(categoryID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, categoryerrors.ErrInvalidUUID
+	}
+	aid, err := uuid.Parse(attributeID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, categoryerrors.ErrInvalidAttributeID
+	}
+	return cid, aid, nil
+}
+
+// normalizeAttributeCode: "Battery Capacity" -> "battery_capacity"
+func normalizeAttributeCode(code string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(code)), " ", "_")
+}
+
+func mapAttributeToResponse(a dbgen.CategoryAttribute) CategoryAttributeResponse {
+	return CategoryAttributeResponse{
+		ID:           a.ID.String(),
+		Code:         a.Code,
+		Name:         a.Name,
+		DataType:     a.DataType,
+		Unit:         a.Unit.String,
+		IsFilterable: a.IsFilterable,
+		SortOrder:    a.SortOrder,
 	}
-	category, err := s.repo.Restore(ctx, id)
-	return mapToResponse(category), err
 }
 
 func mapToResponse(category dbgen.Category) CategoryAdminResponse {
@@ -312,10 +577,18 @@ func mapToResponse(category dbgen.Category) CategoryAdminResponse {
 		Name:      category.Name,
 		ImageUrl:  category.ImageUrl.String,
 		Slug:      category.Slug,
+		ParentID:  nullUUIDString(category.ParentID),
 		CreatedAt: category.CreatedAt,
 	}
 }
 
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.UUID.String()
+}
+
 func (s *service) mapAdminRowsToResponse(rows []dbgen.ListCategoriesAdminRow) []CategoryAdminResponse {
 	res := make([]CategoryAdminResponse, 0)
 	for _, row := range rows {
@@ -326,6 +599,7 @@ func (s *service) mapAdminRowsToResponse(rows []dbgen.ListCategoriesAdminRow) []
 			Description: row.Description.String,
 			ImageUrl:    row.ImageUrl.String,
 			IsActive:    row.IsActive.Bool,
+			ParentID:    nullUUIDString(row.ParentID),
 			CreatedAt:   row.CreatedAt,
 			UpdatedAt:   row.UpdatedAt,
 			DeletedAt:   nil, // Bisa diisi row.DeletedAt jika tipenya cocok
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"go-gadget-api/internal/category"
+	categoryerrors "go-gadget-api/internal/category/errors"
 	"go-gadget-api/internal/pkg/constants"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"go-gadget-api/internal/shared/database/helper"
@@ -201,6 +202,7 @@ func TestCategoryService_Create(t *testing.T) {
 		filename := "logo.png"
 		imgURL := "https://cloudinary.com/apple.png"
 
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, true)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -225,6 +227,7 @@ func TestCategoryService_Create(t *testing.T) {
 
 	t.Run("negative - upload image failed (rollback)", func(t *testing.T) {
 		fakeFile := &mockFile{}
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false) // Expect Rollback
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -240,6 +243,7 @@ func TestCategoryService_Create(t *testing.T) {
 	})
 
 	t.Run("negative - database create failed", func(t *testing.T) {
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
 		expectTx(t, deps.sqlMock, false)
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 
@@ -248,6 +252,50 @@ func TestCategoryService_Create(t *testing.T) {
 		_, err := deps.service.Create(ctx, req, nil, "")
 		assert.Error(t, err)
 	})
+
+	t.Run("negative - slug retired by another category", func(t *testing.T) {
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(true, nil)
+
+		_, err := deps.service.Create(ctx, req, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrSlugUnavailable)
+	})
+
+	t.Run("positive - subcategory", func(t *testing.T) {
+		parentID := uuid.New()
+		deps.repo.EXPECT().
+			ListAncestors(ctx, parentID).
+			Return([]dbgen.ListCategoryAncestorsRow{{ID: parentID, Name: "Accessories", Slug: "accessories"}}, nil)
+
+		deps.repo.EXPECT().IsSlugRetired(ctx, req.Slug, uuid.Nil).Return(false, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateCategoryParams) (dbgen.Category, error) {
+				assert.Equal(t, uuid.NullUUID{UUID: parentID, Valid: true}, arg.ParentID)
+				return dbgen.Category{ID: categoryID, ParentID: arg.ParentID}, nil
+			})
+		deps.repo.EXPECT().GetByID(ctx, categoryID).Return(dbgen.Category{
+			ID: categoryID, Name: "Charger", ParentID: uuid.NullUUID{UUID: parentID, Valid: true},
+		}, nil)
+
+		res, err := deps.service.Create(ctx, category.CreateCategoryRequest{Name: "Charger", ParentID: parentID.String()}, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, parentID.String(), res.ParentID)
+	})
+
+	t.Run("negative - parent not found", func(t *testing.T) {
+		parentID := uuid.New()
+		deps.repo.EXPECT().ListAncestors(ctx, parentID).Return(nil, nil)
+
+		_, err := deps.service.Create(ctx, category.CreateCategoryRequest{Name: "Charger", ParentID: parentID.String()}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrParentCategoryNotFound)
+	})
+
+	t.Run("negative - invalid parent id", func(t *testing.T) {
+		_, err := deps.service.Create(ctx, category.CreateCategoryRequest{Name: "Charger", ParentID: "abc"}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrInvalidParentID)
+	})
 }
 
 func TestCategoryService_Update(t *testing.T) {
@@ -268,6 +316,8 @@ func TestCategoryService_Update(t *testing.T) {
 				IsActive: helper.RawBoolToNull(true),
 			}, nil)
 
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(dbgen.Category{ID: id}, nil)
@@ -304,6 +354,8 @@ func TestCategoryService_Update(t *testing.T) {
 			UploadImage(ctx, fakeFile, gomock.Any(), constants.CloudinaryCategoryFolder).
 			Return(imgURL, nil)
 
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.repo.EXPECT().
 			Update(ctx, gomock.Any()).
 			Return(dbgen.Category{ID: id}, nil)
@@ -321,6 +373,40 @@ func TestCategoryService_Update(t *testing.T) {
 		assert.Equal(t, imgURL, res.ImageUrl)
 	})
 
+	t.Run("positive - slug change is recorded", func(t *testing.T) {
+		renamed := category.UpdateCategoryRequest{Name: "Phone Accessories", Slug: "phone-accessories"}
+
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Category{ID: id, Name: "Accessories", Slug: "accessories"}, nil)
+		deps.repo.EXPECT().IsSlugRetired(ctx, "phone-accessories", id).Return(false, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Category{ID: id}, nil)
+		deps.repo.EXPECT().RecordSlugChange(ctx, id, "accessories", "phone-accessories").Return(nil)
+
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Category{ID: id, Name: renamed.Name, Slug: renamed.Slug}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), renamed, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "phone-accessories", res.Slug)
+	})
+
+	t.Run("negative - slug retired by another category", func(t *testing.T) {
+		renamed := category.UpdateCategoryRequest{Name: "Phone Accessories", Slug: "phone-accessories"}
+
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Category{ID: id, Slug: "accessories"}, nil)
+		deps.repo.EXPECT().IsSlugRetired(ctx, "phone-accessories", id).Return(true, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), renamed, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrSlugUnavailable)
+	})
+
 	t.Run("negative - invalid uuid format", func(t *testing.T) {
 		_, err := deps.service.Update(ctx, "invalid-uuid", req, nil, "")
 		assert.Error(t, err)
@@ -334,6 +420,140 @@ func TestCategoryService_Update(t *testing.T) {
 		_, err := deps.service.Update(ctx, id.String(), req, nil, "")
 		assert.Error(t, err)
 	})
+	t.Run("positive - move to root", func(t *testing.T) {
+		root := ""
+		deps.repo.EXPECT().
+			GetByID(ctx, id).
+			Return(dbgen.Category{ID: id, ParentID: uuid.NullUUID{UUID: uuid.New(), Valid: true}}, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error) {
+				assert.False(t, arg.ParentID.Valid)
+				return dbgen.Category{ID: id}, nil
+			})
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Charger", ParentID: &root}, nil, "")
+		assert.NoError(t, err)
+		assert.Empty(t, res.ParentID)
+	})
+
+	t.Run("negative - parent is itself", func(t *testing.T) {
+		self := id.String()
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Charger", ParentID: &self}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryCycle)
+	})
+
+	t.Run("negative - parent is a descendant", func(t *testing.T) {
+		// id -> child -> grandchild, lalu id dipindah ke bawah grandchild
+		grandchild := uuid.New()
+		target := grandchild.String()
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+		deps.repo.EXPECT().
+			ListAncestors(ctx, grandchild).
+			Return([]dbgen.ListCategoryAncestorsRow{{ID: id}, {ID: uuid.New()}, {ID: grandchild}}, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Charger", ParentID: &target}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryCycle)
+	})
+
+	t.Run("positive - move locks category and parent chain", func(t *testing.T) {
+		root, parent := uuid.New(), uuid.New()
+		target := parent.String()
+		chain := []dbgen.ListCategoryAncestorsRow{{ID: root}, {ID: parent}}
+
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+		deps.repo.EXPECT().ListAncestors(ctx, parent).Return(chain, nil)
+
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		gomock.InOrder(
+			deps.repo.EXPECT().ListAncestors(ctx, parent).Return(chain, nil),
+			deps.repo.EXPECT().LockForUpdate(ctx, []uuid.UUID{id, root, parent}).Return([]uuid.UUID{id, root, parent}, nil),
+			deps.repo.EXPECT().ListAncestors(ctx, parent).Return(chain, nil),
+			deps.repo.EXPECT().
+				Update(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg dbgen.UpdateCategoryParams) (dbgen.Category, error) {
+					assert.Equal(t, uuid.NullUUID{UUID: parent, Valid: true}, arg.ParentID)
+					return dbgen.Category{ID: id}, nil
+				}),
+		)
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id, ParentID: uuid.NullUUID{UUID: parent, Valid: true}}, nil)
+
+		res, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Charger", ParentID: &target}, nil, "")
+		assert.NoError(t, err)
+		assert.Equal(t, parent.String(), res.ParentID)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("negative - cycle from concurrent move is caught under lock", func(t *testing.T) {
+		// Saat pre-check parent masih di root, sebelum lock tx lain memindahkannya ke bawah id
+		parent := uuid.New()
+		target := parent.String()
+
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+		deps.repo.EXPECT().ListAncestors(ctx, parent).Return([]dbgen.ListCategoryAncestorsRow{{ID: parent}}, nil)
+
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		gomock.InOrder(
+			deps.repo.EXPECT().ListAncestors(ctx, parent).Return([]dbgen.ListCategoryAncestorsRow{{ID: parent}}, nil),
+			deps.repo.EXPECT().LockForUpdate(ctx, []uuid.UUID{id, parent}).Return([]uuid.UUID{id, parent}, nil),
+			deps.repo.EXPECT().ListAncestors(ctx, parent).Return([]dbgen.ListCategoryAncestorsRow{{ID: id}, {ID: parent}}, nil),
+		)
+
+		_, err := deps.service.Update(ctx, id.String(), category.UpdateCategoryRequest{Name: "Charger", ParentID: &target}, nil, "")
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryCycle)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryService_Restore(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	id, parent := uuid.New(), uuid.New()
+
+	t.Run("success - parent is active", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			Restore(ctx, id).
+			Return(dbgen.Category{ID: id, Name: "Charger", ParentID: uuid.NullUUID{UUID: parent, Valid: true}}, nil)
+		deps.repo.EXPECT().LockForUpdate(ctx, []uuid.UUID{parent}).Return([]uuid.UUID{parent}, nil)
+
+		res, err := deps.service.Restore(ctx, id.String())
+		assert.NoError(t, err)
+		assert.Equal(t, parent.String(), res.ParentID)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - parent still deleted", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			Restore(ctx, id).
+			Return(dbgen.Category{ID: id, ParentID: uuid.NullUUID{UUID: parent, Valid: true}}, nil)
+		deps.repo.EXPECT().LockForUpdate(ctx, []uuid.UUID{parent}).Return(nil, nil)
+
+		_, err := deps.service.Restore(ctx, id.String())
+		assert.ErrorIs(t, err, categoryerrors.ErrParentCategoryDeleted)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not found", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().Restore(ctx, id).Return(dbgen.Category{}, sql.ErrNoRows)
+
+		_, err := deps.service.Restore(ctx, id.String())
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryNotFound)
+	})
 }
 
 func TestCategoryService_Delete(t *testing.T) {
@@ -354,6 +574,8 @@ func TestCategoryService_Delete(t *testing.T) {
 			GetByID(ctx, id).
 			Return(category, nil)
 
+		deps.repo.EXPECT().CountDependents(ctx, id).Return(dbgen.CountCategoryDependentsRow{}, nil)
+
 		// 2. mock Delete
 		deps.repo.EXPECT().
 			Delete(ctx, id).
@@ -379,6 +601,8 @@ func TestCategoryService_Delete(t *testing.T) {
 			GetByID(ctx, id).
 			Return(category, nil)
 
+		deps.repo.EXPECT().CountDependents(ctx, id).Return(dbgen.CountCategoryDependentsRow{}, nil)
+
 		// 2. Delete image di cloudinary
 		deps.cloudinary.EXPECT().
 			DeleteImage(ctx, gomock.Any()).
@@ -421,6 +645,8 @@ func TestCategoryService_Delete(t *testing.T) {
 			GetByID(ctx, id).
 			Return(category, nil)
 
+		deps.repo.EXPECT().CountDependents(ctx, id).Return(dbgen.CountCategoryDependentsRow{}, nil)
+
 		deps.cloudinary.EXPECT().
 			DeleteImage(ctx, gomock.Any()).
 			Return(errors.New("cloudinary error"))
@@ -439,6 +665,8 @@ func TestCategoryService_Delete(t *testing.T) {
 			GetByID(ctx, id).
 			Return(category, nil)
 
+		deps.repo.EXPECT().CountDependents(ctx, id).Return(dbgen.CountCategoryDependentsRow{}, nil)
+
 		deps.repo.EXPECT().
 			Delete(ctx, id).
 			Return(errors.New("delete failed"))
@@ -447,4 +675,134 @@ func TestCategoryService_Delete(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("fail - has subcategories", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+		deps.repo.EXPECT().CountDependents(ctx, id).Return(dbgen.CountCategoryDependentsRow{ChildCount: 2, ProductCount: 5}, nil)
+
+		err := deps.service.Delete(ctx, id.String())
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryHasChildren)
+	})
+
+	t.Run("fail - has products", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.Category{ID: id}, nil)
+		deps.repo.EXPECT().CountDependents(ctx, id).Return(dbgen.CountCategoryDependentsRow{ProductCount: 1}, nil)
+
+		err := deps.service.Delete(ctx, id.String())
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryHasProducts)
+	})
+}
+
+func TestCategoryService_CreateAttribute(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	categoryID := uuid.New()
+
+	t.Run("success - code normalized", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, categoryID).Return(dbgen.Category{ID: categoryID}, nil)
+		deps.repo.EXPECT().ListAttributes(ctx, categoryID).Return(nil, nil)
+		deps.repo.EXPECT().
+			CreateAttribute(ctx, dbgen.CreateCategoryAttributeParams{
+				CategoryID:   categoryID,
+				Code:         "battery_capacity",
+				Name:         "Battery Capacity",
+				DataType:     "number",
+				Unit:         sql.NullString{String: "mAh", Valid: true},
+				IsFilterable: true,
+			}).
+			Return(dbgen.CategoryAttribute{ID: uuid.New(), Code: "battery_capacity", DataType: "number", IsFilterable: true}, nil)
+
+		res, err := deps.service.CreateAttribute(ctx, categoryID.String(), category.CreateAttributeRequest{
+			Code:     "Battery Capacity",
+			Name:     "Battery Capacity",
+			DataType: "number",
+			Unit:     "mAh",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "battery_capacity", res.Code)
+	})
+
+	t.Run("fail - duplicate code", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(ctx, categoryID).Return(dbgen.Category{ID: categoryID}, nil)
+		deps.repo.EXPECT().
+			ListAttributes(ctx, categoryID).
+			Return([]dbgen.CategoryAttribute{{Code: "ram"}}, nil)
+
+		_, err := deps.service.CreateAttribute(ctx, categoryID.String(), category.CreateAttributeRequest{
+			Code:     "RAM",
+			Name:     "RAM",
+			DataType: "number",
+		})
+
+		assert.ErrorIs(t, err, categoryerrors.ErrAttributeCodeExists)
+	})
+
+	t.Run("fail - invalid data type", func(t *testing.T) {
+		_, err := deps.service.CreateAttribute(ctx, categoryID.String(), category.CreateAttributeRequest{
+			Code:     "ram",
+			Name:     "RAM",
+			DataType: "date",
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCategoryService_DeleteAttribute(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	categoryID := uuid.New()
+	attributeID := uuid.New()
+
+	t.Run("fail - not found", func(t *testing.T) {
+		deps.repo.EXPECT().DeleteAttribute(ctx, categoryID, attributeID).Return(int64(0), nil)
+
+		err := deps.service.DeleteAttribute(ctx, categoryID.String(), attributeID.String())
+		assert.ErrorIs(t, err, categoryerrors.ErrAttributeNotFound)
+	})
+
+	t.Run("fail - invalid attribute id", func(t *testing.T) {
+		err := deps.service.DeleteAttribute(ctx, categoryID.String(), "invalid-uuid")
+		assert.ErrorIs(t, err, categoryerrors.ErrInvalidAttributeID)
+	})
+}
+
+func TestCategoryService_GetTree(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	accessories, charger, hp := uuid.New(), uuid.New(), uuid.New()
+	hiddenParent := uuid.New()
+
+	t.Run("positive - nested tree", func(t *testing.T) {
+		deps.repo.EXPECT().ListTree(ctx).Return([]dbgen.ListCategoryTreeRow{
+			{ID: accessories, Name: "Accessories", Slug: "accessories"},
+			{ID: charger, ParentID: uuid.NullUUID{UUID: accessories, Valid: true}, Name: "Charger", Slug: "charger"},
+			{ID: hp, Name: "Handphone", Slug: "handphone"},
+			// Parent nonaktif tidak ikut di query, anaknya ikut tersembunyi
+			{ID: uuid.New(), ParentID: uuid.NullUUID{UUID: hiddenParent, Valid: true}, Name: "Orphan", Slug: "orphan"},
+		}, nil)
+
+		res, err := deps.service.GetTree(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, "accessories", res[0].Slug)
+		assert.Len(t, res[0].Children, 1)
+		assert.Equal(t, "charger", res[0].Children[0].Slug)
+		assert.Empty(t, res[0].Children[0].Children)
+		assert.Equal(t, "handphone", res[1].Slug)
+	})
+
+	t.Run("negative - repo error", func(t *testing.T) {
+		deps.repo.EXPECT().ListTree(ctx).Return(nil, errors.New("db error"))
+
+		_, err := deps.service.GetTree(ctx)
+		assert.ErrorIs(t, err, categoryerrors.ErrCategoryFailed)
+	})
 }
@@ -0,0 +1,124 @@
+package category
+
+import (
+	"context"
+	categoryerrors "go-gadget-api/internal/category/errors"
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	"github.com/google/uuid"
+)
+
+// maxCategoryDepth sama dengan batas di ListCategoryAncestors, jaga-jaga data lama bersiklus
+const maxCategoryDepth = 32
+
+// GetTree kategori aktif dalam bentuk pohon, anak dari kategori nonaktif ikut disembunyikan
+func (s *service) GetTree(ctx context.Context) ([]CategoryTreeNode, error) {
+	rows, err := s.repo.ListTree(ctx)
+	if err != nil {
+		return nil, categoryerrors.ErrCategoryFailed
+	}
+	return buildTree(rows), nil
+}
+
+func buildTree(rows []dbgen.ListCategoryTreeRow) []CategoryTreeNode {
+	children := make(map[uuid.UUID][]dbgen.ListCategoryTreeRow, len(rows))
+	for _, row := range rows {
+		// Root ditandai uuid.Nil
+		children[row.ParentID.UUID] = append(children[row.ParentID.UUID], row)
+	}
+
+	var build func(parent uuid.UUID, depth int) []CategoryTreeNode
+	build = func(parent uuid.UUID, depth int) []CategoryTreeNode {
+		nodes := make([]CategoryTreeNode, 0, len(children[parent]))
+		if depth > maxCategoryDepth {
+			return nodes
+		}
+		for _, row := range children[parent] {
+			nodes = append(nodes, CategoryTreeNode{
+				ID:       row.ID.String(),
+				Name:     row.Name,
+				Slug:     row.Slug,
+				ImageUrl: row.ImageUrl.String,
+				Children: build(row.ID, depth+1),
+			})
+		}
+		return nodes
+	}
+
+	return build(uuid.Nil, 0)
+}
+
+// resolveParent memvalidasi parent baru untuk kategori id (uuid.Nil saat create).
+// Parent tidak boleh kategori itu sendiri atau salah satu turunannya.
+func (s *service) resolveParent(ctx context.Context, id uuid.UUID, parent string) (uuid.NullUUID, error) {
+	if parent == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	parentID, err := uuid.Parse(parent)
+	if err != nil {
+		return uuid.NullUUID{}, categoryerrors.ErrInvalidParentID
+	}
+	if parentID == id {
+		return uuid.NullUUID{}, categoryerrors.ErrCategoryCycle
+	}
+
+	// Rantai parent -> root; jika id ada di dalamnya, parent adalah turunan id
+	if _, err := checkParentChain(ctx, s.repo, id, parentID); err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	return uuid.NullUUID{UUID: parentID, Valid: true}, nil
+}
+
+// lockParentChain mengunci kategori id beserta seluruh rantai parent baru (FOR UPDATE) di dalam tx,
+// lalu mengulang cek siklus. Perpindahan paralel (A ke bawah B & B ke bawah A) jadi berurutan,
+// karena rantai yang sudah terkunci tidak bisa diubah tx lain sampai commit.
+func lockParentChain(ctx context.Context, repo Repository, id, parentID uuid.UUID) error {
+	locked := map[uuid.UUID]bool{}
+	for i := 0; i <= maxCategoryDepth; i++ {
+		ancestors, err := checkParentChain(ctx, repo, id, parentID)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]uuid.UUID, 0, len(ancestors)+1)
+		if !locked[id] {
+			ids = append(ids, id)
+		}
+		for _, a := range ancestors {
+			if !locked[a.ID] {
+				ids = append(ids, a.ID)
+			}
+		}
+		// Seluruh rantai terbaru sudah terkunci, hasil cek di atas final
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if _, err := repo.LockForUpdate(ctx, ids); err != nil {
+			return categoryerrors.ErrCategoryFailed
+		}
+		for _, lid := range ids {
+			locked[lid] = true
+		}
+	}
+	return categoryerrors.ErrCategoryFailed
+}
+
+// checkParentChain mengembalikan rantai root -> parentID dan menolak jika id ada di dalamnya
+func checkParentChain(ctx context.Context, repo Repository, id, parentID uuid.UUID) ([]dbgen.ListCategoryAncestorsRow, error) {
+	ancestors, err := repo.ListAncestors(ctx, parentID)
+	if err != nil {
+		return nil, categoryerrors.ErrCategoryFailed
+	}
+	if len(ancestors) == 0 {
+		return nil, categoryerrors.ErrParentCategoryNotFound
+	}
+	for _, a := range ancestors {
+		if a.ID == id {
+			return nil, categoryerrors.ErrCategoryCycle
+		}
+	}
+	return ancestors, nil
+}
@@ -25,6 +25,24 @@ var (
 		http.StatusInternalServerError,
 	)
 
+	ErrInvalidAttributeID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid attribute ID",
+		http.StatusBadRequest,
+	)
+
+	ErrAttributeNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Category attribute not found",
+		http.StatusNotFound,
+	)
+
+	ErrAttributeCodeExists = apperror.New(
+		apperror.CodeConflict,
+		"Attribute code already exists in this category",
+		http.StatusConflict,
+	)
+
 	ErrImageUploadFailed = apperror.New(
 		apperror.CodeInternalError,
 		"Failed to upload category image",
@@ -42,4 +60,47 @@ var (
 		"Invalid category image URL",
 		http.StatusBadRequest,
 	)
+
+	ErrInvalidParentID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid parent category ID",
+		http.StatusBadRequest,
+	)
+
+	ErrParentCategoryNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Parent category not found",
+		http.StatusNotFound,
+	)
+
+	ErrParentCategoryDeleted = apperror.New(
+		apperror.CodeConflict,
+		"Parent category is deleted, restore it first",
+		http.StatusConflict,
+	)
+
+	ErrCategoryCycle = apperror.New(
+		apperror.CodeInvalidInput,
+		"Category cannot be moved under itself or its own subcategory",
+		http.StatusBadRequest,
+	)
+
+	ErrCategoryHasChildren = apperror.New(
+		apperror.CodeConflict,
+		"Category still has subcategories, move or delete them first",
+		http.StatusConflict,
+	)
+
+	ErrCategoryHasProducts = apperror.New(
+		apperror.CodeConflict,
+		"Category still has products, move them to another category first",
+		http.StatusConflict,
+	)
+
+	// Slug bentrok dengan slug lama kategori lain (slug_history)
+	ErrSlugUnavailable = apperror.New(
+		apperror.CodeConflict,
+		"Category slug was previously used by another category",
+		http.StatusConflict,
+	)
 )
@@ -49,6 +49,14 @@ type CustomerListResponse struct {
 	CreatedAt string `json:"createdAt"`
 }
 
+type UpdateNotificationPreferencesRequest struct {
+	CartReminder *bool `json:"cart_reminder" validate:"required"`
+}
+
+type NotificationPreferencesResponse struct {
+	CartReminder bool `json:"cartReminder"`
+}
+
 type UpdateStatusRequest struct {
 	IsActive *bool `json:"is_active" validate:"required"`
 }
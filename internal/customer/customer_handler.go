@@ -127,6 +127,43 @@ func (h *Handler) GetOrders(c *gin.Context) {
 	response.Success(c, http.StatusOK, res.Data, &res.Meta)
 }
 
+func (h *Handler) GetNotificationPreferences(c *gin.Context) {
+	customerID := c.GetString("user_id")
+	if customerID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	res, err := h.service.GetNotificationPreferences(c.Request.Context(), customerID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func (h *Handler) UpdateNotificationPreferences(c *gin.Context) {
+	var req UpdateNotificationPreferencesRequest
+	if err := h.bindJSON(c, &req); err != nil {
+		return
+	}
+
+	customerID := c.GetString("user_id")
+	if customerID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	res, err := h.service.UpdateNotificationPreferences(c.Request.Context(), customerID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
 func (h *Handler) bindJSON(c *gin.Context, req interface{}) error {
 	if err := c.ShouldBindJSON(req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body: " + err.Error()})
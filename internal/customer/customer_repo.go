@@ -17,6 +17,9 @@ type Repository interface {
 	UpdatePassword(ctx context.Context, arg dbgen.UpdateCustomerPasswordParams) error
 	ListCustomers(ctx context.Context, params dbgen.ListCustomersParams) ([]dbgen.ListCustomersRow, error)
 	UpdateStatus(ctx context.Context, arg dbgen.UpdateCustomerStatusParams) (dbgen.UpdateCustomerStatusRow, error)
+
+	GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (dbgen.NotificationPreference, error)
+	UpsertCartReminderPreference(ctx context.Context, arg dbgen.UpsertCartReminderPreferenceParams) (dbgen.NotificationPreference, error)
 }
 
 type repository struct {
@@ -64,3 +67,14 @@ func (r *repository) UpdateStatus(
 ) (dbgen.UpdateCustomerStatusRow, error) {
 	return r.queries.UpdateCustomerStatus(ctx, arg)
 }
+
+func (r *repository) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (dbgen.NotificationPreference, error) {
+	return r.queries.GetNotificationPreferences(ctx, userID)
+}
+
+func (r *repository) UpsertCartReminderPreference(
+	ctx context.Context,
+	arg dbgen.UpsertCartReminderPreferenceParams,
+) (dbgen.NotificationPreference, error) {
+	return r.queries.UpsertCartReminderPreference(ctx, arg)
+}
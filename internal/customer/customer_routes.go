@@ -18,6 +18,16 @@ func RegisterRoutes(r *gin.RouterGroup, h *Handler) {
 			middleware.RateLimitByUser(1, 3),
 			h.UpdateProfile,
 		)
+
+		// Preferensi notifikasi (mis. unsubscribe email pengingat cart)
+		customerGroup.GET("/notification-preferences",
+			middleware.RateLimitByUser(5, 10),
+			h.GetNotificationPreferences,
+		)
+		customerGroup.PATCH("/notification-preferences",
+			middleware.RateLimitByUser(1, 3),
+			h.UpdateNotificationPreferences,
+		)
 	}
 
 	adminCustomerGroup := r.Group("admin/customers")
@@ -22,6 +22,9 @@ type Service interface {
 	GetCustomerByID(ctx context.Context, req CustomerDetailsRequest) (CustomerDetailResponse, error)
 	ListCustomerAddresses(ctx context.Context, req CustomerAddressesRequest) (PaginatedAddressResponse, error)
 	ListCustomerOrders(ctx context.Context, req CustomerOrdersRequest) (PaginatedOrderResponse, error)
+
+	GetNotificationPreferences(ctx context.Context, customerID string) (NotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, customerID string, req UpdateNotificationPreferencesRequest) (NotificationPreferencesResponse, error)
 }
 
 type service struct {
@@ -323,3 +326,46 @@ func ToCustomerResponseFromProfile(u dbgen.UpdateCustomerProfileRow) CustomerRes
 		Email: u.Email,
 	}
 }
+
+func (s *service) GetNotificationPreferences(ctx context.Context, customerID string) (NotificationPreferencesResponse, error) {
+	id, err := uuid.Parse(customerID)
+	if err != nil {
+		return NotificationPreferencesResponse{}, err
+	}
+
+	pref, err := s.repo.GetNotificationPreferences(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Belum pernah diatur → default berlangganan
+			return NotificationPreferencesResponse{CartReminder: true}, nil
+		}
+		return NotificationPreferencesResponse{}, err
+	}
+
+	return NotificationPreferencesResponse{CartReminder: pref.CartReminderEnabled}, nil
+}
+
+func (s *service) UpdateNotificationPreferences(
+	ctx context.Context,
+	customerID string,
+	req UpdateNotificationPreferencesRequest,
+) (NotificationPreferencesResponse, error) {
+	id, err := uuid.Parse(customerID)
+	if err != nil {
+		return NotificationPreferencesResponse{}, err
+	}
+
+	if req.CartReminder == nil {
+		return s.GetNotificationPreferences(ctx, customerID)
+	}
+
+	pref, err := s.repo.UpsertCartReminderPreference(ctx, dbgen.UpsertCartReminderPreferenceParams{
+		UserID:              id,
+		CartReminderEnabled: *req.CartReminder,
+	})
+	if err != nil {
+		return NotificationPreferencesResponse{}, err
+	}
+
+	return NotificationPreferencesResponse{CartReminder: pref.CartReminderEnabled}, nil
+}
@@ -339,3 +339,43 @@ func TestCustomerService_UpdateProfile(t *testing.T) {
 		assert.Equal(t, "New Name", resp.Name)
 	})
 }
+
+func TestCustomerService_NotificationPreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, _, _ := sqlmock.New()
+	repo := mockCustomer.NewMockRepository(ctrl)
+	svc := customer.NewService(db, repo, nil, nil)
+	ctx := context.Background()
+
+	t.Run("default_subscribed_when_not_set", func(t *testing.T) {
+		userID := uuid.New()
+		repo.EXPECT().
+			GetNotificationPreferences(ctx, userID).
+			Return(dbgen.NotificationPreference{}, sql.ErrNoRows)
+
+		resp, err := svc.GetNotificationPreferences(ctx, userID.String())
+
+		assert.NoError(t, err)
+		assert.True(t, resp.CartReminder)
+	})
+
+	t.Run("unsubscribe_cart_reminder", func(t *testing.T) {
+		userID := uuid.New()
+		disabled := false
+		repo.EXPECT().
+			UpsertCartReminderPreference(ctx, dbgen.UpsertCartReminderPreferenceParams{
+				UserID:              userID,
+				CartReminderEnabled: false,
+			}).
+			Return(dbgen.NotificationPreference{UserID: userID, CartReminderEnabled: false}, nil)
+
+		resp, err := svc.UpdateNotificationPreferences(ctx, userID.String(), customer.UpdateNotificationPreferencesRequest{
+			CartReminder: &disabled,
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, resp.CartReminder)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
 	"os"
@@ -18,6 +19,16 @@ type Service interface {
 	SendConfirmationPin(ctx context.Context, to, userName, pin string) error
 	SendOrderStatusEmail(ctx context.Context, to, userName, orderNumber, newStatus string) error
 	SendOrderPaymentEmail(ctx context.Context, to, userName, orderNumber, paymentStatus string) error
+	SendAbandonedCartEmail(ctx context.Context, to, userName string, items []CartReminderItem, cartLink, unsubscribeLink string) error
+	SendLowStockAlertEmail(ctx context.Context, to, productName, sku string, stock, threshold int32, adminLink string) error
+	SendBackInStockEmail(ctx context.Context, to, userName, productName, productLink string) error
+}
+
+// CartReminderItem adalah satu baris item pada email pengingat cart
+type CartReminderItem struct {
+	Name  string
+	Qty   int32
+	Price string // harga terkini, sudah diformat
 }
 
 type resendService struct {
@@ -95,6 +106,45 @@ func (s *resendService) SendOrderPaymentEmail(ctx context.Context, to, userName,
 	return s.send(ctx, to, fmt.Sprintf("Update Pembayaran Pesanan %s", orderNumber), html)
 }
 
+func (s *resendService) SendAbandonedCartEmail(ctx context.Context, to, userName string, items []CartReminderItem, cartLink, unsubscribeLink string) error {
+	var rows strings.Builder
+	for _, item := range items {
+		fmt.Fprintf(&rows, "<li>%s &times; %d &mdash; <strong>%s</strong></li>", html.EscapeString(item.Name), item.Qty, html.EscapeString(item.Price))
+	}
+
+	body := fmt.Sprintf(
+		"<p>Halo %s,</p><p>Masih ada barang yang menunggu di keranjang Anda:</p><ul>%s</ul><p><a href=\"%s\">Lanjutkan Belanja</a></p><p style=\"font-size:12px;color:#888\">Tidak ingin menerima pengingat ini lagi? <a href=\"%s\">Berhenti berlangganan</a>.</p>",
+		html.EscapeString(userName),
+		rows.String(),
+		html.EscapeString(cartLink),
+		html.EscapeString(unsubscribeLink),
+	)
+	return s.send(ctx, to, "Keranjang Anda Masih Menunggu", body)
+}
+
+func (s *resendService) SendLowStockAlertEmail(ctx context.Context, to, productName, sku string, stock, threshold int32, adminLink string) error {
+	// Nama produk & SKU diinput admin, jadi di-escape sebelum masuk ke HTML
+	body := fmt.Sprintf(
+		"<p>Stok produk <strong>%s</strong> (SKU: %s) menipis.</p><p>Sisa stok: <strong>%d</strong> (batas: %d).</p><p><a href=\"%s\">Kelola Stok</a></p>",
+		html.EscapeString(productName),
+		html.EscapeString(sku),
+		stock,
+		threshold,
+		html.EscapeString(adminLink),
+	)
+	return s.send(ctx, to, fmt.Sprintf("Stok Menipis: %s", productName), body)
+}
+
+func (s *resendService) SendBackInStockEmail(ctx context.Context, to, userName, productName, productLink string) error {
+	body := fmt.Sprintf(
+		"<p>Halo %s,</p><p>Kabar baik! <strong>%s</strong> yang Anda tunggu sudah tersedia kembali.</p><p><a href=\"%s\">Beli Sekarang</a></p>",
+		html.EscapeString(userName),
+		html.EscapeString(productName),
+		html.EscapeString(productLink),
+	)
+	return s.send(ctx, to, fmt.Sprintf("%s Tersedia Kembali", productName), body)
+}
+
 func (s *resendService) send(ctx context.Context, to, subject, html string) error {
 	payload := map[string]any{
 		"from":    s.fromEmail,
@@ -158,3 +208,15 @@ func (s *noopService) SendOrderStatusEmail(_ context.Context, _, _, _, _ string)
 func (s *noopService) SendOrderPaymentEmail(_ context.Context, _, _, _, _ string) error {
 	return nil
 }
+
+func (s *noopService) SendAbandonedCartEmail(_ context.Context, _, _ string, _ []CartReminderItem, _, _ string) error {
+	return nil
+}
+
+func (s *noopService) SendLowStockAlertEmail(_ context.Context, _, _, _ string, _, _ int32, _ string) error {
+	return nil
+}
+
+func (s *noopService) SendBackInStockEmail(_ context.Context, _, _, _, _ string) error {
+	return nil
+}
@@ -0,0 +1,91 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"go-gadget-api/internal/cart"
+	"go-gadget-api/internal/outbox"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AbandonedCartConfig struct {
+	IdleFor   time.Duration // cart dianggap abandoned setelah tidak disentuh selama ini
+	Cooldown  time.Duration // jarak minimal antar email pengingat per user
+	BatchSize int32
+}
+
+// AbandonedCartConfigFromEnv membaca ABANDONED_CART_IDLE_HOURS (default 24)
+// dan ABANDONED_CART_COOLDOWN_HOURS (default 72)
+func AbandonedCartConfigFromEnv() AbandonedCartConfig {
+	return AbandonedCartConfig{
+		IdleFor:   time.Duration(envInt("ABANDONED_CART_IDLE_HOURS", 24)) * time.Hour,
+		Cooldown:  time.Duration(envInt("ABANDONED_CART_COOLDOWN_HOURS", 72)) * time.Hour,
+		BatchSize: 50,
+	}
+}
+
+// NotifyAbandonedCarts membuat event CART_ABANDONED di outbox untuk setiap cart
+// yang idle, lalu menandai waktu pengingat (cooldown) dalam transaksi yang sama.
+func NotifyAbandonedCarts(db *sql.DB, queries *dbgen.Queries, outboxRepo outbox.Repository, cfg AbandonedCartConfig) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		now := time.Now()
+		carts, err := queries.ListAbandonedCarts(ctx, dbgen.ListAbandonedCartsParams{
+			CooldownBefore: now.Add(-cfg.Cooldown),
+			IdleBefore:     now.Add(-cfg.IdleFor),
+			RowLimit:       cfg.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range carts {
+			if err := enqueueCartAbandoned(ctx, db, queries, outboxRepo, c); err != nil {
+				log.Printf("[WORKER] Failed to enqueue CART_ABANDONED for cart %s: %v", c.CartID, err)
+				continue
+			}
+		}
+
+		if len(carts) > 0 {
+			log.Printf("[WORKER] Enqueued %d abandoned cart reminders", len(carts))
+		}
+		return nil
+	}
+}
+
+func enqueueCartAbandoned(ctx context.Context, db *sql.DB, queries *dbgen.Queries, outboxRepo outbox.Repository, c dbgen.ListAbandonedCartsRow) error {
+	payload, err := json.Marshal(cart.CartAbandonedPayload{
+		CartID:         c.CartID.String(),
+		UserID:         c.UserID.UUID.String(),
+		LastActivityAt: c.LastActivityAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := outboxRepo.WithTx(tx).CreateOutboxEvent(ctx, dbgen.CreateOutboxEventParams{
+		ID:            uuid.New(),
+		AggregateType: "CART",
+		AggregateID:   c.CartID,
+		EventType:     cart.EventCartAbandoned,
+		Payload:       payload,
+	}); err != nil {
+		return err
+	}
+
+	if err := queries.WithTx(tx).MarkCartReminderSent(ctx, c.UserID.UUID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,106 @@
+package jobs_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-gadget-api/internal/cart"
+	"go-gadget-api/internal/jobs"
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	outboxMock "go-gadget-api/internal/mock/outbox"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var abandonedCartColumns = []string{"cart_id", "user_id", "last_activity_at"}
+
+func TestNotifyAbandonedCarts(t *testing.T) {
+	ctx := context.Background()
+	cfg := jobs.AbandonedCartConfig{IdleFor: 24 * time.Hour, Cooldown: 72 * time.Hour, BatchSize: 50}
+
+	t.Run("success - enqueue event and mark reminder in one transaction", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		outboxRepo := outboxMock.NewMockRepository(ctrl)
+
+		cartID, userID := uuid.New(), uuid.New()
+		lastActivity := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+		sqlMock.ExpectQuery("ListAbandonedCarts").
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int32(50)).
+			WillReturnRows(sqlmock.NewRows(abandonedCartColumns).AddRow(cartID, userID, lastActivity))
+		sqlMock.ExpectBegin()
+		outboxRepo.EXPECT().WithTx(gomock.Any()).Return(outboxRepo)
+		outboxRepo.EXPECT().
+			CreateOutboxEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreateOutboxEventParams) error {
+				assert.Equal(t, cart.EventCartAbandoned, arg.EventType)
+				assert.Equal(t, cartID, arg.AggregateID)
+
+				var payload cart.CartAbandonedPayload
+				require.NoError(t, json.Unmarshal(arg.Payload, &payload))
+				assert.Equal(t, userID.String(), payload.UserID)
+				assert.Equal(t, lastActivity.Format(time.RFC3339), payload.LastActivityAt)
+				return nil
+			})
+		sqlMock.ExpectExec("MarkCartReminderSent").WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 1))
+		sqlMock.ExpectCommit()
+
+		err := jobs.NotifyAbandonedCarts(db, dbgen.New(db), outboxRepo, cfg)(ctx)
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("outbox failure - rollback without marking, continue with next cart", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		outboxRepo := outboxMock.NewMockRepository(ctrl)
+
+		secondUser := uuid.New()
+		sqlMock.ExpectQuery("ListAbandonedCarts").
+			WillReturnRows(sqlmock.NewRows(abandonedCartColumns).
+				AddRow(uuid.New(), uuid.New(), time.Now()).
+				AddRow(uuid.New(), secondUser, time.Now()))
+
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectExec("MarkCartReminderSent").WithArgs(secondUser).WillReturnResult(sqlmock.NewResult(0, 1))
+		sqlMock.ExpectCommit()
+
+		outboxRepo.EXPECT().WithTx(gomock.Any()).Return(outboxRepo).Times(2)
+		gomock.InOrder(
+			outboxRepo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(assert.AnError),
+			outboxRepo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		err := jobs.NotifyAbandonedCarts(db, dbgen.New(db), outboxRepo, cfg)(ctx)
+
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("error - list abandoned carts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		outboxRepo := outboxMock.NewMockRepository(ctrl)
+
+		sqlMock.ExpectQuery("ListAbandonedCarts").WillReturnError(assert.AnError)
+
+		err := jobs.NotifyAbandonedCarts(db, dbgen.New(db), outboxRepo, cfg)(ctx)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"log"
+	"time"
+)
+
+type BoughtTogetherConfig struct {
+	Lookback   time.Duration // hanya order COMPLETED dalam rentang ini yang dihitung
+	MinOrders  int32         // pasangan harus muncul minimal di sekian order
+	PerProduct int32         // jumlah pasangan teratas yang disimpan per produk
+}
+
+// BoughtTogetherConfigFromEnv membaca BOUGHT_TOGETHER_LOOKBACK_DAYS (default 180)
+// dan BOUGHT_TOGETHER_MIN_ORDERS (default 2)
+func BoughtTogetherConfigFromEnv() BoughtTogetherConfig {
+	return BoughtTogetherConfig{
+		Lookback:   time.Duration(envInt("BOUGHT_TOGETHER_LOOKBACK_DAYS", 180)) * 24 * time.Hour,
+		MinOrders:  int32(envInt("BOUGHT_TOGETHER_MIN_ORDERS", 2)),
+		PerProduct: 20,
+	}
+}
+
+// RefreshBoughtTogether menghitung ulang tabel product_co_purchases dari order_items.
+// Hapus + isi ulang dalam satu transaksi, jadi pembaca tidak pernah melihat tabel kosong.
+func RefreshBoughtTogether(db *sql.DB, queries *dbgen.Queries, cfg BoughtTogetherConfig) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		qtx := queries.WithTx(tx)
+		if err := qtx.DeleteProductCoPurchases(ctx); err != nil {
+			return err
+		}
+		pairs, err := qtx.InsertProductCoPurchases(ctx, dbgen.InsertProductCoPurchasesParams{
+			Since:      time.Now().Add(-cfg.Lookback),
+			MinOrders:  cfg.MinOrders,
+			PerProduct: cfg.PerProduct,
+		})
+		if err != nil {
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		log.Printf("[WORKER] Bought-together refreshed: %d product pairs", pairs)
+		return nil
+	}
+}
@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"context"
+	"go-gadget-api/internal/cart"
+	"log"
+	"time"
+)
+
+const defaultGuestCartRetentionDays = 30
+
+// GuestCartRetention dibaca dari GUEST_CART_RETENTION_DAYS (default 30 hari)
+func GuestCartRetention() time.Duration {
+	return time.Duration(envInt("GUEST_CART_RETENTION_DAYS", defaultGuestCartRetentionDays)) * 24 * time.Hour
+}
+
+// CleanupGuestCarts menghapus cart guest yang tidak disentuh melebihi retention
+func CleanupGuestCarts(cartSvc cart.Service, retention time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		deleted, err := cartSvc.CleanupGuestCarts(ctx, retention)
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			log.Printf("[WORKER] Deleted %d stale guest carts", deleted)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+	"go-gadget-api/internal/product"
+	"log"
+	"time"
+)
+
+// ApplyPriceSchedules menerapkan jadwal harga yang jatuh tempo dan mengembalikan
+// harga untuk jadwal yang sudah berakhir
+func ApplyPriceSchedules(productSvc product.Service) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		res, err := productSvc.RunPriceSchedules(ctx, time.Now())
+		if res.Applied+res.Ended > 0 || res.Expired > 0 {
+			log.Printf("[WORKER] Price schedules: %d applied, %d ended, %d expired", res.Applied, res.Ended, res.Expired)
+		}
+		return err
+	}
+}
@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"context"
+	"go-gadget-api/internal/product"
+	"log"
+	"time"
+)
+
+// ProductImportTimeout batas wajar satu job import (maks 5000 baris); job yang melewatinya
+// tanpa selesai berarti goroutine-nya sudah mati
+const ProductImportTimeout = time.Hour
+
+// FailStaleProductImports menandai FAILED job import yang tertinggal di PENDING/PROCESSING
+func FailStaleProductImports(productSvc product.Service, olderThan time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		n, err := productSvc.FailStaleImports(ctx, olderThan)
+		if n > 0 {
+			log.Printf("[WORKER] Marked %d stale product import jobs as failed", n)
+		}
+		return err
+	}
+}
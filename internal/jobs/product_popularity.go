@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+	"go-gadget-api/internal/product"
+	"log"
+	"time"
+)
+
+// RefreshProductPopularity memperbarui counter terjual/rating/trending untuk sort listing
+func RefreshProductPopularity(productSvc product.Service) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		n, err := productSvc.RefreshPopularity(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		log.Printf("[WORKER] Product popularity refreshed for %d products", n)
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+	"go-gadget-api/internal/product"
+	"log"
+	"time"
+)
+
+// PublishScheduledProducts mengubah produk SCHEDULED yang sudah jatuh tempo menjadi PUBLISHED.
+// Listing publik sudah menghormati publish_at, job ini merapikan status & menginvalidasi cache katalog.
+func PublishScheduledProducts(productSvc product.Service) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		n, err := productSvc.PublishDueProducts(ctx, time.Now())
+		if n > 0 {
+			log.Printf("[WORKER] Published %d scheduled products", n)
+		}
+		return err
+	}
+}
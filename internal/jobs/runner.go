@@ -0,0 +1,38 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// RunPeriodic menjalankan fn setiap interval sampai ctx dibatalkan.
+// Error hanya di-log agar job berikutnya tetap berjalan.
+func RunPeriodic(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Printf("[WORKER] Job %s started (every %s)", name, interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				log.Printf("[WORKER] Job %s failed: %v", name, err)
+			}
+		}
+	}
+}
+
+// envInt membaca env integer positif, fallback ke def jika kosong/invalid
+func envInt(key string, def int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || v <= 0 {
+		return def
+	}
+	return v
+}
@@ -2,10 +2,17 @@ package consumer
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"go-gadget-api/internal/cart"
+	"go-gadget-api/internal/email"
 	"go-gadget-api/internal/order"
+	"go-gadget-api/internal/shared/database/dbgen"
 	"log"
+	"os"
+
+	"github.com/google/uuid"
 )
 
 func handleDeleteCart(ctx context.Context, payload []byte, cartService cart.Service) error {
@@ -23,3 +30,71 @@ func handleDeleteCart(ctx context.Context, payload []byte, cartService cart.Serv
 	log.Printf("[CONSUMER] Cart deleted successfully for user: %s", data.UserID)
 	return nil
 }
+
+func handleCartAbandoned(ctx context.Context, payload []byte, emailSvc email.Service, queries *dbgen.Queries) error {
+	var data cart.CartAbandonedPayload
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return err
+	}
+
+	log.Printf("[CONSUMER] Handling CART_ABANDONED for user: %s", data.UserID)
+
+	userID, err := uuid.Parse(data.UserID)
+	if err != nil {
+		return err
+	}
+
+	// User bisa saja unsubscribe setelah event dibuat
+	pref, err := queries.GetNotificationPreferences(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil && !pref.CartReminderEnabled {
+		log.Printf("[CONSUMER] User %s unsubscribed from cart reminders, skipping", data.UserID)
+		return nil
+	}
+
+	user, err := queries.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Printf("[CONSUMER] Failed to get user %s: %v", data.UserID, err)
+		return err
+	}
+
+	rows, err := queries.ListCartReminderItems(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		// Cart sudah dikosongkan / checkout sebelum event diproses
+		log.Printf("[CONSUMER] Cart for user %s is empty, skipping reminder", data.UserID)
+		return nil
+	}
+
+	items := make([]email.CartReminderItem, 0, len(rows))
+	for _, r := range rows {
+		price := r.Price
+		if r.DiscountPrice.Valid {
+			price = r.DiscountPrice.String
+		}
+		items = append(items, email.CartReminderItem{
+			Name:  r.ProductName,
+			Qty:   r.Quantity,
+			Price: formatRupiah(price),
+		})
+	}
+
+	baseURL := os.Getenv("WEBSTORE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:3000"
+	}
+	cartURL := fmt.Sprintf("%s/cart", baseURL)
+	unsubscribeURL := fmt.Sprintf("%s/account/notifications", baseURL)
+
+	if err := emailSvc.SendAbandonedCartEmail(ctx, user.Email, user.Name, items, cartURL, unsubscribeURL); err != nil {
+		log.Printf("[CONSUMER] Failed to send abandoned cart email to %s: %v", user.Email, err)
+		return err
+	}
+
+	log.Printf("[CONSUMER] Email sent for CART_ABANDONED: %s", data.UserID)
+	return nil
+}
@@ -0,0 +1,140 @@
+package consumer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-gadget-api/internal/cart"
+	"go-gadget-api/internal/email"
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEmailService mencatat email pengingat cart yang dikirim
+type fakeEmailService struct {
+	email.Service
+	sent     int
+	to       string
+	userName string
+	items    []email.CartReminderItem
+	cartLink string
+	err      error
+}
+
+func (f *fakeEmailService) SendAbandonedCartEmail(ctx context.Context, to, userName string, items []email.CartReminderItem, cartLink, unsubscribeLink string) error {
+	f.sent++
+	f.to, f.userName, f.items, f.cartLink = to, userName, items, cartLink
+	return f.err
+}
+
+var (
+	prefColumns     = []string{"user_id", "cart_reminder_enabled", "cart_reminder_sent_at", "created_at", "updated_at"}
+	userColumns     = []string{"id", "email", "name", "phone", "password", "role", "is_active", "email_confirmed", "created_at"}
+	reminderColumns = []string{"product_name", "quantity", "price", "discount_price"}
+)
+
+func cartAbandonedPayload(t *testing.T, userID uuid.UUID) []byte {
+	t.Helper()
+	payload, err := json.Marshal(cart.CartAbandonedPayload{CartID: uuid.NewString(), UserID: userID.String()})
+	require.NoError(t, err)
+	return payload
+}
+
+func TestHandleCartAbandoned(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("WEBSTORE_URL", "https://shop.test")
+
+	t.Run("success - send reminder with current prices", func(t *testing.T) {
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		emailSvc := &fakeEmailService{}
+		userID := uuid.New()
+
+		sqlMock.ExpectQuery("GetNotificationPreferences").WithArgs(userID).WillReturnError(sql.ErrNoRows)
+		sqlMock.ExpectQuery("GetUserByID").WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(userColumns).
+				AddRow(userID, "budi@mail.com", "Budi", nil, "hash", "CUSTOMER", true, true, time.Now()))
+		sqlMock.ExpectQuery("ListCartReminderItems").
+			WillReturnRows(sqlmock.NewRows(reminderColumns).
+				AddRow("iPhone 15", int32(1), "15000000.00", "14500000.00").
+				AddRow("Case", int32(2), "150000.00", nil))
+
+		err := handleCartAbandoned(ctx, cartAbandonedPayload(t, userID), emailSvc, dbgen.New(db))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, emailSvc.sent)
+		assert.Equal(t, "budi@mail.com", emailSvc.to)
+		assert.Equal(t, "https://shop.test/cart", emailSvc.cartLink)
+		assert.Equal(t, []email.CartReminderItem{
+			{Name: "iPhone 15", Qty: 1, Price: "Rp 14.500.000"},
+			{Name: "Case", Qty: 2, Price: "Rp 150.000"},
+		}, emailSvc.items)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("skip - user unsubscribed after event was created", func(t *testing.T) {
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		emailSvc := &fakeEmailService{}
+		userID := uuid.New()
+
+		sqlMock.ExpectQuery("GetNotificationPreferences").WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(prefColumns).AddRow(userID, false, nil, time.Now(), time.Now()))
+
+		err := handleCartAbandoned(ctx, cartAbandonedPayload(t, userID), emailSvc, dbgen.New(db))
+
+		assert.NoError(t, err)
+		assert.Zero(t, emailSvc.sent)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("skip - cart already emptied", func(t *testing.T) {
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		emailSvc := &fakeEmailService{}
+		userID := uuid.New()
+
+		sqlMock.ExpectQuery("GetNotificationPreferences").WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(prefColumns).AddRow(userID, true, nil, time.Now(), time.Now()))
+		sqlMock.ExpectQuery("GetUserByID").WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(userColumns).
+				AddRow(userID, "budi@mail.com", "Budi", nil, "hash", "CUSTOMER", true, true, time.Now()))
+		sqlMock.ExpectQuery("ListCartReminderItems").WillReturnRows(sqlmock.NewRows(reminderColumns))
+
+		err := handleCartAbandoned(ctx, cartAbandonedPayload(t, userID), emailSvc, dbgen.New(db))
+
+		assert.NoError(t, err)
+		assert.Zero(t, emailSvc.sent)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("error - email failure is returned for retry", func(t *testing.T) {
+		db, sqlMock, _ := sqlmock.New()
+		defer db.Close()
+		emailSvc := &fakeEmailService{err: assert.AnError}
+		userID := uuid.New()
+
+		sqlMock.ExpectQuery("GetNotificationPreferences").WithArgs(userID).WillReturnError(sql.ErrNoRows)
+		sqlMock.ExpectQuery("GetUserByID").WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(userColumns).
+				AddRow(userID, "budi@mail.com", "Budi", nil, "hash", "CUSTOMER", true, true, time.Now()))
+		sqlMock.ExpectQuery("ListCartReminderItems").
+			WillReturnRows(sqlmock.NewRows(reminderColumns).AddRow("Case", int32(1), "150000.00", nil))
+
+		err := handleCartAbandoned(ctx, cartAbandonedPayload(t, userID), emailSvc, dbgen.New(db))
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("error - invalid payload", func(t *testing.T) {
+		err := handleCartAbandoned(ctx, []byte("{bukan json"), &fakeEmailService{}, nil)
+		assert.Error(t, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-gadget-api/internal/cart"
 	"go-gadget-api/internal/email"
+	"go-gadget-api/internal/product"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"log"
 
@@ -56,6 +57,30 @@ func ConsumeMessages(ctx context.Context, reader *kafka.Reader, cartService cart
 					log.Printf("[CONSUMER] Error committing message: %v", err)
 				}
 			}
+		} else if eventType == cart.EventCartAbandoned {
+			if err := handleCartAbandoned(ctx, msg.Value, emailSvc, queries); err != nil {
+				log.Printf("[CONSUMER] Error handling CART_ABANDONED: %v", err)
+			} else {
+				if err := reader.CommitMessages(ctx, msg); err != nil {
+					log.Printf("[CONSUMER] Error committing message: %v", err)
+				}
+			}
+		} else if eventType == product.EventProductLowStock {
+			if err := handleProductLowStock(ctx, msg.Value, emailSvc, queries); err != nil {
+				log.Printf("[CONSUMER] Error handling PRODUCT_LOW_STOCK: %v", err)
+			} else {
+				if err := reader.CommitMessages(ctx, msg); err != nil {
+					log.Printf("[CONSUMER] Error committing message: %v", err)
+				}
+			}
+		} else if eventType == product.EventProductBackInStock {
+			if err := handleProductBackInStock(ctx, msg.Value, emailSvc, queries); err != nil {
+				log.Printf("[CONSUMER] Error handling PRODUCT_BACK_IN_STOCK: %v", err)
+			} else {
+				if err := reader.CommitMessages(ctx, msg); err != nil {
+					log.Printf("[CONSUMER] Error committing message: %v", err)
+				}
+			}
 		} else {
 			// Skip unknown event types
 			_ = reader.CommitMessages(ctx, msg)
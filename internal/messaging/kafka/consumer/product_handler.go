@@ -0,0 +1,171 @@
+package consumer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-gadget-api/internal/email"
+	"go-gadget-api/internal/product"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+)
+
+// stockTarget produk (dan variant, bila ada) yang menjadi subjek event stok
+type stockTarget struct {
+	product dbgen.GetProductByIDRow
+	variant *dbgen.ProductVariant
+}
+
+func (t stockTarget) sku() string {
+	if t.variant != nil {
+		return t.variant.Sku
+	}
+	return t.product.Sku.String
+}
+
+func (t stockTarget) stock() int32 {
+	if t.variant != nil {
+		return t.variant.Stock
+	}
+	return t.product.Stock
+}
+
+// loadStockTarget mengembalikan ok=false jika produk/variant sudah dihapus (event dilewati)
+func loadStockTarget(ctx context.Context, queries *dbgen.Queries, productID, variantID string) (stockTarget, bool, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return stockTarget{}, false, err
+	}
+
+	p, err := queries.GetProductByID(ctx, pid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return stockTarget{}, false, nil
+		}
+		return stockTarget{}, false, err
+	}
+
+	target := stockTarget{product: p}
+	if variantID != "" {
+		vid, err := uuid.Parse(variantID)
+		if err != nil {
+			return stockTarget{}, false, err
+		}
+		v, err := queries.GetProductVariantByID(ctx, dbgen.GetProductVariantByIDParams{ID: vid, ProductID: pid})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return stockTarget{}, false, nil
+			}
+			return stockTarget{}, false, err
+		}
+		target.variant = &v
+	}
+
+	return target, true, nil
+}
+
+func webstoreURL() string {
+	baseURL := os.Getenv("WEBSTORE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:3000"
+	}
+	return baseURL
+}
+
+func handleProductLowStock(ctx context.Context, payload []byte, emailSvc email.Service, queries *dbgen.Queries) error {
+	var data product.ProductLowStockPayload
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return err
+	}
+
+	log.Printf("[CONSUMER] Handling PRODUCT_LOW_STOCK for product: %s", data.ProductID)
+
+	target, ok, err := loadStockTarget(ctx, queries, data.ProductID, data.VariantID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Printf("[CONSUMER] Product %s no longer exists, skipping low stock alert", data.ProductID)
+		return nil
+	}
+
+	admins, err := queries.ListActiveAdmins(ctx)
+	if err != nil {
+		return err
+	}
+
+	adminLink := fmt.Sprintf("%s/admin/products/%s", webstoreURL(), data.ProductID)
+
+	// Gagal kirim ke satu admin tidak di-retry, agar admin lain tidak menerima email ganda
+	for _, a := range admins {
+		if err := emailSvc.SendLowStockAlertEmail(ctx, a.Email, target.product.Name, target.sku(), data.Stock, data.Threshold, adminLink); err != nil {
+			log.Printf("[CONSUMER] Failed to send low stock alert to %s: %v", a.Email, err)
+		}
+	}
+
+	log.Printf("[CONSUMER] Low stock alert sent to %d admins for product: %s", len(admins), data.ProductID)
+	return nil
+}
+
+func handleProductBackInStock(ctx context.Context, payload []byte, emailSvc email.Service, queries *dbgen.Queries) error {
+	var data product.ProductBackInStockPayload
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return err
+	}
+
+	log.Printf("[CONSUMER] Handling PRODUCT_BACK_IN_STOCK for product: %s", data.ProductID)
+
+	target, ok, err := loadStockTarget(ctx, queries, data.ProductID, data.VariantID)
+	if err != nil {
+		return err
+	}
+	if !ok || !target.product.IsActive.Bool {
+		log.Printf("[CONSUMER] Product %s is unavailable, skipping back in stock emails", data.ProductID)
+		return nil
+	}
+
+	// Stok bisa saja sudah habis lagi sebelum event diproses, langganan tetap menunggu
+	if target.stock() <= 0 {
+		log.Printf("[CONSUMER] Product %s is out of stock again, skipping back in stock emails", data.ProductID)
+		return nil
+	}
+
+	var variantID uuid.NullUUID
+	if target.variant != nil {
+		variantID = uuid.NullUUID{UUID: target.variant.ID, Valid: true}
+	}
+
+	subs, err := queries.ListPendingStockNotifications(ctx, dbgen.ListPendingStockNotificationsParams{
+		ProductID: target.product.ID,
+		VariantID: variantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	productLink := fmt.Sprintf("%s/products/%s", webstoreURL(), target.product.Slug)
+
+	// Yang sudah terkirim langsung ditandai, jadi retry hanya mengirim ke sisanya
+	var sendErr error
+	for _, sub := range subs {
+		if err := emailSvc.SendBackInStockEmail(ctx, sub.Email, sub.Name, target.product.Name, productLink); err != nil {
+			log.Printf("[CONSUMER] Failed to send back in stock email to %s: %v", sub.Email, err)
+			sendErr = err
+			continue
+		}
+		if err := queries.MarkStockNotificationSent(ctx, sub.ID); err != nil {
+			return err
+		}
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	log.Printf("[CONSUMER] Back in stock email sent to %d subscribers for product: %s", len(subs), data.ProductID)
+	return nil
+}
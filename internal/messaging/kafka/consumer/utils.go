@@ -1,6 +1,11 @@
 package consumer
 
-import "github.com/segmentio/kafka-go"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+)
 
 func getHeader(headers []kafka.Header, key string) string {
 	for _, h := range headers {
@@ -10,3 +15,21 @@ func getHeader(headers []kafka.Header, key string) string {
 	}
 	return ""
 }
+
+// formatRupiah mengubah nilai DECIMAL ("1500000.00") menjadi "Rp 1.500.000"
+func formatRupiah(amount string) string {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return amount
+	}
+
+	digits := strconv.FormatInt(int64(value), 10)
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(d)
+	}
+	return "Rp " + b.String()
+}
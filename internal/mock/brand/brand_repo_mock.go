@@ -72,6 +72,21 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// FindSlugOwner mocks base method.
+func (m *MockRepository) FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSlugOwner", ctx, slug)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSlugOwner indicates an expected call of FindSlugOwner.
+func (mr *MockRepositoryMockRecorder) FindSlugOwner(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSlugOwner", reflect.TypeOf((*MockRepository)(nil).FindSlugOwner), ctx, slug)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Brand, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +117,21 @@ func (mr *MockRepositoryMockRecorder) GetBySlug(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockRepository)(nil).GetBySlug), ctx, slug)
 }
 
+// IsSlugRetired mocks base method.
+func (m *MockRepository) IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSlugRetired", ctx, slug, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSlugRetired indicates an expected call of IsSlugRetired.
+func (mr *MockRepositoryMockRecorder) IsSlugRetired(ctx, slug, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlugRetired", reflect.TypeOf((*MockRepository)(nil).IsSlugRetired), ctx, slug, id)
+}
+
 // ListAdmin mocks base method.
 func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListBrandsAdminParams) ([]dbgen.ListBrandsAdminRow, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +162,20 @@ func (mr *MockRepositoryMockRecorder) ListPublic(ctx, limit, offset any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockRepository)(nil).ListPublic), ctx, limit, offset)
 }
 
+// RecordSlugChange mocks base method.
+func (m *MockRepository) RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSlugChange", ctx, id, oldSlug, newSlug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSlugChange indicates an expected call of RecordSlugChange.
+func (mr *MockRepositoryMockRecorder) RecordSlugChange(ctx, id, oldSlug, newSlug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSlugChange", reflect.TypeOf((*MockRepository)(nil).RecordSlugChange), ctx, id, oldSlug, newSlug)
+}
+
 // Restore mocks base method.
 func (m *MockRepository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Brand, error) {
 	m.ctrl.T.Helper()
@@ -11,9 +11,11 @@ package mock
 
 import (
 	context "context"
+	sql "database/sql"
 	cart "go-gadget-api/internal/cart"
 	dbgen "go-gadget-api/internal/shared/database/dbgen"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -87,19 +89,34 @@ func (mr *MockRepositoryMockRecorder) CreateCart(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockRepository)(nil).CreateCart), ctx, userID)
 }
 
+// CreateGuestCart mocks base method.
+func (m *MockRepository) CreateGuestCart(ctx context.Context, cartToken string) (dbgen.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestCart", ctx, cartToken)
+	ret0, _ := ret[0].(dbgen.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuestCart indicates an expected call of CreateGuestCart.
+func (mr *MockRepositoryMockRecorder) CreateGuestCart(ctx, cartToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestCart", reflect.TypeOf((*MockRepository)(nil).CreateGuestCart), ctx, cartToken)
+}
+
 // DecrementQty mocks base method.
-func (m *MockRepository) DecrementQty(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error) {
+func (m *MockRepository) DecrementQty(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DecrementQty", ctx, cartID, productID)
+	ret := m.ctrl.Call(m, "DecrementQty", ctx, cartID, productID, variantID)
 	ret0, _ := ret[0].(dbgen.CartItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DecrementQty indicates an expected call of DecrementQty.
-func (mr *MockRepositoryMockRecorder) DecrementQty(ctx, cartID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) DecrementQty(ctx, cartID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementQty", reflect.TypeOf((*MockRepository)(nil).DecrementQty), ctx, cartID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementQty", reflect.TypeOf((*MockRepository)(nil).DecrementQty), ctx, cartID, productID, variantID)
 }
 
 // Delete mocks base method.
@@ -116,6 +133,20 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, cartID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, cartID)
 }
 
+// DeleteActiveItems mocks base method.
+func (m *MockRepository) DeleteActiveItems(ctx context.Context, cartID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActiveItems", ctx, cartID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteActiveItems indicates an expected call of DeleteActiveItems.
+func (mr *MockRepositoryMockRecorder) DeleteActiveItems(ctx, cartID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActiveItems", reflect.TypeOf((*MockRepository)(nil).DeleteActiveItems), ctx, cartID)
+}
+
 // DeleteAllItems mocks base method.
 func (m *MockRepository) DeleteAllItems(ctx context.Context, cartID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -131,17 +162,47 @@ func (mr *MockRepositoryMockRecorder) DeleteAllItems(ctx, cartID any) *gomock.Ca
 }
 
 // DeleteItem mocks base method.
-func (m *MockRepository) DeleteItem(ctx context.Context, cartID, productID uuid.UUID) error {
+func (m *MockRepository) DeleteItem(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteItem", ctx, cartID, productID)
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, cartID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteItem indicates an expected call of DeleteItem.
-func (mr *MockRepositoryMockRecorder) DeleteItem(ctx, cartID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) DeleteItem(ctx, cartID, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockRepository)(nil).DeleteItem), ctx, cartID, productID, variantID)
+}
+
+// DeleteStaleGuestCarts mocks base method.
+func (m *MockRepository) DeleteStaleGuestCarts(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleGuestCarts", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleGuestCarts indicates an expected call of DeleteStaleGuestCarts.
+func (mr *MockRepositoryMockRecorder) DeleteStaleGuestCarts(ctx, before any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockRepository)(nil).DeleteItem), ctx, cartID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleGuestCarts", reflect.TypeOf((*MockRepository)(nil).DeleteStaleGuestCarts), ctx, before)
+}
+
+// GetByToken mocks base method.
+func (m *MockRepository) GetByToken(ctx context.Context, cartToken string) (dbgen.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", ctx, cartToken)
+	ret0, _ := ret[0].(dbgen.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockRepositoryMockRecorder) GetByToken(ctx, cartToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockRepository)(nil).GetByToken), ctx, cartToken)
 }
 
 // GetByUserID mocks base method.
@@ -174,34 +235,196 @@ func (mr *MockRepositoryMockRecorder) GetDetail(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockRepository)(nil).GetDetail), ctx, userID)
 }
 
+// GetDetailByToken mocks base method.
+func (m *MockRepository) GetDetailByToken(ctx context.Context, cartToken string) ([]dbgen.GetCartDetailByTokenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetailByToken", ctx, cartToken)
+	ret0, _ := ret[0].([]dbgen.GetCartDetailByTokenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetailByToken indicates an expected call of GetDetailByToken.
+func (mr *MockRepositoryMockRecorder) GetDetailByToken(ctx, cartToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailByToken", reflect.TypeOf((*MockRepository)(nil).GetDetailByToken), ctx, cartToken)
+}
+
 // GetItemByCartAndProduct mocks base method.
-func (m *MockRepository) GetItemByCartAndProduct(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error) {
+func (m *MockRepository) GetItemByCartAndProduct(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetItemByCartAndProduct", ctx, cartID, productID)
+	ret := m.ctrl.Call(m, "GetItemByCartAndProduct", ctx, cartID, productID, variantID)
 	ret0, _ := ret[0].(dbgen.CartItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetItemByCartAndProduct indicates an expected call of GetItemByCartAndProduct.
-func (mr *MockRepositoryMockRecorder) GetItemByCartAndProduct(ctx, cartID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetItemByCartAndProduct(ctx, cartID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByCartAndProduct", reflect.TypeOf((*MockRepository)(nil).GetItemByCartAndProduct), ctx, cartID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemByCartAndProduct", reflect.TypeOf((*MockRepository)(nil).GetItemByCartAndProduct), ctx, cartID, productID, variantID)
+}
+
+// GetProductPurchaseLimit mocks base method.
+func (m *MockRepository) GetProductPurchaseLimit(ctx context.Context, productID uuid.UUID) (sql.NullInt32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductPurchaseLimit", ctx, productID)
+	ret0, _ := ret[0].(sql.NullInt32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductPurchaseLimit indicates an expected call of GetProductPurchaseLimit.
+func (mr *MockRepositoryMockRecorder) GetProductPurchaseLimit(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductPurchaseLimit", reflect.TypeOf((*MockRepository)(nil).GetProductPurchaseLimit), ctx, productID)
+}
+
+// GetValidation mocks base method.
+func (m *MockRepository) GetValidation(ctx context.Context, userID uuid.UUID) ([]dbgen.GetCartValidationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidation", ctx, userID)
+	ret0, _ := ret[0].([]dbgen.GetCartValidationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidation indicates an expected call of GetValidation.
+func (mr *MockRepositoryMockRecorder) GetValidation(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidation", reflect.TypeOf((*MockRepository)(nil).GetValidation), ctx, userID)
+}
+
+// GetVariant mocks base method.
+func (m *MockRepository) GetVariant(ctx context.Context, productID, variantID uuid.UUID) (dbgen.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariant", ctx, productID, variantID)
+	ret0, _ := ret[0].(dbgen.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariant indicates an expected call of GetVariant.
+func (mr *MockRepositoryMockRecorder) GetVariant(ctx, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariant", reflect.TypeOf((*MockRepository)(nil).GetVariant), ctx, productID, variantID)
 }
 
 // IncrementQty mocks base method.
-func (m *MockRepository) IncrementQty(ctx context.Context, cartID, productID uuid.UUID) (dbgen.CartItem, error) {
+func (m *MockRepository) IncrementQty(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID) (dbgen.CartItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncrementQty", ctx, cartID, productID)
+	ret := m.ctrl.Call(m, "IncrementQty", ctx, cartID, productID, variantID)
 	ret0, _ := ret[0].(dbgen.CartItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IncrementQty indicates an expected call of IncrementQty.
-func (mr *MockRepositoryMockRecorder) IncrementQty(ctx, cartID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) IncrementQty(ctx, cartID, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementQty", reflect.TypeOf((*MockRepository)(nil).IncrementQty), ctx, cartID, productID, variantID)
+}
+
+// ListItemsWithStock mocks base method.
+func (m *MockRepository) ListItemsWithStock(ctx context.Context, cartID uuid.UUID) ([]dbgen.ListCartItemsWithStockRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemsWithStock", ctx, cartID)
+	ret0, _ := ret[0].([]dbgen.ListCartItemsWithStockRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItemsWithStock indicates an expected call of ListItemsWithStock.
+func (mr *MockRepositoryMockRecorder) ListItemsWithStock(ctx, cartID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemsWithStock", reflect.TypeOf((*MockRepository)(nil).ListItemsWithStock), ctx, cartID)
+}
+
+// Lock mocks base method.
+func (m *MockRepository) Lock(ctx context.Context, cartID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, cartID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockRepositoryMockRecorder) Lock(ctx, cartID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockRepository)(nil).Lock), ctx, cartID)
+}
+
+// ProductHasVariants mocks base method.
+func (m *MockRepository) ProductHasVariants(ctx context.Context, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductHasVariants", ctx, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProductHasVariants indicates an expected call of ProductHasVariants.
+func (mr *MockRepositoryMockRecorder) ProductHasVariants(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductHasVariants", reflect.TypeOf((*MockRepository)(nil).ProductHasVariants), ctx, productID)
+}
+
+// SetItemQty mocks base method.
+func (m *MockRepository) SetItemQty(ctx context.Context, arg dbgen.UpsertCartItemQtyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItemQty", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetItemQty indicates an expected call of SetItemQty.
+func (mr *MockRepositoryMockRecorder) SetItemQty(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItemQty", reflect.TypeOf((*MockRepository)(nil).SetItemQty), ctx, arg)
+}
+
+// SetSavedForLater mocks base method.
+func (m *MockRepository) SetSavedForLater(ctx context.Context, cartID, productID uuid.UUID, variantID uuid.NullUUID, saved bool) (dbgen.CartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSavedForLater", ctx, cartID, productID, variantID, saved)
+	ret0, _ := ret[0].(dbgen.CartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSavedForLater indicates an expected call of SetSavedForLater.
+func (mr *MockRepositoryMockRecorder) SetSavedForLater(ctx, cartID, productID, variantID, saved any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSavedForLater", reflect.TypeOf((*MockRepository)(nil).SetSavedForLater), ctx, cartID, productID, variantID, saved)
+}
+
+// SumProductQty mocks base method.
+func (m *MockRepository) SumProductQty(ctx context.Context, cartID, productID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumProductQty", ctx, cartID, productID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumProductQty indicates an expected call of SumProductQty.
+func (mr *MockRepositoryMockRecorder) SumProductQty(ctx, cartID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumProductQty", reflect.TypeOf((*MockRepository)(nil).SumProductQty), ctx, cartID, productID)
+}
+
+// Touch mocks base method.
+func (m *MockRepository) Touch(ctx context.Context, cartID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, cartID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockRepositoryMockRecorder) Touch(ctx, cartID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementQty", reflect.TypeOf((*MockRepository)(nil).IncrementQty), ctx, cartID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockRepository)(nil).Touch), ctx, cartID)
 }
 
 // UpdateQty mocks base method.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	cart "go-gadget-api/internal/cart"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -55,6 +56,21 @@ func (mr *MockServiceMockRecorder) AddItem(ctx, userID, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockService)(nil).AddItem), ctx, userID, req)
 }
 
+// CleanupGuestCarts mocks base method.
+func (m *MockService) CleanupGuestCarts(ctx context.Context, olderThan time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupGuestCarts", ctx, olderThan)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupGuestCarts indicates an expected call of CleanupGuestCarts.
+func (mr *MockServiceMockRecorder) CleanupGuestCarts(ctx, olderThan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupGuestCarts", reflect.TypeOf((*MockService)(nil).CleanupGuestCarts), ctx, olderThan)
+}
+
 // ClearCart mocks base method.
 func (m *MockService) ClearCart(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
@@ -98,18 +114,33 @@ func (mr *MockServiceMockRecorder) Create(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID)
 }
 
+// CreateGuest mocks base method.
+func (m *MockService) CreateGuest(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuest", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuest indicates an expected call of CreateGuest.
+func (mr *MockServiceMockRecorder) CreateGuest(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuest", reflect.TypeOf((*MockService)(nil).CreateGuest), ctx)
+}
+
 // Decrement mocks base method.
-func (m *MockService) Decrement(ctx context.Context, userID, productID string) error {
+func (m *MockService) Decrement(ctx context.Context, userID, productID, variantID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Decrement", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Decrement", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Decrement indicates an expected call of Decrement.
-func (mr *MockServiceMockRecorder) Decrement(ctx, userID, productID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Decrement(ctx, userID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockService)(nil).Decrement), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockService)(nil).Decrement), ctx, userID, productID, variantID)
 }
 
 // Delete mocks base method.
@@ -127,17 +158,17 @@ func (mr *MockServiceMockRecorder) Delete(ctx, userID any) *gomock.Call {
 }
 
 // DeleteItem mocks base method.
-func (m *MockService) DeleteItem(ctx context.Context, userID, productID string) error {
+func (m *MockService) DeleteItem(ctx context.Context, userID, productID, variantID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteItem", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteItem indicates an expected call of DeleteItem.
-func (mr *MockServiceMockRecorder) DeleteItem(ctx, userID, productID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeleteItem(ctx, userID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockService)(nil).DeleteItem), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockService)(nil).DeleteItem), ctx, userID, productID, variantID)
 }
 
 // Detail mocks base method.
@@ -155,30 +186,160 @@ func (mr *MockServiceMockRecorder) Detail(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockService)(nil).Detail), ctx, userID)
 }
 
+// GuestAddItem mocks base method.
+func (m *MockService) GuestAddItem(ctx context.Context, cartToken string, req cart.AddItemRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestAddItem", ctx, cartToken, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestAddItem indicates an expected call of GuestAddItem.
+func (mr *MockServiceMockRecorder) GuestAddItem(ctx, cartToken, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestAddItem", reflect.TypeOf((*MockService)(nil).GuestAddItem), ctx, cartToken, req)
+}
+
+// GuestCount mocks base method.
+func (m *MockService) GuestCount(ctx context.Context, cartToken string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestCount", ctx, cartToken)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestCount indicates an expected call of GuestCount.
+func (mr *MockServiceMockRecorder) GuestCount(ctx, cartToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestCount", reflect.TypeOf((*MockService)(nil).GuestCount), ctx, cartToken)
+}
+
+// GuestDeleteItem mocks base method.
+func (m *MockService) GuestDeleteItem(ctx context.Context, cartToken, productID, variantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestDeleteItem", ctx, cartToken, productID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestDeleteItem indicates an expected call of GuestDeleteItem.
+func (mr *MockServiceMockRecorder) GuestDeleteItem(ctx, cartToken, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestDeleteItem", reflect.TypeOf((*MockService)(nil).GuestDeleteItem), ctx, cartToken, productID, variantID)
+}
+
+// GuestDetail mocks base method.
+func (m *MockService) GuestDetail(ctx context.Context, cartToken string) (cart.CartDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestDetail", ctx, cartToken)
+	ret0, _ := ret[0].(cart.CartDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestDetail indicates an expected call of GuestDetail.
+func (mr *MockServiceMockRecorder) GuestDetail(ctx, cartToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestDetail", reflect.TypeOf((*MockService)(nil).GuestDetail), ctx, cartToken)
+}
+
+// GuestUpdateQty mocks base method.
+func (m *MockService) GuestUpdateQty(ctx context.Context, cartToken, productID, variantID string, req cart.UpdateQtyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestUpdateQty", ctx, cartToken, productID, variantID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestUpdateQty indicates an expected call of GuestUpdateQty.
+func (mr *MockServiceMockRecorder) GuestUpdateQty(ctx, cartToken, productID, variantID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestUpdateQty", reflect.TypeOf((*MockService)(nil).GuestUpdateQty), ctx, cartToken, productID, variantID, req)
+}
+
 // Increment mocks base method.
-func (m *MockService) Increment(ctx context.Context, userID, productID string) error {
+func (m *MockService) Increment(ctx context.Context, userID, productID, variantID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Increment", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Increment", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Increment indicates an expected call of Increment.
-func (mr *MockServiceMockRecorder) Increment(ctx, userID, productID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Increment(ctx, userID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockService)(nil).Increment), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockService)(nil).Increment), ctx, userID, productID, variantID)
+}
+
+// MergeGuestCart mocks base method.
+func (m *MockService) MergeGuestCart(ctx context.Context, userID, cartToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuestCart", ctx, userID, cartToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuestCart indicates an expected call of MergeGuestCart.
+func (mr *MockServiceMockRecorder) MergeGuestCart(ctx, userID, cartToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuestCart", reflect.TypeOf((*MockService)(nil).MergeGuestCart), ctx, userID, cartToken)
+}
+
+// MoveToCart mocks base method.
+func (m *MockService) MoveToCart(ctx context.Context, userID, productID, variantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToCart", ctx, userID, productID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToCart indicates an expected call of MoveToCart.
+func (mr *MockServiceMockRecorder) MoveToCart(ctx, userID, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToCart", reflect.TypeOf((*MockService)(nil).MoveToCart), ctx, userID, productID, variantID)
+}
+
+// SaveForLater mocks base method.
+func (m *MockService) SaveForLater(ctx context.Context, userID, productID, variantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveForLater", ctx, userID, productID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveForLater indicates an expected call of SaveForLater.
+func (mr *MockServiceMockRecorder) SaveForLater(ctx, userID, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveForLater", reflect.TypeOf((*MockService)(nil).SaveForLater), ctx, userID, productID, variantID)
 }
 
 // UpdateQty mocks base method.
-func (m *MockService) UpdateQty(ctx context.Context, userID, productID string, req cart.UpdateQtyRequest) error {
+func (m *MockService) UpdateQty(ctx context.Context, userID, productID, variantID string, req cart.UpdateQtyRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateQty", ctx, userID, productID, req)
+	ret := m.ctrl.Call(m, "UpdateQty", ctx, userID, productID, variantID, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateQty indicates an expected call of UpdateQty.
-func (mr *MockServiceMockRecorder) UpdateQty(ctx, userID, productID, req any) *gomock.Call {
+func (mr *MockServiceMockRecorder) UpdateQty(ctx, userID, productID, variantID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQty", reflect.TypeOf((*MockService)(nil).UpdateQty), ctx, userID, productID, variantID, req)
+}
+
+// Validate mocks base method.
+func (m *MockService) Validate(ctx context.Context, userID string) (cart.CartValidationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, userID)
+	ret0, _ := ret[0].(cart.CartValidationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockServiceMockRecorder) Validate(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQty", reflect.TypeOf((*MockService)(nil).UpdateQty), ctx, userID, productID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockService)(nil).Validate), ctx, userID)
 }
@@ -43,6 +43,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountDependents mocks base method.
+func (m *MockRepository) CountDependents(ctx context.Context, id uuid.UUID) (dbgen.CountCategoryDependentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDependents", ctx, id)
+	ret0, _ := ret[0].(dbgen.CountCategoryDependentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDependents indicates an expected call of CountDependents.
+func (mr *MockRepositoryMockRecorder) CountDependents(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDependents", reflect.TypeOf((*MockRepository)(nil).CountDependents), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, arg dbgen.CreateCategoryParams) (dbgen.Category, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +73,21 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg)
 }
 
+// CreateAttribute mocks base method.
+func (m *MockRepository) CreateAttribute(ctx context.Context, arg dbgen.CreateCategoryAttributeParams) (dbgen.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, arg)
+	ret0, _ := ret[0].(dbgen.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockRepositoryMockRecorder) CreateAttribute(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockRepository)(nil).CreateAttribute), ctx, arg)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -72,6 +102,51 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteAttribute mocks base method.
+func (m *MockRepository) DeleteAttribute(ctx context.Context, categoryID, attributeID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, categoryID, attributeID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockRepositoryMockRecorder) DeleteAttribute(ctx, categoryID, attributeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockRepository)(nil).DeleteAttribute), ctx, categoryID, attributeID)
+}
+
+// FindSlugOwner mocks base method.
+func (m *MockRepository) FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSlugOwner", ctx, slug)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSlugOwner indicates an expected call of FindSlugOwner.
+func (mr *MockRepositoryMockRecorder) FindSlugOwner(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSlugOwner", reflect.TypeOf((*MockRepository)(nil).FindSlugOwner), ctx, slug)
+}
+
+// GetAttribute mocks base method.
+func (m *MockRepository) GetAttribute(ctx context.Context, categoryID, attributeID uuid.UUID) (dbgen.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttribute", ctx, categoryID, attributeID)
+	ret0, _ := ret[0].(dbgen.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttribute indicates an expected call of GetAttribute.
+func (mr *MockRepositoryMockRecorder) GetAttribute(ctx, categoryID, attributeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttribute", reflect.TypeOf((*MockRepository)(nil).GetAttribute), ctx, categoryID, attributeID)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Category, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +162,21 @@ func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
 }
 
+// GetDescendantIDsBySlugs mocks base method.
+func (m *MockRepository) GetDescendantIDsBySlugs(ctx context.Context, slugs []string) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDescendantIDsBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDescendantIDsBySlugs indicates an expected call of GetDescendantIDsBySlugs.
+func (mr *MockRepositoryMockRecorder) GetDescendantIDsBySlugs(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDescendantIDsBySlugs", reflect.TypeOf((*MockRepository)(nil).GetDescendantIDsBySlugs), ctx, slugs)
+}
+
 // GetIDsBySlugs mocks base method.
 func (m *MockRepository) GetIDsBySlugs(ctx context.Context, slugs []string) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +192,21 @@ func (mr *MockRepositoryMockRecorder) GetIDsBySlugs(ctx, slugs any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDsBySlugs", reflect.TypeOf((*MockRepository)(nil).GetIDsBySlugs), ctx, slugs)
 }
 
+// IsSlugRetired mocks base method.
+func (m *MockRepository) IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSlugRetired", ctx, slug, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSlugRetired indicates an expected call of IsSlugRetired.
+func (mr *MockRepositoryMockRecorder) IsSlugRetired(ctx, slug, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlugRetired", reflect.TypeOf((*MockRepository)(nil).IsSlugRetired), ctx, slug, id)
+}
+
 // ListAdmin mocks base method.
 func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListCategoriesAdminParams) ([]dbgen.ListCategoriesAdminRow, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +222,36 @@ func (mr *MockRepositoryMockRecorder) ListAdmin(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockRepository)(nil).ListAdmin), ctx, arg)
 }
 
+// ListAncestors mocks base method.
+func (m *MockRepository) ListAncestors(ctx context.Context, id uuid.UUID) ([]dbgen.ListCategoryAncestorsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAncestors", ctx, id)
+	ret0, _ := ret[0].([]dbgen.ListCategoryAncestorsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAncestors indicates an expected call of ListAncestors.
+func (mr *MockRepositoryMockRecorder) ListAncestors(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAncestors", reflect.TypeOf((*MockRepository)(nil).ListAncestors), ctx, id)
+}
+
+// ListAttributes mocks base method.
+func (m *MockRepository) ListAttributes(ctx context.Context, categoryID uuid.UUID) ([]dbgen.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttributes", ctx, categoryID)
+	ret0, _ := ret[0].([]dbgen.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttributes indicates an expected call of ListAttributes.
+func (mr *MockRepositoryMockRecorder) ListAttributes(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributes", reflect.TypeOf((*MockRepository)(nil).ListAttributes), ctx, categoryID)
+}
+
 // ListPublic mocks base method.
 func (m *MockRepository) ListPublic(ctx context.Context, limit, offset int32) ([]dbgen.ListCategoriesPublicRow, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +267,50 @@ func (mr *MockRepositoryMockRecorder) ListPublic(ctx, limit, offset any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockRepository)(nil).ListPublic), ctx, limit, offset)
 }
 
+// ListTree mocks base method.
+func (m *MockRepository) ListTree(ctx context.Context) ([]dbgen.ListCategoryTreeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTree", ctx)
+	ret0, _ := ret[0].([]dbgen.ListCategoryTreeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTree indicates an expected call of ListTree.
+func (mr *MockRepositoryMockRecorder) ListTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTree", reflect.TypeOf((*MockRepository)(nil).ListTree), ctx)
+}
+
+// LockForUpdate mocks base method.
+func (m *MockRepository) LockForUpdate(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockForUpdate", ctx, ids)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockForUpdate indicates an expected call of LockForUpdate.
+func (mr *MockRepositoryMockRecorder) LockForUpdate(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockForUpdate", reflect.TypeOf((*MockRepository)(nil).LockForUpdate), ctx, ids)
+}
+
+// RecordSlugChange mocks base method.
+func (m *MockRepository) RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSlugChange", ctx, id, oldSlug, newSlug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSlugChange indicates an expected call of RecordSlugChange.
+func (mr *MockRepositoryMockRecorder) RecordSlugChange(ctx, id, oldSlug, newSlug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSlugChange", reflect.TypeOf((*MockRepository)(nil).RecordSlugChange), ctx, id, oldSlug, newSlug)
+}
+
 // Restore mocks base method.
 func (m *MockRepository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Category, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +341,21 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, arg)
 }
 
+// UpdateAttribute mocks base method.
+func (m *MockRepository) UpdateAttribute(ctx context.Context, arg dbgen.UpdateCategoryAttributeParams) (dbgen.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, arg)
+	ret0, _ := ret[0].(dbgen.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockRepositoryMockRecorder) UpdateAttribute(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockRepository)(nil).UpdateAttribute), ctx, arg)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx dbgen.DBTX) category.Repository {
 	m.ctrl.T.Helper()
@@ -110,6 +110,21 @@ func (mr *MockServiceMockRecorder) Create(ctx, req, file, filename any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req, file, filename)
 }
 
+// CreateAttribute mocks base method.
+func (m *MockService) CreateAttribute(ctx context.Context, categoryID string, req category.CreateAttributeRequest) (category.CategoryAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, categoryID, req)
+	ret0, _ := ret[0].(category.CategoryAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockServiceMockRecorder) CreateAttribute(ctx, categoryID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockService)(nil).CreateAttribute), ctx, categoryID, req)
+}
+
 // Delete mocks base method.
 func (m *MockService) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -124,6 +139,20 @@ func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
 }
 
+// DeleteAttribute mocks base method.
+func (m *MockService) DeleteAttribute(ctx context.Context, categoryID, attributeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, categoryID, attributeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockServiceMockRecorder) DeleteAttribute(ctx, categoryID, attributeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockService)(nil).DeleteAttribute), ctx, categoryID, attributeID)
+}
+
 // GetByID mocks base method.
 func (m *MockService) GetByID(ctx context.Context, id string) (category.CategoryAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +168,21 @@ func (mr *MockServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
 }
 
+// GetTree mocks base method.
+func (m *MockService) GetTree(ctx context.Context) ([]category.CategoryTreeNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", ctx)
+	ret0, _ := ret[0].([]category.CategoryTreeNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockServiceMockRecorder) GetTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockService)(nil).GetTree), ctx)
+}
+
 // ListAdmin mocks base method.
 func (m *MockService) ListAdmin(ctx context.Context, req category.ListCategoryRequest) ([]category.CategoryAdminResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +199,21 @@ func (mr *MockServiceMockRecorder) ListAdmin(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, req)
 }
 
+// ListAttributes mocks base method.
+func (m *MockService) ListAttributes(ctx context.Context, categoryID string) ([]category.CategoryAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttributes", ctx, categoryID)
+	ret0, _ := ret[0].([]category.CategoryAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttributes indicates an expected call of ListAttributes.
+func (mr *MockServiceMockRecorder) ListAttributes(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributes", reflect.TypeOf((*MockService)(nil).ListAttributes), ctx, categoryID)
+}
+
 // ListPublic mocks base method.
 func (m *MockService) ListPublic(ctx context.Context, page, limit int) ([]category.CategoryPublicResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -200,3 +259,18 @@ func (mr *MockServiceMockRecorder) Update(ctx, id, req, file, filename any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, id, req, file, filename)
 }
+
+// UpdateAttribute mocks base method.
+func (m *MockService) UpdateAttribute(ctx context.Context, categoryID, attributeID string, req category.UpdateAttributeRequest) (category.CategoryAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, categoryID, attributeID, req)
+	ret0, _ := ret[0].(category.CategoryAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockServiceMockRecorder) UpdateAttribute(ctx, categoryID, attributeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockService)(nil).UpdateAttribute), ctx, categoryID, attributeID, req)
+}
@@ -58,6 +58,21 @@ func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
 }
 
+// GetNotificationPreferences mocks base method.
+func (m *MockRepository) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (dbgen.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(dbgen.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockRepositoryMockRecorder) GetNotificationPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockRepository)(nil).GetNotificationPreferences), ctx, userID)
+}
+
 // ListCustomers mocks base method.
 func (m *MockRepository) ListCustomers(ctx context.Context, params dbgen.ListCustomersParams) ([]dbgen.ListCustomersRow, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +132,21 @@ func (mr *MockRepositoryMockRecorder) UpdateStatus(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockRepository)(nil).UpdateStatus), ctx, arg)
 }
 
+// UpsertCartReminderPreference mocks base method.
+func (m *MockRepository) UpsertCartReminderPreference(ctx context.Context, arg dbgen.UpsertCartReminderPreferenceParams) (dbgen.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCartReminderPreference", ctx, arg)
+	ret0, _ := ret[0].(dbgen.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertCartReminderPreference indicates an expected call of UpsertCartReminderPreference.
+func (mr *MockRepositoryMockRecorder) UpsertCartReminderPreference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCartReminderPreference", reflect.TypeOf((*MockRepository)(nil).UpsertCartReminderPreference), ctx, arg)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx dbgen.DBTX) customer.Repository {
 	m.ctrl.T.Helper()
@@ -56,6 +56,21 @@ func (mr *MockServiceMockRecorder) GetCustomerByID(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockService)(nil).GetCustomerByID), ctx, req)
 }
 
+// GetNotificationPreferences mocks base method.
+func (m *MockService) GetNotificationPreferences(ctx context.Context, customerID string) (customer.NotificationPreferencesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, customerID)
+	ret0, _ := ret[0].(customer.NotificationPreferencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockServiceMockRecorder) GetNotificationPreferences(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockService)(nil).GetNotificationPreferences), ctx, customerID)
+}
+
 // ListCustomerAddresses mocks base method.
 func (m *MockService) ListCustomerAddresses(ctx context.Context, req customer.CustomerAddressesRequest) (customer.PaginatedAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +132,21 @@ func (mr *MockServiceMockRecorder) ToggleCustomerStatus(ctx, customerID, active
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleCustomerStatus", reflect.TypeOf((*MockService)(nil).ToggleCustomerStatus), ctx, customerID, active)
 }
 
+// UpdateNotificationPreferences mocks base method.
+func (m *MockService) UpdateNotificationPreferences(ctx context.Context, customerID string, req customer.UpdateNotificationPreferencesRequest) (customer.NotificationPreferencesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationPreferences", ctx, customerID, req)
+	ret0, _ := ret[0].(customer.NotificationPreferencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationPreferences indicates an expected call of UpdateNotificationPreferences.
+func (mr *MockServiceMockRecorder) UpdateNotificationPreferences(ctx, customerID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationPreferences", reflect.TypeOf((*MockService)(nil).UpdateNotificationPreferences), ctx, customerID, req)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockService) UpdateProfile(ctx context.Context, customerID string, req customer.UpdateProfileRequest) (customer.CustomerResponse, error) {
 	m.ctrl.T.Helper()
@@ -43,6 +43,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApplyStockMovement mocks base method.
+func (m *MockRepository) ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStockMovement", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ApplyStockMovementRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyStockMovement indicates an expected call of ApplyStockMovement.
+func (mr *MockRepositoryMockRecorder) ApplyStockMovement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStockMovement", reflect.TypeOf((*MockRepository)(nil).ApplyStockMovement), ctx, arg)
+}
+
 // CreateOrder mocks base method.
 func (m *MockRepository) CreateOrder(ctx context.Context, arg dbgen.CreateOrderParams) (dbgen.Order, error) {
 	m.ctrl.T.Helper()
@@ -207,6 +222,66 @@ func (mr *MockRepositoryMockRecorder) ListAdmin(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockRepository)(nil).ListAdmin), ctx, arg)
 }
 
+// ListAdminByCreated mocks base method.
+func (m *MockRepository) ListAdminByCreated(ctx context.Context, arg dbgen.ListOrdersAdminByCreatedDescParams, desc bool) ([]dbgen.ListOrdersAdminRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminByCreated", ctx, arg, desc)
+	ret0, _ := ret[0].([]dbgen.ListOrdersAdminRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminByCreated indicates an expected call of ListAdminByCreated.
+func (mr *MockRepositoryMockRecorder) ListAdminByCreated(ctx, arg, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminByCreated", reflect.TypeOf((*MockRepository)(nil).ListAdminByCreated), ctx, arg, desc)
+}
+
+// ListByPlaced mocks base method.
+func (m *MockRepository) ListByPlaced(ctx context.Context, arg dbgen.ListOrdersByPlacedDescParams, desc bool) ([]dbgen.ListOrdersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPlaced", ctx, arg, desc)
+	ret0, _ := ret[0].([]dbgen.ListOrdersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPlaced indicates an expected call of ListByPlaced.
+func (mr *MockRepositoryMockRecorder) ListByPlaced(ctx, arg, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPlaced", reflect.TypeOf((*MockRepository)(nil).ListByPlaced), ctx, arg, desc)
+}
+
+// ListFulfilmentWarehouses mocks base method.
+func (m *MockRepository) ListFulfilmentWarehouses(ctx context.Context, arg dbgen.ListFulfilmentWarehousesParams) ([]dbgen.ListFulfilmentWarehousesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFulfilmentWarehouses", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListFulfilmentWarehousesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFulfilmentWarehouses indicates an expected call of ListFulfilmentWarehouses.
+func (mr *MockRepositoryMockRecorder) ListFulfilmentWarehouses(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFulfilmentWarehouses", reflect.TypeOf((*MockRepository)(nil).ListFulfilmentWarehouses), ctx, arg)
+}
+
+// ListStockMovementsByReference mocks base method.
+func (m *MockRepository) ListStockMovementsByReference(ctx context.Context, arg dbgen.ListStockMovementsByReferenceParams) ([]dbgen.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStockMovementsByReference", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStockMovementsByReference indicates an expected call of ListStockMovementsByReference.
+func (mr *MockRepositoryMockRecorder) ListStockMovementsByReference(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStockMovementsByReference", reflect.TypeOf((*MockRepository)(nil).ListStockMovementsByReference), ctx, arg)
+}
+
 // UpdateOrderPaymentStatus mocks base method.
 func (m *MockRepository) UpdateOrderPaymentStatus(ctx context.Context, arg dbgen.UpdateOrderPaymentStatusParams) (dbgen.Order, error) {
 	m.ctrl.T.Helper()
@@ -11,9 +11,11 @@ package mock
 
 import (
 	context "context"
+	sql "database/sql"
 	product "go-gadget-api/internal/product"
 	dbgen "go-gadget-api/internal/shared/database/dbgen"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -43,6 +45,79 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddVariantValue mocks base method.
+func (m *MockRepository) AddVariantValue(ctx context.Context, variantID, optionValueID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariantValue", ctx, variantID, optionValueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVariantValue indicates an expected call of AddVariantValue.
+func (mr *MockRepositoryMockRecorder) AddVariantValue(ctx, variantID, optionValueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariantValue", reflect.TypeOf((*MockRepository)(nil).AddVariantValue), ctx, variantID, optionValueID)
+}
+
+// ApplyStockMovement mocks base method.
+func (m *MockRepository) ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyStockMovement", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ApplyStockMovementRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyStockMovement indicates an expected call of ApplyStockMovement.
+func (mr *MockRepositoryMockRecorder) ApplyStockMovement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyStockMovement", reflect.TypeOf((*MockRepository)(nil).ApplyStockMovement), ctx, arg)
+}
+
+// CancelPendingPriceSchedule mocks base method.
+func (m *MockRepository) CancelPendingPriceSchedule(ctx context.Context, id uuid.UUID) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingPriceSchedule", ctx, id)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPendingPriceSchedule indicates an expected call of CancelPendingPriceSchedule.
+func (mr *MockRepositoryMockRecorder) CancelPendingPriceSchedule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingPriceSchedule", reflect.TypeOf((*MockRepository)(nil).CancelPendingPriceSchedule), ctx, id)
+}
+
+// ClearPrimaryImage mocks base method.
+func (m *MockRepository) ClearPrimaryImage(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPrimaryImage", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPrimaryImage indicates an expected call of ClearPrimaryImage.
+func (mr *MockRepositoryMockRecorder) ClearPrimaryImage(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPrimaryImage", reflect.TypeOf((*MockRepository)(nil).ClearPrimaryImage), ctx, productID)
+}
+
+// CountOverlappingPriceSchedules mocks base method.
+func (m *MockRepository) CountOverlappingPriceSchedules(ctx context.Context, arg dbgen.CountOverlappingPriceSchedulesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOverlappingPriceSchedules", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOverlappingPriceSchedules indicates an expected call of CountOverlappingPriceSchedules.
+func (mr *MockRepositoryMockRecorder) CountOverlappingPriceSchedules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOverlappingPriceSchedules", reflect.TypeOf((*MockRepository)(nil).CountOverlappingPriceSchedules), ctx, arg)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(ctx context.Context, arg dbgen.CreateProductParams) (dbgen.Product, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +133,80 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, arg)
 }
 
+// CreateImage mocks base method.
+func (m *MockRepository) CreateImage(ctx context.Context, arg dbgen.CreateProductImageParams) (dbgen.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImage", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImage indicates an expected call of CreateImage.
+func (mr *MockRepositoryMockRecorder) CreateImage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockRepository)(nil).CreateImage), ctx, arg)
+}
+
+// CreateImportJob mocks base method.
+func (m *MockRepository) CreateImportJob(ctx context.Context, arg dbgen.CreateProductImportJobParams) (dbgen.ProductImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportJob", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ProductImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImportJob indicates an expected call of CreateImportJob.
+func (mr *MockRepositoryMockRecorder) CreateImportJob(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportJob", reflect.TypeOf((*MockRepository)(nil).CreateImportJob), ctx, arg)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockRepository) CreateOutboxEvent(ctx context.Context, arg dbgen.CreateOutboxEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockRepositoryMockRecorder) CreateOutboxEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockRepository)(nil).CreateOutboxEvent), ctx, arg)
+}
+
+// CreatePriceSchedule mocks base method.
+func (m *MockRepository) CreatePriceSchedule(ctx context.Context, arg dbgen.CreatePriceScheduleParams) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceSchedule", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceSchedule indicates an expected call of CreatePriceSchedule.
+func (mr *MockRepositoryMockRecorder) CreatePriceSchedule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceSchedule", reflect.TypeOf((*MockRepository)(nil).CreatePriceSchedule), ctx, arg)
+}
+
+// CreateVariant mocks base method.
+func (m *MockRepository) CreateVariant(ctx context.Context, arg dbgen.CreateProductVariantParams) (dbgen.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVariant", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockRepositoryMockRecorder) CreateVariant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockRepository)(nil).CreateVariant), ctx, arg)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -72,6 +221,153 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
 }
 
+// DeleteAttributeValue mocks base method.
+func (m *MockRepository) DeleteAttributeValue(ctx context.Context, productID, attributeID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttributeValue", ctx, productID, attributeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttributeValue indicates an expected call of DeleteAttributeValue.
+func (mr *MockRepositoryMockRecorder) DeleteAttributeValue(ctx, productID, attributeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttributeValue", reflect.TypeOf((*MockRepository)(nil).DeleteAttributeValue), ctx, productID, attributeID)
+}
+
+// DeleteImage mocks base method.
+func (m *MockRepository) DeleteImage(ctx context.Context, productID, imageID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, productID, imageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockRepositoryMockRecorder) DeleteImage(ctx, productID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockRepository)(nil).DeleteImage), ctx, productID, imageID)
+}
+
+// DeleteStockNotification mocks base method.
+func (m *MockRepository) DeleteStockNotification(ctx context.Context, arg dbgen.DeleteStockNotificationParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStockNotification", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStockNotification indicates an expected call of DeleteStockNotification.
+func (mr *MockRepositoryMockRecorder) DeleteStockNotification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStockNotification", reflect.TypeOf((*MockRepository)(nil).DeleteStockNotification), ctx, arg)
+}
+
+// DeleteVariant mocks base method.
+func (m *MockRepository) DeleteVariant(ctx context.Context, productID, variantID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", ctx, productID, variantID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockRepositoryMockRecorder) DeleteVariant(ctx, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockRepository)(nil).DeleteVariant), ctx, productID, variantID)
+}
+
+// ExpirePriceSchedules mocks base method.
+func (m *MockRepository) ExpirePriceSchedules(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePriceSchedules", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePriceSchedules indicates an expected call of ExpirePriceSchedules.
+func (mr *MockRepositoryMockRecorder) ExpirePriceSchedules(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePriceSchedules", reflect.TypeOf((*MockRepository)(nil).ExpirePriceSchedules), ctx, now)
+}
+
+// FailImportJob mocks base method.
+func (m *MockRepository) FailImportJob(ctx context.Context, id uuid.UUID, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailImportJob", ctx, id, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailImportJob indicates an expected call of FailImportJob.
+func (mr *MockRepositoryMockRecorder) FailImportJob(ctx, id, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailImportJob", reflect.TypeOf((*MockRepository)(nil).FailImportJob), ctx, id, message)
+}
+
+// FailStaleImportJobs mocks base method.
+func (m *MockRepository) FailStaleImportJobs(ctx context.Context, startedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailStaleImportJobs", ctx, startedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailStaleImportJobs indicates an expected call of FailStaleImportJobs.
+func (mr *MockRepositoryMockRecorder) FailStaleImportJobs(ctx, startedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailStaleImportJobs", reflect.TypeOf((*MockRepository)(nil).FailStaleImportJobs), ctx, startedBefore)
+}
+
+// FindSlugOwner mocks base method.
+func (m *MockRepository) FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSlugOwner", ctx, slug)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSlugOwner indicates an expected call of FindSlugOwner.
+func (mr *MockRepositoryMockRecorder) FindSlugOwner(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSlugOwner", reflect.TypeOf((*MockRepository)(nil).FindSlugOwner), ctx, slug)
+}
+
+// FinishImportJob mocks base method.
+func (m *MockRepository) FinishImportJob(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishImportJob", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishImportJob indicates an expected call of FinishImportJob.
+func (mr *MockRepositoryMockRecorder) FinishImportJob(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishImportJob", reflect.TypeOf((*MockRepository)(nil).FinishImportJob), ctx, arg)
+}
+
+// FinishPriceSchedule mocks base method.
+func (m *MockRepository) FinishPriceSchedule(ctx context.Context, id uuid.UUID, status string) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPriceSchedule", ctx, id, status)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPriceSchedule indicates an expected call of FinishPriceSchedule.
+func (mr *MockRepositoryMockRecorder) FinishPriceSchedule(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPriceSchedule", reflect.TypeOf((*MockRepository)(nil).FinishPriceSchedule), ctx, id, status)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetProductByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +398,156 @@ func (mr *MockRepositoryMockRecorder) GetBySlug(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockRepository)(nil).GetBySlug), ctx, slug)
 }
 
+// GetFacets mocks base method.
+func (m *MockRepository) GetFacets(ctx context.Context, arg dbgen.GetProductFacetsParams) ([]dbgen.GetProductFacetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacets", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.GetProductFacetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockRepositoryMockRecorder) GetFacets(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockRepository)(nil).GetFacets), ctx, arg)
+}
+
+// GetImage mocks base method.
+func (m *MockRepository) GetImage(ctx context.Context, productID, imageID uuid.UUID) (dbgen.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, productID, imageID)
+	ret0, _ := ret[0].(dbgen.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockRepositoryMockRecorder) GetImage(ctx, productID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockRepository)(nil).GetImage), ctx, productID, imageID)
+}
+
+// GetImportJob mocks base method.
+func (m *MockRepository) GetImportJob(ctx context.Context, id uuid.UUID) (dbgen.ProductImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportJob", ctx, id)
+	ret0, _ := ret[0].(dbgen.ProductImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportJob indicates an expected call of GetImportJob.
+func (mr *MockRepositoryMockRecorder) GetImportJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportJob", reflect.TypeOf((*MockRepository)(nil).GetImportJob), ctx, id)
+}
+
+// GetImportTargetBySKU mocks base method.
+func (m *MockRepository) GetImportTargetBySKU(ctx context.Context, sku string) (dbgen.GetProductImportTargetBySKURow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportTargetBySKU", ctx, sku)
+	ret0, _ := ret[0].(dbgen.GetProductImportTargetBySKURow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportTargetBySKU indicates an expected call of GetImportTargetBySKU.
+func (mr *MockRepositoryMockRecorder) GetImportTargetBySKU(ctx, sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportTargetBySKU", reflect.TypeOf((*MockRepository)(nil).GetImportTargetBySKU), ctx, sku)
+}
+
+// GetPriceSchedule mocks base method.
+func (m *MockRepository) GetPriceSchedule(ctx context.Context, id, productID uuid.UUID) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceSchedule", ctx, id, productID)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceSchedule indicates an expected call of GetPriceSchedule.
+func (mr *MockRepositoryMockRecorder) GetPriceSchedule(ctx, id, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceSchedule", reflect.TypeOf((*MockRepository)(nil).GetPriceSchedule), ctx, id, productID)
+}
+
+// GetStockForUpdate mocks base method.
+func (m *MockRepository) GetStockForUpdate(ctx context.Context, id uuid.UUID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockForUpdate", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockForUpdate indicates an expected call of GetStockForUpdate.
+func (mr *MockRepositoryMockRecorder) GetStockForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockForUpdate", reflect.TypeOf((*MockRepository)(nil).GetStockForUpdate), ctx, id)
+}
+
+// GetVariant mocks base method.
+func (m *MockRepository) GetVariant(ctx context.Context, productID, variantID uuid.UUID) (dbgen.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariant", ctx, productID, variantID)
+	ret0, _ := ret[0].(dbgen.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariant indicates an expected call of GetVariant.
+func (mr *MockRepositoryMockRecorder) GetVariant(ctx, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariant", reflect.TypeOf((*MockRepository)(nil).GetVariant), ctx, productID, variantID)
+}
+
+// GetWarehouse mocks base method.
+func (m *MockRepository) GetWarehouse(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouse", ctx, id)
+	ret0, _ := ret[0].(dbgen.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouse indicates an expected call of GetWarehouse.
+func (mr *MockRepositoryMockRecorder) GetWarehouse(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouse", reflect.TypeOf((*MockRepository)(nil).GetWarehouse), ctx, id)
+}
+
+// HasVariants mocks base method.
+func (m *MockRepository) HasVariants(ctx context.Context, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasVariants", ctx, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasVariants indicates an expected call of HasVariants.
+func (mr *MockRepositoryMockRecorder) HasVariants(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasVariants", reflect.TypeOf((*MockRepository)(nil).HasVariants), ctx, productID)
+}
+
+// IsSlugRetired mocks base method.
+func (m *MockRepository) IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSlugRetired", ctx, slug, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSlugRetired indicates an expected call of IsSlugRetired.
+func (mr *MockRepositoryMockRecorder) IsSlugRetired(ctx, slug, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlugRetired", reflect.TypeOf((*MockRepository)(nil).IsSlugRetired), ctx, slug, id)
+}
+
 // ListAdmin mocks base method.
 func (m *MockRepository) ListAdmin(ctx context.Context, arg dbgen.ListProductsAdminParams) ([]dbgen.ListProductsAdminRow, error) {
 	m.ctrl.T.Helper()
@@ -117,38 +563,513 @@ func (mr *MockRepositoryMockRecorder) ListAdmin(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockRepository)(nil).ListAdmin), ctx, arg)
 }
 
-// ListPublic mocks base method.
-func (m *MockRepository) ListPublic(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+// ListAttributeValues mocks base method.
+func (m *MockRepository) ListAttributeValues(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductAttributeValuesRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPublic", ctx, arg)
-	ret0, _ := ret[0].([]dbgen.ListProductsPublicRow)
+	ret := m.ctrl.Call(m, "ListAttributeValues", ctx, productID)
+	ret0, _ := ret[0].([]dbgen.ListProductAttributeValuesRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPublic indicates an expected call of ListPublic.
-func (mr *MockRepositoryMockRecorder) ListPublic(ctx, arg any) *gomock.Call {
+// ListAttributeValues indicates an expected call of ListAttributeValues.
+func (mr *MockRepositoryMockRecorder) ListAttributeValues(ctx, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockRepository)(nil).ListPublic), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttributeValues", reflect.TypeOf((*MockRepository)(nil).ListAttributeValues), ctx, productID)
 }
 
-// Restore mocks base method.
-func (m *MockRepository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Product, error) {
+// ListBoughtTogether mocks base method.
+func (m *MockRepository) ListBoughtTogether(ctx context.Context, productID uuid.UUID, limit int32) ([]dbgen.ListBoughtTogetherRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Restore", ctx, id)
-	ret0, _ := ret[0].(dbgen.Product)
+	ret := m.ctrl.Call(m, "ListBoughtTogether", ctx, productID, limit)
+	ret0, _ := ret[0].([]dbgen.ListBoughtTogetherRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Restore indicates an expected call of Restore.
-func (mr *MockRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+// ListBoughtTogether indicates an expected call of ListBoughtTogether.
+func (mr *MockRepositoryMockRecorder) ListBoughtTogether(ctx, productID, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBoughtTogether", reflect.TypeOf((*MockRepository)(nil).ListBoughtTogether), ctx, productID, limit)
 }
 
-// Update mocks base method.
-func (m *MockRepository) Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
+// ListBrandRefsBySlugs mocks base method.
+func (m *MockRepository) ListBrandRefsBySlugs(ctx context.Context, slugs []string) ([]dbgen.ListBrandRefsBySlugsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBrandRefsBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]dbgen.ListBrandRefsBySlugsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrandRefsBySlugs indicates an expected call of ListBrandRefsBySlugs.
+func (mr *MockRepositoryMockRecorder) ListBrandRefsBySlugs(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrandRefsBySlugs", reflect.TypeOf((*MockRepository)(nil).ListBrandRefsBySlugs), ctx, slugs)
+}
+
+// ListCategoryRefsBySlugs mocks base method.
+func (m *MockRepository) ListCategoryRefsBySlugs(ctx context.Context, slugs []string) ([]dbgen.ListCategoryRefsBySlugsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryRefsBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]dbgen.ListCategoryRefsBySlugsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryRefsBySlugs indicates an expected call of ListCategoryRefsBySlugs.
+func (mr *MockRepositoryMockRecorder) ListCategoryRefsBySlugs(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryRefsBySlugs", reflect.TypeOf((*MockRepository)(nil).ListCategoryRefsBySlugs), ctx, slugs)
+}
+
+// ListDuePriceSchedules mocks base method.
+func (m *MockRepository) ListDuePriceSchedules(ctx context.Context, now time.Time, limit int32) ([]dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDuePriceSchedules", ctx, now, limit)
+	ret0, _ := ret[0].([]dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDuePriceSchedules indicates an expected call of ListDuePriceSchedules.
+func (mr *MockRepositoryMockRecorder) ListDuePriceSchedules(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDuePriceSchedules", reflect.TypeOf((*MockRepository)(nil).ListDuePriceSchedules), ctx, now, limit)
+}
+
+// ListEndingPriceSchedules mocks base method.
+func (m *MockRepository) ListEndingPriceSchedules(ctx context.Context, now time.Time, limit int32) ([]dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndingPriceSchedules", ctx, now, limit)
+	ret0, _ := ret[0].([]dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndingPriceSchedules indicates an expected call of ListEndingPriceSchedules.
+func (mr *MockRepositoryMockRecorder) ListEndingPriceSchedules(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndingPriceSchedules", reflect.TypeOf((*MockRepository)(nil).ListEndingPriceSchedules), ctx, now, limit)
+}
+
+// ListExistingSKUs mocks base method.
+func (m *MockRepository) ListExistingSKUs(ctx context.Context, skus []string) ([]dbgen.ListExistingProductSKUsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExistingSKUs", ctx, skus)
+	ret0, _ := ret[0].([]dbgen.ListExistingProductSKUsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExistingSKUs indicates an expected call of ListExistingSKUs.
+func (mr *MockRepositoryMockRecorder) ListExistingSKUs(ctx, skus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExistingSKUs", reflect.TypeOf((*MockRepository)(nil).ListExistingSKUs), ctx, skus)
+}
+
+// ListForExport mocks base method.
+func (m *MockRepository) ListForExport(ctx context.Context) ([]dbgen.ListProductsForExportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForExport", ctx)
+	ret0, _ := ret[0].([]dbgen.ListProductsForExportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForExport indicates an expected call of ListForExport.
+func (mr *MockRepositoryMockRecorder) ListForExport(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForExport", reflect.TypeOf((*MockRepository)(nil).ListForExport), ctx)
+}
+
+// ListImages mocks base method.
+func (m *MockRepository) ListImages(ctx context.Context, productID uuid.UUID) ([]dbgen.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx, productID)
+	ret0, _ := ret[0].([]dbgen.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockRepositoryMockRecorder) ListImages(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockRepository)(nil).ListImages), ctx, productID)
+}
+
+// ListOptions mocks base method.
+func (m *MockRepository) ListOptions(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductOptionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOptions", ctx, productID)
+	ret0, _ := ret[0].([]dbgen.ListProductOptionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOptions indicates an expected call of ListOptions.
+func (mr *MockRepositoryMockRecorder) ListOptions(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOptions", reflect.TypeOf((*MockRepository)(nil).ListOptions), ctx, productID)
+}
+
+// ListPriceHistory mocks base method.
+func (m *MockRepository) ListPriceHistory(ctx context.Context, arg dbgen.ListPriceHistoryParams) ([]dbgen.ListPriceHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceHistory", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListPriceHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceHistory indicates an expected call of ListPriceHistory.
+func (mr *MockRepositoryMockRecorder) ListPriceHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceHistory", reflect.TypeOf((*MockRepository)(nil).ListPriceHistory), ctx, arg)
+}
+
+// ListPriceSchedules mocks base method.
+func (m *MockRepository) ListPriceSchedules(ctx context.Context, arg dbgen.ListPriceSchedulesParams) ([]dbgen.ListPriceSchedulesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceSchedules", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListPriceSchedulesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceSchedules indicates an expected call of ListPriceSchedules.
+func (mr *MockRepositoryMockRecorder) ListPriceSchedules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceSchedules", reflect.TypeOf((*MockRepository)(nil).ListPriceSchedules), ctx, arg)
+}
+
+// ListPublic mocks base method.
+func (m *MockRepository) ListPublic(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublic", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListProductsPublicRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublic indicates an expected call of ListPublic.
+func (mr *MockRepositoryMockRecorder) ListPublic(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockRepository)(nil).ListPublic), ctx, arg)
+}
+
+// ListPublicByCreated mocks base method.
+func (m *MockRepository) ListPublicByCreated(ctx context.Context, arg dbgen.ListProductsPublicByCreatedDescParams, desc bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicByCreated", ctx, arg, desc)
+	ret0, _ := ret[0].([]dbgen.ListProductsPublicByCreatedDescRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicByCreated indicates an expected call of ListPublicByCreated.
+func (mr *MockRepositoryMockRecorder) ListPublicByCreated(ctx, arg, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicByCreated", reflect.TypeOf((*MockRepository)(nil).ListPublicByCreated), ctx, arg, desc)
+}
+
+// ListPublicByPopularity mocks base method.
+func (m *MockRepository) ListPublicByPopularity(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicByPopularity", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListProductsPublicRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicByPopularity indicates an expected call of ListPublicByPopularity.
+func (mr *MockRepositoryMockRecorder) ListPublicByPopularity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicByPopularity", reflect.TypeOf((*MockRepository)(nil).ListPublicByPopularity), ctx, arg)
+}
+
+// ListPublicByPrice mocks base method.
+func (m *MockRepository) ListPublicByPrice(ctx context.Context, arg dbgen.ListProductsPublicByPriceDescParams, desc bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicByPrice", ctx, arg, desc)
+	ret0, _ := ret[0].([]dbgen.ListProductsPublicByCreatedDescRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicByPrice indicates an expected call of ListPublicByPrice.
+func (mr *MockRepositoryMockRecorder) ListPublicByPrice(ctx, arg, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicByPrice", reflect.TypeOf((*MockRepository)(nil).ListPublicByPrice), ctx, arg, desc)
+}
+
+// ListRelated mocks base method.
+func (m *MockRepository) ListRelated(ctx context.Context, arg dbgen.ListRelatedProductsParams) ([]dbgen.ListRelatedProductsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelated", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListRelatedProductsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelated indicates an expected call of ListRelated.
+func (mr *MockRepositoryMockRecorder) ListRelated(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelated", reflect.TypeOf((*MockRepository)(nil).ListRelated), ctx, arg)
+}
+
+// ListStockMovements mocks base method.
+func (m *MockRepository) ListStockMovements(ctx context.Context, arg dbgen.ListStockMovementsParams) ([]dbgen.ListStockMovementsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStockMovements", ctx, arg)
+	ret0, _ := ret[0].([]dbgen.ListStockMovementsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStockMovements indicates an expected call of ListStockMovements.
+func (mr *MockRepositoryMockRecorder) ListStockMovements(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStockMovements", reflect.TypeOf((*MockRepository)(nil).ListStockMovements), ctx, arg)
+}
+
+// ListSummariesByIDs mocks base method.
+func (m *MockRepository) ListSummariesByIDs(ctx context.Context, ids []uuid.UUID) ([]dbgen.ListProductSummariesByIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSummariesByIDs", ctx, ids)
+	ret0, _ := ret[0].([]dbgen.ListProductSummariesByIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSummariesByIDs indicates an expected call of ListSummariesByIDs.
+func (mr *MockRepositoryMockRecorder) ListSummariesByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSummariesByIDs", reflect.TypeOf((*MockRepository)(nil).ListSummariesByIDs), ctx, ids)
+}
+
+// ListVariants mocks base method.
+func (m *MockRepository) ListVariants(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductVariantsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVariants", ctx, productID)
+	ret0, _ := ret[0].([]dbgen.ListProductVariantsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVariants indicates an expected call of ListVariants.
+func (mr *MockRepositoryMockRecorder) ListVariants(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariants", reflect.TypeOf((*MockRepository)(nil).ListVariants), ctx, productID)
+}
+
+// NextImageSortOrder mocks base method.
+func (m *MockRepository) NextImageSortOrder(ctx context.Context, productID uuid.UUID) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextImageSortOrder", ctx, productID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextImageSortOrder indicates an expected call of NextImageSortOrder.
+func (mr *MockRepositoryMockRecorder) NextImageSortOrder(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextImageSortOrder", reflect.TypeOf((*MockRepository)(nil).NextImageSortOrder), ctx, productID)
+}
+
+// PublishDue mocks base method.
+func (m *MockRepository) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDue", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDue indicates an expected call of PublishDue.
+func (mr *MockRepositoryMockRecorder) PublishDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockRepository)(nil).PublishDue), ctx, now)
+}
+
+// RecordPriceChange mocks base method.
+func (m *MockRepository) RecordPriceChange(ctx context.Context, arg dbgen.RecordPriceChangeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPriceChange", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPriceChange indicates an expected call of RecordPriceChange.
+func (mr *MockRepositoryMockRecorder) RecordPriceChange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPriceChange", reflect.TypeOf((*MockRepository)(nil).RecordPriceChange), ctx, arg)
+}
+
+// RecordSlugChange mocks base method.
+func (m *MockRepository) RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSlugChange", ctx, id, oldSlug, newSlug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSlugChange indicates an expected call of RecordSlugChange.
+func (mr *MockRepositoryMockRecorder) RecordSlugChange(ctx, id, oldSlug, newSlug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSlugChange", reflect.TypeOf((*MockRepository)(nil).RecordSlugChange), ctx, id, oldSlug, newSlug)
+}
+
+// RefreshStats mocks base method.
+func (m *MockRepository) RefreshStats(ctx context.Context, arg dbgen.RefreshProductStatsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshStats", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshStats indicates an expected call of RefreshStats.
+func (mr *MockRepositoryMockRecorder) RefreshStats(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshStats", reflect.TypeOf((*MockRepository)(nil).RefreshStats), ctx, arg)
+}
+
+// Restore mocks base method.
+func (m *MockRepository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(dbgen.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
+}
+
+// RevertScheduledPrice mocks base method.
+func (m *MockRepository) RevertScheduledPrice(ctx context.Context, scheduleID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertScheduledPrice", ctx, scheduleID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertScheduledPrice indicates an expected call of RevertScheduledPrice.
+func (mr *MockRepositoryMockRecorder) RevertScheduledPrice(ctx, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertScheduledPrice", reflect.TypeOf((*MockRepository)(nil).RevertScheduledPrice), ctx, scheduleID)
+}
+
+// SetImageURL mocks base method.
+func (m *MockRepository) SetImageURL(ctx context.Context, productID uuid.UUID, imageURL sql.NullString) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImageURL", ctx, productID, imageURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImageURL indicates an expected call of SetImageURL.
+func (mr *MockRepositoryMockRecorder) SetImageURL(ctx, productID, imageURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImageURL", reflect.TypeOf((*MockRepository)(nil).SetImageURL), ctx, productID, imageURL)
+}
+
+// SetPrice mocks base method.
+func (m *MockRepository) SetPrice(ctx context.Context, arg dbgen.SetProductPriceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrice", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrice indicates an expected call of SetPrice.
+func (mr *MockRepositoryMockRecorder) SetPrice(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrice", reflect.TypeOf((*MockRepository)(nil).SetPrice), ctx, arg)
+}
+
+// SetPrimaryImage mocks base method.
+func (m *MockRepository) SetPrimaryImage(ctx context.Context, productID, imageID uuid.UUID) (dbgen.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryImage", ctx, productID, imageID)
+	ret0, _ := ret[0].(dbgen.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrimaryImage indicates an expected call of SetPrimaryImage.
+func (mr *MockRepositoryMockRecorder) SetPrimaryImage(ctx, productID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryImage", reflect.TypeOf((*MockRepository)(nil).SetPrimaryImage), ctx, productID, imageID)
+}
+
+// SetPublication mocks base method.
+func (m *MockRepository) SetPublication(ctx context.Context, arg dbgen.SetProductPublicationParams) (dbgen.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPublication", ctx, arg)
+	ret0, _ := ret[0].(dbgen.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPublication indicates an expected call of SetPublication.
+func (mr *MockRepositoryMockRecorder) SetPublication(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublication", reflect.TypeOf((*MockRepository)(nil).SetPublication), ctx, arg)
+}
+
+// StartImportJob mocks base method.
+func (m *MockRepository) StartImportJob(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartImportJob", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartImportJob indicates an expected call of StartImportJob.
+func (mr *MockRepositoryMockRecorder) StartImportJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImportJob", reflect.TypeOf((*MockRepository)(nil).StartImportJob), ctx, id)
+}
+
+// StartPriceSchedule mocks base method.
+func (m *MockRepository) StartPriceSchedule(ctx context.Context, id uuid.UUID) (dbgen.ProductPriceSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPriceSchedule", ctx, id)
+	ret0, _ := ret[0].(dbgen.ProductPriceSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPriceSchedule indicates an expected call of StartPriceSchedule.
+func (mr *MockRepositoryMockRecorder) StartPriceSchedule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPriceSchedule", reflect.TypeOf((*MockRepository)(nil).StartPriceSchedule), ctx, id)
+}
+
+// Suggest mocks base method.
+func (m *MockRepository) Suggest(ctx context.Context, query string, limitPerKind int32) ([]dbgen.SuggestSearchTermsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, query, limitPerKind)
+	ret0, _ := ret[0].([]dbgen.SuggestSearchTermsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockRepositoryMockRecorder) Suggest(ctx, query, limitPerKind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockRepository)(nil).Suggest), ctx, query, limitPerKind)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", ctx, arg)
 	ret0, _ := ret[0].(dbgen.Product)
@@ -162,6 +1083,153 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, arg)
 }
 
+// UpdateImageAltText mocks base method.
+func (m *MockRepository) UpdateImageAltText(ctx context.Context, productID, imageID uuid.UUID, altText sql.NullString) (dbgen.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageAltText", ctx, productID, imageID, altText)
+	ret0, _ := ret[0].(dbgen.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImageAltText indicates an expected call of UpdateImageAltText.
+func (mr *MockRepositoryMockRecorder) UpdateImageAltText(ctx, productID, imageID, altText any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageAltText", reflect.TypeOf((*MockRepository)(nil).UpdateImageAltText), ctx, productID, imageID, altText)
+}
+
+// UpdateImageSortOrder mocks base method.
+func (m *MockRepository) UpdateImageSortOrder(ctx context.Context, productID, imageID uuid.UUID, sortOrder int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageSortOrder", ctx, productID, imageID, sortOrder)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImageSortOrder indicates an expected call of UpdateImageSortOrder.
+func (mr *MockRepositoryMockRecorder) UpdateImageSortOrder(ctx, productID, imageID, sortOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageSortOrder", reflect.TypeOf((*MockRepository)(nil).UpdateImageSortOrder), ctx, productID, imageID, sortOrder)
+}
+
+// UpdateImportJobProgress mocks base method.
+func (m *MockRepository) UpdateImportJobProgress(ctx context.Context, arg dbgen.UpdateProductImportJobProgressParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImportJobProgress", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImportJobProgress indicates an expected call of UpdateImportJobProgress.
+func (mr *MockRepositoryMockRecorder) UpdateImportJobProgress(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImportJobProgress", reflect.TypeOf((*MockRepository)(nil).UpdateImportJobProgress), ctx, arg)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockRepository) UpdateVariant(ctx context.Context, arg dbgen.UpdateProductVariantParams) (dbgen.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, arg)
+	ret0, _ := ret[0].(dbgen.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockRepositoryMockRecorder) UpdateVariant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockRepository)(nil).UpdateVariant), ctx, arg)
+}
+
+// UpsertAttributeValue mocks base method.
+func (m *MockRepository) UpsertAttributeValue(ctx context.Context, arg dbgen.UpsertProductAttributeValueParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAttributeValue", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAttributeValue indicates an expected call of UpsertAttributeValue.
+func (mr *MockRepositoryMockRecorder) UpsertAttributeValue(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAttributeValue", reflect.TypeOf((*MockRepository)(nil).UpsertAttributeValue), ctx, arg)
+}
+
+// UpsertBySKU mocks base method.
+func (m *MockRepository) UpsertBySKU(ctx context.Context, arg dbgen.UpsertProductBySKUParams) (dbgen.UpsertProductBySKURow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBySKU", ctx, arg)
+	ret0, _ := ret[0].(dbgen.UpsertProductBySKURow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBySKU indicates an expected call of UpsertBySKU.
+func (mr *MockRepositoryMockRecorder) UpsertBySKU(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBySKU", reflect.TypeOf((*MockRepository)(nil).UpsertBySKU), ctx, arg)
+}
+
+// UpsertOption mocks base method.
+func (m *MockRepository) UpsertOption(ctx context.Context, productID uuid.UUID, name string) (dbgen.ProductOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOption", ctx, productID, name)
+	ret0, _ := ret[0].(dbgen.ProductOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertOption indicates an expected call of UpsertOption.
+func (mr *MockRepositoryMockRecorder) UpsertOption(ctx, productID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOption", reflect.TypeOf((*MockRepository)(nil).UpsertOption), ctx, productID, name)
+}
+
+// UpsertOptionValue mocks base method.
+func (m *MockRepository) UpsertOptionValue(ctx context.Context, optionID uuid.UUID, value string) (dbgen.ProductOptionValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOptionValue", ctx, optionID, value)
+	ret0, _ := ret[0].(dbgen.ProductOptionValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertOptionValue indicates an expected call of UpsertOptionValue.
+func (mr *MockRepositoryMockRecorder) UpsertOptionValue(ctx, optionID, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOptionValue", reflect.TypeOf((*MockRepository)(nil).UpsertOptionValue), ctx, optionID, value)
+}
+
+// UpsertPrimaryImage mocks base method.
+func (m *MockRepository) UpsertPrimaryImage(ctx context.Context, productID uuid.UUID, imageURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPrimaryImage", ctx, productID, imageURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertPrimaryImage indicates an expected call of UpsertPrimaryImage.
+func (mr *MockRepositoryMockRecorder) UpsertPrimaryImage(ctx, productID, imageURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPrimaryImage", reflect.TypeOf((*MockRepository)(nil).UpsertPrimaryImage), ctx, productID, imageURL)
+}
+
+// UpsertStockNotification mocks base method.
+func (m *MockRepository) UpsertStockNotification(ctx context.Context, arg dbgen.UpsertStockNotificationParams) (dbgen.StockNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertStockNotification", ctx, arg)
+	ret0, _ := ret[0].(dbgen.StockNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertStockNotification indicates an expected call of UpsertStockNotification.
+func (mr *MockRepositoryMockRecorder) UpsertStockNotification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertStockNotification", reflect.TypeOf((*MockRepository)(nil).UpsertStockNotification), ctx, arg)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx dbgen.DBTX) product.Repository {
 	m.ctrl.T.Helper()
@@ -11,10 +11,13 @@ package mock
 
 import (
 	context "context"
+	cursor "go-gadget-api/internal/pkg/cursor"
 	product "go-gadget-api/internal/product"
 	dbgen "go-gadget-api/internal/shared/database/dbgen"
+	io "io"
 	multipart "mime/multipart"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -142,6 +145,102 @@ func (mr *MockCloudinaryServiceMockRecorder) UploadImage(ctx, file, filename, fo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockCloudinaryService)(nil).UploadImage), ctx, file, filename, folderName)
 }
 
+// MockViewHistory is a mock of ViewHistory interface.
+type MockViewHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockViewHistoryMockRecorder is the mock recorder for MockViewHistory.
+type MockViewHistoryMockRecorder struct {
+	mock *MockViewHistory
+}
+
+// NewMockViewHistory creates a new mock instance.
+func NewMockViewHistory(ctrl *gomock.Controller) *MockViewHistory {
+	mock := &MockViewHistory{ctrl: ctrl}
+	mock.recorder = &MockViewHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewHistory) EXPECT() *MockViewHistoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockViewHistory) Add(ctx context.Context, userID string, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockViewHistoryMockRecorder) Add(ctx, userID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockViewHistory)(nil).Add), ctx, userID, productID)
+}
+
+// Clear mocks base method.
+func (m *MockViewHistory) Clear(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockViewHistoryMockRecorder) Clear(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockViewHistory)(nil).Clear), ctx, userID)
+}
+
+// CountView mocks base method.
+func (m *MockViewHistory) CountView(ctx context.Context, productID uuid.UUID, viewer string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountView", ctx, productID, viewer, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CountView indicates an expected call of CountView.
+func (mr *MockViewHistoryMockRecorder) CountView(ctx, productID, viewer, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountView", reflect.TypeOf((*MockViewHistory)(nil).CountView), ctx, productID, viewer, at)
+}
+
+// List mocks base method.
+func (m *MockViewHistory) List(ctx context.Context, userID string) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockViewHistoryMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockViewHistory)(nil).List), ctx, userID)
+}
+
+// ViewCounts mocks base method.
+func (m *MockViewHistory) ViewCounts(ctx context.Context, since, until time.Time) (map[uuid.UUID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewCounts", ctx, since, until)
+	ret0, _ := ret[0].(map[uuid.UUID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewCounts indicates an expected call of ViewCounts.
+func (mr *MockViewHistoryMockRecorder) ViewCounts(ctx, since, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewCounts", reflect.TypeOf((*MockViewHistory)(nil).ViewCounts), ctx, since, until)
+}
+
 // MockService is a mock of Service interface.
 type MockService struct {
 	ctrl     *gomock.Controller
@@ -166,6 +265,50 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AdjustStock mocks base method.
+func (m *MockService) AdjustStock(ctx context.Context, productID string, req product.StockAdjustmentRequest) (product.StockMovementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStock", ctx, productID, req)
+	ret0, _ := ret[0].(product.StockMovementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStock indicates an expected call of AdjustStock.
+func (mr *MockServiceMockRecorder) AdjustStock(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStock", reflect.TypeOf((*MockService)(nil).AdjustStock), ctx, productID, req)
+}
+
+// CancelPriceSchedule mocks base method.
+func (m *MockService) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) (product.PriceScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPriceSchedule", ctx, productID, scheduleID)
+	ret0, _ := ret[0].(product.PriceScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPriceSchedule indicates an expected call of CancelPriceSchedule.
+func (mr *MockServiceMockRecorder) CancelPriceSchedule(ctx, productID, scheduleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPriceSchedule", reflect.TypeOf((*MockService)(nil).CancelPriceSchedule), ctx, productID, scheduleID)
+}
+
+// ClearRecentlyViewed mocks base method.
+func (m *MockService) ClearRecentlyViewed(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRecentlyViewed", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearRecentlyViewed indicates an expected call of ClearRecentlyViewed.
+func (mr *MockServiceMockRecorder) ClearRecentlyViewed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecentlyViewed", reflect.TypeOf((*MockService)(nil).ClearRecentlyViewed), ctx, userID)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req product.CreateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +324,51 @@ func (mr *MockServiceMockRecorder) Create(ctx, req, file, filename any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req, file, filename)
 }
 
+// CreatePreviewToken mocks base method.
+func (m *MockService) CreatePreviewToken(ctx context.Context, id string, req product.PreviewTokenRequest) (product.PreviewTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePreviewToken", ctx, id, req)
+	ret0, _ := ret[0].(product.PreviewTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePreviewToken indicates an expected call of CreatePreviewToken.
+func (mr *MockServiceMockRecorder) CreatePreviewToken(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePreviewToken", reflect.TypeOf((*MockService)(nil).CreatePreviewToken), ctx, id, req)
+}
+
+// CreatePriceSchedule mocks base method.
+func (m *MockService) CreatePriceSchedule(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceSchedule", ctx, productID, req)
+	ret0, _ := ret[0].(product.PriceScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceSchedule indicates an expected call of CreatePriceSchedule.
+func (mr *MockServiceMockRecorder) CreatePriceSchedule(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceSchedule", reflect.TypeOf((*MockService)(nil).CreatePriceSchedule), ctx, productID, req)
+}
+
+// CreateVariant mocks base method.
+func (m *MockService) CreateVariant(ctx context.Context, productID string, req product.CreateVariantRequest) (product.ProductVariantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVariant", ctx, productID, req)
+	ret0, _ := ret[0].(product.ProductVariantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockServiceMockRecorder) CreateVariant(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockService)(nil).CreateVariant), ctx, productID, req)
+}
+
 // Delete mocks base method.
 func (m *MockService) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -195,6 +383,78 @@ func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
 }
 
+// DeleteImage mocks base method.
+func (m *MockService) DeleteImage(ctx context.Context, productID, imageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, productID, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockServiceMockRecorder) DeleteImage(ctx, productID, imageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockService)(nil).DeleteImage), ctx, productID, imageID)
+}
+
+// DeleteVariant mocks base method.
+func (m *MockService) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", ctx, productID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockServiceMockRecorder) DeleteVariant(ctx, productID, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockService)(nil).DeleteVariant), ctx, productID, variantID)
+}
+
+// Export mocks base method.
+func (m *MockService) Export(ctx context.Context, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockServiceMockRecorder) Export(ctx, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockService)(nil).Export), ctx, w)
+}
+
+// FailStaleImports mocks base method.
+func (m *MockService) FailStaleImports(ctx context.Context, olderThan time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailStaleImports", ctx, olderThan)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailStaleImports indicates an expected call of FailStaleImports.
+func (mr *MockServiceMockRecorder) FailStaleImports(ctx, olderThan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailStaleImports", reflect.TypeOf((*MockService)(nil).FailStaleImports), ctx, olderThan)
+}
+
+// GetAttributes mocks base method.
+func (m *MockService) GetAttributes(ctx context.Context, productID string) ([]product.ProductAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributes", ctx, productID)
+	ret0, _ := ret[0].([]product.ProductAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributes indicates an expected call of GetAttributes.
+func (mr *MockServiceMockRecorder) GetAttributes(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributes", reflect.TypeOf((*MockService)(nil).GetAttributes), ctx, productID)
+}
+
 // GetByID mocks base method.
 func (m *MockService) GetByID(ctx context.Context, id string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -225,6 +485,51 @@ func (mr *MockServiceMockRecorder) GetBySlug(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockService)(nil).GetBySlug), ctx, slug)
 }
 
+// GetFacets mocks base method.
+func (m *MockService) GetFacets(ctx context.Context, req product.ListPublicRequest) (product.ProductFacetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacets", ctx, req)
+	ret0, _ := ret[0].(product.ProductFacetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockServiceMockRecorder) GetFacets(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockService)(nil).GetFacets), ctx, req)
+}
+
+// GetImportJob mocks base method.
+func (m *MockService) GetImportJob(ctx context.Context, id string) (product.ImportJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportJob", ctx, id)
+	ret0, _ := ret[0].(product.ImportJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportJob indicates an expected call of GetImportJob.
+func (mr *MockServiceMockRecorder) GetImportJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportJob", reflect.TypeOf((*MockService)(nil).GetImportJob), ctx, id)
+}
+
+// GetPreview mocks base method.
+func (m *MockService) GetPreview(ctx context.Context, slug, token string) (product.ProductDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", ctx, slug, token)
+	ret0, _ := ret[0].(product.ProductDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockServiceMockRecorder) GetPreview(ctx, slug, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockService)(nil).GetPreview), ctx, slug, token)
+}
+
 // ListAdmin mocks base method.
 func (m *MockService) ListAdmin(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +546,68 @@ func (mr *MockServiceMockRecorder) ListAdmin(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, req)
 }
 
+// ListBoughtTogether mocks base method.
+func (m *MockService) ListBoughtTogether(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBoughtTogether", ctx, slug, limit)
+	ret0, _ := ret[0].([]product.ProductPublicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBoughtTogether indicates an expected call of ListBoughtTogether.
+func (mr *MockServiceMockRecorder) ListBoughtTogether(ctx, slug, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBoughtTogether", reflect.TypeOf((*MockService)(nil).ListBoughtTogether), ctx, slug, limit)
+}
+
+// ListImages mocks base method.
+func (m *MockService) ListImages(ctx context.Context, productID string) ([]product.ProductImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx, productID)
+	ret0, _ := ret[0].([]product.ProductImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockServiceMockRecorder) ListImages(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockService)(nil).ListImages), ctx, productID)
+}
+
+// ListPriceHistory mocks base method.
+func (m *MockService) ListPriceHistory(ctx context.Context, productID string, page, limit int) ([]product.PriceHistoryResponse, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceHistory", ctx, productID, page, limit)
+	ret0, _ := ret[0].([]product.PriceHistoryResponse)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPriceHistory indicates an expected call of ListPriceHistory.
+func (mr *MockServiceMockRecorder) ListPriceHistory(ctx, productID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceHistory", reflect.TypeOf((*MockService)(nil).ListPriceHistory), ctx, productID, page, limit)
+}
+
+// ListPriceSchedules mocks base method.
+func (m *MockService) ListPriceSchedules(ctx context.Context, productID string, req product.ListPriceSchedulesRequest) ([]product.PriceScheduleResponse, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceSchedules", ctx, productID, req)
+	ret0, _ := ret[0].([]product.PriceScheduleResponse)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPriceSchedules indicates an expected call of ListPriceSchedules.
+func (mr *MockServiceMockRecorder) ListPriceSchedules(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceSchedules", reflect.TypeOf((*MockService)(nil).ListPriceSchedules), ctx, productID, req)
+}
+
 // ListPublic mocks base method.
 func (m *MockService) ListPublic(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
 	m.ctrl.T.Helper()
@@ -257,6 +624,142 @@ func (mr *MockServiceMockRecorder) ListPublic(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublic", reflect.TypeOf((*MockService)(nil).ListPublic), ctx, req)
 }
 
+// ListPublicCursor mocks base method.
+func (m *MockService) ListPublicCursor(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicCursor", ctx, req)
+	ret0, _ := ret[0].([]product.ProductPublicResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPublicCursor indicates an expected call of ListPublicCursor.
+func (mr *MockServiceMockRecorder) ListPublicCursor(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicCursor", reflect.TypeOf((*MockService)(nil).ListPublicCursor), ctx, req)
+}
+
+// ListRecentlyViewed mocks base method.
+func (m *MockService) ListRecentlyViewed(ctx context.Context, userID string) ([]product.ProductPublicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentlyViewed", ctx, userID)
+	ret0, _ := ret[0].([]product.ProductPublicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentlyViewed indicates an expected call of ListRecentlyViewed.
+func (mr *MockServiceMockRecorder) ListRecentlyViewed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentlyViewed", reflect.TypeOf((*MockService)(nil).ListRecentlyViewed), ctx, userID)
+}
+
+// ListRelated mocks base method.
+func (m *MockService) ListRelated(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelated", ctx, slug, limit)
+	ret0, _ := ret[0].([]product.ProductPublicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelated indicates an expected call of ListRelated.
+func (mr *MockServiceMockRecorder) ListRelated(ctx, slug, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelated", reflect.TypeOf((*MockService)(nil).ListRelated), ctx, slug, limit)
+}
+
+// ListStockMovements mocks base method.
+func (m *MockService) ListStockMovements(ctx context.Context, productID string, req product.ListStockMovementsRequest) ([]product.StockMovementResponse, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStockMovements", ctx, productID, req)
+	ret0, _ := ret[0].([]product.StockMovementResponse)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListStockMovements indicates an expected call of ListStockMovements.
+func (mr *MockServiceMockRecorder) ListStockMovements(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStockMovements", reflect.TypeOf((*MockService)(nil).ListStockMovements), ctx, productID, req)
+}
+
+// ListVariants mocks base method.
+func (m *MockService) ListVariants(ctx context.Context, productID string) ([]product.ProductVariantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVariants", ctx, productID)
+	ret0, _ := ret[0].([]product.ProductVariantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVariants indicates an expected call of ListVariants.
+func (mr *MockServiceMockRecorder) ListVariants(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariants", reflect.TypeOf((*MockService)(nil).ListVariants), ctx, productID)
+}
+
+// PublishDueProducts mocks base method.
+func (m *MockService) PublishDueProducts(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDueProducts", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDueProducts indicates an expected call of PublishDueProducts.
+func (mr *MockServiceMockRecorder) PublishDueProducts(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDueProducts", reflect.TypeOf((*MockService)(nil).PublishDueProducts), ctx, now)
+}
+
+// RecordView mocks base method.
+func (m *MockService) RecordView(ctx context.Context, userID, clientIP, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordView", ctx, userID, clientIP, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordView indicates an expected call of RecordView.
+func (mr *MockServiceMockRecorder) RecordView(ctx, userID, clientIP, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordView", reflect.TypeOf((*MockService)(nil).RecordView), ctx, userID, clientIP, productID)
+}
+
+// RefreshPopularity mocks base method.
+func (m *MockService) RefreshPopularity(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshPopularity", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshPopularity indicates an expected call of RefreshPopularity.
+func (mr *MockServiceMockRecorder) RefreshPopularity(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshPopularity", reflect.TypeOf((*MockService)(nil).RefreshPopularity), ctx, now)
+}
+
+// ReorderImages mocks base method.
+func (m *MockService) ReorderImages(ctx context.Context, productID string, req product.ReorderImagesRequest) ([]product.ProductImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderImages", ctx, productID, req)
+	ret0, _ := ret[0].([]product.ProductImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderImages indicates an expected call of ReorderImages.
+func (mr *MockServiceMockRecorder) ReorderImages(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderImages", reflect.TypeOf((*MockService)(nil).ReorderImages), ctx, productID, req)
+}
+
 // Restore mocks base method.
 func (m *MockService) Restore(ctx context.Context, id string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -272,6 +775,110 @@ func (mr *MockServiceMockRecorder) Restore(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockService)(nil).Restore), ctx, id)
 }
 
+// RunPriceSchedules mocks base method.
+func (m *MockService) RunPriceSchedules(ctx context.Context, now time.Time) (product.PriceScheduleRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunPriceSchedules", ctx, now)
+	ret0, _ := ret[0].(product.PriceScheduleRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunPriceSchedules indicates an expected call of RunPriceSchedules.
+func (mr *MockServiceMockRecorder) RunPriceSchedules(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPriceSchedules", reflect.TypeOf((*MockService)(nil).RunPriceSchedules), ctx, now)
+}
+
+// SetAttributes mocks base method.
+func (m *MockService) SetAttributes(ctx context.Context, productID string, req product.SetAttributesRequest) ([]product.ProductAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAttributes", ctx, productID, req)
+	ret0, _ := ret[0].([]product.ProductAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAttributes indicates an expected call of SetAttributes.
+func (mr *MockServiceMockRecorder) SetAttributes(ctx, productID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttributes", reflect.TypeOf((*MockService)(nil).SetAttributes), ctx, productID, req)
+}
+
+// SetPublication mocks base method.
+func (m *MockService) SetPublication(ctx context.Context, id string, req product.SetPublicationRequest) (product.ProductAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPublication", ctx, id, req)
+	ret0, _ := ret[0].(product.ProductAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPublication indicates an expected call of SetPublication.
+func (mr *MockServiceMockRecorder) SetPublication(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublication", reflect.TypeOf((*MockService)(nil).SetPublication), ctx, id, req)
+}
+
+// StartImport mocks base method.
+func (m *MockService) StartImport(ctx context.Context, req product.ImportProductsRequest, file io.Reader) (product.ImportJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartImport", ctx, req, file)
+	ret0, _ := ret[0].(product.ImportJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImport indicates an expected call of StartImport.
+func (mr *MockServiceMockRecorder) StartImport(ctx, req, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImport", reflect.TypeOf((*MockService)(nil).StartImport), ctx, req, file)
+}
+
+// SubscribeStockNotification mocks base method.
+func (m *MockService) SubscribeStockNotification(ctx context.Context, userID, slug string, req product.StockNotificationRequest) (product.StockNotificationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeStockNotification", ctx, userID, slug, req)
+	ret0, _ := ret[0].(product.StockNotificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeStockNotification indicates an expected call of SubscribeStockNotification.
+func (mr *MockServiceMockRecorder) SubscribeStockNotification(ctx, userID, slug, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeStockNotification", reflect.TypeOf((*MockService)(nil).SubscribeStockNotification), ctx, userID, slug, req)
+}
+
+// Suggest mocks base method.
+func (m *MockService) Suggest(ctx context.Context, query string) (product.SuggestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, query)
+	ret0, _ := ret[0].(product.SuggestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockServiceMockRecorder) Suggest(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockService)(nil).Suggest), ctx, query)
+}
+
+// UnsubscribeStockNotification mocks base method.
+func (m *MockService) UnsubscribeStockNotification(ctx context.Context, userID, slug, variantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeStockNotification", ctx, userID, slug, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeStockNotification indicates an expected call of UnsubscribeStockNotification.
+func (mr *MockServiceMockRecorder) UnsubscribeStockNotification(ctx, userID, slug, variantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeStockNotification", reflect.TypeOf((*MockService)(nil).UnsubscribeStockNotification), ctx, userID, slug, variantID)
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, idStr string, req product.UpdateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error) {
 	m.ctrl.T.Helper()
@@ -286,3 +893,48 @@ func (mr *MockServiceMockRecorder) Update(ctx, idStr, req, file, filename any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, idStr, req, file, filename)
 }
+
+// UpdateImage mocks base method.
+func (m *MockService) UpdateImage(ctx context.Context, productID, imageID string, req product.UpdateImageRequest) (product.ProductImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, productID, imageID, req)
+	ret0, _ := ret[0].(product.ProductImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockServiceMockRecorder) UpdateImage(ctx, productID, imageID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockService)(nil).UpdateImage), ctx, productID, imageID, req)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockService) UpdateVariant(ctx context.Context, productID, variantID string, req product.UpdateVariantRequest) (product.ProductVariantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, productID, variantID, req)
+	ret0, _ := ret[0].(product.ProductVariantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockServiceMockRecorder) UpdateVariant(ctx, productID, variantID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockService)(nil).UpdateVariant), ctx, productID, variantID, req)
+}
+
+// UploadImages mocks base method.
+func (m *MockService) UploadImages(ctx context.Context, productID string, files []product.ImageUpload) ([]product.ProductImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImages", ctx, productID, files)
+	ret0, _ := ret[0].([]product.ProductImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImages indicates an expected call of UploadImages.
+func (mr *MockServiceMockRecorder) UploadImages(ctx, productID, files any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImages", reflect.TypeOf((*MockService)(nil).UploadImages), ctx, productID, files)
+}
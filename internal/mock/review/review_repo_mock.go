@@ -177,6 +177,21 @@ func (mr *MockRepositoryMockRecorder) GetByProductID(ctx, productID, limit, offs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductID", reflect.TypeOf((*MockRepository)(nil).GetByProductID), ctx, productID, limit, offset)
 }
 
+// GetByProductIDCursor mocks base method.
+func (m *MockRepository) GetByProductIDCursor(ctx context.Context, arg dbgen.GetReviewsByProductIDCreatedDescParams, desc bool) ([]dbgen.GetReviewsByProductIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProductIDCursor", ctx, arg, desc)
+	ret0, _ := ret[0].([]dbgen.GetReviewsByProductIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProductIDCursor indicates an expected call of GetByProductIDCursor.
+func (mr *MockRepositoryMockRecorder) GetByProductIDCursor(ctx, arg, desc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductIDCursor", reflect.TypeOf((*MockRepository)(nil).GetByProductIDCursor), ctx, arg, desc)
+}
+
 // GetByUserID mocks base method.
 func (m *MockRepository) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByUserIDRow, error) {
 	m.ctrl.T.Helper()
@@ -11,6 +11,7 @@ package mock
 
 import (
 	context "context"
+	cursor "go-gadget-api/internal/pkg/cursor"
 	review "go-gadget-api/internal/review"
 	reflect "reflect"
 
@@ -100,6 +101,22 @@ func (mr *MockServiceMockRecorder) GetByProductSlug(ctx, productSlug, page, limi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductSlug", reflect.TypeOf((*MockService)(nil).GetByProductSlug), ctx, productSlug, page, limit)
 }
 
+// GetByProductSlugCursor mocks base method.
+func (m *MockService) GetByProductSlugCursor(ctx context.Context, productSlug, cursorStr string, limit int) (review.ReviewListResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProductSlugCursor", ctx, productSlug, cursorStr, limit)
+	ret0, _ := ret[0].(review.ReviewListResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByProductSlugCursor indicates an expected call of GetByProductSlugCursor.
+func (mr *MockServiceMockRecorder) GetByProductSlugCursor(ctx, productSlug, cursorStr, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductSlugCursor", reflect.TypeOf((*MockService)(nil).GetByProductSlugCursor), ctx, productSlug, cursorStr, limit)
+}
+
 // GetByUserID mocks base method.
 func (m *MockService) GetByUserID(ctx context.Context, userID string, page, limit int) (review.UserReviewListResponse, error) {
 	m.ctrl.T.Helper()
@@ -44,46 +44,46 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // AddItem mocks base method.
-func (m *MockRepository) AddItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+func (m *MockRepository) AddItem(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddItem", ctx, wishlistID, productID)
+	ret := m.ctrl.Call(m, "AddItem", ctx, wishlistID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddItem indicates an expected call of AddItem.
-func (mr *MockRepositoryMockRecorder) AddItem(ctx, wishlistID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) AddItem(ctx, wishlistID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockRepository)(nil).AddItem), ctx, wishlistID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockRepository)(nil).AddItem), ctx, wishlistID, productID, variantID)
 }
 
 // CheckItemExists mocks base method.
-func (m *MockRepository) CheckItemExists(ctx context.Context, wishlistID, productID uuid.UUID) (bool, error) {
+func (m *MockRepository) CheckItemExists(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckItemExists", ctx, wishlistID, productID)
+	ret := m.ctrl.Call(m, "CheckItemExists", ctx, wishlistID, productID, variantID)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckItemExists indicates an expected call of CheckItemExists.
-func (mr *MockRepositoryMockRecorder) CheckItemExists(ctx, wishlistID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) CheckItemExists(ctx, wishlistID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckItemExists", reflect.TypeOf((*MockRepository)(nil).CheckItemExists), ctx, wishlistID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckItemExists", reflect.TypeOf((*MockRepository)(nil).CheckItemExists), ctx, wishlistID, productID, variantID)
 }
 
 // DeleteItem mocks base method.
-func (m *MockRepository) DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+func (m *MockRepository) DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteItem", ctx, wishlistID, productID)
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, wishlistID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteItem indicates an expected call of DeleteItem.
-func (mr *MockRepositoryMockRecorder) DeleteItem(ctx, wishlistID, productID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) DeleteItem(ctx, wishlistID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockRepository)(nil).DeleteItem), ctx, wishlistID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockRepository)(nil).DeleteItem), ctx, wishlistID, productID, variantID)
 }
 
 // GetItems mocks base method.
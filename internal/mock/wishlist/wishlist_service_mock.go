@@ -42,32 +42,32 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockService) Create(ctx context.Context, userID, productID string) (wishlist.AddItemResponse, error) {
+func (m *MockService) Create(ctx context.Context, userID, productID, variantID string) (wishlist.AddItemResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Create", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(wishlist.AddItemResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockServiceMockRecorder) Create(ctx, userID, productID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Create(ctx, userID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, userID, productID, variantID)
 }
 
 // Delete mocks base method.
-func (m *MockService) Delete(ctx context.Context, userID, productID string) error {
+func (m *MockService) Delete(ctx context.Context, userID, productID, variantID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceMockRecorder) Delete(ctx, userID, productID any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Delete(ctx, userID, productID, variantID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, userID, productID, variantID)
 }
 
 // List mocks base method.
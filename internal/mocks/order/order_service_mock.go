@@ -13,6 +13,7 @@ import (
 	context "context"
 	midtrans "go-gadget-api/internal/midtrans"
 	order "go-gadget-api/internal/order"
+	cursor "go-gadget-api/internal/pkg/cursor"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -147,9 +148,9 @@ func (mr *MockServiceMockRecorder) List(ctx, userID, status, page, limit any) *g
 }
 
 // ListAdmin mocks base method.
-func (m *MockService) ListAdmin(ctx context.Context, status, search string, page, limit int) ([]order.OrderResponse, int64, error) {
+func (m *MockService) ListAdmin(ctx context.Context, status, search, warehouseID string, page, limit int) ([]order.OrderResponse, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAdmin", ctx, status, search, page, limit)
+	ret := m.ctrl.Call(m, "ListAdmin", ctx, status, search, warehouseID, page, limit)
 	ret0, _ := ret[0].([]order.OrderResponse)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -157,9 +158,41 @@ func (m *MockService) ListAdmin(ctx context.Context, status, search string, page
 }
 
 // ListAdmin indicates an expected call of ListAdmin.
-func (mr *MockServiceMockRecorder) ListAdmin(ctx, status, search, page, limit any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ListAdmin(ctx, status, search, warehouseID, page, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, status, search, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmin", reflect.TypeOf((*MockService)(nil).ListAdmin), ctx, status, search, warehouseID, page, limit)
+}
+
+// ListAdminCursor mocks base method.
+func (m *MockService) ListAdminCursor(ctx context.Context, status, search, warehouseID, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminCursor", ctx, status, search, warehouseID, cursorStr, limit)
+	ret0, _ := ret[0].([]order.OrderResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAdminCursor indicates an expected call of ListAdminCursor.
+func (mr *MockServiceMockRecorder) ListAdminCursor(ctx, status, search, warehouseID, cursorStr, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminCursor", reflect.TypeOf((*MockService)(nil).ListAdminCursor), ctx, status, search, warehouseID, cursorStr, limit)
+}
+
+// ListCursor mocks base method.
+func (m *MockService) ListCursor(ctx context.Context, userID, status, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCursor", ctx, userID, status, cursorStr, limit)
+	ret0, _ := ret[0].([]order.OrderResponse)
+	ret1, _ := ret[1].(cursor.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCursor indicates an expected call of ListCursor.
+func (mr *MockServiceMockRecorder) ListCursor(ctx, userID, status, cursorStr, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCursor", reflect.TypeOf((*MockService)(nil).ListCursor), ctx, userID, status, cursorStr, limit)
 }
 
 // UpdatePaymentStatus mocks base method.
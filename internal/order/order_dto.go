@@ -78,6 +78,8 @@ type OrderResponse struct {
 	UserName        string              `json:"userName"`
 	Status          string              `json:"status"`
 	ReceiptNo       *string             `json:"receiptNo,omitempty"` // Tambahkan di sini
+	WarehouseID     string              `json:"warehouseId,omitempty"`
+	WarehouseName   string              `json:"warehouseName,omitempty"`
 	PaymentStatus   string              `json:"paymentStatus"`
 	SubtotalPrice   float64             `json:"subtotalPrice"`
 	ShippingPrice   float64             `json:"shippingPrice"`
@@ -93,6 +95,7 @@ type OrderResponse struct {
 type OrderItemResponse struct {
 	ID              string  `json:"id"`
 	ProductID       string  `json:"productId"`
+	VariantID       string  `json:"variantId,omitempty"`
 	ProductImageUrl string  `json:"productImageUrl"`
 	ProductSlug     string  `json:"productSlug"`
 	NameSnapshot    string  `json:"nameSnapshot"`
@@ -30,6 +30,12 @@ var (
 		http.StatusBadRequest,
 	)
 
+	ErrCartHasIssues = apperror.New(
+		apperror.CodeInvalidState,
+		"Some items in your cart are unavailable or out of stock, please review your cart",
+		http.StatusConflict,
+	)
+
 	ErrCannotCancel = apperror.New(
 		apperror.CodeInvalidState,
 		"Order cannot be cancelled",
@@ -101,4 +107,17 @@ var (
 		"invalid order number",
 		http.StatusBadRequest,
 	)
+
+	ErrInvalidWarehouseID = apperror.New(
+		apperror.CodeInvalidInput,
+		"invalid warehouse ID",
+		http.StatusBadRequest,
+	)
+
+	// Tidak ada satu gudang pun yang stoknya cukup untuk seluruh isi cart
+	ErrNoFulfilmentWarehouse = apperror.New(
+		apperror.CodeConflict,
+		"no warehouse can fulfil all items in this order",
+		http.StatusConflict,
+	)
 )
@@ -112,6 +112,24 @@ func (h *Handler) List(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
+	// Mode cursor (infinite scroll riwayat order), aktif jika parameter cursor dikirim
+	if cur, ok := c.GetQuery("cursor"); ok {
+		if limit < 1 || limit > 100 {
+			limit = 10
+		}
+		orders, p, err := h.service.ListCursor(c.Request.Context(), userID, status, cur, limit)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		response.Success(c, http.StatusOK, gin.H{
+			"items": orders,
+			"meta":  response.NewCursorMeta(limit, p.Next, p.Prev),
+		}, nil)
+		return
+	}
+
 	orders, total, err := h.service.List(c.Request.Context(), userID, status, page, limit)
 	if err != nil {
 		log.Printf("[Handler.List] Error: %v", err) // Log error service
@@ -185,6 +203,7 @@ func (h *Handler) Cancel(c *gin.Context) {
 func (h *Handler) ListAdmin(c *gin.Context) {
 	status := c.Query("status")
 	search := c.Query("search")
+	warehouseID := c.Query("warehouseId")
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
@@ -196,10 +215,23 @@ func (h *Handler) ListAdmin(c *gin.Context) {
 		limit = 20
 	}
 
+	if cur, ok := c.GetQuery("cursor"); ok {
+		data, p, err := h.service.ListAdminCursor(c.Request.Context(), status, search, warehouseID, cur, limit)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+		meta := response.NewCursorMeta(limit, p.Next, p.Prev)
+		response.Success(c, http.StatusOK, data, &meta)
+		return
+	}
+
 	data, total, err := h.service.ListAdmin(
 		c.Request.Context(),
 		status,
 		search,
+		warehouseID,
 		page,
 		limit,
 	)
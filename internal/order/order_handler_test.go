@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"go-gadget-api/internal/midtrans"
 	"go-gadget-api/internal/order"
+	"go-gadget-api/internal/pkg/cursor"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -26,7 +27,9 @@ type fakeOrderService struct {
 	detailFunc                           func(ctx context.Context, orderID string) (order.OrderResponse, error)
 	cancelFunc                           func(ctx context.Context, orderID string) error
 	completeFunc                         func(ctx context.Context, orderID string, userID string, nextStatus string) (order.OrderResponse, error)
-	listAdminFunc                        func(ctx context.Context, status string, search string, page, limit int) ([]order.OrderResponse, int64, error)
+	listAdminFunc                        func(ctx context.Context, status string, search string, warehouseID string, page, limit int) ([]order.OrderResponse, int64, error)
+	listCursorFunc                       func(ctx context.Context, userID string, status string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error)
+	listAdminCursorFunc                  func(ctx context.Context, status string, search string, warehouseID string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error)
 	updateStatusAdminFunc                func(ctx context.Context, orderID string, status string, receiptNo *string) (order.OrderResponse, error)
 	updatePaymentStatusFunc              func(ctx context.Context, orderID string, input order.UpdatePaymentStatusInput) (order.OrderResponse, error)
 	updatePaymentStatusByOrderNumberFunc func(ctx context.Context, orderNumber string, input order.UpdatePaymentStatusInput) (order.OrderResponse, error)
@@ -46,6 +49,12 @@ func (f *fakeOrderService) List(ctx context.Context, userID string, status strin
 	}
 	return []order.OrderResponse{}, 0, nil
 }
+func (f *fakeOrderService) ListCursor(ctx context.Context, userID string, status string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	if f.listCursorFunc != nil {
+		return f.listCursorFunc(ctx, userID, status, cursorStr, limit)
+	}
+	return []order.OrderResponse{}, cursor.Page{}, nil
+}
 func (f *fakeOrderService) Detail(ctx context.Context, orderID string) (order.OrderResponse, error) {
 	if f.detailFunc != nil {
 		return f.detailFunc(ctx, orderID)
@@ -58,12 +67,18 @@ func (f *fakeOrderService) Cancel(ctx context.Context, orderID string) error {
 	}
 	return nil
 }
-func (f *fakeOrderService) ListAdmin(ctx context.Context, status, search string, page, limit int) ([]order.OrderResponse, int64, error) {
+func (f *fakeOrderService) ListAdmin(ctx context.Context, status, search, warehouseID string, page, limit int) ([]order.OrderResponse, int64, error) {
 	if f.listAdminFunc != nil {
-		return f.listAdminFunc(ctx, status, search, page, limit)
+		return f.listAdminFunc(ctx, status, search, warehouseID, page, limit)
 	}
 	return []order.OrderResponse{}, 0, nil
 }
+func (f *fakeOrderService) ListAdminCursor(ctx context.Context, status, search, warehouseID string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+	if f.listAdminCursorFunc != nil {
+		return f.listAdminCursorFunc(ctx, status, search, warehouseID, cursorStr, limit)
+	}
+	return []order.OrderResponse{}, cursor.Page{}, nil
+}
 func (f *fakeOrderService) Complete(ctx context.Context, orderID string, userID string, nextStatus string) (order.OrderResponse, error) {
 	if f.completeFunc != nil {
 		return f.completeFunc(ctx, orderID, userID, nextStatus)
@@ -242,6 +257,41 @@ func TestOrderHandler_List(t *testing.T) {
 		ctrl.List(c)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("cursor_mode", func(t *testing.T) {
+		svc := &fakeOrderService{
+			listCursorFunc: func(ctx context.Context, uid string, status string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+				assert.Equal(t, "", cursorStr)
+				assert.Equal(t, 5, limit)
+				return []order.OrderResponse{{OrderNumber: "ORD-009"}}, cursor.Page{Next: "abc"}, nil
+			},
+		}
+		ctrl := newTestHandler(svc, nil)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/orders?cursor=&limit=5", nil)
+		c.Set("user_id", "user-1")
+
+		ctrl.List(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"meta":{"limit":5,"nextCursor":"abc"}`)
+	})
+
+	t.Run("cursor_invalid", func(t *testing.T) {
+		svc := &fakeOrderService{
+			listCursorFunc: func(ctx context.Context, uid string, status string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+				return nil, cursor.Page{}, cursor.ErrInvalidCursor
+			},
+		}
+		ctrl := newTestHandler(svc, nil)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/orders?cursor=rusak", nil)
+		c.Set("user_id", "user-1")
+
+		ctrl.List(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 // ==================== DETAIL & CANCEL TESTS ====================
@@ -326,20 +376,39 @@ func TestOrderHandler_Cancel(t *testing.T) {
 func TestOrderHandler_ListAdmin(t *testing.T) {
 	t.Run("success_list_admin", func(t *testing.T) {
 		svc := &fakeOrderService{
-			listAdminFunc: func(ctx context.Context, status, search string, page, limit int) ([]order.OrderResponse, int64, error) {
+			listAdminFunc: func(ctx context.Context, status, search, warehouseID string, page, limit int) ([]order.OrderResponse, int64, error) {
 				assert.Equal(t, "SHIPPED", status)
+				assert.Equal(t, "6f1c1f3e-0b1a-4d5e-9a7b-2c3d4e5f6a7b", warehouseID)
 				return []order.OrderResponse{{OrderNumber: "ADM-001"}}, 1, nil
 			},
 		}
 		ctrl := newTestHandler(svc, nil)
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
-		c.Request = httptest.NewRequest(http.MethodGet, "/admin/orders?status=SHIPPED&page=1&limit=20", nil)
+		c.Request = httptest.NewRequest(http.MethodGet, "/admin/orders?status=SHIPPED&warehouseId=6f1c1f3e-0b1a-4d5e-9a7b-2c3d4e5f6a7b&page=1&limit=20", nil)
 
 		ctrl.ListAdmin(c)
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Contains(t, w.Body.String(), "ADM-001")
 	})
+
+	t.Run("cursor_mode", func(t *testing.T) {
+		svc := &fakeOrderService{
+			listAdminCursorFunc: func(ctx context.Context, status, search, warehouseID string, cursorStr string, limit int) ([]order.OrderResponse, cursor.Page, error) {
+				assert.Equal(t, "xyz", cursorStr)
+				return []order.OrderResponse{{OrderNumber: "ADM-002"}}, cursor.Page{Prev: "xyz-prev"}, nil
+			},
+		}
+		ctrl := newTestHandler(svc, nil)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/admin/orders?cursor=xyz", nil)
+
+		ctrl.ListAdmin(c)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"prevCursor":"xyz-prev"`)
+		assert.NotContains(t, w.Body.String(), `"total"`)
+	})
 }
 
 func TestOrderHandler_UpdateStatusByAdmin(t *testing.T) {
@@ -19,6 +19,8 @@ type Repository interface {
 	UpdateOrderSnapToken(ctx context.Context, arg dbgen.UpdateOrderSnapTokenParams) (dbgen.Order, error)
 	List(ctx context.Context, arg dbgen.ListOrdersParams) ([]dbgen.ListOrdersRow, error)
 	ListAdmin(ctx context.Context, arg dbgen.ListOrdersAdminParams) ([]dbgen.ListOrdersAdminRow, error)
+	ListByPlaced(ctx context.Context, arg dbgen.ListOrdersByPlacedDescParams, desc bool) ([]dbgen.ListOrdersRow, error)
+	ListAdminByCreated(ctx context.Context, arg dbgen.ListOrdersAdminByCreatedDescParams, desc bool) ([]dbgen.ListOrdersAdminRow, error)
 
 	// New Payment & Summary Methods
 	GetOrderPaymentForUpdateByID(ctx context.Context, id uuid.UUID) (dbgen.GetOrderPaymentForUpdateByIDRow, error)
@@ -27,6 +29,13 @@ type Repository interface {
 	GetOrderSummaryByOrderNumber(ctx context.Context, orderNumber string) (dbgen.GetOrderSummaryByOrderNumberRow, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (dbgen.GetUserByIDRow, error)
 	GetAddressByID(ctx context.Context, arg dbgen.GetAddressByIDParams) (dbgen.GetAddressByIDRow, error)
+
+	// Ledger stok (penjualan mengurangi stok, batal/refund mengembalikan)
+	ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error)
+	ListStockMovementsByReference(ctx context.Context, arg dbgen.ListStockMovementsByReferenceParams) ([]dbgen.StockMovement, error)
+
+	// Gudang pengirim yang sanggup memenuhi seluruh item, urut prioritas
+	ListFulfilmentWarehouses(ctx context.Context, arg dbgen.ListFulfilmentWarehousesParams) ([]dbgen.ListFulfilmentWarehousesRow, error)
 }
 
 type repository struct {
@@ -82,6 +91,79 @@ func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListOrdersAdminPar
 	return r.queries.ListOrdersAdmin(ctx, arg)
 }
 
+// ListByPlaced keyset pagination riwayat order customer, desc menentukan arah (placed_at, id)
+func (r *repository) ListByPlaced(ctx context.Context, arg dbgen.ListOrdersByPlacedDescParams, desc bool) ([]dbgen.ListOrdersRow, error) {
+	var rows []dbgen.ListOrdersByPlacedDescRow
+	if desc {
+		res, err := r.queries.ListOrdersByPlacedDesc(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		rows = res
+	} else {
+		res, err := r.queries.ListOrdersByPlacedAsc(ctx, dbgen.ListOrdersByPlacedAscParams(arg))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range res {
+			rows = append(rows, dbgen.ListOrdersByPlacedDescRow(row))
+		}
+	}
+
+	out := make([]dbgen.ListOrdersRow, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, dbgen.ListOrdersRow{
+			ID:          row.ID,
+			OrderNumber: row.OrderNumber,
+			Status:      row.Status,
+			TotalPrice:  row.TotalPrice,
+			PlacedAt:    row.PlacedAt,
+			UserID:      row.UserID,
+			ItemsJson:   row.ItemsJson,
+		})
+	}
+	return out, nil
+}
+
+// ListAdminByCreated keyset pagination order admin, desc menentukan arah (created_at, id)
+func (r *repository) ListAdminByCreated(ctx context.Context, arg dbgen.ListOrdersAdminByCreatedDescParams, desc bool) ([]dbgen.ListOrdersAdminRow, error) {
+	var rows []dbgen.ListOrdersAdminByCreatedDescRow
+	if desc {
+		res, err := r.queries.ListOrdersAdminByCreatedDesc(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		rows = res
+	} else {
+		res, err := r.queries.ListOrdersAdminByCreatedAsc(ctx, dbgen.ListOrdersAdminByCreatedAscParams(arg))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range res {
+			rows = append(rows, dbgen.ListOrdersAdminByCreatedDescRow(row))
+		}
+	}
+
+	out := make([]dbgen.ListOrdersAdminRow, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, dbgen.ListOrdersAdminRow{
+			ID:            row.ID,
+			OrderNumber:   row.OrderNumber,
+			TotalPrice:    row.TotalPrice,
+			Status:        row.Status,
+			CreatedAt:     row.CreatedAt,
+			PlacedAt:      row.PlacedAt,
+			UserID:        row.UserID,
+			SubtotalPrice: row.SubtotalPrice,
+			ShippingPrice: row.ShippingPrice,
+			UserName:      row.UserName,
+			WarehouseID:   row.WarehouseID,
+			WarehouseName: row.WarehouseName,
+		})
+	}
+	return out, nil
+}
+
 func (r *repository) GetOrderPaymentForUpdateByID(ctx context.Context, id uuid.UUID) (dbgen.GetOrderPaymentForUpdateByIDRow, error) {
 	return r.queries.GetOrderPaymentForUpdateByID(ctx, id)
 }
@@ -105,3 +187,15 @@ func (r *repository) GetUserByID(ctx context.Context, id uuid.UUID) (dbgen.GetUs
 func (r *repository) GetAddressByID(ctx context.Context, arg dbgen.GetAddressByIDParams) (dbgen.GetAddressByIDRow, error) {
 	return r.queries.GetAddressByID(ctx, arg)
 }
+
+func (r *repository) ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+	return r.queries.ApplyStockMovement(ctx, arg)
+}
+
+func (r *repository) ListStockMovementsByReference(ctx context.Context, arg dbgen.ListStockMovementsByReferenceParams) ([]dbgen.StockMovement, error) {
+	return r.queries.ListStockMovementsByReference(ctx, arg)
+}
+
+func (r *repository) ListFulfilmentWarehouses(ctx context.Context, arg dbgen.ListFulfilmentWarehousesParams) ([]dbgen.ListFulfilmentWarehousesRow, error) {
+	return r.queries.ListFulfilmentWarehouses(ctx, arg)
+}
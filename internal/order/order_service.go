@@ -10,8 +10,12 @@ import (
 	"fmt"
 	autherrors "go-gadget-api/internal/auth/errors"
 	"go-gadget-api/internal/cart"
+	carterrors "go-gadget-api/internal/cart/errors"
 	"go-gadget-api/internal/midtrans"
 	"go-gadget-api/internal/outbox"
+	"go-gadget-api/internal/pkg/constants"
+	"go-gadget-api/internal/pkg/cursor"
+	"go-gadget-api/internal/product"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"go-gadget-api/internal/shared/database/helper"
 	"log"
@@ -30,13 +34,15 @@ type Service interface {
 	// Customer Actions
 	Checkout(ctx context.Context, userID string, req CheckoutRequest) (OrderResponse, error)
 	List(ctx context.Context, userID string, status string, page, limit int) ([]OrderResponse, int64, error)
+	ListCursor(ctx context.Context, userID string, status string, cursorStr string, limit int) ([]OrderResponse, cursor.Page, error)
 	Detail(ctx context.Context, orderID string) (OrderResponse, error)
 	Cancel(ctx context.Context, orderID string) error
 	Complete(ctx context.Context, orderID string, userID string, nextStatus string) (OrderResponse, error)
 	ContinuePayment(ctx context.Context, orderID string, userID string) (*midtrans.CreateTransactionResponse, error)
 
 	// Shared/Admin Actions
-	ListAdmin(ctx context.Context, status string, search string, page, limit int) ([]OrderResponse, int64, error)
+	ListAdmin(ctx context.Context, status string, search string, warehouseID string, page, limit int) ([]OrderResponse, int64, error)
+	ListAdminCursor(ctx context.Context, status string, search string, warehouseID string, cursorStr string, limit int) ([]OrderResponse, cursor.Page, error)
 	UpdateStatusByAdmin(ctx context.Context, orderID string, nextStatus string, receiptNo *string) (OrderResponse, error)
 	UpdatePaymentStatus(ctx context.Context, orderID string, input UpdatePaymentStatusInput) (OrderResponse, error)
 	UpdatePaymentStatusByOrderNumber(ctx context.Context, orderNumber string, input UpdatePaymentStatusInput) (OrderResponse, error)
@@ -211,6 +217,40 @@ func (s *service) Checkout(
 		return OrderResponse{}, ErrCartEmpty
 	}
 
+	// Cek ulang ketersediaan, stok & harga terkini sebelum membuat order
+	validation, err := s.cartSvc.Validate(ctx, userID)
+	if err != nil {
+		logger.Error("failed to validate cart", zap.Error(err))
+		return OrderResponse{}, err
+	}
+	// Batas pembelian per produk & per order, error menyebutkan qty maksimum
+	for _, v := range validation.Items {
+		if v.Status == cart.ItemStatusQtyLimitExceeded {
+			logger.Warn("checkout blocked by product qty limit", zap.String("product_id", v.ProductID))
+			return OrderResponse{}, carterrors.NewQtyLimitError(carterrors.ErrQtyExceedsProductLimit, v.MaxQty)
+		}
+	}
+	if validation.MaxQtyPerOrder > 0 && validation.TotalQty > validation.MaxQtyPerOrder {
+		logger.Warn("checkout blocked by order qty limit", zap.Int32("total_qty", validation.TotalQty))
+		return OrderResponse{}, carterrors.NewQtyLimitError(carterrors.ErrQtyExceedsOrderLimit, validation.MaxQtyPerOrder)
+	}
+	if validation.HasBlockingIssues {
+		logger.Warn("checkout blocked by cart issues")
+		return OrderResponse{}, ErrCartHasIssues
+	}
+
+	// Pakai harga terkini, bukan harga saat item ditambahkan ke cart
+	// key product+variant karena satu produk bisa punya beberapa variant di cart
+	currentPrices := make(map[string]int32, len(validation.Items))
+	for _, v := range validation.Items {
+		currentPrices[v.ProductID+":"+v.VariantID] = v.CurrentPrice
+	}
+	for i, item := range cartData.Items {
+		if price, ok := currentPrices[item.ProductID+":"+item.VariantID]; ok {
+			cartData.Items[i].Price = price
+		}
+	}
+
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		logger.Warn("invalid user id format", zap.Error(err))
@@ -261,6 +301,15 @@ func (s *service) Checkout(
 
 	addressSnapshot, _ := json.Marshal(addressBody)
 
+	// Gudang pengirim dipilih sebelum Midtrans agar order yang tidak bisa dipenuhi tidak membuat transaksi
+	warehouse, err := s.pickFulfilmentWarehouse(ctx, cartData.Items, addressBody.Province)
+	if err != nil {
+		logger.Warn("no fulfilment warehouse for checkout", zap.Error(err))
+		return OrderResponse{}, err
+	}
+	warehouseID := uuid.NullUUID{UUID: warehouse.ID, Valid: true}
+	logger = logger.With(zap.String("warehouse_code", warehouse.Code))
+
 	// 4. Generate Order Number & Info Dasar
 	orderNumber := fmt.Sprintf("GGS#%d-%s", time.Now().Unix(), strings.ToUpper(uuid.New().String()[:4]))
 	logger = logger.With(zap.String("order_number", orderNumber))
@@ -343,6 +392,7 @@ func (s *service) Checkout(
 		Note:            helper.StringToNull(&req.Note),
 		SnapToken:       sql.NullString{String: midtransResp.Token, Valid: midtransResp.Token != ""},
 		SnapRedirectUrl: sql.NullString{String: midtransResp.RedirectURL, Valid: midtransResp.RedirectURL != ""},
+		WarehouseID:     warehouseID,
 	})
 	if err != nil {
 		logger.Error("failed to create order record", zap.Error(err))
@@ -352,10 +402,24 @@ func (s *service) Checkout(
 	// 6. Create Order Items
 	for _, item := range cartData.Items {
 		productID, _ := uuid.Parse(item.ProductID)
+
+		// Snapshot nama menyertakan pilihan variant, mis. "iPhone 15 (Color: Black, Storage: 128GB)"
+		var variantID uuid.NullUUID
+		nameSnapshot := item.ProductName
+		if item.VariantID != "" {
+			if vid, err := uuid.Parse(item.VariantID); err == nil {
+				variantID = uuid.NullUUID{UUID: vid, Valid: true}
+			}
+			if item.VariantName != "" {
+				nameSnapshot = fmt.Sprintf("%s (%s)", item.ProductName, item.VariantName)
+			}
+		}
+
 		err = qtx.CreateOrderItem(ctx, dbgen.CreateOrderItemParams{
 			OrderID:      order.ID,
 			ProductID:    productID,
-			NameSnapshot: item.ProductName,
+			VariantID:    variantID,
+			NameSnapshot: nameSnapshot,
 			UnitPrice:    fmt.Sprintf("%.2f", float64(item.Price)),
 			Quantity:     item.Qty,
 			TotalPrice:   fmt.Sprintf("%.2f", float64(item.Price)*float64(item.Qty)),
@@ -364,6 +428,29 @@ func (s *service) Checkout(
 			logger.Error("failed to create order item", zap.String("product_id", item.ProductID), zap.Error(err))
 			return OrderResponse{}, err
 		}
+
+		// Stok gudang pengirim dikurangi di transaksi yang sama; gagal = stok habis didahului checkout lain
+		movement, err := qtx.ApplyStockMovement(ctx, dbgen.ApplyStockMovementParams{
+			WarehouseID: warehouseID,
+			Delta:       -item.Qty,
+			ProductID:   productID,
+			VariantID:   variantID,
+			Reason:      constants.StockReasonSale,
+			ReferenceID: uuid.NullUUID{UUID: order.ID, Valid: true},
+			ActorID:     uuid.NullUUID{UUID: uid, Valid: true},
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				logger.Warn("checkout blocked by insufficient stock", zap.String("product_id", item.ProductID))
+				return OrderResponse{}, ErrCartHasIssues
+			}
+			logger.Error("failed to record stock movement", zap.String("product_id", item.ProductID), zap.Error(err))
+			return OrderResponse{}, err
+		}
+		if err := s.enqueueStockAlerts(ctx, tx, movement); err != nil {
+			logger.Error("failed to enqueue stock alert", zap.String("product_id", item.ProductID), zap.Error(err))
+			return OrderResponse{}, err
+		}
 	}
 
 	// 7. Outbox Event
@@ -434,33 +521,8 @@ func (s *service) List(ctx context.Context, userID string, status string, page,
 
 		total = r.TotalCount
 
-		// 2. Inisialisasi slice kosong (bukan nil) di setiap iterasi
-		currentItems := make([]OrderItemResponse, 0)
-
-		if len(r.ItemsJson) > 0 {
-			fmt.Println("DEBUG JSON DARI DB:", string(r.ItemsJson))
-			// Unmarshal ke variabel lokal yang benar-benar baru
-			if err := json.Unmarshal(r.ItemsJson, &currentItems); err != nil {
-				log.Printf("[ListOrders] Error unmarshal: %v\n", err)
-			}
-		}
-
-		// 3. Log hasil setelah unmarshal (sebelum append)
-		if len(currentItems) > 0 {
-			log.Printf("[ListOrders] Mapped NameSnapshot: %s\n", currentItems[0].NameSnapshot)
-		}
-
-		totalPrice, _ := strconv.ParseFloat(r.TotalPrice, 64)
-
-		// 4. Masukkan data ke struct response
-		res = append(res, OrderResponse{
-			ID:          r.ID.String(),
-			OrderNumber: r.OrderNumber,
-			Status:      r.Status,
-			TotalPrice:  totalPrice,
-			PlacedAt:    r.PlacedAt,
-			Items:       currentItems,
-		})
+		// 2. Masukkan data ke struct response
+		res = append(res, mapOrderListRow(r))
 	}
 
 	// Pastikan tidak mengembalikan nil slice ke frontend
@@ -471,13 +533,82 @@ func (s *service) List(ctx context.Context, userID string, status string, page,
 	return res, total, nil
 }
 
-func (s *service) ListAdmin(ctx context.Context, status string, search string, page int, limit int) ([]OrderResponse, int64, error) {
+// ListCursor riwayat order customer dengan keyset pagination (placed_at, id)
+func (s *service) ListCursor(ctx context.Context, userID string, status string, cursorStr string, limit int) ([]OrderResponse, cursor.Page, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, cursor.Page{}, fmt.Errorf("invalid user id format: %w", err)
+	}
+
+	cur, err := cursor.Decode(cursorStr)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+	// Riwayat order terbaru dulu; halaman sebelumnya dibaca ke arah sebaliknya
+	desc := cursor.Descending(cur, true)
+	placedAt, cursorID, err := cursor.Bound(cur, desc)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	rows, err := s.repo.ListByPlaced(ctx, dbgen.ListOrdersByPlacedDescParams{
+		// Satu baris ekstra sebagai penanda masih ada halaman berikutnya
+		Limit:          int32(limit + 1),
+		UserID:         uid,
+		Status:         helper.StringToNull(&status),
+		CursorPlacedAt: placedAt,
+		CursorID:       cursorID,
+	}, desc)
+	if err != nil {
+		log.Printf("[ListOrdersCursor] repo.ListByPlaced error: %+v\n", err)
+		return nil, cursor.Page{}, err
+	}
+
+	rows, page := cursor.Paginate(rows, limit, cur, func(r dbgen.ListOrdersRow) cursor.Cursor {
+		return cursor.Cursor{ID: r.ID.String(), CreatedAt: r.PlacedAt}
+	})
+
+	res := make([]OrderResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapOrderListRow(r))
+	}
+	return res, page, nil
+}
+
+func mapOrderListRow(r dbgen.ListOrdersRow) OrderResponse {
+	// Inisialisasi slice kosong (bukan nil) agar frontend selalu menerima array
+	items := make([]OrderItemResponse, 0)
+	if len(r.ItemsJson) > 0 {
+		if err := json.Unmarshal(r.ItemsJson, &items); err != nil {
+			log.Printf("[ListOrders] Error unmarshal: %v\n", err)
+		}
+	}
+
+	totalPrice, _ := strconv.ParseFloat(r.TotalPrice, 64)
+
+	return OrderResponse{
+		ID:          r.ID.String(),
+		OrderNumber: r.OrderNumber,
+		Status:      r.Status,
+		TotalPrice:  totalPrice,
+		PlacedAt:    r.PlacedAt,
+		Items:       items,
+	}
+}
+
+func (s *service) ListAdmin(ctx context.Context, status string, search string, warehouseID string, page int, limit int) ([]OrderResponse, int64, error) {
+	wid, err := parseWarehouseFilter(warehouseID)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	rows, err := s.repo.ListAdmin(ctx, dbgen.ListOrdersAdminParams{
 		Limit:  int32(limit),
 		Offset: int32((page - 1) * limit),
 		// Menggunakan helper ToText untuk mengonversi string ke sql.NullString
-		Status: helper.StringToNull(&status),
-		Search: helper.StringToNull(&search),
+		Status:      helper.StringToNull(&status),
+		Search:      helper.StringToNull(&search),
+		WarehouseID: wid,
 	})
 	if err != nil {
 		return nil, 0, err
@@ -490,13 +621,15 @@ func (s *service) ListAdmin(ctx context.Context, status string, search string, p
 			total = r.TotalCount
 			// Melakukan type casting dari row result ke dbgen.Order
 			res = append(res, s.mapAdminOrderToResponse(dbgen.ListOrdersAdminRow{
-				ID:          r.ID,
-				OrderNumber: r.OrderNumber,
-				UserID:      r.UserID,
-				UserName:    r.UserName,
-				Status:      r.Status,
-				TotalPrice:  r.TotalPrice,
-				PlacedAt:    r.PlacedAt,
+				ID:            r.ID,
+				OrderNumber:   r.OrderNumber,
+				UserID:        r.UserID,
+				UserName:      r.UserName,
+				Status:        r.Status,
+				TotalPrice:    r.TotalPrice,
+				PlacedAt:      r.PlacedAt,
+				WarehouseID:   r.WarehouseID,
+				WarehouseName: r.WarehouseName,
 				// ... field lain sesuai ketersediaan di ListOrdersAdminRow
 			}, nil))
 		}
@@ -505,6 +638,45 @@ func (s *service) ListAdmin(ctx context.Context, status string, search string, p
 	return res, total, nil
 }
 
+// ListAdminCursor daftar order admin dengan keyset pagination (created_at, id)
+func (s *service) ListAdminCursor(ctx context.Context, status string, search string, warehouseID string, cursorStr string, limit int) ([]OrderResponse, cursor.Page, error) {
+	cur, err := cursor.Decode(cursorStr)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+	wid, err := parseWarehouseFilter(warehouseID)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+	desc := cursor.Descending(cur, true)
+	createdAt, cursorID, err := cursor.Bound(cur, desc)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	rows, err := s.repo.ListAdminByCreated(ctx, dbgen.ListOrdersAdminByCreatedDescParams{
+		Limit:           int32(limit + 1),
+		Status:          helper.StringToNull(&status),
+		Search:          helper.StringToNull(&search),
+		WarehouseID:     wid,
+		CursorCreatedAt: createdAt,
+		CursorID:        cursorID,
+	}, desc)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	rows, page := cursor.Paginate(rows, limit, cur, func(r dbgen.ListOrdersAdminRow) cursor.Cursor {
+		return cursor.Cursor{ID: r.ID.String(), CreatedAt: r.CreatedAt}
+	})
+
+	res := make([]OrderResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, s.mapAdminOrderToResponse(r, nil))
+	}
+	return res, page, nil
+}
+
 // CUSTOMER & ADMIN: Detail
 func (s *service) Detail(ctx context.Context, orderID string) (OrderResponse, error) {
 	oid, err := uuid.Parse(orderID)
@@ -550,6 +722,8 @@ func (s *service) Detail(ctx context.Context, orderID string) (OrderResponse, er
 		TotalPrice:    totalPrice,
 		ShippingPrice: shippingPrice,
 		PlacedAt:      row.PlacedAt,
+		WarehouseID:   nullUUIDString(row.WarehouseID),
+		WarehouseName: row.WarehouseName.String,
 		Customer:      customer,
 		Items:         items,
 	}
@@ -596,6 +770,16 @@ func (s *service) Cancel(ctx context.Context, orderID string) error {
 	// 4. Gunakan WithTx
 	qtx := s.repo.WithTx(tx)
 
+	// 4b. Kunci baris order lalu cek ulang status: cancel ganda atau webhook expire/refund
+	// yang berjalan bersamaan tidak boleh mengembalikan stok dua kali
+	locked, err := qtx.GetOrderPaymentForUpdateByID(ctx, oid)
+	if err != nil {
+		return err
+	}
+	if locked.Status != "PENDING" {
+		return ErrCannotCancel
+	}
+
 	// 5. Update Status melalui qtx
 	_, err = qtx.UpdateStatus(ctx, oid, "CANCELLED")
 	if err != nil {
@@ -603,9 +787,9 @@ func (s *service) Cancel(ctx context.Context, orderID string) error {
 		return err
 	}
 
-	// Jika ke depannya ada logika kembalikan stok:
-	// err = s.productSvc.RestoreStock(ctx, o.Items)
-	// if err != nil { return err }
+	if err := s.restock(ctx, tx, qtx, oid, constants.StockReasonCancellation, uuid.NullUUID{UUID: o.UserID, Valid: true}); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
@@ -846,6 +1030,95 @@ func (s *service) HandleMidtransNotification(ctx context.Context, payload Midtra
 
 // Removed manual structs as we now use dbgen types
 
+// restock membalik mutasi SALE sebuah order. Order yang dibuat sebelum ada ledger
+// tidak punya mutasi SALE (stoknya memang tidak pernah dikurangi), jadi tidak ada yang dikembalikan.
+func (s *service) restock(ctx context.Context, tx *sql.Tx, qtx Repository, orderID uuid.UUID, reason string, actor uuid.NullUUID) error {
+	sales, err := qtx.ListStockMovementsByReference(ctx, dbgen.ListStockMovementsByReferenceParams{
+		ReferenceID: uuid.NullUUID{UUID: orderID, Valid: true},
+		Reason:      constants.StockReasonSale,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range sales {
+		// Stok kembali ke gudang asal penjualannya
+		movement, err := qtx.ApplyStockMovement(ctx, dbgen.ApplyStockMovementParams{
+			WarehouseID: uuid.NullUUID{UUID: m.WarehouseID, Valid: true},
+			Delta:       -m.Delta,
+			ProductID:   m.ProductID,
+			VariantID:   m.VariantID,
+			Reason:      reason,
+			ReferenceID: m.ReferenceID,
+			ActorID:     actor,
+		})
+		if err != nil {
+			// Variant yang sudah dihapus permanen tidak bisa menerima stok kembali
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return err
+		}
+		if err := s.enqueueStockAlerts(ctx, tx, movement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pickFulfilmentWarehouse memilih gudang aktif yang stoknya cukup untuk semua item cart.
+// Gudang di provinsi alamat kirim didahulukan, sisanya urut priority gudang.
+func (s *service) pickFulfilmentWarehouse(ctx context.Context, items []cart.CartItemDetailResponse, province string) (dbgen.ListFulfilmentWarehousesRow, error) {
+	arg := dbgen.ListFulfilmentWarehousesParams{
+		ProductIds: make([]uuid.UUID, 0, len(items)),
+		VariantIds: make([]uuid.UUID, 0, len(items)),
+		Quantities: make([]int32, 0, len(items)),
+		Province:   strings.TrimSpace(province),
+	}
+	for _, item := range items {
+		productID, _ := uuid.Parse(item.ProductID)
+		variantID, _ := uuid.Parse(item.VariantID) // kosong = uuid.Nil (stok level produk)
+		arg.ProductIds = append(arg.ProductIds, productID)
+		arg.VariantIds = append(arg.VariantIds, variantID)
+		arg.Quantities = append(arg.Quantities, item.Qty)
+	}
+
+	warehouses, err := s.repo.ListFulfilmentWarehouses(ctx, arg)
+	if err != nil {
+		return dbgen.ListFulfilmentWarehousesRow{}, err
+	}
+	if len(warehouses) == 0 {
+		return dbgen.ListFulfilmentWarehousesRow{}, ErrNoFulfilmentWarehouse
+	}
+	return warehouses[0], nil
+}
+
+func parseWarehouseFilter(warehouseID string) (uuid.NullUUID, error) {
+	if warehouseID == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(warehouseID)
+	if err != nil {
+		return uuid.NullUUID{}, ErrInvalidWarehouseID
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+// enqueueStockAlerts membuat event PRODUCT_LOW_STOCK / PRODUCT_BACK_IN_STOCK
+// (lihat product.StockAlertEvents) di transaksi yang sama dengan mutasinya
+func (s *service) enqueueStockAlerts(ctx context.Context, tx *sql.Tx, m dbgen.ApplyStockMovementRow) error {
+	events, err := product.StockAlertEvents(m)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		if err := s.outboxRepo.WithTx(tx).CreateOutboxEvent(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *service) updatePaymentStatusWithFilter(ctx context.Context, input UpdatePaymentStatusInput, filter string, filterValue any) (OrderResponse, error) {
 	nextStatus := strings.ToUpper(strings.TrimSpace(input.PaymentStatus))
 	if nextStatus == "" {
@@ -982,6 +1255,13 @@ func (s *service) updatePaymentStatusWithFilter(ctx context.Context, input Updat
 		return OrderResponse{}, ErrOrderFailed
 	}
 
+	// Refund yang membatalkan order mengembalikan stok (sekali saja, order yang sudah CANCELLED dilewati)
+	if nextOrderStatus == "CANCELLED" && row.Status != "CANCELLED" {
+		if err := s.restock(ctx, tx, qtx, row.ID, constants.StockReasonRefund, uuid.NullUUID{}); err != nil {
+			return OrderResponse{}, ErrOrderFailed
+		}
+	}
+
 	fullOrder, err := qtx.GetByID(ctx, row.ID)
 	if err != nil {
 		return OrderResponse{}, err
@@ -1162,6 +1442,13 @@ func stringPtr(v string) *string {
 // }
 
 // Helper Mapper
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.UUID.String()
+}
+
 func (s *service) mapOrderToResponse(o dbgen.Order, items []dbgen.GetOrderItemsRow) OrderResponse {
 	total, _ := strconv.ParseFloat(o.TotalPrice, 64)
 	subtotal, _ := strconv.ParseFloat(o.SubtotalPrice, 64)
@@ -1176,6 +1463,7 @@ func (s *service) mapOrderToResponse(o dbgen.Order, items []dbgen.GetOrderItemsR
 		ShippingPrice: shipping,
 		TotalPrice:    total,
 		PlacedAt:      o.PlacedAt,
+		WarehouseID:   nullUUIDString(o.WarehouseID),
 	}
 
 	if o.SnapToken.Valid {
@@ -1202,6 +1490,7 @@ func (s *service) mapOrderToResponse(o dbgen.Order, items []dbgen.GetOrderItemsR
 		res.Items = append(res.Items, OrderItemResponse{
 			ID:              item.ID.String(),
 			ProductID:       item.ProductID.String(),
+			VariantID:       nullUUIDString(item.VariantID),
 			ProductSlug:     item.Productslug.String,
 			ProductImageUrl: item.Productimageurl.String,
 			NameSnapshot:    item.NameSnapshot,
@@ -1217,13 +1506,15 @@ func (s *service) mapAdminOrderToResponse(o dbgen.ListOrdersAdminRow, items []db
 	total, _ := strconv.ParseFloat(o.TotalPrice, 64)
 
 	res := OrderResponse{
-		ID:          o.ID.String(),
-		OrderNumber: o.OrderNumber,
-		Status:      o.Status,
-		TotalPrice:  total,
-		PlacedAt:    o.PlacedAt,
-		UserID:      o.UserID.String(),
-		UserName:    o.UserName,
+		ID:            o.ID.String(),
+		OrderNumber:   o.OrderNumber,
+		Status:        o.Status,
+		TotalPrice:    total,
+		PlacedAt:      o.PlacedAt,
+		UserID:        o.UserID.String(),
+		UserName:      o.UserName,
+		WarehouseID:   nullUUIDString(o.WarehouseID),
+		WarehouseName: o.WarehouseName.String,
 	}
 
 	for _, item := range items {
@@ -1232,6 +1523,7 @@ func (s *service) mapAdminOrderToResponse(o dbgen.ListOrdersAdminRow, items []db
 		res.Items = append(res.Items, OrderItemResponse{
 			ID:              item.ID.String(),
 			ProductID:       item.ProductID.String(),
+			VariantID:       nullUUIDString(item.VariantID),
 			ProductSlug:     item.Productslug.String,
 			ProductImageUrl: item.Productimageurl.String,
 			NameSnapshot:    item.NameSnapshot,
@@ -5,14 +5,19 @@ import (
 	"database/sql"
 	"errors"
 	"go-gadget-api/internal/cart"
+	carterrors "go-gadget-api/internal/cart/errors"
 	"go-gadget-api/internal/midtrans"
 	cartMock "go-gadget-api/internal/mock/cart"
 	midtransMock "go-gadget-api/internal/mock/midtrans"
 	orderMock "go-gadget-api/internal/mock/order"
 	outboxMock "go-gadget-api/internal/mock/outbox"
 	"go-gadget-api/internal/order"
+	"go-gadget-api/internal/pkg/constants"
+	"go-gadget-api/internal/pkg/cursor"
+	"go-gadget-api/internal/product"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -69,6 +74,21 @@ func TestOrderService_Checkout(t *testing.T) {
 			}, nil).
 			Times(1)
 
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil).
+			Times(1)
+
+		warehouseID := uuid.New()
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.ListFulfilmentWarehousesParams) ([]dbgen.ListFulfilmentWarehousesRow, error) {
+				assert.Equal(t, []uuid.UUID{productID}, p.ProductIds)
+				assert.Equal(t, []uuid.UUID{uuid.Nil}, p.VariantIds)
+				assert.Equal(t, []int32{2}, p.Quantities)
+				return []dbgen.ListFulfilmentWarehousesRow{{ID: warehouseID, Code: "SBY"}}, nil
+			}).Times(1)
+
 		orderRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(dbgen.GetUserByIDRow{
 			ID: userID, Name: "Customer", Email: "customer@example.com",
 		}, nil).Times(1)
@@ -78,12 +98,13 @@ func TestOrderService_Checkout(t *testing.T) {
 		}, nil).Times(1)
 
 		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).Times(1)
-		outboxRepo.EXPECT().WithTx(gomock.Any()).Return(outboxRepo).Times(1)
+		outboxRepo.EXPECT().WithTx(gomock.Any()).Return(outboxRepo).Times(2)
 
 		orderRepo.EXPECT().
 			CreateOrder(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, p dbgen.CreateOrderParams) (dbgen.Order, error) {
 				assert.NotEmpty(t, p.OrderNumber)
+				assert.Equal(t, uuid.NullUUID{UUID: warehouseID, Valid: true}, p.WarehouseID)
 				return dbgen.Order{
 					ID:          orderID,
 					OrderNumber: p.OrderNumber,
@@ -97,6 +118,25 @@ func TestOrderService_Checkout(t *testing.T) {
 			Return(nil).
 			Times(1)
 
+		orderRepo.EXPECT().
+			ApplyStockMovement(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+				assert.Equal(t, int32(-2), p.Delta)
+				assert.Equal(t, productID, p.ProductID)
+				assert.Equal(t, constants.StockReasonSale, p.Reason)
+				assert.Equal(t, orderID, p.ReferenceID.UUID)
+				assert.Equal(t, uuid.NullUUID{UUID: warehouseID, Valid: true}, p.WarehouseID)
+				// Saldo 5 -> 3 melewati batas stok menipis (4)
+				return dbgen.ApplyStockMovementRow{ProductID: productID, Delta: -2, BalanceAfter: 3, LowStockThreshold: 4}, nil
+			}).Times(1)
+
+		outboxRepo.EXPECT().
+			CreateOutboxEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e dbgen.CreateOutboxEventParams) error {
+				assert.Equal(t, product.EventProductLowStock, e.EventType)
+				return nil
+			}).
+			Times(1)
 		outboxRepo.EXPECT().
 			CreateOutboxEvent(gomock.Any(), gomock.Any()).
 			Return(nil).
@@ -127,6 +167,16 @@ func TestOrderService_Checkout(t *testing.T) {
 			}, nil).
 			Times(1)
 
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil).
+			Times(1)
+
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListFulfilmentWarehousesRow{{ID: uuid.New(), Code: "JKT"}}, nil).
+			Times(1)
+
 		orderRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(dbgen.GetUserByIDRow{
 			ID: userID, Name: "Customer", Email: "customer@example.com",
 		}, nil).Times(1)
@@ -151,6 +201,7 @@ func TestOrderService_Checkout(t *testing.T) {
 			}).Times(1)
 
 		orderRepo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		orderRepo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Return(dbgen.ApplyStockMovementRow{}, nil).Times(3)
 		outboxRepo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 		res, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
@@ -174,6 +225,65 @@ func TestOrderService_Checkout(t *testing.T) {
 		assert.ErrorIs(t, err, order.ErrCartEmpty)
 	})
 
+	// =========================================================
+	t.Run("error_cart_has_blocking_issues", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.NewString()
+
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), userID.String()).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{
+					{ProductID: productID, Qty: 5, Price: 5000, ProductName: "Product 1"},
+				},
+			}, nil).
+			Times(1)
+
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{
+				Items: []cart.CartValidationItemResponse{
+					{ProductID: productID, Qty: 5, Stock: 2, Status: cart.ItemStatusInsufficientStock},
+				},
+				HasBlockingIssues: true,
+			}, nil).
+			Times(1)
+
+		_, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, order.ErrCartHasIssues)
+	})
+
+	// =========================================================
+	t.Run("error_product_qty_limit_exceeded", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.NewString()
+
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), userID.String()).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{
+					{ProductID: productID, Qty: 5, Price: 5000, ProductName: "Product 1"},
+				},
+			}, nil).
+			Times(1)
+
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{
+				Items: []cart.CartValidationItemResponse{
+					{ProductID: productID, Qty: 5, MaxQty: 2, Status: cart.ItemStatusQtyLimitExceeded},
+				},
+				HasBlockingIssues: true,
+			}, nil).
+			Times(1)
+
+		_, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, carterrors.ErrQtyExceedsProductLimit)
+		assert.Contains(t, err.Error(), "max 2")
+	})
+
 	// =========================================================
 	t.Run("error_cart_service_failed", func(t *testing.T) {
 		userID := uuid.New()
@@ -204,6 +314,11 @@ func TestOrderService_Checkout(t *testing.T) {
 				},
 			}, nil).Times(1)
 
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListFulfilmentWarehousesRow{{ID: uuid.New(), Code: "JKT"}}, nil).
+			Times(1)
+
 		orderRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(dbgen.GetUserByIDRow{
 			ID: userID, Name: "Customer", Email: "customer@example.com",
 		}, nil).Times(1)
@@ -219,6 +334,11 @@ func TestOrderService_Checkout(t *testing.T) {
 			Return(dbgen.Order{}, order.ErrOrderFailed).
 			Times(1)
 
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil).
+			Times(1)
+
 		_, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, order.ErrOrderFailed)
@@ -242,6 +362,11 @@ func TestOrderService_Checkout(t *testing.T) {
 				},
 			}, nil).Times(1)
 
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListFulfilmentWarehousesRow{{ID: uuid.New(), Code: "JKT"}}, nil).
+			Times(1)
+
 		orderRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(dbgen.GetUserByIDRow{
 			ID: userID, Name: "Customer", Email: "customer@example.com",
 		}, nil).Times(1)
@@ -268,6 +393,11 @@ func TestOrderService_Checkout(t *testing.T) {
 			Return(order.ErrOrderFailed). // Sengaja dibuat error
 			Times(1)
 
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil).
+			Times(1)
+
 		// Execution
 		_, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
 
@@ -277,6 +407,86 @@ func TestOrderService_Checkout(t *testing.T) {
 		require.NoError(t, sqlMock.ExpectationsWereMet())
 	})
 
+	// =========================================================
+	t.Run("error_no_fulfilment_warehouse", func(t *testing.T) {
+		userID := uuid.New()
+
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), userID.String()).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{
+					{ProductID: uuid.NewString(), Qty: 10, Price: 1000, ProductName: "Product 1"},
+				},
+			}, nil).Times(1)
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil).
+			Times(1)
+
+		// Tidak ada gudang yang stoknya cukup -> berhenti sebelum Midtrans & transaksi
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListFulfilmentWarehousesRow{}, nil).
+			Times(1)
+
+		_, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, order.ErrNoFulfilmentWarehouse)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	// =========================================================
+	t.Run("error_stock_taken_by_concurrent_checkout", func(t *testing.T) {
+		userID := uuid.New()
+
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		cartSvc.EXPECT().
+			Detail(gomock.Any(), userID.String()).
+			Return(cart.CartDetailResponse{
+				Items: []cart.CartItemDetailResponse{
+					{ProductID: uuid.NewString(), Qty: 3, Price: 1000, ProductName: "Product 1"},
+				},
+			}, nil).Times(1)
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil).
+			Times(1)
+
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListFulfilmentWarehousesRow{{ID: uuid.New(), Code: "JKT"}}, nil).
+			Times(1)
+
+		orderRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(dbgen.GetUserByIDRow{
+			ID: userID, Name: "Customer", Email: "customer@example.com",
+		}, nil).Times(1)
+		midtransSvc.EXPECT().CreateTransactionToken(gomock.Any()).Return(&midtrans.CreateTransactionResponse{
+			Token: "token-stock", RedirectURL: "url-stock",
+		}, nil).Times(1)
+
+		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).Times(1)
+		orderRepo.EXPECT().
+			CreateOrder(gomock.Any(), gomock.Any()).
+			Return(dbgen.Order{
+				ID: uuid.New(), OrderNumber: "ORD-STOCK", UserID: userID, Status: "PENDING",
+			}, nil).Times(1)
+		orderRepo.EXPECT().CreateOrderItem(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		// Guard stok di query gagal -> tidak ada baris yang dikembalikan
+		orderRepo.EXPECT().
+			ApplyStockMovement(gomock.Any(), gomock.Any()).
+			Return(dbgen.ApplyStockMovementRow{}, sql.ErrNoRows).
+			Times(1)
+
+		_, err := svc.Checkout(ctx, userID.String(), order.CheckoutRequest{})
+
+		assert.ErrorIs(t, err, order.ErrCartHasIssues)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
 	// =========================================================
 	t.Run("error_commit_failed", func(t *testing.T) {
 		// -------------------------------------------------
@@ -302,6 +512,15 @@ func TestOrderService_Checkout(t *testing.T) {
 				},
 			}, nil)
 
+		cartSvc.EXPECT().
+			Validate(gomock.Any(), userID.String()).
+			Return(cart.CartValidationResponse{}, nil)
+
+		orderRepo.EXPECT().
+			ListFulfilmentWarehouses(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListFulfilmentWarehousesRow{{ID: uuid.New(), Code: "JKT"}}, nil).
+			Times(1)
+
 		orderRepo.EXPECT().GetUserByID(gomock.Any(), userID).Return(dbgen.GetUserByIDRow{
 			ID: userID, Name: "Customer", Email: "customer@example.com",
 		}, nil).Times(1)
@@ -330,6 +549,10 @@ func TestOrderService_Checkout(t *testing.T) {
 			CreateOrderItem(gomock.Any(), gomock.Any()).
 			Return(nil)
 
+		orderRepo.EXPECT().
+			ApplyStockMovement(gomock.Any(), gomock.Any()).
+			Return(dbgen.ApplyStockMovementRow{}, nil)
+
 		outboxRepo.EXPECT().
 			WithTx(gomock.Any()).
 			Return(outboxRepo)
@@ -412,6 +635,57 @@ func TestOrderService_List(t *testing.T) {
 		_, _, err := svc.List(ctx, userID.String(), "ALL", 1, 10)
 		assert.Error(t, err)
 	})
+
+	t.Run("success_list_cursor", func(t *testing.T) {
+		userID := uuid.New()
+		placed := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+		mockRows := []dbgen.ListOrdersRow{
+			{ID: uuid.New(), OrderNumber: "ORD-003", TotalPrice: "10000.00", PlacedAt: placed},
+			{ID: uuid.New(), OrderNumber: "ORD-002", TotalPrice: "10000.00", PlacedAt: placed.Add(-time.Hour)},
+		}
+		cursorID := uuid.New()
+		next := cursor.Encode(cursor.Cursor{ID: cursorID.String(), CreatedAt: placed.Add(time.Hour)})
+
+		orderRepo.EXPECT().
+			ListByPlaced(gomock.Any(), gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, arg dbgen.ListOrdersByPlacedDescParams, _ bool) ([]dbgen.ListOrdersRow, error) {
+				assert.Equal(t, int32(2), arg.Limit)
+				assert.Equal(t, cursorID, arg.CursorID)
+				assert.Equal(t, placed.Add(time.Hour), arg.CursorPlacedAt)
+				return mockRows, nil
+			})
+
+		res, page, err := svc.ListCursor(ctx, userID.String(), "", next, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "ORD-003", res[0].OrderNumber)
+		assert.NotEmpty(t, page.Next)
+		assert.NotEmpty(t, page.Prev)
+	})
+
+	t.Run("success_list_cursor_prev_reads_ascending", func(t *testing.T) {
+		placed := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+		prev := cursor.Encode(cursor.Cursor{ID: uuid.NewString(), CreatedAt: placed, Prev: true})
+
+		orderRepo.EXPECT().
+			ListByPlaced(gomock.Any(), gomock.Any(), false).
+			Return([]dbgen.ListOrdersRow{
+				{ID: uuid.New(), OrderNumber: "ORD-004", TotalPrice: "10000.00", PlacedAt: placed.Add(time.Hour)},
+				{ID: uuid.New(), OrderNumber: "ORD-005", TotalPrice: "10000.00", PlacedAt: placed.Add(2 * time.Hour)},
+			}, nil)
+
+		res, _, err := svc.ListCursor(ctx, uuid.NewString(), "", prev, 10)
+
+		assert.NoError(t, err)
+		// Hasil dibalik lagi agar tetap terbaru dulu
+		assert.Equal(t, "ORD-005", res[0].OrderNumber)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		_, _, err := svc.ListCursor(ctx, uuid.NewString(), "", "bukan-cursor", 10)
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor)
+	})
 }
 
 func TestOrderService_ListAdmin(t *testing.T) {
@@ -444,11 +718,28 @@ func TestOrderService_ListAdmin(t *testing.T) {
 				{ID: uuid.New(), OrderNumber: "ORD-001", TotalCount: 1},
 			}, nil)
 
-		res, total, err := svc.ListAdmin(ctx, "", "", 1, 10)
+		res, total, err := svc.ListAdmin(ctx, "", "", "", 1, 10)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, res, 1)
 	})
+
+	t.Run("success_list_cursor_last_page", func(t *testing.T) {
+		orderRepo.EXPECT().
+			ListAdminByCreated(gomock.Any(), gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, arg dbgen.ListOrdersAdminByCreatedDescParams, _ bool) ([]dbgen.ListOrdersAdminRow, error) {
+				assert.Equal(t, int32(11), arg.Limit)
+				// Halaman pertama memakai batas atas sentinel, bukan cursor kosong
+				assert.Equal(t, uuid.Max, arg.CursorID)
+				return []dbgen.ListOrdersAdminRow{{ID: uuid.New(), OrderNumber: "ORD-001", CreatedAt: time.Now()}}, nil
+			})
+
+		res, page, err := svc.ListAdminCursor(ctx, "", "", "", "", 10)
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Empty(t, page.Next)
+		assert.Empty(t, page.Prev)
+	})
 }
 
 func TestOrderService_Detail(t *testing.T) {
@@ -528,10 +819,34 @@ func TestOrderService_Cancel(t *testing.T) {
 
 		// 3. Mock WithTx dan UpdateStatus (DIDALAM transaksi)
 		orderRepo.EXPECT().WithTx(gomock.Any()).Return(orderRepo).AnyTimes()
+		orderRepo.EXPECT().
+			GetOrderPaymentForUpdateByID(gomock.Any(), orderID).
+			Return(dbgen.GetOrderPaymentForUpdateByIDRow{ID: orderID, Status: "PENDING"}, nil)
 		orderRepo.EXPECT().
 			UpdateStatus(gomock.Any(), orderID, "CANCELLED").
 			Return(dbgen.Order{}, nil)
 
+		// 4. Stok dari mutasi SALE dikembalikan
+		productID := uuid.New()
+		warehouseID := uuid.New()
+		orderRepo.EXPECT().
+			ListStockMovementsByReference(gomock.Any(), dbgen.ListStockMovementsByReferenceParams{
+				ReferenceID: uuid.NullUUID{UUID: orderID, Valid: true},
+				Reason:      constants.StockReasonSale,
+			}).
+			Return([]dbgen.StockMovement{
+				{ProductID: productID, WarehouseID: warehouseID, Delta: -2, ReferenceID: uuid.NullUUID{UUID: orderID, Valid: true}},
+			}, nil)
+		orderRepo.EXPECT().
+			ApplyStockMovement(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+				assert.Equal(t, int32(2), p.Delta)
+				assert.Equal(t, productID, p.ProductID)
+				assert.Equal(t, constants.StockReasonCancellation, p.Reason)
+				assert.Equal(t, uuid.NullUUID{UUID: warehouseID, Valid: true}, p.WarehouseID)
+				return dbgen.ApplyStockMovementRow{}, nil
+			})
+
 		mock.ExpectCommit()
 
 		// Execute
@@ -552,6 +867,25 @@ func TestOrderService_Cancel(t *testing.T) {
 		err := svc.Cancel(ctx, orderID.String())
 		assert.ErrorIs(t, err, order.ErrCannotCancel)
 	})
+
+	t.Run("error_already_cancelled_concurrently", func(t *testing.T) {
+		orderID := uuid.New()
+		// Cek awal masih PENDING, tapi request lain sudah membatalkan sebelum lock didapat
+		orderRepo.EXPECT().GetByID(gomock.Any(), orderID).Return(dbgen.GetOrderByIDRow{
+			ID: orderID, Status: "PENDING",
+		}, nil)
+
+		mock.ExpectBegin()
+		orderRepo.EXPECT().
+			GetOrderPaymentForUpdateByID(gomock.Any(), orderID).
+			Return(dbgen.GetOrderPaymentForUpdateByIDRow{ID: orderID, Status: "CANCELLED"}, nil)
+		mock.ExpectRollback()
+
+		// UpdateStatus & restock tidak boleh dipanggil
+		err := svc.Cancel(ctx, orderID.String())
+		assert.ErrorIs(t, err, order.ErrCannotCancel)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestOrderService_Complete(t *testing.T) {
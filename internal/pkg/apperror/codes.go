@@ -1,6 +1,9 @@
 package apperror
 
 const (
+	// Redirect (3xx)
+	CodeMovedPermanently = "MOVED_PERMANENTLY"
+
 	// Client errors (4xx)
 	CodeInvalidInput = "INVALID_INPUT"
 	CodeUnauthorized = "UNAUTHORIZED"
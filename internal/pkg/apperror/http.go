@@ -14,6 +14,16 @@ type HTTPError struct {
 
 // ToHTTP converts any error to HTTPError
 func ToHTTP(err error) *HTTPError {
+	var moved *MovedError
+	if errors.As(err, &moved) {
+		return &HTTPError{
+			Status:  http.StatusMovedPermanently,
+			Code:    CodeMovedPermanently,
+			Message: "Resource has moved",
+			Details: map[string]string{"slug": moved.Slug},
+		}
+	}
+
 	var appErr *AppError
 	if errors.As(err, &appErr) {
 		return &HTTPError{
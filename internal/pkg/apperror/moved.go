@@ -0,0 +1,11 @@
+package apperror
+
+// MovedError dikembalikan saat slug yang diminta adalah slug lama (sudah di-rename).
+// Slug berisi slug kanonik; handler membalas 301 ke URL dengan slug tersebut.
+type MovedError struct {
+	Slug string
+}
+
+func (e *MovedError) Error() string {
+	return "resource moved to " + e.Slug
+}
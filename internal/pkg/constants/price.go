@@ -0,0 +1,18 @@
+package constants
+
+// Sumber perubahan harga di product_price_history
+const (
+	PriceSourceManual      = "MANUAL"
+	PriceSourceImport      = "IMPORT"
+	PriceSourceSchedule    = "SCHEDULE"
+	PriceSourceScheduleEnd = "SCHEDULE_END"
+)
+
+// Status jadwal harga di product_price_schedules
+const (
+	PriceSchedulePending   = "PENDING"
+	PriceScheduleActive    = "ACTIVE"
+	PriceScheduleCompleted = "COMPLETED"
+	PriceScheduleCancelled = "CANCELLED"
+	PriceScheduleExpired   = "EXPIRED"
+)
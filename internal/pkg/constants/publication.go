@@ -0,0 +1,9 @@
+package constants
+
+// Status publikasi produk (products.status)
+const (
+	ProductStatusDraft     = "DRAFT"
+	ProductStatusScheduled = "SCHEDULED"
+	ProductStatusPublished = "PUBLISHED"
+	ProductStatusArchived  = "ARCHIVED"
+)
@@ -0,0 +1,8 @@
+package constants
+
+// Jenis entitas di slug_history.entity_type
+const (
+	SlugEntityProduct  = "product"
+	SlugEntityBrand    = "brand"
+	SlugEntityCategory = "category"
+)
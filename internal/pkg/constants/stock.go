@@ -0,0 +1,11 @@
+package constants
+
+// Alasan mutasi stok di ledger stock_movements
+const (
+	StockReasonSale         = "SALE"
+	StockReasonCancellation = "CANCELLATION"
+	StockReasonRefund       = "REFUND"
+	StockReasonAdjustment   = "ADJUSTMENT"
+	StockReasonImport       = "IMPORT"
+	StockReasonTransfer     = "TRANSFER"
+)
@@ -0,0 +1,125 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"go-gadget-api/internal/pkg/apperror"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor cursor rusak, dimodifikasi, atau berasal dari urutan (sort) lain
+var ErrInvalidCursor = apperror.New(apperror.CodeInvalidInput, "Invalid pagination cursor", http.StatusBadRequest)
+
+// Cursor posisi baris terakhir/pertama yang sudah dilihat klien (keyset pagination).
+// Dikirim ke klien sebagai string opaque (base64url JSON), klien tidak perlu tahu isinya.
+type Cursor struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"t"`
+	Price     string    `json:"p,omitempty"` // hanya untuk sort harga
+	Sort      string    `json:"s,omitempty"` // cursor hanya valid untuk sort yang sama
+	Prev      bool      `json:"b,omitempty"` // true = ambil halaman sebelum posisi ini
+}
+
+// Page cursor halaman berikut/sebelumnya, kosong jika tidak ada
+type Page struct {
+	Next string
+	Prev string
+}
+
+func Encode(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode string kosong = halaman pertama (nil, nil)
+func Decode(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == "" || c.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Batas terluar keyset untuk halaman pertama. Query keyset selalu membandingkan dengan
+// sebuah posisi, jadi tanpa cursor dipakai posisi di luar semua data yang mungkin ada.
+var (
+	minTime = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// Descending arah query keyset yang harus dijalankan. sortDesc = urutan tampilan menurun;
+// halaman sebelumnya (Prev) berjalan dengan arah kebalikannya.
+func Descending(cur *Cursor, sortDesc bool) bool {
+	if cur != nil && cur.Prev {
+		return !sortDesc
+	}
+	return sortDesc
+}
+
+// Bound posisi (created_at, id) untuk predikat keyset. Tanpa cursor dikembalikan batas terluar
+// sesuai arah query agar semua baris lolos.
+func Bound(cur *Cursor, desc bool) (time.Time, uuid.UUID, error) {
+	if cur == nil {
+		if desc {
+			return maxTime, uuid.Max, nil
+		}
+		return minTime, uuid.Nil, nil
+	}
+	id, err := uuid.Parse(cur.ID)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrInvalidCursor
+	}
+	return cur.CreatedAt, id, nil
+}
+
+// Paginate mengolah hasil query keyset yang mengambil limit+1 baris.
+// Baris ke-(limit+1) hanya penanda masih ada data, tidak ikut dikembalikan.
+// Untuk arah Prev query berjalan terbalik, jadi hasilnya dibalik lagi ke urutan normal.
+// key membentuk cursor dari satu baris (tanpa Prev, diisi di sini).
+func Paginate[T any](rows []T, limit int, cur *Cursor, key func(T) Cursor) ([]T, Page) {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := cur != nil && cur.Prev
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	var hasPrev, hasNext bool
+	if backward {
+		hasPrev, hasNext = hasMore, true
+	} else {
+		hasPrev, hasNext = cur != nil, hasMore
+	}
+
+	var page Page
+	if len(rows) == 0 {
+		return rows, page
+	}
+	if hasNext {
+		page.Next = Encode(key(rows[len(rows)-1]))
+	}
+	if hasPrev {
+		first := key(rows[0])
+		first.Prev = true
+		page.Prev = Encode(first)
+	}
+	return rows, page
+}
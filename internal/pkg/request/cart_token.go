@@ -0,0 +1,17 @@
+package platform
+
+import "strings"
+
+// Cart guest dikirim lewat header (mobile) atau cookie (web)
+const (
+	CartTokenHeader = "X-Cart-Token"
+	CartTokenCookie = "cart_token"
+)
+
+// ResolveCartToken mengambil cart token, header diprioritaskan dari cookie
+func ResolveCartToken(headerValue, cookieValue string) string {
+	if token := strings.TrimSpace(headerValue); token != "" {
+		return token
+	}
+	return strings.TrimSpace(cookieValue)
+}
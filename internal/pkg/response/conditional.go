@@ -0,0 +1,82 @@
+package response
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SuccessConditional sama seperti Success, ditambah ETag & Last-Modified.
+// Jika klien mengirim If-None-Match / If-Modified-Since yang masih cocok, dibalas 304 tanpa body.
+// lastModified boleh zero (header Last-Modified tidak dikirim, validasi cukup lewat ETag).
+func SuccessConditional(c *gin.Context, data interface{}, meta *PaginationMeta, lastModified time.Time) {
+	Conditional(c, ApiEnvelope{Ok: true, Data: data, Meta: meta}, lastModified)
+}
+
+// Conditional menulis envelope (200) dengan header validasi cache HTTP
+func Conditional(c *gin.Context, env ApiEnvelope, lastModified time.Time) {
+	body, err := json.Marshal(env)
+	if err != nil {
+		c.JSON(http.StatusOK, env)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	// Weak ETag: isi sama secara semantik, bukan byte-per-byte (mis. beda kompresi)
+	etag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+
+	h := c.Writer.Header()
+	h.Set("ETag", etag)
+	if !lastModified.IsZero() {
+		h.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	// Klien boleh menyimpan, tapi wajib revalidasi (murah karena 304)
+	if h.Get("Cache-Control") == "" {
+		h.Set("Cache-Control", "no-cache")
+	}
+
+	if isNotModified(c.Request, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+func isNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	// If-None-Match lebih diutamakan dibanding If-Modified-Since (RFC 9110 13.2.2)
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || weakTag(candidate) == weakTag(etag) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		t, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// Presisi header HTTP hanya sampai detik
+		return !lastModified.Truncate(time.Second).After(t)
+	}
+
+	return false
+}
+
+// weakTag membuang prefix W/ agar perbandingan memakai weak comparison
+func weakTag(tag string) string {
+	return strings.TrimPrefix(tag, "W/")
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MovedPermanently membalas 301 dari slug lama ke slug kanonik. Segmen path terakhir yang sama
+// dengan oldSlug diganti (query string ikut dibawa). Body tetap berisi hint slug kanonik
+// untuk klien API yang tidak mengikuti redirect otomatis.
+func MovedPermanently(c *gin.Context, oldSlug, newSlug string) {
+	segments := strings.Split(c.Request.URL.Path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] == oldSlug {
+			segments[i] = newSlug
+			break
+		}
+	}
+
+	location := strings.Join(segments, "/")
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+
+	c.Header("Location", location)
+	Error(c, http.StatusMovedPermanently, "MOVED_PERMANENTLY", "Resource has moved", map[string]string{
+		"slug":     newSlug,
+		"location": location,
+	})
+}
@@ -9,6 +9,9 @@ type PaginationMeta struct {
 	TotalPages int   `json:"totalPages,omitempty"`
 	Page       int   `json:"page,omitempty"`
 	Limit      int   `json:"limit,omitempty"`
+	// Mode cursor (keyset): total/halaman tidak dikirim, klien cukup mengikuti cursor
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
 
 func NewPaginationMeta(total int64, page, limit int) PaginationMeta {
@@ -26,11 +29,21 @@ func NewPaginationMeta(total int64, page, limit int) PaginationMeta {
 	}
 }
 
+func NewCursorMeta(limit int, next, prev string) PaginationMeta {
+	return PaginationMeta{
+		Limit:      limit,
+		NextCursor: next,
+		PrevCursor: prev,
+	}
+}
+
 type ApiEnvelope struct {
-	Ok    bool            `json:"ok"`
-	Data  any             `json:"data,omitempty"`
-	Meta  *PaginationMeta `json:"meta,omitempty"`
-	Error any             `json:"error,omitempty"`
+	Ok   bool            `json:"ok"`
+	Data any             `json:"data,omitempty"`
+	Meta *PaginationMeta `json:"meta,omitempty"`
+	// Facets agregasi filter sidebar (hanya di listing yang mendukung)
+	Facets any `json:"facets,omitempty"`
+	Error  any `json:"error,omitempty"`
 }
 
 func Success(c *gin.Context, status int, data interface{}, meta *PaginationMeta) {
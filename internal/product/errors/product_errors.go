@@ -30,15 +30,222 @@ var (
 		http.StatusNotFound,
 	)
 
+	ErrInvalidVariantID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid variant ID",
+		http.StatusBadRequest,
+	)
+
+	ErrVariantNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Product variant not found",
+		http.StatusNotFound,
+	)
+
+	ErrVariantSKUExists = apperror.New(
+		apperror.CodeConflict,
+		"Variant SKU already exists",
+		http.StatusConflict,
+	)
+
+	ErrInvalidVariantInput = apperror.New(
+		apperror.CodeInvalidInput,
+		"Variant must have at least one option",
+		http.StatusBadRequest,
+	)
+
+	ErrUnknownAttribute = apperror.New(
+		apperror.CodeInvalidInput,
+		"Attribute is not defined for this product's category",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidAttributeValue = apperror.New(
+		apperror.CodeInvalidInput,
+		"Attribute value does not match its data type",
+		http.StatusBadRequest,
+	)
+
 	ErrProductFailed = apperror.New(
 		apperror.CodeInternalError,
 		"Failed to process product operation",
 		http.StatusInternalServerError,
 	)
 
+	ErrInvalidImageID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid image ID",
+		http.StatusBadRequest,
+	)
+
+	ErrProductImageNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Product image not found",
+		http.StatusNotFound,
+	)
+
+	ErrTooManyImages = apperror.New(
+		apperror.CodeInvalidInput,
+		"Product gallery image limit exceeded",
+		http.StatusBadRequest,
+	)
+
 	ErrImageUploadFailed = apperror.New(
 		apperror.CodeInternalError,
 		"Failed to upload image",
 		http.StatusInternalServerError,
 	)
+
+	// Autocomplete melewati budget waktu; handler membalas saran kosong
+	ErrSuggestTimeout = apperror.New(
+		apperror.CodeInternalError,
+		"Suggestion query timed out",
+		http.StatusServiceUnavailable,
+	)
+
+	ErrImportInvalidFile = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid CSV file",
+		http.StatusBadRequest,
+	)
+
+	ErrImportTooManyRows = apperror.New(
+		apperror.CodeInvalidInput,
+		"CSV file exceeds the maximum number of rows",
+		http.StatusBadRequest,
+	)
+
+	ErrImportJobNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Import job not found",
+		http.StatusNotFound,
+	)
+
+	// Mutasi membuat saldo stok minus (atau produk/variant tidak ditemukan saat update saldo)
+	ErrInsufficientStock = apperror.New(
+		apperror.CodeConflict,
+		"Insufficient stock for this movement",
+		http.StatusConflict,
+	)
+
+	ErrInvalidStockReason = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid stock movement reason",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidWarehouseID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid warehouse ID",
+		http.StatusBadRequest,
+	)
+
+	ErrWarehouseNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Warehouse not found",
+		http.StatusNotFound,
+	)
+
+	// Produk bervariant: langganan stok harus menyebut variant-nya
+	ErrVariantRequired = apperror.New(
+		apperror.CodeInvalidInput,
+		"Please select a product variant",
+		http.StatusBadRequest,
+	)
+
+	// Notify-me hanya untuk produk/variant yang stoknya habis
+	ErrProductInStock = apperror.New(
+		apperror.CodeConflict,
+		"Product is currently in stock",
+		http.StatusConflict,
+	)
+
+	ErrStockNotificationNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Stock notification subscription not found",
+		http.StatusNotFound,
+	)
+
+	// Skor relevansi tidak stabil untuk keyset, pencarian relevansi tetap pakai page/limit
+	ErrCursorSortUnsupported = apperror.New(
+		apperror.CodeInvalidInput,
+		"Cursor pagination is not supported for relevance and popularity sorts",
+		http.StatusBadRequest,
+	)
+	ErrInvalidPriceScheduleID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid price schedule ID",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidPriceScheduleStatus = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid price schedule status",
+		http.StatusBadRequest,
+	)
+
+	ErrPriceScheduleNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Price schedule not found",
+		http.StatusNotFound,
+	)
+
+	// Jadwal baru tidak boleh beririsan dengan jadwal lain yang belum selesai
+	ErrPriceScheduleOverlap = apperror.New(
+		apperror.CodeConflict,
+		"Price schedule overlaps with another pending or active schedule",
+		http.StatusConflict,
+	)
+
+	ErrPriceScheduleInPast = apperror.New(
+		apperror.CodeInvalidInput,
+		"Price schedule must start in the future",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidPriceSchedulePeriod = apperror.New(
+		apperror.CodeInvalidInput,
+		"Price schedule must end after it starts",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidDiscountPrice = apperror.New(
+		apperror.CodeInvalidInput,
+		"Discount price must be lower than price",
+		http.StatusBadRequest,
+	)
+
+	// Hanya jadwal PENDING/ACTIVE yang bisa dibatalkan
+	ErrPriceScheduleNotCancellable = apperror.New(
+		apperror.CodeConflict,
+		"Price schedule has already finished",
+		http.StatusConflict,
+	)
+
+	ErrInvalidProductStatus = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid product status",
+		http.StatusBadRequest,
+	)
+
+	// SCHEDULED wajib punya publishAt di masa depan
+	ErrInvalidPublishAt = apperror.New(
+		apperror.CodeInvalidInput,
+		"Scheduled products need a publishAt in the future",
+		http.StatusBadRequest,
+	)
+
+	// Token rusak, kedaluwarsa, atau milik produk lain
+	ErrInvalidPreviewToken = apperror.New(
+		apperror.CodeUnauthorized,
+		"Invalid or expired preview token",
+		http.StatusUnauthorized,
+	)
+
+	// Slug baru bentrok dengan slug lama produk lain (slug_history)
+	ErrSlugUnavailable = apperror.New(
+		apperror.CodeConflict,
+		"Product slug was previously used by another product",
+		http.StatusConflict,
+	)
 )
@@ -0,0 +1,229 @@
+package product
+
+import (
+	"context"
+	"go-gadget-api/internal/pkg/cursor"
+	"go-gadget-api/internal/shared/cache"
+	"io"
+	"mime/multipart"
+	"time"
+)
+
+// TTL dibuat pendek karena stok berubah lewat checkout tanpa invalidasi;
+// perubahan dari admin langsung terlihat karena versi entity dinaikkan.
+const (
+	publicListCacheTTL = time.Minute
+	detailCacheTTL     = time.Minute
+	suggestCacheTTL    = 5 * time.Minute
+	// Bought-together hanya berubah saat worker menghitung ulang
+	recommendationCacheTTL = 5 * time.Minute
+)
+
+type recommendationKey struct {
+	Slug  string `json:"slug"`
+	Limit int    `json:"limit"`
+}
+
+// Listing & facet juga bergantung pada nama brand/kategori dan rating review
+var catalogCacheDeps = []string{cache.EntityProduct, cache.EntityBrand, cache.EntityCategory, cache.EntityReview}
+
+type publicListPage struct {
+	Items []ProductPublicResponse `json:"items"`
+	Total int64                   `json:"total"`
+}
+
+// cachedService read-through cache untuk endpoint publik katalog.
+// Method yang tidak di-override (admin) diteruskan langsung ke Service asli.
+type cachedService struct {
+	Service
+	cache *cache.Cache
+}
+
+func NewCachedService(svc Service, c *cache.Cache) Service {
+	return &cachedService{Service: svc, cache: c}
+}
+
+func (s *cachedService) ListPublic(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, int64, error) {
+	page, err := cache.Remember(ctx, s.cache, "product:list", catalogCacheDeps, publicListCacheTTL, req,
+		func(ctx context.Context) (publicListPage, error) {
+			items, total, err := s.Service.ListPublic(ctx, req)
+			return publicListPage{Items: items, Total: total}, err
+		})
+	return page.Items, page.Total, err
+}
+
+type publicCursorPage struct {
+	Items []ProductPublicResponse `json:"items"`
+	Next  string                  `json:"next"`
+	Prev  string                  `json:"prev"`
+}
+
+func (s *cachedService) ListPublicCursor(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, cursor.Page, error) {
+	page, err := cache.Remember(ctx, s.cache, "product:list_cursor", catalogCacheDeps, publicListCacheTTL, req,
+		func(ctx context.Context) (publicCursorPage, error) {
+			items, p, err := s.Service.ListPublicCursor(ctx, req)
+			return publicCursorPage{Items: items, Next: p.Next, Prev: p.Prev}, err
+		})
+	return page.Items, cursor.Page{Next: page.Next, Prev: page.Prev}, err
+}
+
+func (s *cachedService) GetFacets(ctx context.Context, req ListPublicRequest) (ProductFacetsResponse, error) {
+	return cache.Remember(ctx, s.cache, "product:facets", catalogCacheDeps, publicListCacheTTL, req,
+		func(ctx context.Context) (ProductFacetsResponse, error) {
+			return s.Service.GetFacets(ctx, req)
+		})
+}
+
+func (s *cachedService) GetBySlug(ctx context.Context, slug string) (ProductDetailResponse, error) {
+	return cache.Remember(ctx, s.cache, "product:detail", catalogCacheDeps, detailCacheTTL, slug,
+		func(ctx context.Context) (ProductDetailResponse, error) {
+			return s.Service.GetBySlug(ctx, slug)
+		})
+}
+
+func (s *cachedService) Suggest(ctx context.Context, query string) (SuggestResponse, error) {
+	deps := []string{cache.EntityProduct, cache.EntityBrand, cache.EntityCategory}
+	return cache.Remember(ctx, s.cache, "product:suggest", deps, suggestCacheTTL, normalizeSuggestQuery(query),
+		func(ctx context.Context) (SuggestResponse, error) {
+			return s.Service.Suggest(ctx, query)
+		})
+}
+
+func (s *cachedService) ListRelated(ctx context.Context, slug string, limit int) ([]ProductPublicResponse, error) {
+	return cache.Remember(ctx, s.cache, "product:related", catalogCacheDeps, recommendationCacheTTL, recommendationKey{slug, limit},
+		func(ctx context.Context) ([]ProductPublicResponse, error) {
+			return s.Service.ListRelated(ctx, slug, limit)
+		})
+}
+
+func (s *cachedService) ListBoughtTogether(ctx context.Context, slug string, limit int) ([]ProductPublicResponse, error) {
+	return cache.Remember(ctx, s.cache, "product:bought_together", catalogCacheDeps, recommendationCacheTTL, recommendationKey{slug, limit},
+		func(ctx context.Context) ([]ProductPublicResponse, error) {
+			return s.Service.ListBoughtTogether(ctx, slug, limit)
+		})
+}
+
+// ==================== MUTATIONS (invalidate) ====================
+
+func (s *cachedService) invalidate(ctx context.Context, err error) {
+	if err == nil {
+		s.cache.Invalidate(ctx, cache.EntityProduct)
+	}
+}
+
+func (s *cachedService) Create(ctx context.Context, req CreateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error) {
+	res, err := s.Service.Create(ctx, req, file, filename)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Update(ctx context.Context, idStr string, req UpdateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error) {
+	res, err := s.Service.Update(ctx, idStr, req, file, filename)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Delete(ctx context.Context, id string) error {
+	err := s.Service.Delete(ctx, id)
+	s.invalidate(ctx, err)
+	return err
+}
+
+func (s *cachedService) Restore(ctx context.Context, id string) (ProductAdminResponse, error) {
+	res, err := s.Service.Restore(ctx, id)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) CreateVariant(ctx context.Context, productID string, req CreateVariantRequest) (ProductVariantResponse, error) {
+	res, err := s.Service.CreateVariant(ctx, productID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) UpdateVariant(ctx context.Context, productID, variantID string, req UpdateVariantRequest) (ProductVariantResponse, error) {
+	res, err := s.Service.UpdateVariant(ctx, productID, variantID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	err := s.Service.DeleteVariant(ctx, productID, variantID)
+	s.invalidate(ctx, err)
+	return err
+}
+
+func (s *cachedService) SetAttributes(ctx context.Context, productID string, req SetAttributesRequest) ([]ProductAttributeResponse, error) {
+	res, err := s.Service.SetAttributes(ctx, productID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) UploadImages(ctx context.Context, productID string, files []ImageUpload) ([]ProductImageResponse, error) {
+	res, err := s.Service.UploadImages(ctx, productID, files)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) UpdateImage(ctx context.Context, productID, imageID string, req UpdateImageRequest) (ProductImageResponse, error) {
+	res, err := s.Service.UpdateImage(ctx, productID, imageID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) ReorderImages(ctx context.Context, productID string, req ReorderImagesRequest) ([]ProductImageResponse, error) {
+	res, err := s.Service.ReorderImages(ctx, productID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) DeleteImage(ctx context.Context, productID, imageID string) error {
+	err := s.Service.DeleteImage(ctx, productID, imageID)
+	s.invalidate(ctx, err)
+	return err
+}
+
+func (s *cachedService) AdjustStock(ctx context.Context, productID string, req StockAdjustmentRequest) (StockMovementResponse, error) {
+	res, err := s.Service.AdjustStock(ctx, productID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+// Jadwal PENDING belum mengubah harga, hanya pembatalan & eksekusi worker yang perlu invalidasi
+func (s *cachedService) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) (PriceScheduleResponse, error) {
+	res, err := s.Service.CancelPriceSchedule(ctx, productID, scheduleID)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) RunPriceSchedules(ctx context.Context, now time.Time) (PriceScheduleRunResult, error) {
+	res, err := s.Service.RunPriceSchedules(ctx, now)
+	if res.Applied+res.Ended > 0 {
+		s.cache.Invalidate(ctx, cache.EntityProduct)
+	}
+	return res, err
+}
+
+func (s *cachedService) SetPublication(ctx context.Context, id string, req SetPublicationRequest) (ProductAdminResponse, error) {
+	res, err := s.Service.SetPublication(ctx, id, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) PublishDueProducts(ctx context.Context, now time.Time) (int64, error) {
+	n, err := s.Service.PublishDueProducts(ctx, now)
+	if n > 0 {
+		s.cache.Invalidate(ctx, cache.EntityProduct)
+	}
+	return n, err
+}
+
+// Import berjalan async, jadi invalidasi dilakukan saat job selesai (bukan saat request)
+func (s *cachedService) StartImport(ctx context.Context, req ImportProductsRequest, file io.Reader) (ImportJobResponse, error) {
+	req.onFinish = func(ctx context.Context, job ImportJobResponse) {
+		if !job.DryRun && job.Created+job.Updated > 0 {
+			s.cache.Invalidate(ctx, cache.EntityProduct)
+		}
+	}
+	return s.Service.StartImport(ctx, req, file)
+}
@@ -1,6 +1,10 @@
 package product
 
-import "time"
+import (
+	"context"
+	"mime/multipart"
+	"time"
+)
 
 // ==================== REQUEST STRUCTS ====================
 
@@ -15,7 +19,11 @@ type ListPublicRequest struct {
 	CategoryIDs []string
 	MinPrice    float64
 	MaxPrice    float64
+	MinRating   float64
+	InStock     *bool // nil = semua
 	SortBy      string
+	Attributes  map[string]string // ?attr[ram]=8GB, beberapa nilai dipisah koma = OR
+	Cursor      string            // mode keyset (ListPublicCursor), kosong = halaman pertama
 }
 
 type ListPublicQuery struct {
@@ -26,7 +34,9 @@ type ListPublicQuery struct {
 	CategoryIDs []string `form:"category_ids"`
 	MinPrice    float64  `form:"min_price"`
 	MaxPrice    float64  `form:"max_price"`
-	SortBy      string   `form:"sort_by,default=newest"`
+	MinRating   float64  `form:"min_rating" binding:"min=0,max=5"`
+	InStock     *bool    `form:"in_stock"`
+	SortBy      string   `form:"sort_by,default=newest"` // newest | oldest | price_high | price_low | relevance | best_selling | top_rated | trending
 }
 
 type ListProductAdminRequest struct {
@@ -48,26 +58,77 @@ type ListAdminQuery struct {
 }
 
 type CreateProductRequest struct {
-	BrandID     string  `json:"brandId" validate:"required"`
-	CategoryID  string  `json:"categoryId" validate:"required"`
-	Name        string  `json:"name" validate:"required"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" validate:"required,gt=0"`
-	Stock       int32   `json:"stock" validate:"required,min=0"`
-	SKU         string  `json:"sku"`
-	ImageUrl    string  `json:"imageUrl"`
+	BrandID        string  `json:"brandId" validate:"required"`
+	CategoryID     string  `json:"categoryId" validate:"required"`
+	Name           string  `json:"name" validate:"required"`
+	Description    string  `json:"description"`
+	Price          float64 `json:"price" validate:"required,gt=0"`
+	Stock          int32   `json:"stock" validate:"required,min=0"`
+	SKU            string  `json:"sku"`
+	ImageUrl       string  `json:"imageUrl"`
+	MaxQtyPerOrder *int32  `json:"maxQtyPerOrder" validate:"omitempty,min=0"` // nil/0 = tidak dibatasi
+	// Alert PRODUCT_LOW_STOCK dikirim saat stok turun di bawah angka ini (0 = nonaktif)
+	LowStockThreshold int32 `json:"lowStockThreshold" validate:"min=0"`
+	// Kosong = PUBLISHED; SCHEDULED wajib mengisi PublishAt
+	Status    string     `json:"status" validate:"omitempty,oneof=DRAFT SCHEDULED PUBLISHED"`
+	PublishAt *time.Time `json:"publishAt"`
 }
 
 type UpdateProductRequest struct {
-	BrandID     string  `json:"brandId"`
-	CategoryID  string  `json:"categoryId"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" validate:"omitempty,gt=0"`
-	Stock       int32   `json:"stock" validate:"omitempty,min=0"`
-	SKU         string  `json:"sku"`
-	ImageUrl    string  `json:"imageUrl"`
-	IsActive    *bool   `json:"isActive"` // Tetap menggunakan pointer untuk opsionalitas
+	BrandID           string  `json:"brandId"`
+	CategoryID        string  `json:"categoryId"`
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	Price             float64 `json:"price" validate:"omitempty,gt=0"`
+	Stock             int32   `json:"stock" validate:"omitempty,min=0"`
+	SKU               string  `json:"sku"`
+	ImageUrl          string  `json:"imageUrl"`
+	IsActive          *bool   `json:"isActive"`                                     // Tetap menggunakan pointer untuk opsionalitas
+	MaxQtyPerOrder    *int32  `json:"maxQtyPerOrder" validate:"omitempty,min=0"`    // nil = tidak diubah, 0 = hapus batas
+	LowStockThreshold *int32  `json:"lowStockThreshold" validate:"omitempty,min=0"` // nil = tidak diubah, 0 = matikan alert
+}
+
+// CreateVariantRequest: options berisi pasangan nama opsi -> nilai, mis. {"Color": "Black", "Storage": "128GB"}
+type CreateVariantRequest struct {
+	SKU           string            `json:"sku" binding:"required"`
+	Price         float64           `json:"price" binding:"required,gt=0"`
+	DiscountPrice *float64          `json:"discountPrice" binding:"omitempty,gt=0"`
+	Stock         int32             `json:"stock" binding:"min=0"`
+	ImageUrl      string            `json:"imageUrl"`
+	Options       map[string]string `json:"options" binding:"required,min=1"`
+}
+
+// UpdateVariantRequest: field nil/kosong = tidak diubah. Kombinasi opsi tidak bisa diubah, buat variant baru.
+type UpdateVariantRequest struct {
+	SKU           string   `json:"sku"`
+	Price         float64  `json:"price" binding:"omitempty,gt=0"`
+	DiscountPrice *float64 `json:"discountPrice" binding:"omitempty,min=0"` // 0 = hapus harga diskon
+	Stock         *int32   `json:"stock" binding:"omitempty,min=0"`
+	ImageUrl      *string  `json:"imageUrl"`
+	IsActive      *bool    `json:"isActive"`
+}
+
+// SetAttributesRequest: key = code atribut kategori, value bertipe sesuai dataType (null = hapus nilai)
+type SetAttributesRequest struct {
+	Values map[string]any `json:"values" binding:"required"`
+}
+
+// ImageUpload satu file dari upload multi-gambar galeri
+type ImageUpload struct {
+	File     multipart.File
+	Filename string
+	AltText  string
+}
+
+// UpdateImageRequest: isPrimary hanya bisa true, primary lama otomatis dilepas
+type UpdateImageRequest struct {
+	AltText   *string `json:"altText" binding:"omitempty,max=255"`
+	IsPrimary *bool   `json:"isPrimary"`
+}
+
+// ReorderImagesRequest: urutan imageIds = urutan tampil di galeri
+type ReorderImagesRequest struct {
+	ImageIDs []string `json:"imageIds" binding:"required,min=1"`
 }
 
 // ==================== RESPONSE STRUCTS ====================
@@ -81,6 +142,51 @@ type ProductPublicResponse struct {
 	Slug         string  `json:"slug"`
 	Price        float64 `json:"price"`
 	ImageURL     string  `json:"imageUrl,omitempty"`
+
+	// Hanya terisi saat ada ?search=
+	Highlight *SearchHighlight `json:"highlight,omitempty"`
+}
+
+// ProductFacetsResponse jumlah produk per opsi filter sidebar.
+// Tiap facet dihitung tanpa filternya sendiri, jadi opsi lain tetap terlihat.
+type ProductFacetsResponse struct {
+	Brands      []FacetBucket      `json:"brands"`
+	Categories  []FacetBucket      `json:"categories"`
+	PriceRanges []PriceFacetBucket `json:"priceRanges"`
+	Ratings     []FacetBucket      `json:"ratings"` // kumulatif, key "4" = rating 4 ke atas
+	Stock       []FacetBucket      `json:"stock"`
+}
+
+type FacetBucket struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+type PriceFacetBucket struct {
+	Min   float64  `json:"min"`
+	Max   *float64 `json:"max,omitempty"` // nil = tanpa batas atas
+	Label string   `json:"label"`
+	Count int64    `json:"count"`
+}
+
+// SuggestResponse hasil autocomplete search box, dikelompokkan per jenis
+type SuggestResponse struct {
+	Products   []SuggestionItem `json:"products"`
+	Brands     []SuggestionItem `json:"brands"`
+	Categories []SuggestionItem `json:"categories"`
+}
+
+type SuggestionItem struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// SearchHighlight potongan teks dengan kata yang cocok dibungkus <mark>
+type SearchHighlight struct {
+	Name    string  `json:"name"`
+	Snippet string  `json:"snippet,omitempty"`
+	Score   float64 `json:"score"`
 }
 
 // ProductDetailResponse untuk detail produk dengan reviews
@@ -90,14 +196,26 @@ type ProductDetailResponse struct {
 	Slug           string            `json:"slug"`
 	Description    string            `json:"description"`
 	Price          float64           `json:"price"`
+	DiscountPrice  *float64          `json:"discountPrice,omitempty"`
+	LowestPrice30d float64           `json:"lowestPrice30d"` // harga terendah 30 hari terakhir (aturan perlindungan konsumen)
 	Stock          int32             `json:"stock"`
 	CategoryID     string            `json:"categoryId,omitempty"`
 	CategoryName   string            `json:"categoryName,omitempty"`
+	Breadcrumbs    []BreadcrumbItem  `json:"breadcrumbs"` // root -> kategori produk
 	BrandID        string            `json:"brandId,omitempty"`
 	BrandName      string            `json:"brandName,omitempty"`
-	ImageURL       string            `json:"imageUrl,omitempty"`
+	ImageURL       string            `json:"imageUrl,omitempty"` // thumbnail = gambar primary
 	SKU            string            `json:"sku,omitempty"`
-	Specifications map[string]string `json:"specifications,omitempty"`
+	MaxQtyPerOrder int32             `json:"maxQtyPerOrder,omitempty"`
+	Specifications map[string]string `json:"specifications,omitempty"` // nama atribut -> nilai siap tampil, mis. "RAM": "8 GB"
+
+	Attributes []ProductAttributeResponse `json:"attributes,omitempty"`
+
+	Images []ProductImageResponse `json:"images"` // galeri, urut sesuai sortOrder
+
+	// Variant fields, kosong jika produk tidak punya variant
+	Options  []ProductOptionResponse  `json:"options,omitempty"`
+	Variants []ProductVariantResponse `json:"variants,omitempty"`
 
 	// Review fields
 	Reviews       []ReviewSummary `json:"reviews"`
@@ -108,6 +226,45 @@ type ProductDetailResponse struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+type BreadcrumbItem struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// ProductOptionResponse daftar nilai yang tersedia untuk satu opsi (mis. Color: Black, White)
+type ProductOptionResponse struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type ProductVariantResponse struct {
+	ID            string            `json:"id"`
+	SKU           string            `json:"sku"`
+	Price         float64           `json:"price"`
+	DiscountPrice *float64          `json:"discountPrice,omitempty"`
+	Stock         int32             `json:"stock"`
+	ImageURL      string            `json:"imageUrl,omitempty"`
+	Options       map[string]string `json:"options"`
+	IsActive      bool              `json:"isActive"`
+}
+
+// ProductAttributeResponse nilai spesifikasi bertipe (string, number, atau boolean)
+type ProductAttributeResponse struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	DataType string `json:"dataType"`
+	Unit     string `json:"unit,omitempty"`
+	Value    any    `json:"value"`
+}
+
+type ProductImageResponse struct {
+	ID        string `json:"id"`
+	ImageURL  string `json:"imageUrl"`
+	AltText   string `json:"altText,omitempty"`
+	SortOrder int32  `json:"sortOrder"`
+	IsPrimary bool   `json:"isPrimary"`
+}
+
 // ReviewSummary for product detail (5 reviews terbaru)
 type ReviewSummary struct {
 	ID        string    `json:"id"`
@@ -119,22 +276,178 @@ type ReviewSummary struct {
 
 // ProductAdminResponse untuk dashboard admin
 type ProductAdminResponse struct {
-	ID           string    `json:"id"`
-	CategoryID   string    `json:"categoryId"`
-	CategoryName string    `json:"categoryName"`
-	BrandID      string    `json:"brandId"`
-	Name         string    `json:"name"`
-	Slug         string    `json:"slug"`
-	Price        float64   `json:"price"`
-	Stock        int32     `json:"stock"`
-	SKU          string    `json:"sku"`
-	ImageURL     string    `json:"imageUrl,omitempty"`
-	IsActive     bool      `json:"isActive"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID                string     `json:"id"`
+	CategoryID        string     `json:"categoryId"`
+	CategoryName      string     `json:"categoryName"`
+	BrandID           string     `json:"brandId"`
+	Name              string     `json:"name"`
+	Slug              string     `json:"slug"`
+	Price             float64    `json:"price"`
+	Stock             int32      `json:"stock"`
+	SKU               string     `json:"sku"`
+	ImageURL          string     `json:"imageUrl,omitempty"`
+	IsActive          bool       `json:"isActive"`
+	MaxQtyPerOrder    int32      `json:"maxQtyPerOrder"`    // 0 = tidak dibatasi
+	LowStockThreshold int32      `json:"lowStockThreshold"` // 0 = alert stok menipis nonaktif
+	Status            string     `json:"status"`
+	PublishAt         *time.Time `json:"publishAt,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+// ==================== PUBLIKASI ====================
+
+type SetPublicationRequest struct {
+	Status    string     `json:"status" validate:"required,oneof=DRAFT SCHEDULED PUBLISHED ARCHIVED"`
+	PublishAt *time.Time `json:"publishAt"` // wajib untuk SCHEDULED
+}
+
+type PreviewTokenRequest struct {
+	ExpiresInHours int `json:"expiresInHours" validate:"omitempty,min=1,max=168"` // default 24 jam
+}
+
+type PreviewTokenResponse struct {
+	Token     string    `json:"token"`
+	Slug      string    `json:"slug"`
+	ExpiresAt time.Time `json:"expiresAt"`
 }
 
 type EligibilityResponse struct {
 	CanReview bool   `json:"canReview"`
 	Reason    string `json:"reason,omitempty"`
 }
+
+// ==================== IMPORT / EXPORT CSV ====================
+
+type ImportProductsRequest struct {
+	UserID   string
+	Filename string
+	DryRun   bool // hanya validasi & hitung create/update, tanpa menulis ke DB
+
+	// dipanggil setelah job selesai (dipakai decorator cache untuk invalidasi)
+	onFinish func(ctx context.Context, job ImportJobResponse)
+}
+
+type ImportRowError struct {
+	Row     int    `json:"row"` // nomor baris di file CSV (header = baris 1)
+	SKU     string `json:"sku,omitempty"`
+	Message string `json:"message"`
+}
+
+type ImportJobResponse struct {
+	ID            string           `json:"id"`
+	Status        string           `json:"status"` // PENDING | PROCESSING | COMPLETED | FAILED
+	DryRun        bool             `json:"dryRun"`
+	Filename      string           `json:"filename,omitempty"`
+	TotalRows     int32            `json:"totalRows"`
+	ProcessedRows int32            `json:"processedRows"`
+	Created       int32            `json:"created"`
+	Updated       int32            `json:"updated"`
+	Failed        int32            `json:"failed"`
+	Errors        []ImportRowError `json:"errors"`
+	Message       string           `json:"message,omitempty"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	StartedAt     *time.Time       `json:"startedAt,omitempty"`
+	FinishedAt    *time.Time       `json:"finishedAt,omitempty"`
+}
+
+// StockAdjustmentRequest koreksi stok manual oleh admin (mis. stock opname, barang rusak).
+// Delta positif menambah stok, negatif mengurangi; note wajib sebagai alasan.
+// WarehouseID kosong = gudang default.
+type StockAdjustmentRequest struct {
+	VariantID   string `json:"variantId" validate:"omitempty,uuid"`
+	WarehouseID string `json:"warehouseId" validate:"omitempty,uuid"`
+	Delta       int32  `json:"delta" validate:"required"`
+	Note        string `json:"note" validate:"required,max=500"`
+}
+
+type ListStockMovementsRequest struct {
+	Page        int
+	Limit       int
+	Reason      string // SALE | CANCELLATION | REFUND | ADJUSTMENT | IMPORT | TRANSFER, kosong = semua
+	WarehouseID string // kosong = semua gudang
+}
+
+type StockMovementResponse struct {
+	ID            string    `json:"id"`
+	ProductID     string    `json:"productId"`
+	VariantID     string    `json:"variantId,omitempty"`
+	VariantSKU    string    `json:"variantSku,omitempty"`
+	WarehouseID   string    `json:"warehouseId"`
+	WarehouseName string    `json:"warehouseName,omitempty"`
+	Delta         int32     `json:"delta"`
+	BalanceAfter  int32     `json:"balanceAfter"` // total semua gudang
+	Reason        string    `json:"reason"`
+	ReferenceID   string    `json:"referenceId,omitempty"`
+	ActorID       string    `json:"actorId,omitempty"`
+	ActorName     string    `json:"actorName,omitempty"`
+	Note          string    `json:"note,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// ==================== PRICE SCHEDULE ====================
+
+// CreatePriceScheduleRequest harga baru berlaku mulai startsAt (diterapkan worker).
+// endsAt kosong = permanen; jika diisi, harga sebelumnya dikembalikan saat endsAt.
+type CreatePriceScheduleRequest struct {
+	Price         float64    `json:"price" validate:"required,gt=0"`
+	DiscountPrice *float64   `json:"discountPrice" validate:"omitempty,gt=0"`
+	StartsAt      time.Time  `json:"startsAt" validate:"required"`
+	EndsAt        *time.Time `json:"endsAt"`
+	Note          string     `json:"note" validate:"max=500"`
+}
+
+type ListPriceSchedulesRequest struct {
+	Page   int
+	Limit  int
+	Status string // PENDING | ACTIVE | COMPLETED | CANCELLED | EXPIRED, kosong = semua
+}
+
+type PriceScheduleResponse struct {
+	ID                    string     `json:"id"`
+	ProductID             string     `json:"productId"`
+	Price                 float64    `json:"price"`
+	DiscountPrice         *float64   `json:"discountPrice,omitempty"`
+	StartsAt              time.Time  `json:"startsAt"`
+	EndsAt                *time.Time `json:"endsAt,omitempty"`
+	Status                string     `json:"status"`
+	PreviousPrice         *float64   `json:"previousPrice,omitempty"` // terisi setelah jadwal diterapkan
+	PreviousDiscountPrice *float64   `json:"previousDiscountPrice,omitempty"`
+	Note                  string     `json:"note,omitempty"`
+	CreatedBy             string     `json:"createdBy,omitempty"`
+	CreatedByName         string     `json:"createdByName,omitempty"`
+	AppliedAt             *time.Time `json:"appliedAt,omitempty"`
+	EndedAt               *time.Time `json:"endedAt,omitempty"`
+	CreatedAt             time.Time  `json:"createdAt"`
+}
+
+type PriceHistoryResponse struct {
+	ID            string    `json:"id"`
+	Price         float64   `json:"price"`
+	DiscountPrice *float64  `json:"discountPrice,omitempty"`
+	Source        string    `json:"source"` // INITIAL | MANUAL | IMPORT | SCHEDULE | SCHEDULE_END
+	ScheduleID    string    `json:"scheduleId,omitempty"`
+	ChangedBy     string    `json:"changedBy,omitempty"`
+	ChangedByName string    `json:"changedByName,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// PriceScheduleRunResult ringkasan satu putaran job worker
+type PriceScheduleRunResult struct {
+	Applied int
+	Ended   int
+	Expired int64
+}
+
+// ==================== STOCK NOTIFICATION ====================
+
+// StockNotificationRequest langganan email saat produk (atau variant tertentu) tersedia kembali
+type StockNotificationRequest struct {
+	VariantID string `json:"variantId" validate:"omitempty,uuid"` // wajib untuk produk bervariant
+}
+
+type StockNotificationResponse struct {
+	ProductID string    `json:"productId"`
+	VariantID string    `json:"variantId,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
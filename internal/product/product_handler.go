@@ -1,15 +1,22 @@
 package product
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/cursor"
 	"go-gadget-api/internal/pkg/httpx"
 	"go-gadget-api/internal/pkg/response"
+	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/contextutil"
 	"log"
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -50,6 +57,15 @@ func (h *Handler) GetPublicList(c *gin.Context) {
 		brandSlug = c.Query("brand_slug")
 	}
 
+	// Mode cursor aktif jika parameter cursor dikirim (kosong = halaman pertama)
+	cursorParam, cursorMode := c.GetQuery("cursor")
+
+	// Pencarian tanpa sort eksplisit diurutkan berdasarkan relevansi (relevansi hanya untuk mode page)
+	sortBy := q.SortBy
+	if q.Search != "" && c.Query("sort_by") == "" && !cursorMode {
+		sortBy = "relevance"
+	}
+
 	req := ListPublicRequest{
 		Page:        q.Page,
 		Limit:       q.Limit,
@@ -58,16 +74,81 @@ func (h *Handler) GetPublicList(c *gin.Context) {
 		CategoryIDs: q.CategoryIDs,
 		MinPrice:    q.MinPrice,
 		MaxPrice:    q.MaxPrice,
-		SortBy:      q.SortBy,
+		MinRating:   q.MinRating,
+		InStock:     q.InStock,
+		SortBy:      sortBy,
+		Attributes:  c.QueryMap("attr"),
+		Cursor:      cursorParam,
 	}
 
-	data, total, err := h.productService.ListPublic(c.Request.Context(), req)
+	// List & facet dijalankan paralel agar latensi tidak bertambah
+	var (
+		wg        sync.WaitGroup
+		facets    ProductFacetsResponse
+		facetsErr error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		facets, facetsErr = h.productService.GetFacets(c.Request.Context(), req)
+	}()
+
+	var (
+		data []ProductPublicResponse
+		meta *response.PaginationMeta
+		err  error
+	)
+	if cursorMode {
+		var page cursor.Page
+		data, page, err = h.productService.ListPublicCursor(c.Request.Context(), req)
+		cm := response.NewCursorMeta(q.Limit, page.Next, page.Prev)
+		meta = &cm
+	} else {
+		var total int64
+		data, total, err = h.productService.ListPublic(c.Request.Context(), req)
+		meta = h.makePagination(q.Page, q.Limit, total)
+	}
+	wg.Wait()
 	if err != nil {
+		var appErr *apperror.AppError
+		if errors.As(err, &appErr) {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "FETCH_ERROR", "Gagal mengambil data produk", err.Error())
 		return
 	}
 
-	response.Success(c, http.StatusOK, data, h.makePagination(q.Page, q.Limit, total))
+	env := response.ApiEnvelope{Ok: true, Data: data, Meta: meta}
+	// Facet hanya pelengkap sidebar, gagal hitung tidak menggagalkan listing
+	if facetsErr == nil {
+		env.Facets = facets
+	}
+
+	response.Conditional(c, env, time.Time{})
+}
+
+// GET /products/suggest?q= (autocomplete search box)
+func (h *Handler) Suggest(c *gin.Context) {
+	data, err := h.productService.Suggest(c.Request.Context(), c.Query("q"))
+	if errors.Is(err, producterrors.ErrSuggestTimeout) {
+		// Autocomplete tidak boleh memblok search box, cukup kosongkan saran
+		response.Success(c, http.StatusOK, SuggestResponse{
+			Products:   []SuggestionItem{},
+			Brands:     []SuggestionItem{},
+			Categories: []SuggestionItem{},
+		}, nil)
+		return
+	}
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=60")
+	response.Success(c, http.StatusOK, data, nil)
 }
 
 // 2. GET ADMIN LIST (Dashboard)
@@ -140,6 +221,32 @@ func (h *Handler) Create(c *gin.Context) {
 		Stock:       stock,
 	}
 
+	maxQty, err := parseMaxQtyForm(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", err.Error(), nil)
+		return
+	}
+	req.MaxQtyPerOrder = maxQty
+	threshold, err := parseLowStockThresholdForm(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", err.Error(), nil)
+		return
+	}
+	if threshold != nil {
+		req.LowStockThreshold = *threshold
+	}
+
+	// Publikasi opsional: status (default PUBLISHED) & publishAt RFC3339 untuk SCHEDULED
+	req.Status = c.PostForm("status")
+	if v := c.PostForm("publishAt"); v != "" {
+		publishAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "publishAt harus format RFC3339", nil)
+			return
+		}
+		req.PublishAt = &publishAt
+	}
+
 	// Debug log setelah diisi manual
 	log.Printf("Received CreateProductRequest: %+v", req)
 
@@ -161,7 +268,7 @@ func (h *Handler) Create(c *gin.Context) {
 	}
 
 	// 4. Call service
-	result, err := h.productService.Create(c.Request.Context(), req, file, filename)
+	result, err := h.productService.Create(actorContext(c), req, file, filename)
 	if err != nil {
 		// Gunakan helper apperror.ToHTTP agar error mapping Anda berjalan
 		httpErr := apperror.ToHTTP(err)
@@ -192,6 +299,12 @@ func (h *Handler) GetByID(c *gin.Context) {
 func (h *Handler) GetBySlug(c *gin.Context) {
 	res, err := h.productService.GetBySlug(c.Request.Context(), c.Param("slug"))
 	if err != nil {
+		// Slug lama dari riwayat rename diarahkan ke slug kanonik
+		var moved *apperror.MovedError
+		if errors.As(err, &moved) {
+			response.MovedPermanently(c, c.Param("slug"), moved.Slug)
+			return
+		}
 		response.Error(
 			c,
 			http.StatusNotFound,
@@ -202,9 +315,68 @@ func (h *Handler) GetBySlug(c *gin.Context) {
 		return
 	}
 
+	// View dihitung untuk skor trending (sekali per user/IP per hari); user login (lewat
+	// OptionalAuthMiddleware) juga dicatat ke "terakhir dilihat". Gagal mencatat tidak boleh
+	// menggagalkan halaman detail.
+	if err := h.productService.RecordView(c.Request.Context(), c.GetString("user_id"), c.ClientIP(), res.ID); err != nil {
+		log.Printf("[GetBySlug] Failed to record view for product %s: %v", res.ID, err)
+	}
+
+	// Tanpa Last-Modified: detail menggabungkan harga terjadwal, stok, varian, gambar, rating &
+	// review yang tidak ikut mengubah updated_at produk, jadi cukup validasi lewat ETag
+	response.SuccessConditional(c, res, nil, time.Time{})
+}
+
+// GetRelated produk serupa (kategori/brand sama, harga berdekatan)
+func (h *Handler) GetRelated(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	res, err := h.productService.ListRelated(c.Request.Context(), c.Param("slug"), limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
 	response.Success(c, http.StatusOK, res, nil)
 }
 
+// GetBoughtTogether produk yang sering dibeli bersama
+func (h *Handler) GetBoughtTogether(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	res, err := h.productService.ListBoughtTogether(c.Request.Context(), c.Param("slug"), limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ListRecentlyViewed produk yang terakhir dilihat user, terbaru di depan
+func (h *Handler) ListRecentlyViewed(c *gin.Context) {
+	res, err := h.productService.ListRecentlyViewed(c.Request.Context(), c.GetString("user_id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+func (h *Handler) ClearRecentlyViewed(c *gin.Context) {
+	if err := h.productService.ClearRecentlyViewed(c.Request.Context(), c.GetString("user_id")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
 func (h *Handler) CheckReviewEligibility(c *gin.Context) {
 	userID, _ := c.Get("user_id")
 	userIDStr, _ := userID.(string)
@@ -272,6 +444,19 @@ func (h *Handler) Update(c *gin.Context) {
 		}
 	}
 
+	maxQty, err := parseMaxQtyForm(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", err.Error(), nil)
+		return
+	}
+	req.MaxQtyPerOrder = maxQty
+	threshold, err := parseLowStockThresholdForm(c)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", err.Error(), nil)
+		return
+	}
+	req.LowStockThreshold = threshold
+
 	isActiveStr := c.PostForm("isActive")
 	if isActiveStr == "" {
 		isActiveStr = c.PostForm("is_active")
@@ -296,7 +481,7 @@ func (h *Handler) Update(c *gin.Context) {
 	}
 
 	// 4. Call service
-	res, err := h.productService.Update(c.Request.Context(), id, req, file, filename)
+	res, err := h.productService.Update(actorContext(c), id, req, file, filename)
 	if err != nil {
 		httpErr := apperror.ToHTTP(err)
 		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
@@ -341,6 +526,201 @@ func (h *Handler) Restore(c *gin.Context) {
 }
 
 // Helper: Pagination Meta
+// ==================== VARIANTS (Admin) ====================
+
+// GET /admin/products/:id/variants
+func (h *Handler) ListVariants(c *gin.Context) {
+	res, err := h.productService.ListVariants(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// POST /admin/products/:id/variants
+func (h *Handler) CreateVariant(c *gin.Context) {
+	var req CreateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data variant tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.CreateVariant(actorContext(c), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// PATCH /admin/products/:id/variants/:variantId
+func (h *Handler) UpdateVariant(c *gin.Context) {
+	var req UpdateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data variant tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.UpdateVariant(actorContext(c), c.Param("id"), c.Param("variantId"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// DELETE /admin/products/:id/variants/:variantId
+func (h *Handler) DeleteVariant(c *gin.Context) {
+	err := h.productService.DeleteVariant(c.Request.Context(), c.Param("id"), c.Param("variantId"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
+// ==================== ATTRIBUTES (Admin) ====================
+
+// GET /admin/products/:id/attributes
+func (h *Handler) GetAttributes(c *gin.Context) {
+	res, err := h.productService.GetAttributes(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// PUT /admin/products/:id/attributes
+func (h *Handler) SetAttributes(c *gin.Context) {
+	var req SetAttributesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data atribut tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.SetAttributes(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// ==================== GALLERY (Admin) ====================
+
+// GET /admin/products/:id/images
+func (h *Handler) ListImages(c *gin.Context) {
+	res, err := h.productService.ListImages(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// POST /admin/products/:id/images (multipart: images[], altTexts[] opsional sesuai urutan file)
+func (h *Handler) UploadImages(c *gin.Context) {
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_FORM", "Invalid multipart form", err.Error())
+		return
+	}
+
+	fileHeaders := c.Request.MultipartForm.File["images"]
+	if len(fileHeaders) == 0 {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Missing required files: images", nil)
+		return
+	}
+	altTexts := c.PostFormArray("altTexts")
+
+	uploads := make([]ImageUpload, 0, len(fileHeaders))
+	for i, fh := range fileHeaders {
+		f, err := fh.Open()
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "FILE_ERROR", "Failed to open file", err.Error())
+			return
+		}
+		defer f.Close()
+
+		upload := ImageUpload{File: f, Filename: fh.Filename}
+		if i < len(altTexts) {
+			upload.AltText = altTexts[i]
+		}
+		uploads = append(uploads, upload)
+	}
+
+	res, err := h.productService.UploadImages(c.Request.Context(), c.Param("id"), uploads)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// PATCH /admin/products/:id/images/:imageId
+func (h *Handler) UpdateImage(c *gin.Context) {
+	var req UpdateImageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data gambar tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.UpdateImage(c.Request.Context(), c.Param("id"), c.Param("imageId"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// PUT /admin/products/:id/images/order
+func (h *Handler) ReorderImages(c *gin.Context) {
+	var req ReorderImagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Urutan gambar tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.ReorderImages(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// DELETE /admin/products/:id/images/:imageId
+func (h *Handler) DeleteImage(c *gin.Context) {
+	if err := h.productService.DeleteImage(c.Request.Context(), c.Param("id"), c.Param("imageId")); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
 func (h *Handler) makePagination(page, limit int, total int64) *response.PaginationMeta {
 	totalPages := 0
 	if limit > 0 {
@@ -354,3 +734,310 @@ func (h *Handler) makePagination(page, limit int, total int64) *response.Paginat
 		Limit:      limit,
 	}
 }
+
+// parseMaxQtyForm membaca batas pembelian per order dari form (opsional).
+// Field kosong = tidak diisi, "0" = tanpa batas.
+func parseMaxQtyForm(c *gin.Context) (*int32, error) {
+	return parseOptionalInt32Form(c, "maxQtyPerOrder", "max_qty_per_order")
+}
+
+func parseLowStockThresholdForm(c *gin.Context) (*int32, error) {
+	return parseOptionalInt32Form(c, "lowStockThreshold", "low_stock_threshold")
+}
+
+// parseOptionalInt32Form: nil jika field tidak dikirim, error jika bukan angka (camelCase didahulukan)
+func parseOptionalInt32Form(c *gin.Context, camel, snake string) (*int32, error) {
+	v := c.PostForm(camel)
+	if v == "" {
+		v = c.PostForm(snake)
+	}
+	if v == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%s harus berupa angka", camel)
+	}
+	n32 := int32(n)
+	return &n32, nil
+}
+
+// importMaxFileSize batas ukuran CSV import (5 MB cukup untuk importMaxRows baris)
+const importMaxFileSize = 5 << 20
+
+// POST /admin/products/import (multipart: file, dryRun)
+// Diproses async, status dipantau lewat GET /admin/products/import/:jobId
+func (h *Handler) Import(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, importMaxFileSize+1<<20)
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "VALIDATION_ERROR", "Missing required file: file", nil)
+		return
+	}
+	if fh.Size > importMaxFileSize {
+		response.Error(c, http.StatusBadRequest, "FILE_TOO_LARGE", "CSV file must not exceed 5 MB", nil)
+		return
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "FILE_ERROR", "Failed to open file", err.Error())
+		return
+	}
+	defer f.Close()
+
+	dryRun, _ := strconv.ParseBool(c.DefaultPostForm("dryRun", c.Query("dryRun")))
+
+	res, err := h.productService.StartImport(c.Request.Context(), ImportProductsRequest{
+		UserID:   c.GetString("user_id"),
+		Filename: fh.Filename,
+		DryRun:   dryRun,
+	}, f)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusAccepted, res, nil)
+}
+
+// GET /admin/products/import/:jobId
+func (h *Handler) GetImportJob(c *gin.Context) {
+	res, err := h.productService.GetImportJob(c.Request.Context(), c.Param("jobId"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GET /admin/products/export (CSV, format kolom sama dengan import)
+func (h *Handler) Export(c *gin.Context) {
+	// Ditulis ke buffer dulu agar error di tengah jalan masih bisa dibalas JSON
+	var buf bytes.Buffer
+	if err := h.productService.Export(c.Request.Context(), &buf); err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	filename := fmt.Sprintf("products-%s.csv", time.Now().Format("20060102"))
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// GET /admin/products/:id/stock-movements?reason=SALE&warehouseId=
+func (h *Handler) ListStockMovements(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	data, total, err := h.productService.ListStockMovements(c.Request.Context(), c.Param("id"), ListStockMovementsRequest{
+		Page:        page,
+		Limit:       limit,
+		Reason:      c.Query("reason"),
+		WarehouseID: c.Query("warehouseId"),
+	})
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, h.makePagination(page, limit, total))
+}
+
+// POST /admin/products/:id/stock-adjustments
+func (h *Handler) AdjustStock(c *gin.Context) {
+	var req StockAdjustmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data penyesuaian stok tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.AdjustStock(actorContext(c), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// POST /admin/products/:id/price-schedules
+func (h *Handler) CreatePriceSchedule(c *gin.Context) {
+	var req CreatePriceScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data jadwal harga tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.CreatePriceSchedule(actorContext(c), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// PATCH /admin/products/:id/publication
+func (h *Handler) SetPublication(c *gin.Context) {
+	var req SetPublicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data publikasi tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.productService.SetPublication(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// POST /admin/products/:id/preview-token (body opsional)
+func (h *Handler) CreatePreviewToken(c *gin.Context) {
+	var req PreviewTokenRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data preview tidak valid", err.Error())
+			return
+		}
+	}
+
+	res, err := h.productService.CreatePreviewToken(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GET /products/:slug/preview?token=
+func (h *Handler) GetPreview(c *gin.Context) {
+	res, err := h.productService.GetPreview(c.Request.Context(), c.Param("slug"), c.Query("token"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	// Konten belum publik: jangan di-cache proxy/browser dan jangan diindeks
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Robots-Tag", "noindex")
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GET /admin/products/:id/price-schedules?status=&page=&limit=
+func (h *Handler) ListPriceSchedules(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	data, total, err := h.productService.ListPriceSchedules(c.Request.Context(), c.Param("id"), ListPriceSchedulesRequest{
+		Page:   page,
+		Limit:  limit,
+		Status: c.Query("status"),
+	})
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, h.makePagination(page, limit, total))
+}
+
+// PATCH /admin/products/:id/price-schedules/:scheduleId/cancel
+func (h *Handler) CancelPriceSchedule(c *gin.Context) {
+	res, err := h.productService.CancelPriceSchedule(actorContext(c), c.Param("id"), c.Param("scheduleId"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GET /admin/products/:id/price-history?page=&limit=
+func (h *Handler) ListPriceHistory(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	data, total, err := h.productService.ListPriceHistory(c.Request.Context(), c.Param("id"), page, limit)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, data, h.makePagination(page, limit, total))
+}
+
+// POST /products/:slug/stock-notifications (body opsional: variantId)
+func (h *Handler) SubscribeStockNotification(c *gin.Context) {
+	var req StockNotificationRequest
+	// Body boleh kosong untuk produk tanpa variant
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data langganan stok tidak valid", err.Error())
+			return
+		}
+	}
+
+	res, err := h.productService.SubscribeStockNotification(c.Request.Context(), c.GetString("user_id"), c.Param("slug"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// DELETE /products/:slug/stock-notifications?variantId=
+func (h *Handler) UnsubscribeStockNotification(c *gin.Context) {
+	err := h.productService.UnsubscribeStockNotification(c.Request.Context(), c.GetString("user_id"), c.Param("slug"), c.Query("variantId"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil, nil)
+}
+
+// actorContext menyertakan admin yang login ke context, dicatat sebagai actor di ledger stok
+func actorContext(c *gin.Context) context.Context {
+	return contextutil.WithUserID(c.Request.Context(), c.GetString("user_id"))
+}
@@ -8,10 +8,15 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/cursor"
 	"go-gadget-api/internal/product"
 	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/contextutil"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -42,14 +47,56 @@ func (f *fakeReviewService) CheckEligibility(
 }
 
 type fakeProductService struct {
-	CreateFn     func(ctx context.Context, req product.CreateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error)
-	UpdateFn     func(ctx context.Context, id string, req product.UpdateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error)
-	ListPublicFn func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error)
-	ListAdminFn  func(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error)
-	GetByIDFn    func(ctx context.Context, id string) (product.ProductAdminResponse, error)
-	GetBySlugFn  func(ctx context.Context, slug string) (product.ProductDetailResponse, error)
-	DeleteFn     func(ctx context.Context, id string) error
-	RestoreFn    func(ctx context.Context, id string) (product.ProductAdminResponse, error)
+	CreateFn           func(ctx context.Context, req product.CreateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error)
+	UpdateFn           func(ctx context.Context, id string, req product.UpdateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error)
+	ListPublicFn       func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error)
+	ListPublicCursorFn func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error)
+	SuggestFn          func(ctx context.Context, query string) (product.SuggestResponse, error)
+	GetFacetsFn        func(ctx context.Context, req product.ListPublicRequest) (product.ProductFacetsResponse, error)
+	ListAdminFn        func(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error)
+	GetByIDFn          func(ctx context.Context, id string) (product.ProductAdminResponse, error)
+	GetBySlugFn        func(ctx context.Context, slug string) (product.ProductDetailResponse, error)
+	DeleteFn           func(ctx context.Context, id string) error
+	RestoreFn          func(ctx context.Context, id string) (product.ProductAdminResponse, error)
+
+	ListVariantsFn  func(ctx context.Context, productID string) ([]product.ProductVariantResponse, error)
+	CreateVariantFn func(ctx context.Context, productID string, req product.CreateVariantRequest) (product.ProductVariantResponse, error)
+	UpdateVariantFn func(ctx context.Context, productID, variantID string, req product.UpdateVariantRequest) (product.ProductVariantResponse, error)
+	DeleteVariantFn func(ctx context.Context, productID, variantID string) error
+
+	GetAttributesFn func(ctx context.Context, productID string) ([]product.ProductAttributeResponse, error)
+	SetAttributesFn func(ctx context.Context, productID string, req product.SetAttributesRequest) ([]product.ProductAttributeResponse, error)
+
+	ListImagesFn    func(ctx context.Context, productID string) ([]product.ProductImageResponse, error)
+	UploadImagesFn  func(ctx context.Context, productID string, files []product.ImageUpload) ([]product.ProductImageResponse, error)
+	UpdateImageFn   func(ctx context.Context, productID, imageID string, req product.UpdateImageRequest) (product.ProductImageResponse, error)
+	ReorderImagesFn func(ctx context.Context, productID string, req product.ReorderImagesRequest) ([]product.ProductImageResponse, error)
+	DeleteImageFn   func(ctx context.Context, productID, imageID string) error
+
+	StartImportFn  func(ctx context.Context, req product.ImportProductsRequest, file io.Reader) (product.ImportJobResponse, error)
+	GetImportJobFn func(ctx context.Context, id string) (product.ImportJobResponse, error)
+	ExportFn       func(ctx context.Context, w io.Writer) error
+
+	ListStockMovementsFn func(ctx context.Context, productID string, req product.ListStockMovementsRequest) ([]product.StockMovementResponse, int64, error)
+	AdjustStockFn        func(ctx context.Context, productID string, req product.StockAdjustmentRequest) (product.StockMovementResponse, error)
+	SubscribeStockFn     func(ctx context.Context, userID, slug string, req product.StockNotificationRequest) (product.StockNotificationResponse, error)
+	UnsubscribeStockFn   func(ctx context.Context, userID, slug, variantID string) error
+
+	CreatePriceScheduleFn func(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error)
+	ListPriceSchedulesFn  func(ctx context.Context, productID string, req product.ListPriceSchedulesRequest) ([]product.PriceScheduleResponse, int64, error)
+	CancelPriceScheduleFn func(ctx context.Context, productID, scheduleID string) (product.PriceScheduleResponse, error)
+	ListPriceHistoryFn    func(ctx context.Context, productID string, page, limit int) ([]product.PriceHistoryResponse, int64, error)
+	RunPriceSchedulesFn   func(ctx context.Context, now time.Time) (product.PriceScheduleRunResult, error)
+	ListRelatedFn         func(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error)
+	ListBoughtTogetherFn  func(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error)
+	RecordViewFn          func(ctx context.Context, userID, clientIP, productID string) error
+	ListRecentlyViewedFn  func(ctx context.Context, userID string) ([]product.ProductPublicResponse, error)
+	ClearRecentlyViewedFn func(ctx context.Context, userID string) error
+	RefreshPopularityFn   func(ctx context.Context, now time.Time) (int64, error)
+	SetPublicationFn      func(ctx context.Context, id string, req product.SetPublicationRequest) (product.ProductAdminResponse, error)
+	PublishDueProductsFn  func(ctx context.Context, now time.Time) (int64, error)
+	CreatePreviewTokenFn  func(ctx context.Context, id string, req product.PreviewTokenRequest) (product.PreviewTokenResponse, error)
+	GetPreviewFn          func(ctx context.Context, slug, token string) (product.ProductDetailResponse, error)
 }
 
 func (f *fakeProductService) Create(ctx context.Context, req product.CreateProductRequest, file multipart.File, filename string) (product.ProductAdminResponse, error) {
@@ -73,6 +120,27 @@ func (f *fakeProductService) ListPublic(ctx context.Context, req product.ListPub
 	return f.ListPublicFn(ctx, req)
 }
 
+func (f *fakeProductService) ListPublicCursor(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+	if f.ListPublicCursorFn == nil {
+		return nil, cursor.Page{}, nil
+	}
+	return f.ListPublicCursorFn(ctx, req)
+}
+
+func (f *fakeProductService) GetFacets(ctx context.Context, req product.ListPublicRequest) (product.ProductFacetsResponse, error) {
+	if f.GetFacetsFn == nil {
+		return product.ProductFacetsResponse{}, nil
+	}
+	return f.GetFacetsFn(ctx, req)
+}
+
+func (f *fakeProductService) Suggest(ctx context.Context, query string) (product.SuggestResponse, error) {
+	if f.SuggestFn == nil {
+		return product.SuggestResponse{}, nil
+	}
+	return f.SuggestFn(ctx, query)
+}
+
 func (f *fakeProductService) ListAdmin(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error) {
 	if f.ListAdminFn == nil {
 		return nil, 0, nil
@@ -111,6 +179,241 @@ func (f *fakeProductService) Restore(
 	return f.RestoreFn(ctx, id)
 }
 
+func (f *fakeProductService) ListVariants(ctx context.Context, productID string) ([]product.ProductVariantResponse, error) {
+	if f.ListVariantsFn == nil {
+		return nil, nil
+	}
+	return f.ListVariantsFn(ctx, productID)
+}
+
+func (f *fakeProductService) CreateVariant(ctx context.Context, productID string, req product.CreateVariantRequest) (product.ProductVariantResponse, error) {
+	if f.CreateVariantFn == nil {
+		return product.ProductVariantResponse{}, nil
+	}
+	return f.CreateVariantFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) UpdateVariant(ctx context.Context, productID, variantID string, req product.UpdateVariantRequest) (product.ProductVariantResponse, error) {
+	if f.UpdateVariantFn == nil {
+		return product.ProductVariantResponse{}, nil
+	}
+	return f.UpdateVariantFn(ctx, productID, variantID, req)
+}
+
+func (f *fakeProductService) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	if f.DeleteVariantFn == nil {
+		return nil
+	}
+	return f.DeleteVariantFn(ctx, productID, variantID)
+}
+
+func (f *fakeProductService) GetAttributes(ctx context.Context, productID string) ([]product.ProductAttributeResponse, error) {
+	if f.GetAttributesFn == nil {
+		return nil, nil
+	}
+	return f.GetAttributesFn(ctx, productID)
+}
+
+func (f *fakeProductService) SetAttributes(ctx context.Context, productID string, req product.SetAttributesRequest) ([]product.ProductAttributeResponse, error) {
+	if f.SetAttributesFn == nil {
+		return nil, nil
+	}
+	return f.SetAttributesFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) ListImages(ctx context.Context, productID string) ([]product.ProductImageResponse, error) {
+	if f.ListImagesFn == nil {
+		return nil, nil
+	}
+	return f.ListImagesFn(ctx, productID)
+}
+
+func (f *fakeProductService) UploadImages(ctx context.Context, productID string, files []product.ImageUpload) ([]product.ProductImageResponse, error) {
+	if f.UploadImagesFn == nil {
+		return nil, nil
+	}
+	return f.UploadImagesFn(ctx, productID, files)
+}
+
+func (f *fakeProductService) UpdateImage(ctx context.Context, productID, imageID string, req product.UpdateImageRequest) (product.ProductImageResponse, error) {
+	if f.UpdateImageFn == nil {
+		return product.ProductImageResponse{}, nil
+	}
+	return f.UpdateImageFn(ctx, productID, imageID, req)
+}
+
+func (f *fakeProductService) ReorderImages(ctx context.Context, productID string, req product.ReorderImagesRequest) ([]product.ProductImageResponse, error) {
+	if f.ReorderImagesFn == nil {
+		return nil, nil
+	}
+	return f.ReorderImagesFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) DeleteImage(ctx context.Context, productID, imageID string) error {
+	if f.DeleteImageFn == nil {
+		return nil
+	}
+	return f.DeleteImageFn(ctx, productID, imageID)
+}
+
+func (f *fakeProductService) StartImport(ctx context.Context, req product.ImportProductsRequest, file io.Reader) (product.ImportJobResponse, error) {
+	if f.StartImportFn == nil {
+		return product.ImportJobResponse{}, nil
+	}
+	return f.StartImportFn(ctx, req, file)
+}
+
+func (f *fakeProductService) GetImportJob(ctx context.Context, id string) (product.ImportJobResponse, error) {
+	if f.GetImportJobFn == nil {
+		return product.ImportJobResponse{}, nil
+	}
+	return f.GetImportJobFn(ctx, id)
+}
+
+func (f *fakeProductService) FailStaleImports(ctx context.Context, olderThan time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeProductService) Export(ctx context.Context, w io.Writer) error {
+	if f.ExportFn == nil {
+		return nil
+	}
+	return f.ExportFn(ctx, w)
+}
+
+func (f *fakeProductService) ListStockMovements(ctx context.Context, productID string, req product.ListStockMovementsRequest) ([]product.StockMovementResponse, int64, error) {
+	if f.ListStockMovementsFn == nil {
+		return nil, 0, nil
+	}
+	return f.ListStockMovementsFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) AdjustStock(ctx context.Context, productID string, req product.StockAdjustmentRequest) (product.StockMovementResponse, error) {
+	if f.AdjustStockFn == nil {
+		return product.StockMovementResponse{}, nil
+	}
+	return f.AdjustStockFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) SubscribeStockNotification(ctx context.Context, userID, slug string, req product.StockNotificationRequest) (product.StockNotificationResponse, error) {
+	if f.SubscribeStockFn == nil {
+		return product.StockNotificationResponse{}, nil
+	}
+	return f.SubscribeStockFn(ctx, userID, slug, req)
+}
+
+func (f *fakeProductService) UnsubscribeStockNotification(ctx context.Context, userID, slug, variantID string) error {
+	if f.UnsubscribeStockFn == nil {
+		return nil
+	}
+	return f.UnsubscribeStockFn(ctx, userID, slug, variantID)
+}
+
+func (f *fakeProductService) CreatePriceSchedule(ctx context.Context, productID string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+	if f.CreatePriceScheduleFn == nil {
+		return product.PriceScheduleResponse{}, nil
+	}
+	return f.CreatePriceScheduleFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) ListPriceSchedules(ctx context.Context, productID string, req product.ListPriceSchedulesRequest) ([]product.PriceScheduleResponse, int64, error) {
+	if f.ListPriceSchedulesFn == nil {
+		return nil, 0, nil
+	}
+	return f.ListPriceSchedulesFn(ctx, productID, req)
+}
+
+func (f *fakeProductService) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) (product.PriceScheduleResponse, error) {
+	if f.CancelPriceScheduleFn == nil {
+		return product.PriceScheduleResponse{}, nil
+	}
+	return f.CancelPriceScheduleFn(ctx, productID, scheduleID)
+}
+
+func (f *fakeProductService) ListPriceHistory(ctx context.Context, productID string, page, limit int) ([]product.PriceHistoryResponse, int64, error) {
+	if f.ListPriceHistoryFn == nil {
+		return nil, 0, nil
+	}
+	return f.ListPriceHistoryFn(ctx, productID, page, limit)
+}
+
+func (f *fakeProductService) RunPriceSchedules(ctx context.Context, now time.Time) (product.PriceScheduleRunResult, error) {
+	if f.RunPriceSchedulesFn == nil {
+		return product.PriceScheduleRunResult{}, nil
+	}
+	return f.RunPriceSchedulesFn(ctx, now)
+}
+
+func (f *fakeProductService) ListRelated(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+	if f.ListRelatedFn == nil {
+		return nil, nil
+	}
+	return f.ListRelatedFn(ctx, slug, limit)
+}
+
+func (f *fakeProductService) ListBoughtTogether(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+	if f.ListBoughtTogetherFn == nil {
+		return nil, nil
+	}
+	return f.ListBoughtTogetherFn(ctx, slug, limit)
+}
+
+func (f *fakeProductService) RecordView(ctx context.Context, userID, clientIP, productID string) error {
+	if f.RecordViewFn == nil {
+		return nil
+	}
+	return f.RecordViewFn(ctx, userID, clientIP, productID)
+}
+
+func (f *fakeProductService) ListRecentlyViewed(ctx context.Context, userID string) ([]product.ProductPublicResponse, error) {
+	if f.ListRecentlyViewedFn == nil {
+		return nil, nil
+	}
+	return f.ListRecentlyViewedFn(ctx, userID)
+}
+
+func (f *fakeProductService) ClearRecentlyViewed(ctx context.Context, userID string) error {
+	if f.ClearRecentlyViewedFn == nil {
+		return nil
+	}
+	return f.ClearRecentlyViewedFn(ctx, userID)
+}
+
+func (f *fakeProductService) RefreshPopularity(ctx context.Context, now time.Time) (int64, error) {
+	if f.RefreshPopularityFn == nil {
+		return 0, nil
+	}
+	return f.RefreshPopularityFn(ctx, now)
+}
+
+func (f *fakeProductService) SetPublication(ctx context.Context, id string, req product.SetPublicationRequest) (product.ProductAdminResponse, error) {
+	if f.SetPublicationFn == nil {
+		return product.ProductAdminResponse{}, nil
+	}
+	return f.SetPublicationFn(ctx, id, req)
+}
+
+func (f *fakeProductService) PublishDueProducts(ctx context.Context, now time.Time) (int64, error) {
+	if f.PublishDueProductsFn == nil {
+		return 0, nil
+	}
+	return f.PublishDueProductsFn(ctx, now)
+}
+
+func (f *fakeProductService) CreatePreviewToken(ctx context.Context, id string, req product.PreviewTokenRequest) (product.PreviewTokenResponse, error) {
+	if f.CreatePreviewTokenFn == nil {
+		return product.PreviewTokenResponse{}, nil
+	}
+	return f.CreatePreviewTokenFn(ctx, id, req)
+}
+
+func (f *fakeProductService) GetPreview(ctx context.Context, slug, token string) (product.ProductDetailResponse, error) {
+	if f.GetPreviewFn == nil {
+		return product.ProductDetailResponse{}, nil
+	}
+	return f.GetPreviewFn(ctx, slug, token)
+}
+
 //
 // ==================== HELPERS ====================
 //
@@ -229,6 +532,30 @@ func TestCreateProduct(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+
+	t.Run("invalid_max_qty", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/products", newTestHandler(&fakeProductService{}, &fakeReviewService{}).Create)
+
+		body, ct, _ := createMultipartForm(
+			map[string]string{
+				"name":           "Product",
+				"price":          "10000",
+				"maxQtyPerOrder": "lima",
+			},
+			"",
+			"",
+			nil,
+		)
+
+		req := httptest.NewRequest(http.MethodPost, "/products", body)
+		req.Header.Set("Content-Type", ct)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 //
@@ -275,6 +602,170 @@ func TestGetPublicListProducts(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+
+	t.Run("success - returns facets", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
+				return []product.ProductPublicResponse{}, 0, nil
+			},
+			GetFacetsFn: func(ctx context.Context, req product.ListPublicRequest) (product.ProductFacetsResponse, error) {
+				// Facet menerima filter yang sama dengan listing
+				assert.Equal(t, "apple", req.BrandSlug)
+				assert.Equal(t, 4.0, req.MinRating)
+				assert.True(t, *req.InStock)
+				return product.ProductFacetsResponse{
+					Brands: []product.FacetBucket{{Key: "samsung", Label: "Samsung", Count: 42}},
+				}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestHandler(svc, &fakeReviewService{}).GetPublicList)
+
+		req := httptest.NewRequest(http.MethodGet, "/products?brandSlug=apple&min_rating=4&in_stock=true", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"facets":{"brands":[{"key":"samsung","label":"Samsung","count":42}]`)
+	})
+
+	t.Run("success - facet error does not fail listing", func(t *testing.T) {
+		svc := &fakeProductService{
+			GetFacetsFn: func(ctx context.Context, req product.ListPublicRequest) (product.ProductFacetsResponse, error) {
+				return product.ProductFacetsResponse{}, producterrors.ErrProductFailed
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestHandler(svc, &fakeReviewService{}).GetPublicList)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "facets")
+	})
+
+	t.Run("success - search defaults to relevance sort", func(t *testing.T) {
+		var got []string
+		svc := &fakeProductService{
+			ListPublicFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
+				got = append(got, req.SortBy)
+				return []product.ProductPublicResponse{}, 0, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestHandler(svc, &fakeReviewService{}).GetPublicList)
+
+		for _, url := range []string{"/products?search=iphon", "/products?search=iphon&sort_by=price_low", "/products"} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+			assert.Equal(t, http.StatusOK, w.Code)
+		}
+
+		assert.Equal(t, []string{"relevance", "price_low", "newest"}, got)
+	})
+
+	t.Run("success - cursor mode", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, int64, error) {
+				t.Fatal("page mode should not be used when cursor is present")
+				return nil, 0, nil
+			},
+			ListPublicCursorFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+				assert.Equal(t, "abc", req.Cursor)
+				// Mode cursor tidak memakai relevansi sebagai default sort pencarian
+				assert.Equal(t, "newest", req.SortBy)
+				return []product.ProductPublicResponse{{ID: uuid.NewString()}}, cursor.Page{Next: "next123", Prev: "prev123"}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestHandler(svc, &fakeReviewService{}).GetPublicList)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products?cursor=abc&limit=5&search=iphone", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"meta":{"limit":5,"nextCursor":"next123","prevCursor":"prev123"}`)
+	})
+
+	t.Run("error - invalid cursor", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListPublicCursorFn: func(ctx context.Context, req product.ListPublicRequest) ([]product.ProductPublicResponse, cursor.Page, error) {
+				return nil, cursor.Page{}, cursor.ErrInvalidCursor
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products", newTestHandler(svc, &fakeReviewService{}).GetPublicList)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products?cursor=rusak", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSuggestProducts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			SuggestFn: func(ctx context.Context, query string) (product.SuggestResponse, error) {
+				assert.Equal(t, "iph", query)
+				return product.SuggestResponse{
+					Products: []product.SuggestionItem{{Name: "iPhone 15", Slug: "iphone-15"}},
+				}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/suggest", newTestHandler(svc, &fakeReviewService{}).Suggest)
+		r.GET("/products/:slug", newTestHandler(svc, &fakeReviewService{}).GetBySlug)
+
+		req := httptest.NewRequest(http.MethodGet, "/products/suggest?q=iph", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "iphone-15")
+	})
+
+	t.Run("timeout_returns_empty", func(t *testing.T) {
+		svc := &fakeProductService{
+			SuggestFn: func(ctx context.Context, query string) (product.SuggestResponse, error) {
+				return product.SuggestResponse{}, producterrors.ErrSuggestTimeout
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/suggest", newTestHandler(svc, &fakeReviewService{}).Suggest)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/suggest?q=iph", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"products":[]`)
+	})
+
+	t.Run("service_error", func(t *testing.T) {
+		svc := &fakeProductService{
+			SuggestFn: func(ctx context.Context, query string) (product.SuggestResponse, error) {
+				return product.SuggestResponse{}, producterrors.ErrProductFailed
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/suggest", newTestHandler(svc, &fakeReviewService{}).Suggest)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/suggest?q=iph", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
 }
 
 //
@@ -345,17 +836,40 @@ func TestUpdateProduct(t *testing.T) {
 
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
-}
 
-//
-// ==================== LIST ADMIN ====================
-//
+	t.Run("invalid_low_stock_threshold", func(t *testing.T) {
+		r := setupTestRouter()
+		r.PATCH("/admin/products/:id", newTestHandler(&fakeProductService{}, &fakeReviewService{}).Update)
 
-func TestListAdminProducts(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
-		svc := &fakeProductService{
-			ListAdminFn: func(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error) {
-				assert.Equal(t, 1, req.Page)
+		body, ct, _ := createMultipartForm(
+			map[string]string{
+				"name":                "Updated Product",
+				"low_stock_threshold": "5x",
+			},
+			"",
+			"",
+			nil,
+		)
+
+		req := httptest.NewRequest(http.MethodPatch, "/admin/products/"+id, body)
+		req.Header.Set("Content-Type", ct)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+//
+// ==================== LIST ADMIN ====================
+//
+
+func TestListAdminProducts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListAdminFn: func(ctx context.Context, req product.ListProductAdminRequest) ([]product.ProductAdminResponse, int64, error) {
+				assert.Equal(t, 1, req.Page)
 				assert.Equal(t, 10, req.Limit)
 				return []product.ProductAdminResponse{
 					{ID: uuid.NewString(), Name: "Product"},
@@ -479,6 +993,57 @@ func TestGetProductBySlug(t *testing.T) {
 
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
+
+	t.Run("conditional_request", func(t *testing.T) {
+		updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		reviewedAt := updatedAt.Add(time.Hour)
+		svc := &fakeProductService{
+			GetBySlugFn: func(ctx context.Context, slug string) (product.ProductDetailResponse, error) {
+				return product.ProductDetailResponse{
+					Slug:      slug,
+					UpdatedAt: updatedAt,
+					Reviews:   []product.ReviewSummary{{CreatedAt: reviewedAt}},
+				}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/:slug", newTestHandler(svc, &fakeReviewService{}).GetBySlug)
+
+		// 1. Request pertama: dapat ETag, tanpa Last-Modified
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/iphone-15", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		etag := w.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+		assert.Empty(t, w.Header().Get("Last-Modified"))
+
+		// 2. If-None-Match cocok -> 304 tanpa body
+		req := httptest.NewRequest(http.MethodGet, "/products/iphone-15", nil)
+		req.Header.Set("If-None-Match", etag)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+
+		// 3. If-Modified-Since diabaikan (harga/stok bisa berubah tanpa menyentuh updated_at) -> 200
+		req = httptest.NewRequest(http.MethodGet, "/products/iphone-15", nil)
+		req.Header.Set("If-Modified-Since", reviewedAt.Add(time.Hour).Format(http.TimeFormat))
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// 4. ETag lama tidak cocok -> 200
+		req = httptest.NewRequest(http.MethodGet, "/products/iphone-15", nil)
+		req.Header.Set("If-None-Match", `W/"stale"`)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
 }
 
 //
@@ -526,3 +1091,723 @@ func TestDeleteProduct(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
 }
+
+//
+// ==================== VARIANTS ====================
+//
+
+func TestCreateVariant(t *testing.T) {
+	productID := uuid.NewString()
+
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreateVariantFn: func(ctx context.Context, pid string, req product.CreateVariantRequest) (product.ProductVariantResponse, error) {
+				assert.Equal(t, productID, pid)
+				assert.Equal(t, "IP15-BLK-128", req.SKU)
+				assert.Equal(t, "Black", req.Options["Color"])
+				return product.ProductVariantResponse{ID: uuid.NewString(), SKU: req.SKU, Options: req.Options}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/variants", newTestHandler(svc, &fakeReviewService{}).CreateVariant)
+
+		body := `{"sku":"IP15-BLK-128","price":15000000,"stock":5,"options":{"Color":"Black","Storage":"128GB"}}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/variants", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("missing_options", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/variants", newTestHandler(&fakeProductService{}, &fakeReviewService{}).CreateVariant)
+
+		body := `{"sku":"IP15-BLK-128","price":15000000,"stock":5}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/variants", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("duplicate_sku", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreateVariantFn: func(ctx context.Context, pid string, req product.CreateVariantRequest) (product.ProductVariantResponse, error) {
+				return product.ProductVariantResponse{}, producterrors.ErrVariantSKUExists
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/variants", newTestHandler(svc, &fakeReviewService{}).CreateVariant)
+
+		body := `{"sku":"IP15-BLK-128","price":15000000,"stock":5,"options":{"Color":"Black"}}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/variants", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestDeleteVariant(t *testing.T) {
+	productID := uuid.NewString()
+	variantID := uuid.NewString()
+
+	t.Run("not_found", func(t *testing.T) {
+		svc := &fakeProductService{
+			DeleteVariantFn: func(ctx context.Context, pid, vid string) error {
+				assert.Equal(t, productID, pid)
+				assert.Equal(t, variantID, vid)
+				return producterrors.ErrVariantNotFound
+			},
+		}
+
+		r := setupTestRouter()
+		r.DELETE("/admin/products/:id/variants/:variantId", newTestHandler(svc, &fakeReviewService{}).DeleteVariant)
+
+		req := httptest.NewRequest(http.MethodDelete, "/admin/products/"+productID+"/variants/"+variantID, nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+//
+// ==================== GALLERY ====================
+//
+
+func TestUploadProductImages(t *testing.T) {
+	productID := uuid.NewString()
+
+	t.Run("success_multiple_files", func(t *testing.T) {
+		svc := &fakeProductService{
+			UploadImagesFn: func(ctx context.Context, pid string, files []product.ImageUpload) ([]product.ProductImageResponse, error) {
+				assert.Equal(t, productID, pid)
+				assert.Len(t, files, 2)
+				assert.Equal(t, "a.png", files[0].Filename)
+				assert.Equal(t, "Tampak depan", files[0].AltText)
+				assert.Equal(t, "", files[1].AltText)
+				return []product.ProductImageResponse{{ID: uuid.NewString()}, {ID: uuid.NewString()}}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/images", newTestHandler(svc, &fakeReviewService{}).UploadImages)
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		for _, name := range []string{"a.png", "b.png"} {
+			part, _ := writer.CreateFormFile("images", name)
+			_, _ = part.Write([]byte("img"))
+		}
+		_ = writer.WriteField("altTexts", "Tampak depan")
+		_ = writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/images", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("missing_files", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/images", newTestHandler(&fakeProductService{}, &fakeReviewService{}).UploadImages)
+
+		body, ct, _ := createMultipartForm(map[string]string{"altTexts": "x"}, "", "", nil)
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/images", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestImportProducts(t *testing.T) {
+	t.Run("accepted_dry_run", func(t *testing.T) {
+		svc := &fakeProductService{
+			StartImportFn: func(ctx context.Context, req product.ImportProductsRequest, file io.Reader) (product.ImportJobResponse, error) {
+				assert.True(t, req.DryRun)
+				assert.Equal(t, "products.csv", req.Filename)
+				assert.Equal(t, "admin-1", req.UserID)
+
+				content, _ := io.ReadAll(file)
+				assert.Contains(t, string(content), "name,sku")
+				return product.ImportJobResponse{ID: uuid.NewString(), Status: product.ImportStatusPending, DryRun: true}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/import", func(c *gin.Context) {
+			c.Set("user_id", "admin-1")
+			c.Next()
+		}, newTestHandler(svc, &fakeReviewService{}).Import)
+
+		body, ct, _ := createMultipartForm(map[string]string{"dryRun": "true"}, "file", "products.csv", []byte("name,sku\n"))
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/import", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"PENDING"`)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/admin/products/import", newTestHandler(&fakeProductService{}, &fakeReviewService{}).Import)
+
+		body, ct, _ := createMultipartForm(map[string]string{"dryRun": "true"}, "", "", nil)
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/import", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid_csv", func(t *testing.T) {
+		svc := &fakeProductService{
+			StartImportFn: func(ctx context.Context, req product.ImportProductsRequest, file io.Reader) (product.ImportJobResponse, error) {
+				return product.ImportJobResponse{}, producterrors.ErrImportInvalidFile
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/import", newTestHandler(svc, &fakeReviewService{}).Import)
+
+		body, ct, _ := createMultipartForm(nil, "file", "products.csv", []byte("garbage"))
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/import", body)
+		req.Header.Set("Content-Type", ct)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetImportJob(t *testing.T) {
+	svc := &fakeProductService{
+		GetImportJobFn: func(ctx context.Context, id string) (product.ImportJobResponse, error) {
+			return product.ImportJobResponse{}, producterrors.ErrImportJobNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	r.GET("/admin/products/import/:jobId", newTestHandler(svc, &fakeReviewService{}).GetImportJob)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/products/import/"+uuid.NewString(), nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestExportProducts(t *testing.T) {
+	svc := &fakeProductService{
+		ExportFn: func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, "name,sku\niPhone 15,IP15\n")
+			return err
+		},
+	}
+
+	r := setupTestRouter()
+	r.GET("/admin/products/export", newTestHandler(svc, &fakeReviewService{}).Export)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/products/export", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/csv")
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment; filename=\"products-")
+	assert.Equal(t, "name,sku\niPhone 15,IP15\n", w.Body.String())
+}
+
+func TestAdjustStock(t *testing.T) {
+	productID := uuid.NewString()
+
+	t.Run("success_records_actor", func(t *testing.T) {
+		svc := &fakeProductService{
+			AdjustStockFn: func(ctx context.Context, pid string, req product.StockAdjustmentRequest) (product.StockMovementResponse, error) {
+				assert.Equal(t, productID, pid)
+				assert.Equal(t, int32(-3), req.Delta)
+				assert.Equal(t, "Barang rusak", req.Note)
+				assert.Equal(t, "admin-1", contextutil.GetUserID(ctx))
+				return product.StockMovementResponse{Delta: -3, BalanceAfter: 9, Reason: "ADJUSTMENT"}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/stock-adjustments", func(c *gin.Context) {
+			c.Set("user_id", "admin-1")
+			c.Next()
+		}, newTestHandler(svc, &fakeReviewService{}).AdjustStock)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/stock-adjustments",
+			strings.NewReader(`{"delta":-3,"note":"Barang rusak"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"balanceAfter":9`)
+	})
+
+	t.Run("insufficient_stock", func(t *testing.T) {
+		svc := &fakeProductService{
+			AdjustStockFn: func(ctx context.Context, pid string, req product.StockAdjustmentRequest) (product.StockMovementResponse, error) {
+				return product.StockMovementResponse{}, producterrors.ErrInsufficientStock
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/stock-adjustments", newTestHandler(svc, &fakeReviewService{}).AdjustStock)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/stock-adjustments",
+			strings.NewReader(`{"delta":-100,"note":"Stock opname"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestListStockMovements(t *testing.T) {
+	productID := uuid.NewString()
+
+	svc := &fakeProductService{
+		ListStockMovementsFn: func(ctx context.Context, pid string, req product.ListStockMovementsRequest) ([]product.StockMovementResponse, int64, error) {
+			assert.Equal(t, productID, pid)
+			assert.Equal(t, "SALE", req.Reason)
+			assert.Equal(t, 2, req.Page)
+			assert.Equal(t, 20, req.Limit)
+			return []product.StockMovementResponse{{Delta: -1, Reason: "SALE"}}, 21, nil
+		},
+	}
+
+	r := setupTestRouter()
+	r.GET("/admin/products/:id/stock-movements", newTestHandler(svc, &fakeReviewService{}).ListStockMovements)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/products/"+productID+"/stock-movements?reason=SALE&page=2", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"totalPages":2`)
+}
+
+func TestSubscribeStockNotification(t *testing.T) {
+	t.Run("success_without_body", func(t *testing.T) {
+		svc := &fakeProductService{
+			SubscribeStockFn: func(ctx context.Context, userID, slug string, req product.StockNotificationRequest) (product.StockNotificationResponse, error) {
+				assert.Equal(t, "user-1", userID)
+				assert.Equal(t, "iphone-15", slug)
+				assert.Empty(t, req.VariantID)
+				return product.StockNotificationResponse{ProductID: "p-1"}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/products/:slug/stock-notifications", func(c *gin.Context) {
+			c.Set("user_id", "user-1")
+			c.Next()
+		}, newTestHandler(svc, &fakeReviewService{}).SubscribeStockNotification)
+
+		req := httptest.NewRequest(http.MethodPost, "/products/iphone-15/stock-notifications", nil)
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("product_in_stock", func(t *testing.T) {
+		svc := &fakeProductService{
+			SubscribeStockFn: func(ctx context.Context, userID, slug string, req product.StockNotificationRequest) (product.StockNotificationResponse, error) {
+				return product.StockNotificationResponse{}, producterrors.ErrProductInStock
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/products/:slug/stock-notifications", newTestHandler(svc, &fakeReviewService{}).SubscribeStockNotification)
+
+		req := httptest.NewRequest(http.MethodPost, "/products/iphone-15/stock-notifications",
+			strings.NewReader(`{"variantId":"`+uuid.NewString()+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestUnsubscribeStockNotification(t *testing.T) {
+	svc := &fakeProductService{
+		UnsubscribeStockFn: func(ctx context.Context, userID, slug, variantID string) error {
+			assert.Equal(t, "v-1", variantID)
+			return producterrors.ErrStockNotificationNotFound
+		},
+	}
+
+	r := setupTestRouter()
+	r.DELETE("/products/:slug/stock-notifications", newTestHandler(svc, &fakeReviewService{}).UnsubscribeStockNotification)
+
+	req := httptest.NewRequest(http.MethodDelete, "/products/iphone-15/stock-notifications?variantId=v-1", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCreatePriceSchedule(t *testing.T) {
+	productID := uuid.NewString()
+
+	t.Run("success_records_actor", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreatePriceScheduleFn: func(ctx context.Context, pid string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+				assert.Equal(t, productID, pid)
+				assert.Equal(t, 14000000.0, req.Price)
+				assert.Equal(t, 12500000.0, *req.DiscountPrice)
+				assert.Equal(t, time.Date(2026, 11, 11, 0, 0, 0, 0, time.UTC), req.StartsAt.UTC())
+				assert.NotNil(t, req.EndsAt)
+				assert.Equal(t, "admin-1", contextutil.GetUserID(ctx))
+				return product.PriceScheduleResponse{ID: uuid.NewString(), Status: "PENDING"}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/price-schedules", func(c *gin.Context) {
+			c.Set("user_id", "admin-1")
+			c.Next()
+		}, newTestHandler(svc, &fakeReviewService{}).CreatePriceSchedule)
+
+		body := `{"price":14000000,"discountPrice":12500000,"startsAt":"2026-11-11T07:00:00+07:00","endsAt":"2026-11-12T07:00:00+07:00"}`
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/price-schedules", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"PENDING"`)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		svc := &fakeProductService{
+			CreatePriceScheduleFn: func(ctx context.Context, pid string, req product.CreatePriceScheduleRequest) (product.PriceScheduleResponse, error) {
+				return product.PriceScheduleResponse{}, producterrors.ErrPriceScheduleOverlap
+			},
+		}
+
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/price-schedules", newTestHandler(svc, &fakeReviewService{}).CreatePriceSchedule)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/price-schedules",
+			strings.NewReader(`{"price":14000000,"startsAt":"2026-11-11T00:00:00Z"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("invalid_json", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/admin/products/:id/price-schedules", newTestHandler(&fakeProductService{}, &fakeReviewService{}).CreatePriceSchedule)
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/products/"+productID+"/price-schedules",
+			strings.NewReader(`{"price":14000000,"startsAt":"besok"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestListPriceHistory(t *testing.T) {
+	productID := uuid.NewString()
+
+	svc := &fakeProductService{
+		ListPriceHistoryFn: func(ctx context.Context, pid string, page, limit int) ([]product.PriceHistoryResponse, int64, error) {
+			assert.Equal(t, productID, pid)
+			assert.Equal(t, 2, page)
+			assert.Equal(t, 20, limit)
+			return []product.PriceHistoryResponse{{Price: 15000000, Source: "SCHEDULE"}}, 21, nil
+		},
+	}
+
+	r := setupTestRouter()
+	r.GET("/admin/products/:id/price-history", newTestHandler(svc, &fakeReviewService{}).ListPriceHistory)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/products/"+productID+"/price-history?page=2&limit=500", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"source":"SCHEDULE"`)
+}
+
+func TestGetRelated(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListRelatedFn: func(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+				assert.Equal(t, "iphone-15", slug)
+				assert.Equal(t, 4, limit)
+				return []product.ProductPublicResponse{{Slug: "iphone-15-plus"}}, nil
+			},
+		}
+		r := setupTestRouter()
+		r.GET("/products/:slug/related", newTestHandler(svc, &fakeReviewService{}).GetRelated)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/iphone-15/related?limit=4", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"slug":"iphone-15-plus"`)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		svc := &fakeProductService{
+			ListRelatedFn: func(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+				return nil, producterrors.ErrProductNotFound
+			},
+		}
+		r := setupTestRouter()
+		r.GET("/products/:slug/related", newTestHandler(svc, &fakeReviewService{}).GetRelated)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/unknown/related", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetBoughtTogether(t *testing.T) {
+	svc := &fakeProductService{
+		ListBoughtTogetherFn: func(ctx context.Context, slug string, limit int) ([]product.ProductPublicResponse, error) {
+			assert.Equal(t, "iphone-15", slug)
+			assert.Equal(t, 0, limit)
+			return []product.ProductPublicResponse{{Slug: "magsafe-charger"}}, nil
+		},
+	}
+	r := setupTestRouter()
+	r.GET("/products/:slug/bought-together", newTestHandler(svc, &fakeReviewService{}).GetBoughtTogether)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/iphone-15/bought-together", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"slug":"magsafe-charger"`)
+}
+
+func TestGetBySlug_RecordsView(t *testing.T) {
+	userID, productID := uuid.NewString(), uuid.NewString()
+	var recorded []string
+	svc := &fakeProductService{
+		GetBySlugFn: func(ctx context.Context, slug string) (product.ProductDetailResponse, error) {
+			return product.ProductDetailResponse{ID: productID, Slug: slug}, nil
+		},
+		RecordViewFn: func(ctx context.Context, uid, ip, pid string) error {
+			assert.Equal(t, "192.0.2.1", ip)
+			recorded = append(recorded, uid+":"+pid)
+			return errors.New("redis down")
+		},
+	}
+	r := setupTestRouter()
+	r.GET("/products/:slug", func(c *gin.Context) {
+		if c.GetHeader("X-Test-User") != "" {
+			c.Set("user_id", c.GetHeader("X-Test-User"))
+		}
+		c.Next()
+	}, newTestHandler(svc, &fakeReviewService{}).GetBySlug)
+
+	// Guest tetap dihitung (trending) tanpa user
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/iphone-15", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{":" + productID}, recorded)
+
+	// Gagal mencatat tidak menggagalkan halaman detail
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/products/iphone-15", nil)
+	req.Header.Set("X-Test-User", userID)
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{":" + productID, userID + ":" + productID}, recorded)
+}
+
+func TestGetBySlug_OldSlugRedirects(t *testing.T) {
+	viewed := false
+	svc := &fakeProductService{
+		GetBySlugFn: func(ctx context.Context, slug string) (product.ProductDetailResponse, error) {
+			assert.Equal(t, "iphone-15-abcde", slug)
+			return product.ProductDetailResponse{}, &apperror.MovedError{Slug: "iphone-15-pro-fghij"}
+		},
+		RecordViewFn: func(ctx context.Context, uid, ip, pid string) error {
+			viewed = true
+			return nil
+		},
+	}
+	r := setupTestRouter()
+	r.GET("/api/v1/products/:slug", newTestHandler(svc, &fakeReviewService{}).GetBySlug)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/products/iphone-15-abcde?ref=home", nil))
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/api/v1/products/iphone-15-pro-fghij?ref=home", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"slug":"iphone-15-pro-fghij"`)
+	assert.False(t, viewed)
+}
+
+func TestListRecentlyViewed(t *testing.T) {
+	userID := uuid.NewString()
+	svc := &fakeProductService{
+		ListRecentlyViewedFn: func(ctx context.Context, uid string) ([]product.ProductPublicResponse, error) {
+			assert.Equal(t, userID, uid)
+			return []product.ProductPublicResponse{{Slug: "iphone-15"}}, nil
+		},
+	}
+	r := setupTestRouter()
+	r.GET("/me/recently-viewed", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}, newTestHandler(svc, &fakeReviewService{}).ListRecentlyViewed)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/me/recently-viewed", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"slug":"iphone-15"`)
+}
+
+func TestSetPublication(t *testing.T) {
+	productID := uuid.NewString()
+
+	t.Run("success", func(t *testing.T) {
+		svc := &fakeProductService{
+			SetPublicationFn: func(ctx context.Context, id string, req product.SetPublicationRequest) (product.ProductAdminResponse, error) {
+				assert.Equal(t, productID, id)
+				assert.Equal(t, "SCHEDULED", req.Status)
+				assert.Equal(t, time.Date(2026, 12, 1, 2, 0, 0, 0, time.UTC), req.PublishAt.UTC())
+				return product.ProductAdminResponse{ID: id, Status: req.Status, PublishAt: req.PublishAt}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.PATCH("/admin/products/:id/publication", newTestHandler(svc, &fakeReviewService{}).SetPublication)
+
+		req := httptest.NewRequest(http.MethodPatch, "/admin/products/"+productID+"/publication",
+			strings.NewReader(`{"status":"SCHEDULED","publishAt":"2026-12-01T09:00:00+07:00"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"SCHEDULED"`)
+	})
+
+	t.Run("publish_at_in_past", func(t *testing.T) {
+		svc := &fakeProductService{
+			SetPublicationFn: func(ctx context.Context, id string, req product.SetPublicationRequest) (product.ProductAdminResponse, error) {
+				return product.ProductAdminResponse{}, producterrors.ErrInvalidPublishAt
+			},
+		}
+
+		r := setupTestRouter()
+		r.PATCH("/admin/products/:id/publication", newTestHandler(svc, &fakeReviewService{}).SetPublication)
+
+		req := httptest.NewRequest(http.MethodPatch, "/admin/products/"+productID+"/publication",
+			strings.NewReader(`{"status":"SCHEDULED","publishAt":"2020-01-01T00:00:00Z"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid_json", func(t *testing.T) {
+		r := setupTestRouter()
+		r.PATCH("/admin/products/:id/publication", newTestHandler(&fakeProductService{}, &fakeReviewService{}).SetPublication)
+
+		req := httptest.NewRequest(http.MethodPatch, "/admin/products/"+productID+"/publication",
+			strings.NewReader(`{"status":"SCHEDULED","publishAt":"besok"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetPreview(t *testing.T) {
+	t.Run("success_not_cacheable", func(t *testing.T) {
+		svc := &fakeProductService{
+			GetPreviewFn: func(ctx context.Context, slug, token string) (product.ProductDetailResponse, error) {
+				assert.Equal(t, "pixel-10", slug)
+				assert.Equal(t, "abc", token)
+				return product.ProductDetailResponse{Slug: slug}, nil
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/:slug/preview", newTestHandler(svc, &fakeReviewService{}).GetPreview)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/pixel-10/preview?token=abc", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "private, no-store", w.Header().Get("Cache-Control"))
+		assert.Equal(t, "noindex", w.Header().Get("X-Robots-Tag"))
+	})
+
+	t.Run("invalid_token", func(t *testing.T) {
+		svc := &fakeProductService{
+			GetPreviewFn: func(ctx context.Context, slug, token string) (product.ProductDetailResponse, error) {
+				return product.ProductDetailResponse{}, producterrors.ErrInvalidPreviewToken
+			},
+		}
+
+		r := setupTestRouter()
+		r.GET("/products/:slug/preview", newTestHandler(svc, &fakeReviewService{}).GetPreview)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/pixel-10/preview", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Empty(t, w.Header().Get("Cache-Control"))
+	})
+}
@@ -0,0 +1,588 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/constants"
+	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"go-gadget-api/internal/shared/database/helper"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ImportStatusPending    = "PENDING"
+	ImportStatusProcessing = "PROCESSING"
+	ImportStatusCompleted  = "COMPLETED"
+	ImportStatusFailed     = "FAILED"
+)
+
+const (
+	importMaxRows       = 5000
+	importProgressEvery = 50   // progress job di-update tiap N baris
+	importMaxRowErrors  = 1000 // error per baris yang disimpan, sisanya hanya dihitung
+)
+
+// errImportSKUDeleted produk dengan SKU ini sudah dihapus; import tidak menghidupkannya lagi
+var errImportSKUDeleted = errors.New("Product with this SKU was deleted, restore it before importing")
+
+// Urutan kolom CSV export, sekaligus header yang dikenali saat import
+var importColumns = []string{"name", "sku", "brand_slug", "category_slug", "price", "discount_price", "stock", "description", "image_url"}
+
+var importRequiredColumns = []string{"name", "sku", "brand_slug", "category_slug", "price", "stock"}
+
+type importRow struct {
+	Line   int
+	Fields map[string]string
+}
+
+// importItem satu baris yang lolos validasi; stok target diterapkan lewat ledger
+type importItem struct {
+	params dbgen.UpsertProductBySKUParams
+	stock  int32
+}
+
+type importResult struct {
+	processed, created, updated, failed int32
+	errors                              []ImportRowError
+}
+
+func (r *importResult) addError(row importRow, msg string) {
+	r.failed++
+	if len(r.errors) < importMaxRowErrors {
+		r.errors = append(r.errors, ImportRowError{Row: row.Line, SKU: row.Fields["sku"], Message: msg})
+	}
+}
+
+// StartImport memvalidasi header CSV secara sinkron (file rusak langsung 400),
+// lalu memproses baris di background. Status dipantau lewat GetImportJob.
+func (s *service) StartImport(ctx context.Context, req ImportProductsRequest, file io.Reader) (ImportJobResponse, error) {
+	rows, err := parseImportCSV(file)
+	if err != nil {
+		return ImportJobResponse{}, err
+	}
+
+	var createdBy uuid.NullUUID
+	if uid, err := uuid.Parse(req.UserID); err == nil {
+		createdBy = uuid.NullUUID{UUID: uid, Valid: true}
+	}
+
+	job, err := s.repo.CreateImportJob(ctx, dbgen.CreateProductImportJobParams{
+		DryRun:    req.DryRun,
+		Filename:  helper.StringToNull(&req.Filename),
+		TotalRows: int32(len(rows)),
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		log.Printf("[StartImport] create job failed: %v", err)
+		return ImportJobResponse{}, producterrors.ErrProductFailed
+	}
+
+	// Request HTTP sudah selesai saat job berjalan, jadi context dilepas dari cancel
+	go s.runImport(context.WithoutCancel(ctx), job.ID, req, rows)
+
+	return mapImportJob(job), nil
+}
+
+func (s *service) runImport(ctx context.Context, jobID uuid.UUID, req ImportProductsRequest, rows []importRow) {
+	// Panic di goroutine ini tidak boleh menjatuhkan API atau meninggalkan job PROCESSING selamanya
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[Import %s] panic: %v", jobID, r)
+			if err := s.repo.FailImportJob(ctx, jobID, "Import aborted, please retry"); err != nil {
+				log.Printf("[Import %s] mark failed: %v", jobID, err)
+			}
+		}
+	}()
+
+	if err := s.repo.StartImportJob(ctx, jobID); err != nil {
+		log.Printf("[Import %s] start failed: %v", jobID, err)
+	}
+
+	var actor uuid.NullUUID
+	if uid, err := uuid.Parse(req.UserID); err == nil {
+		actor = uuid.NullUUID{UUID: uid, Valid: true}
+	}
+
+	res, runErr := s.importRows(ctx, jobID, actor, req.DryRun, rows)
+
+	status := ImportStatusCompleted
+	var message string
+	if runErr != nil {
+		status = ImportStatusFailed
+		message = "Import aborted, please retry"
+		log.Printf("[Import %s] aborted: %v", jobID, runErr)
+	}
+
+	rowErrors := res.errors
+	if rowErrors == nil {
+		rowErrors = []ImportRowError{}
+	}
+	errorsJSON, _ := json.Marshal(rowErrors)
+
+	err := s.repo.FinishImportJob(ctx, dbgen.FinishProductImportJobParams{
+		ID:            jobID,
+		Status:        status,
+		ProcessedRows: res.processed,
+		CreatedCount:  res.created,
+		UpdatedCount:  res.updated,
+		FailedCount:   res.failed,
+		Errors:        errorsJSON,
+		Message:       helper.StringToNull(&message),
+	})
+	if err != nil {
+		log.Printf("[Import %s] finish failed: %v", jobID, err)
+	}
+
+	if req.onFinish != nil {
+		req.onFinish(ctx, ImportJobResponse{
+			ID:            jobID.String(),
+			Status:        status,
+			DryRun:        req.DryRun,
+			TotalRows:     int32(len(rows)),
+			ProcessedRows: res.processed,
+			Created:       res.created,
+			Updated:       res.updated,
+			Failed:        res.failed,
+			Errors:        rowErrors,
+			Message:       message,
+		})
+	}
+}
+
+// importRows error hanya untuk kegagalan infrastruktur; kesalahan data dicatat per baris
+func (s *service) importRows(ctx context.Context, jobID uuid.UUID, actor uuid.NullUUID, dryRun bool, rows []importRow) (importResult, error) {
+	var res importResult
+
+	brands, categories, err := s.resolveImportRefs(ctx, rows)
+	if err != nil {
+		return res, err
+	}
+
+	// Dry-run tidak menulis ke DB, jadi create/update diprediksi dari SKU yang sudah ada
+	existing := map[string]bool{}
+	deleted := map[string]bool{}
+	if dryRun {
+		skus := make([]string, 0, len(rows))
+		for _, row := range rows {
+			if sku := row.Fields["sku"]; sku != "" {
+				skus = append(skus, sku)
+			}
+		}
+		found, err := s.repo.ListExistingSKUs(ctx, skus)
+		if err != nil {
+			return res, err
+		}
+		for _, f := range found {
+			existing[f.Sku] = true
+			deleted[f.Sku] = f.Deleted
+		}
+	}
+
+	seen := map[string]int{}
+	for _, row := range rows {
+		item, err := s.buildImportParams(row, brands, categories)
+		sku := item.params.Sku.String
+		if err == nil {
+			if first, dup := seen[sku]; dup {
+				err = fmt.Errorf("Duplicate SKU, already used on row %d", first)
+			} else {
+				seen[sku] = row.Line
+			}
+		}
+
+		switch {
+		case err != nil:
+			res.addError(row, err.Error())
+		case dryRun && deleted[sku]:
+			res.addError(row, errImportSKUDeleted.Error())
+		case dryRun:
+			if existing[sku] {
+				res.updated++
+			} else {
+				res.created++
+			}
+		default:
+			inserted, err := s.upsertImportRow(ctx, jobID, actor, item)
+			if errors.Is(err, errImportSKUDeleted) {
+				res.addError(row, err.Error())
+			} else if err != nil {
+				log.Printf("[Import %s] row %d failed: %v", jobID, row.Line, err)
+				res.addError(row, "Failed to save product")
+			} else if inserted {
+				res.created++
+			} else {
+				res.updated++
+			}
+		}
+
+		res.processed++
+		if res.processed%importProgressEvery == 0 {
+			_ = s.repo.UpdateImportJobProgress(ctx, dbgen.UpdateProductImportJobProgressParams{
+				ID:            jobID,
+				ProcessedRows: res.processed,
+				CreatedCount:  res.created,
+				UpdatedCount:  res.updated,
+				FailedCount:   res.failed,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// resolveImportRefs memetakan slug brand & kategori di file ke ID (satu query per tabel)
+func (s *service) resolveImportRefs(ctx context.Context, rows []importRow) (map[string]uuid.UUID, map[string]uuid.UUID, error) {
+	var brandSlugs, categorySlugs []string
+	for _, row := range rows {
+		if v := row.Fields["brand_slug"]; v != "" {
+			brandSlugs = append(brandSlugs, strings.ToLower(v))
+		}
+		if v := row.Fields["category_slug"]; v != "" {
+			categorySlugs = append(categorySlugs, strings.ToLower(v))
+		}
+	}
+
+	brands := map[string]uuid.UUID{}
+	brandRows, err := s.repo.ListBrandRefsBySlugs(ctx, brandSlugs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, b := range brandRows {
+		brands[b.Slug] = b.ID
+	}
+
+	categories := map[string]uuid.UUID{}
+	categoryRows, err := s.repo.ListCategoryRefsBySlugs(ctx, categorySlugs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range categoryRows {
+		categories[c.Slug] = c.ID
+	}
+
+	return brands, categories, nil
+}
+
+func (s *service) buildImportParams(row importRow, brands, categories map[string]uuid.UUID) (importItem, error) {
+	f := row.Fields
+
+	price, err := parseImportNumber(f["price"])
+	if err != nil {
+		return importItem{}, errors.New("Price must be a number")
+	}
+	stock, err := strconv.ParseInt(f["stock"], 10, 32)
+	if f["stock"] != "" && err != nil {
+		return importItem{}, errors.New("Stock must be an integer")
+	}
+
+	if f["brand_slug"] == "" {
+		return importItem{}, errors.New("Brand slug is required")
+	}
+	if f["category_slug"] == "" {
+		return importItem{}, errors.New("Category slug is required")
+	}
+
+	// Aturan validasi sama dengan POST /admin/products.
+	// Stock dicek terpisah: tag required menolak 0, padahal produk habis tetap valid di-import (hasil export).
+	req := CreateProductRequest{
+		BrandID:     f["brand_slug"],
+		CategoryID:  f["category_slug"],
+		Name:        f["name"],
+		Description: f["description"],
+		Price:       price,
+		SKU:         f["sku"],
+		ImageUrl:    f["image_url"],
+	}
+	if err := s.validate.StructExcept(req, "Stock"); err != nil {
+		return importItem{}, apperror.MapValidationError(err)
+	}
+	if f["stock"] == "" {
+		return importItem{}, errors.New("Stock is required")
+	}
+	if stock < 0 {
+		return importItem{}, errors.New("Stock is invalid")
+	}
+	if req.SKU == "" {
+		return importItem{}, errors.New("Sku is required")
+	}
+	if req.ImageUrl != "" && s.validate.Var(req.ImageUrl, "url") != nil {
+		return importItem{}, errors.New("Image URL is invalid")
+	}
+
+	brandID, ok := brands[strings.ToLower(req.BrandID)]
+	if !ok {
+		return importItem{}, fmt.Errorf("Brand not found: %s", req.BrandID)
+	}
+	categoryID, ok := categories[strings.ToLower(req.CategoryID)]
+	if !ok {
+		return importItem{}, fmt.Errorf("Category not found: %s", req.CategoryID)
+	}
+
+	var discount sql.NullString
+	if f["discount_price"] != "" {
+		d, err := parseImportNumber(f["discount_price"])
+		if err != nil {
+			return importItem{}, errors.New("Discount price must be a number")
+		}
+		if d <= 0 || d >= price {
+			return importItem{}, errors.New("Discount price must be greater than 0 and lower than price")
+		}
+		discount = sql.NullString{String: fmt.Sprintf("%.2f", d), Valid: true}
+	}
+
+	params := dbgen.UpsertProductBySKUParams{
+		BrandID:       uuid.NullUUID{UUID: brandID, Valid: true},
+		CategoryID:    categoryID,
+		Name:          req.Name,
+		Description:   helper.StringToNull(&req.Description),
+		Price:         fmt.Sprintf("%.2f", price),
+		DiscountPrice: discount,
+		Sku:           helper.StringToNull(&req.SKU),
+		ImageUrl:      helper.StringToNull(&req.ImageUrl),
+	}
+	return importItem{params: params, stock: int32(stock)}, nil
+}
+
+// upsertImportRow satu transaksi per baris, supaya baris gagal tidak membatalkan baris lain.
+// Selisih stok (target di CSV - saldo sekarang) dicatat di ledger dengan reason IMPORT.
+// Slug mengikuti aturan admin Create/Update: produk baru & rename memakai availableSlug,
+// slug lama hasil rename masuk slug_history untuk redirect.
+func (s *service) upsertImportRow(ctx context.Context, jobID uuid.UUID, actor uuid.NullUUID, item importItem) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	existing, err := qtx.GetImportTargetBySKU(ctx, item.params.Sku.String)
+	found := err == nil
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		item.params.Slug, err = s.availableSlug(ctx, item.params.Name, uuid.Nil)
+	case err != nil:
+		return false, err
+	case existing.DeletedAt.Valid:
+		return false, errImportSKUDeleted
+	case existing.Name != item.params.Name:
+		item.params.Slug, err = s.availableSlug(ctx, item.params.Name, existing.ID)
+	default:
+		item.params.Slug = existing.Slug
+	}
+	if err != nil {
+		return false, err
+	}
+
+	row, err := qtx.UpsertBySKU(ctx, item.params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, errImportSKUDeleted
+		}
+		return false, err
+	}
+
+	if found && existing.Slug != item.params.Slug {
+		if err := qtx.RecordSlugChange(ctx, row.ID, existing.Slug, item.params.Slug); err != nil {
+			return false, err
+		}
+	}
+
+	if err := recordPrice(ctx, qtx, dbgen.RecordPriceChangeParams{
+		ProductID: row.ID,
+		Source:    constants.PriceSourceImport,
+		ChangedBy: actor,
+	}); err != nil {
+		return false, err
+	}
+
+	if _, err := applyStock(ctx, qtx, dbgen.ApplyStockMovementParams{
+		Delta:       item.stock - row.Stock,
+		ProductID:   row.ID,
+		Reason:      constants.StockReasonImport,
+		ReferenceID: uuid.NullUUID{UUID: jobID, Valid: true},
+		ActorID:     actor,
+	}); err != nil {
+		return false, err
+	}
+
+	// Gambar utama juga masuk galeri sebagai primary (sama seperti Create)
+	if item.params.ImageUrl.Valid {
+		if err := qtx.UpsertPrimaryImage(ctx, row.ID, item.params.ImageUrl.String); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return row.Inserted, nil
+}
+
+// FailStaleImports dijalankan worker: job yang masih PENDING/PROCESSING setelah olderThan
+// dianggap mati (proses API restart/crash) dan ditandai FAILED
+func (s *service) FailStaleImports(ctx context.Context, olderThan time.Duration) (int64, error) {
+	return s.repo.FailStaleImportJobs(ctx, time.Now().Add(-olderThan))
+}
+
+func (s *service) GetImportJob(ctx context.Context, id string) (ImportJobResponse, error) {
+	jobID, err := uuid.Parse(id)
+	if err != nil {
+		return ImportJobResponse{}, producterrors.ErrImportJobNotFound
+	}
+
+	job, err := s.repo.GetImportJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ImportJobResponse{}, producterrors.ErrImportJobNotFound
+		}
+		return ImportJobResponse{}, producterrors.ErrProductFailed
+	}
+
+	return mapImportJob(job), nil
+}
+
+// Export menulis semua produk aktif (belum dihapus) dengan format kolom yang sama dengan import
+func (s *service) Export(ctx context.Context, w io.Writer) error {
+	rows, err := s.repo.ListForExport(ctx)
+	if err != nil {
+		return producterrors.ErrProductFailed
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(importColumns); err != nil {
+		return err
+	}
+	for _, p := range rows {
+		record := []string{
+			p.Name,
+			p.Sku.String,
+			p.BrandSlug.String,
+			p.CategorySlug,
+			p.Price,
+			p.DiscountPrice.String,
+			strconv.Itoa(int(p.Stock)),
+			p.Description.String,
+			p.ImageUrl.String,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// parseImportCSV membaca header (case-insensitive, urutan bebas) lalu semua baris data
+func parseImportCSV(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // kolom kurang di satu baris dianggap kosong, bukan file rusak
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, producterrors.ErrImportInvalidFile
+	}
+
+	index := map[string]int{}
+	for i, h := range header {
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff") // BOM dari Excel
+		}
+		index[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, col := range importRequiredColumns {
+		if _, ok := index[col]; !ok {
+			return nil, apperror.New(apperror.CodeInvalidInput, "Missing required CSV column: "+col, http.StatusBadRequest)
+		}
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, producterrors.ErrImportInvalidFile
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) == importMaxRows {
+			return nil, producterrors.ErrImportTooManyRows
+		}
+
+		line, _ := reader.FieldPos(0)
+		fields := make(map[string]string, len(importColumns))
+		for _, col := range importColumns {
+			if i, ok := index[col]; ok && i < len(record) {
+				fields[col] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, importRow{Line: line, Fields: fields})
+	}
+
+	if len(rows) == 0 {
+		return nil, producterrors.ErrImportInvalidFile
+	}
+	return rows, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func parseImportNumber(s string) (float64, error) {
+	if s == "" {
+		return 0, nil // kosong ditangani validator (required)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func mapImportJob(job dbgen.ProductImportJob) ImportJobResponse {
+	res := ImportJobResponse{
+		ID:            job.ID.String(),
+		Status:        job.Status,
+		DryRun:        job.DryRun,
+		Filename:      job.Filename.String,
+		TotalRows:     job.TotalRows,
+		ProcessedRows: job.ProcessedRows,
+		Created:       job.CreatedCount,
+		Updated:       job.UpdatedCount,
+		Failed:        job.FailedCount,
+		Errors:        []ImportRowError{},
+		Message:       job.Message.String,
+		CreatedAt:     job.CreatedAt,
+	}
+	if len(job.Errors) > 0 {
+		_ = json.Unmarshal(job.Errors, &res.Errors)
+	}
+	if job.StartedAt.Valid {
+		t := job.StartedAt.Time
+		res.StartedAt = &t
+	}
+	if job.FinishedAt.Valid {
+		t := job.FinishedAt.Time
+		res.FinishedAt = &t
+	}
+	return res
+}
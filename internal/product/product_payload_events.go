@@ -0,0 +1,77 @@
+package product
+
+import (
+	"encoding/json"
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	"github.com/google/uuid"
+)
+
+// Event outbox yang dibuat ketika mutasi stok melewati batas tertentu
+const (
+	EventProductLowStock    = "PRODUCT_LOW_STOCK"     // saldo turun ke bawah low_stock_threshold -> email admin
+	EventProductBackInStock = "PRODUCT_BACK_IN_STOCK" // saldo naik dari 0 -> email pelanggan yang subscribe
+)
+
+type ProductLowStockPayload struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	Stock     int32  `json:"stock"`
+	Threshold int32  `json:"threshold"`
+}
+
+type ProductBackInStockPayload struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	Stock     int32  `json:"stock"`
+}
+
+// StockAlertEvents menentukan event yang perlu dibuat dari satu mutasi stok.
+// Hanya saat melewati batas (bukan setiap mutasi di bawah batas), jadi admin
+// tidak dibanjiri email selama stok masih menipis.
+func StockAlertEvents(m dbgen.ApplyStockMovementRow) ([]dbgen.CreateOutboxEventParams, error) {
+	before := m.BalanceAfter - m.Delta
+	variantID := ""
+	if m.VariantID.Valid {
+		variantID = m.VariantID.UUID.String()
+	}
+
+	var events []dbgen.CreateOutboxEventParams
+	add := func(eventType string, payload any) error {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		events = append(events, dbgen.CreateOutboxEventParams{
+			ID:            uuid.New(),
+			AggregateType: "PRODUCT",
+			AggregateID:   m.ProductID,
+			EventType:     eventType,
+			Payload:       b,
+		})
+		return nil
+	}
+
+	if m.LowStockThreshold > 0 && before >= m.LowStockThreshold && m.BalanceAfter < m.LowStockThreshold {
+		if err := add(EventProductLowStock, ProductLowStockPayload{
+			ProductID: m.ProductID.String(),
+			VariantID: variantID,
+			Stock:     m.BalanceAfter,
+			Threshold: m.LowStockThreshold,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if before <= 0 && m.BalanceAfter > 0 {
+		if err := add(EventProductBackInStock, ProductBackInStockPayload{
+			ProductID: m.ProductID.String(),
+			VariantID: variantID,
+			Stock:     m.BalanceAfter,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,40 @@
+package product
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	"github.com/google/uuid"
+)
+
+// trendingWindow jendela penjualan & view terbaru untuk skor trending
+const trendingWindow = 7 * 24 * time.Hour
+
+// RefreshPopularity menghitung ulang product_stats (terjual, rating, skor trending).
+// Tanpa ViewHistory (Redis) skor trending hanya dari penjualan terbaru.
+func (s *service) RefreshPopularity(ctx context.Context, now time.Time) (int64, error) {
+	since := now.Add(-trendingWindow)
+
+	ids := []uuid.UUID{}
+	counts := []int32{}
+	if s.viewHistory != nil {
+		views, err := s.viewHistory.ViewCounts(ctx, since, now)
+		if err != nil {
+			// View bersifat pelengkap, penjualan & rating tetap diperbarui
+			log.Printf("[RefreshPopularity] Failed to read view counts: %v", err)
+		}
+		for id, n := range views {
+			ids = append(ids, id)
+			counts = append(counts, int32(n))
+		}
+	}
+
+	return s.repo.RefreshStats(ctx, dbgen.RefreshProductStatsParams{
+		Since:          since,
+		ViewProductIds: ids,
+		ViewCounts:     counts,
+	})
+}
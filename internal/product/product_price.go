@@ -0,0 +1,390 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/constants"
+	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// priceScheduleBatchSize jumlah jadwal maksimal yang diproses per putaran worker
+const priceScheduleBatchSize = 100
+
+var priceScheduleStatuses = map[string]bool{
+	constants.PriceSchedulePending:   true,
+	constants.PriceScheduleActive:    true,
+	constants.PriceScheduleCompleted: true,
+	constants.PriceScheduleCancelled: true,
+	constants.PriceScheduleExpired:   true,
+}
+
+// recordPrice menyimpan harga produk saat ini ke riwayat; query melewatinya jika harga tidak berubah.
+// repo boleh hasil WithTx agar snapshot diambil setelah update di transaksi yang sama.
+func recordPrice(ctx context.Context, repo Repository, arg dbgen.RecordPriceChangeParams) error {
+	if !arg.ChangedBy.Valid {
+		arg.ChangedBy = actorFromContext(ctx)
+	}
+	return repo.RecordPriceChange(ctx, arg)
+}
+
+// CreatePriceSchedule menjadwalkan harga baru; harga produk baru berubah saat worker menerapkannya
+func (s *service) CreatePriceSchedule(ctx context.Context, productID string, req CreatePriceScheduleRequest) (PriceScheduleResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return PriceScheduleResponse{}, apperror.MapValidationError(err)
+	}
+
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return PriceScheduleResponse{}, producterrors.ErrInvalidProductID
+	}
+
+	if req.DiscountPrice != nil && *req.DiscountPrice >= req.Price {
+		return PriceScheduleResponse{}, producterrors.ErrInvalidDiscountPrice
+	}
+
+	// Kolom TIMESTAMP tanpa zona waktu, semua waktu jadwal disimpan dalam UTC
+	startsAt := req.StartsAt.UTC()
+	if !startsAt.After(time.Now().UTC()) {
+		return PriceScheduleResponse{}, producterrors.ErrPriceScheduleInPast
+	}
+	var endsAt sql.NullTime
+	if req.EndsAt != nil {
+		if !req.EndsAt.After(req.StartsAt) {
+			return PriceScheduleResponse{}, producterrors.ErrInvalidPriceSchedulePeriod
+		}
+		endsAt = sql.NullTime{Time: req.EndsAt.UTC(), Valid: true}
+	}
+
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PriceScheduleResponse{}, producterrors.ErrProductNotFound
+		}
+		return PriceScheduleResponse{}, producterrors.ErrProductFailed
+	}
+
+	overlaps, err := s.repo.CountOverlappingPriceSchedules(ctx, dbgen.CountOverlappingPriceSchedulesParams{
+		ProductID: pid,
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+	})
+	if err != nil {
+		return PriceScheduleResponse{}, producterrors.ErrProductFailed
+	}
+	if overlaps > 0 {
+		return PriceScheduleResponse{}, producterrors.ErrPriceScheduleOverlap
+	}
+
+	note := strings.TrimSpace(req.Note)
+	sched, err := s.repo.CreatePriceSchedule(ctx, dbgen.CreatePriceScheduleParams{
+		ProductID:     pid,
+		Price:         fmt.Sprintf("%.2f", req.Price),
+		DiscountPrice: discountToNull(req.DiscountPrice),
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+		Note:          sql.NullString{String: note, Valid: note != ""},
+		CreatedBy:     actorFromContext(ctx),
+	})
+	if err != nil {
+		return PriceScheduleResponse{}, producterrors.ErrProductFailed
+	}
+
+	return mapPriceSchedule(sched), nil
+}
+
+func (s *service) ListPriceSchedules(ctx context.Context, productID string, req ListPriceSchedulesRequest) ([]PriceScheduleResponse, int64, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, 0, producterrors.ErrInvalidProductID
+	}
+
+	status := strings.ToUpper(strings.TrimSpace(req.Status))
+	if status != "" && !priceScheduleStatuses[status] {
+		return nil, 0, producterrors.ErrInvalidPriceScheduleStatus
+	}
+
+	rows, err := s.repo.ListPriceSchedules(ctx, dbgen.ListPriceSchedulesParams{
+		ProductID: pid,
+		Status:    sql.NullString{String: status, Valid: status != ""},
+		Limit:     int32(req.Limit),
+		Offset:    int32((req.Page - 1) * req.Limit),
+	})
+	if err != nil {
+		return nil, 0, producterrors.ErrProductFailed
+	}
+
+	var total int64
+	res := make([]PriceScheduleResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		item := mapPriceSchedule(dbgen.ProductPriceSchedule{
+			ID:                    r.ID,
+			ProductID:             r.ProductID,
+			Price:                 r.Price,
+			DiscountPrice:         r.DiscountPrice,
+			StartsAt:              r.StartsAt,
+			EndsAt:                r.EndsAt,
+			Status:                r.Status,
+			PreviousPrice:         r.PreviousPrice,
+			PreviousDiscountPrice: r.PreviousDiscountPrice,
+			Note:                  r.Note,
+			CreatedBy:             r.CreatedBy,
+			AppliedAt:             r.AppliedAt,
+			EndedAt:               r.EndedAt,
+			CreatedAt:             r.CreatedAt,
+			UpdatedAt:             r.UpdatedAt,
+		})
+		item.CreatedByName = r.CreatedByName.String
+		res = append(res, item)
+	}
+
+	return res, total, nil
+}
+
+// CancelPriceSchedule membatalkan jadwal PENDING, atau menghentikan jadwal ACTIVE lebih awal
+// (harga dikembalikan ke harga sebelum jadwal diterapkan).
+func (s *service) CancelPriceSchedule(ctx context.Context, productID, scheduleID string) (PriceScheduleResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return PriceScheduleResponse{}, producterrors.ErrInvalidProductID
+	}
+	sid, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return PriceScheduleResponse{}, producterrors.ErrInvalidPriceScheduleID
+	}
+
+	sched, err := s.repo.GetPriceSchedule(ctx, sid, pid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PriceScheduleResponse{}, producterrors.ErrPriceScheduleNotFound
+		}
+		return PriceScheduleResponse{}, producterrors.ErrProductFailed
+	}
+
+	switch sched.Status {
+	case constants.PriceSchedulePending:
+		cancelled, err := s.repo.CancelPendingPriceSchedule(ctx, sid)
+		if err != nil {
+			// Worker baru saja menerapkannya
+			if errors.Is(err, sql.ErrNoRows) {
+				return PriceScheduleResponse{}, producterrors.ErrPriceScheduleNotCancellable
+			}
+			return PriceScheduleResponse{}, producterrors.ErrProductFailed
+		}
+		return mapPriceSchedule(cancelled), nil
+
+	case constants.PriceScheduleActive:
+		ended, err := s.endPriceSchedule(ctx, sched, constants.PriceScheduleCancelled)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return PriceScheduleResponse{}, producterrors.ErrPriceScheduleNotCancellable
+			}
+			return PriceScheduleResponse{}, producterrors.ErrProductFailed
+		}
+		return mapPriceSchedule(ended), nil
+	}
+
+	return PriceScheduleResponse{}, producterrors.ErrPriceScheduleNotCancellable
+}
+
+func (s *service) ListPriceHistory(ctx context.Context, productID string, page, limit int) ([]PriceHistoryResponse, int64, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, 0, producterrors.ErrInvalidProductID
+	}
+
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, producterrors.ErrProductNotFound
+		}
+		return nil, 0, producterrors.ErrProductFailed
+	}
+
+	rows, err := s.repo.ListPriceHistory(ctx, dbgen.ListPriceHistoryParams{
+		ProductID: pid,
+		Limit:     int32(limit),
+		Offset:    int32((page - 1) * limit),
+	})
+	if err != nil {
+		return nil, 0, producterrors.ErrProductFailed
+	}
+
+	var total int64
+	res := make([]PriceHistoryResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		price, _ := strconv.ParseFloat(r.Price, 64)
+		res = append(res, PriceHistoryResponse{
+			ID:            r.ID.String(),
+			Price:         price,
+			DiscountPrice: nullPrice(r.DiscountPrice),
+			Source:        r.Source,
+			ScheduleID:    nullUUIDString(r.ScheduleID),
+			ChangedBy:     nullUUIDString(r.ChangedBy),
+			ChangedByName: r.ChangedByName.String,
+			CreatedAt:     r.CreatedAt,
+		})
+	}
+
+	return res, total, nil
+}
+
+// RunPriceSchedules dipanggil worker secara berkala. Jadwal yang berakhir diproses lebih dulu
+// agar jadwal berikutnya yang mulai di waktu yang sama tidak langsung ditimpa.
+// Kegagalan satu jadwal hanya di-log, jadwal tersebut dicoba lagi di putaran berikutnya.
+func (s *service) RunPriceSchedules(ctx context.Context, now time.Time) (PriceScheduleRunResult, error) {
+	var result PriceScheduleRunResult
+	now = now.UTC()
+
+	ending, err := s.repo.ListEndingPriceSchedules(ctx, now, priceScheduleBatchSize)
+	if err != nil {
+		return result, err
+	}
+	for _, sched := range ending {
+		if _, err := s.endPriceSchedule(ctx, sched, constants.PriceScheduleCompleted); err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.Printf("[PriceSchedule] Failed to end schedule %s: %v", sched.ID, err)
+			}
+			continue
+		}
+		result.Ended++
+	}
+
+	result.Expired, err = s.repo.ExpirePriceSchedules(ctx, now)
+	if err != nil {
+		return result, err
+	}
+
+	due, err := s.repo.ListDuePriceSchedules(ctx, now, priceScheduleBatchSize)
+	if err != nil {
+		return result, err
+	}
+	for _, sched := range due {
+		if err := s.startPriceSchedule(ctx, sched); err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.Printf("[PriceSchedule] Failed to apply schedule %s: %v", sched.ID, err)
+			}
+			continue
+		}
+		result.Applied++
+	}
+
+	return result, nil
+}
+
+// startPriceSchedule menerapkan harga jadwal dalam satu transaksi.
+// sql.ErrNoRows = jadwal sudah diklaim proses lain.
+func (s *service) startPriceSchedule(ctx context.Context, sched dbgen.ProductPriceSchedule) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	if _, err := qtx.StartPriceSchedule(ctx, sched.ID); err != nil {
+		return err
+	}
+
+	if err := qtx.SetPrice(ctx, dbgen.SetProductPriceParams{
+		ID:            sched.ProductID,
+		Price:         sched.Price,
+		DiscountPrice: sched.DiscountPrice,
+	}); err != nil {
+		return err
+	}
+
+	if err := recordPrice(ctx, qtx, dbgen.RecordPriceChangeParams{
+		ProductID:  sched.ProductID,
+		Source:     constants.PriceSourceSchedule,
+		ScheduleID: uuid.NullUUID{UUID: sched.ID, Valid: true},
+		ChangedBy:  sched.CreatedBy,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// endPriceSchedule menutup jadwal ACTIVE (COMPLETED oleh worker, CANCELLED oleh admin)
+// dan mengembalikan harga sebelumnya jika belum diubah manual selama periode jadwal.
+func (s *service) endPriceSchedule(ctx context.Context, sched dbgen.ProductPriceSchedule, status string) (dbgen.ProductPriceSchedule, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return dbgen.ProductPriceSchedule{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	ended, err := qtx.FinishPriceSchedule(ctx, sched.ID, status)
+	if err != nil {
+		return dbgen.ProductPriceSchedule{}, err
+	}
+
+	reverted, err := qtx.RevertScheduledPrice(ctx, sched.ID)
+	if err != nil {
+		return dbgen.ProductPriceSchedule{}, err
+	}
+	if reverted > 0 {
+		if err := recordPrice(ctx, qtx, dbgen.RecordPriceChangeParams{
+			ProductID:  sched.ProductID,
+			Source:     constants.PriceSourceScheduleEnd,
+			ScheduleID: uuid.NullUUID{UUID: sched.ID, Valid: true},
+		}); err != nil {
+			return dbgen.ProductPriceSchedule{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return dbgen.ProductPriceSchedule{}, err
+	}
+	return ended, nil
+}
+
+func mapPriceSchedule(s dbgen.ProductPriceSchedule) PriceScheduleResponse {
+	price, _ := strconv.ParseFloat(s.Price, 64)
+	return PriceScheduleResponse{
+		ID:                    s.ID.String(),
+		ProductID:             s.ProductID.String(),
+		Price:                 price,
+		DiscountPrice:         nullPrice(s.DiscountPrice),
+		StartsAt:              s.StartsAt,
+		EndsAt:                nullTime(s.EndsAt),
+		Status:                s.Status,
+		PreviousPrice:         nullPrice(s.PreviousPrice),
+		PreviousDiscountPrice: nullPrice(s.PreviousDiscountPrice),
+		Note:                  s.Note.String,
+		CreatedBy:             nullUUIDString(s.CreatedBy),
+		AppliedAt:             nullTime(s.AppliedAt),
+		EndedAt:               nullTime(s.EndedAt),
+		CreatedAt:             s.CreatedAt,
+	}
+}
+
+// nullPrice kolom DECIMAL nullable -> *float64 (nil = tidak ada)
+func nullPrice(v sql.NullString) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	f, err := strconv.ParseFloat(v.String, 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+func nullTime(v sql.NullTime) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Time
+}
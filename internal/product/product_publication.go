@@ -0,0 +1,180 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/constants"
+	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	previewTokenPurpose    = "product_preview"
+	defaultPreviewTokenTTL = 24 * time.Hour
+)
+
+// isPublished sama dengan filter publikasi di query listing publik
+func isPublished(status string, publishAt sql.NullTime, now time.Time) bool {
+	switch status {
+	case constants.ProductStatusPublished:
+		return true
+	case constants.ProductStatusScheduled:
+		return publishAt.Valid && !publishAt.Time.After(now)
+	default:
+		return false
+	}
+}
+
+// publicationFor menentukan publish_at untuk status baru.
+// PUBLISHED dicap sekarang, DRAFT dikosongkan, ARCHIVED menyimpan tanggal terbit sebelumnya.
+func publicationFor(status string, publishAt *time.Time, current sql.NullTime, now time.Time) (string, sql.NullTime, error) {
+	status = strings.ToUpper(status)
+	switch status {
+	case "", constants.ProductStatusPublished:
+		return constants.ProductStatusPublished, sql.NullTime{Time: now, Valid: true}, nil
+	case constants.ProductStatusDraft:
+		return status, sql.NullTime{}, nil
+	case constants.ProductStatusScheduled:
+		if publishAt == nil || !publishAt.After(now) {
+			return "", sql.NullTime{}, producterrors.ErrInvalidPublishAt
+		}
+		return status, sql.NullTime{Time: publishAt.UTC(), Valid: true}, nil
+	case constants.ProductStatusArchived:
+		return status, current, nil
+	default:
+		return "", sql.NullTime{}, producterrors.ErrInvalidProductStatus
+	}
+}
+
+func (s *service) SetPublication(ctx context.Context, id string, req SetPublicationRequest) (ProductAdminResponse, error) {
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return ProductAdminResponse{}, producterrors.ErrInvalidProductID
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return ProductAdminResponse{}, apperror.MapValidationError(err)
+	}
+
+	existing, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProductAdminResponse{}, producterrors.ErrProductNotFound
+		}
+		return ProductAdminResponse{}, producterrors.ErrProductFailed
+	}
+
+	status, publishAt, err := publicationFor(req.Status, req.PublishAt, existing.PublishAt, time.Now())
+	if err != nil {
+		return ProductAdminResponse{}, err
+	}
+
+	if _, err := s.repo.SetPublication(ctx, dbgen.SetProductPublicationParams{
+		ID:        productID,
+		Status:    status,
+		PublishAt: publishAt,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProductAdminResponse{}, producterrors.ErrProductNotFound
+		}
+		return ProductAdminResponse{}, producterrors.ErrProductFailed
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// PublishDueProducts dijalankan worker untuk merapikan status SCHEDULED yang sudah jatuh tempo
+func (s *service) PublishDueProducts(ctx context.Context, now time.Time) (int64, error) {
+	return s.repo.PublishDue(ctx, now)
+}
+
+// CreatePreviewToken token bertanda tangan (JWT) untuk melihat produk yang belum terbit
+// tanpa login, mis. dibagikan ke tim marketing sebelum launch
+func (s *service) CreatePreviewToken(ctx context.Context, id string, req PreviewTokenRequest) (PreviewTokenResponse, error) {
+	productID, err := uuid.Parse(id)
+	if err != nil {
+		return PreviewTokenResponse{}, producterrors.ErrInvalidProductID
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return PreviewTokenResponse{}, apperror.MapValidationError(err)
+	}
+
+	p, err := s.repo.GetByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PreviewTokenResponse{}, producterrors.ErrProductNotFound
+		}
+		return PreviewTokenResponse{}, producterrors.ErrProductFailed
+	}
+
+	ttl := defaultPreviewTokenTTL
+	if req.ExpiresInHours > 0 {
+		ttl = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"product_id": p.ID.String(),
+		"purpose":    previewTokenPurpose,
+		"exp":        expiresAt.Unix(),
+	}).SignedString(previewSecret())
+	if err != nil {
+		return PreviewTokenResponse{}, producterrors.ErrProductFailed
+	}
+
+	return PreviewTokenResponse{Token: token, Slug: p.Slug, ExpiresAt: expiresAt}, nil
+}
+
+// GetPreview detail produk apa pun statusnya, asalkan token valid untuk produk tersebut
+func (s *service) GetPreview(ctx context.Context, slug, token string) (ProductDetailResponse, error) {
+	productID, err := parsePreviewToken(token)
+	if err != nil {
+		return ProductDetailResponse{}, err
+	}
+
+	product, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProductDetailResponse{}, producterrors.ErrProductNotFound
+		}
+		return ProductDetailResponse{}, producterrors.ErrProductFailed
+	}
+	if product.ID != productID {
+		return ProductDetailResponse{}, producterrors.ErrInvalidPreviewToken
+	}
+
+	return s.buildDetail(ctx, product)
+}
+
+func previewSecret() []byte {
+	return []byte(os.Getenv("JWT_SECRET"))
+}
+
+func parsePreviewToken(token string) (uuid.UUID, error) {
+	claims := jwt.MapClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return previewSecret(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !parsed.Valid {
+		return uuid.Nil, producterrors.ErrInvalidPreviewToken
+	}
+
+	// Access token login juga ditandatangani JWT_SECRET, purpose mencegah dipakai sebagai preview
+	if purpose, _ := claims["purpose"].(string); purpose != previewTokenPurpose {
+		return uuid.Nil, producterrors.ErrInvalidPreviewToken
+	}
+	raw, _ := claims["product_id"].(string)
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, producterrors.ErrInvalidPreviewToken
+	}
+	return id, nil
+}
@@ -0,0 +1,204 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	producterrors "go-gadget-api/internal/product/errors"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	recentlyViewedKeyPrefix = "recently_viewed:"
+	// Jumlah produk yang disimpan per user, yang lama terbuang dari ekor list
+	maxRecentlyViewed = 20
+	// History ikut hilang jika user tidak melihat produk apa pun selama ini
+	recentlyViewedTTL = 30 * 24 * time.Hour
+
+	// Hitungan view per produk per hari (sorted set), disimpan sedikit lebih lama dari jendela trending
+	productViewsKeyPrefix = "product_views:"
+	productViewsTTL       = trendingWindow + 24*time.Hour
+
+	// Viewer (user/IP) yang sudah dihitung per produk per hari, supaya refresh berulang
+	// tidak menaikkan skor trending
+	productViewersKeyPrefix = "product_viewers:"
+	productViewersTTL       = 48 * time.Hour
+)
+
+type redisViewHistory struct {
+	rdb *redis.Client
+}
+
+// NewViewHistory menyimpan history di Redis list per user. Nil rdb → nil (fitur nonaktif).
+func NewViewHistory(rdb *redis.Client) ViewHistory {
+	if rdb == nil {
+		return nil
+	}
+	return &redisViewHistory{rdb: rdb}
+}
+
+func recentlyViewedKey(userID string) string {
+	return recentlyViewedKeyPrefix + userID
+}
+
+// Add memindahkan produk ke depan list (tanpa duplikat) lalu memotong list ke kapasitas maksimum
+func (h *redisViewHistory) Add(ctx context.Context, userID string, productID uuid.UUID) error {
+	key := recentlyViewedKey(userID)
+	pipe := h.rdb.TxPipeline()
+	pipe.LRem(ctx, key, 0, productID.String())
+	pipe.LPush(ctx, key, productID.String())
+	pipe.LTrim(ctx, key, 0, maxRecentlyViewed-1)
+	pipe.Expire(ctx, key, recentlyViewedTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (h *redisViewHistory) List(ctx context.Context, userID string) ([]uuid.UUID, error) {
+	vals, err := h.rdb.LRange(ctx, recentlyViewedKey(userID), 0, maxRecentlyViewed-1).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(vals))
+	for _, v := range vals {
+		if id, err := uuid.Parse(v); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (h *redisViewHistory) Clear(ctx context.Context, userID string) error {
+	return h.rdb.Del(ctx, recentlyViewedKey(userID)).Err()
+}
+
+func productViewsKey(day time.Time) string {
+	return productViewsKeyPrefix + day.UTC().Format("20060102")
+}
+
+func productViewersKey(day time.Time, productID uuid.UUID) string {
+	return productViewersKeyPrefix + day.UTC().Format("20060102") + ":" + productID.String()
+}
+
+func (h *redisViewHistory) CountView(ctx context.Context, productID uuid.UUID, viewer string, at time.Time) error {
+	seenKey := productViewersKey(at, productID)
+	seen := h.rdb.Pipeline()
+	added := seen.SAdd(ctx, seenKey, viewer)
+	seen.Expire(ctx, seenKey, productViewersTTL)
+	if _, err := seen.Exec(ctx); err != nil {
+		return err
+	}
+	if added.Val() == 0 {
+		return nil
+	}
+
+	key := productViewsKey(at)
+	pipe := h.rdb.Pipeline()
+	pipe.ZIncrBy(ctx, key, 1, productID.String())
+	pipe.Expire(ctx, key, productViewsTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ViewCounts total view per produk dari bucket harian antara since dan until (inklusif)
+func (h *redisViewHistory) ViewCounts(ctx context.Context, since, until time.Time) (map[uuid.UUID]int64, error) {
+	pipe := h.rdb.Pipeline()
+	var cmds []*redis.ZSliceCmd
+	for day := since.UTC().Truncate(24 * time.Hour); !day.After(until.UTC()); day = day.Add(24 * time.Hour) {
+		cmds = append(cmds, pipe.ZRangeWithScores(ctx, productViewsKey(day), 0, -1))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int64)
+	for _, cmd := range cmds {
+		for _, z := range cmd.Val() {
+			member, _ := z.Member.(string)
+			if id, err := uuid.Parse(member); err == nil {
+				counts[id] += int64(z.Score)
+			}
+		}
+	}
+	return counts, nil
+}
+
+// RecordView dipanggil dari halaman detail: view dihitung untuk trending sekali per hari
+// per user (atau per IP untuk guest), dan untuk user yang login juga masuk ke riwayat "terakhir dilihat"
+func (s *service) RecordView(ctx context.Context, userID, clientIP, productID string) error {
+	if s.viewHistory == nil {
+		return nil
+	}
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return producterrors.ErrInvalidProductID
+	}
+	viewer := "ip:" + clientIP
+	if userID != "" {
+		viewer = "user:" + userID
+	}
+	if err := s.viewHistory.CountView(ctx, pid, viewer, time.Now()); err != nil {
+		return err
+	}
+	if userID == "" {
+		return nil
+	}
+	return s.viewHistory.Add(ctx, userID, pid)
+}
+
+// ListRecentlyViewed ringkasan produk sesuai urutan history; produk nonaktif/terhapus dilewati
+func (s *service) ListRecentlyViewed(ctx context.Context, userID string) ([]ProductPublicResponse, error) {
+	res := make([]ProductPublicResponse, 0)
+	if s.viewHistory == nil {
+		return res, nil
+	}
+
+	ids, err := s.viewHistory.List(ctx, userID)
+	if err != nil {
+		log.Printf("[ListRecentlyViewed] Redis error for user %s: %v", userID, err)
+		return nil, producterrors.ErrProductFailed
+	}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	rows, err := s.repo.ListSummariesByIDs(ctx, ids)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	byID := make(map[uuid.UUID]ProductPublicResponse, len(rows))
+	for _, row := range rows {
+		price, _ := strconv.ParseFloat(row.Price, 64)
+		byID[row.ID] = ProductPublicResponse{
+			ID:           row.ID.String(),
+			CategoryId:   row.CategoryID.String(),
+			CategoryName: row.CategoryName,
+			Name:         row.Name,
+			Slug:         row.Slug,
+			Price:        price,
+			ImageURL:     row.ImageUrl.String,
+		}
+	}
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func (s *service) ClearRecentlyViewed(ctx context.Context, userID string) error {
+	if s.viewHistory == nil {
+		return nil
+	}
+	if err := s.viewHistory.Clear(ctx, userID); err != nil {
+		log.Printf("[ClearRecentlyViewed] Redis error for user %s: %v", userID, err)
+		return producterrors.ErrProductFailed
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/database/dbgen"
+)
+
+// Jumlah rekomendasi default & maksimum per widget
+const (
+	defaultRecommendationLimit = 8
+	maxRecommendationLimit     = 20
+)
+
+func clampRecommendationLimit(limit int) int32 {
+	if limit <= 0 {
+		return defaultRecommendationLimit
+	}
+	if limit > maxRecommendationLimit {
+		return maxRecommendationLimit
+	}
+	return int32(limit)
+}
+
+// getRecommendationSource produk acuan widget rekomendasi; produk nonaktif / belum terbit dianggap tidak ada
+func (s *service) getRecommendationSource(ctx context.Context, slug string) (dbgen.GetProductBySlugRow, error) {
+	product, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.GetProductBySlugRow{}, producterrors.ErrProductNotFound
+		}
+		return dbgen.GetProductBySlugRow{}, producterrors.ErrProductFailed
+	}
+	if !product.IsActive.Bool || !isPublished(product.Status, product.PublishAt, time.Now()) {
+		return dbgen.GetProductBySlugRow{}, producterrors.ErrProductNotFound
+	}
+	return product, nil
+}
+
+// ListRelated produk sekategori/sebrand dengan harga terdekat dari produk acuan
+func (s *service) ListRelated(ctx context.Context, slug string, limit int) ([]ProductPublicResponse, error) {
+	product, err := s.getRecommendationSource(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListRelated(ctx, dbgen.ListRelatedProductsParams{
+		ProductID:  product.ID,
+		CategoryID: product.CategoryID,
+		BrandID:    product.BrandID,
+		Price:      product.Price,
+		Limit:      clampRecommendationLimit(limit),
+	})
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	res := make([]ProductPublicResponse, 0, len(rows))
+	for _, row := range rows {
+		price, _ := strconv.ParseFloat(row.Price, 64)
+		res = append(res, ProductPublicResponse{
+			ID:           row.ID.String(),
+			CategoryId:   row.CategoryID.String(),
+			CategoryName: row.CategoryName,
+			Name:         row.Name,
+			Slug:         row.Slug,
+			Price:        price,
+			ImageURL:     row.ImageUrl.String,
+		})
+	}
+	return res, nil
+}
+
+// ListBoughtTogether produk yang paling sering ada di order COMPLETED yang sama.
+// Datanya dihitung berkala oleh worker (jobs.RefreshBoughtTogether), jadi produk
+// baru atau yang belum pernah terjual akan mengembalikan list kosong.
+func (s *service) ListBoughtTogether(ctx context.Context, slug string, limit int) ([]ProductPublicResponse, error) {
+	product, err := s.getRecommendationSource(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ListBoughtTogether(ctx, product.ID, clampRecommendationLimit(limit))
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	res := make([]ProductPublicResponse, 0, len(rows))
+	for _, row := range rows {
+		price, _ := strconv.ParseFloat(row.Price, 64)
+		res = append(res, ProductPublicResponse{
+			ID:           row.ID.String(),
+			CategoryId:   row.CategoryID.String(),
+			CategoryName: row.CategoryName,
+			Name:         row.Name,
+			Slug:         row.Slug,
+			Price:        price,
+			ImageURL:     row.ImageUrl.String,
+		})
+	}
+	return res, nil
+}
@@ -3,7 +3,10 @@ package product
 import (
 	"context"
 	"database/sql"
+	"go-gadget-api/internal/pkg/constants"
 	"go-gadget-api/internal/shared/database/dbgen"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,14 +17,111 @@ type Repository interface {
 	Create(ctx context.Context, arg dbgen.CreateProductParams) (dbgen.Product, error)
 	// Pisahkan List menjadi Public dan Admin sesuai query.sql terbaru
 	ListPublic(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error)
+	// Sort popularitas (best_selling/top_rated/trending), arg.SortBy menentukan query
+	ListPublicByPopularity(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error)
+	// Keyset (cursor): desc = baris sebelum posisi cursor dalam urutan menurun, false = sesudahnya menaik
+	ListPublicByCreated(ctx context.Context, arg dbgen.ListProductsPublicByCreatedDescParams, desc bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error)
+	ListPublicByPrice(ctx context.Context, arg dbgen.ListProductsPublicByPriceDescParams, desc bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error)
+	Suggest(ctx context.Context, query string, limitPerKind int32) ([]dbgen.SuggestSearchTermsRow, error)
+	GetFacets(ctx context.Context, arg dbgen.GetProductFacetsParams) ([]dbgen.GetProductFacetsRow, error)
 	ListAdmin(ctx context.Context, arg dbgen.ListProductsAdminParams) ([]dbgen.ListProductsAdminRow, error)
 
 	GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetProductByIDRow, error)
+	// Stok terkini dengan row lock (FOR UPDATE), hanya bermakna di dalam transaksi
+	GetStockForUpdate(ctx context.Context, id uuid.UUID) (int32, error)
 	Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) (dbgen.Product, error)
 
 	GetBySlug(ctx context.Context, slug string) (dbgen.GetProductBySlugRow, error)
+
+	// Slug history (redirect slug lama & cegah pemakaian ulang)
+	FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error)
+	IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error)
+	RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error
+
+	// Variants
+	ListVariants(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductVariantsRow, error)
+	ListOptions(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductOptionsRow, error)
+	GetVariant(ctx context.Context, productID, variantID uuid.UUID) (dbgen.ProductVariant, error)
+	HasVariants(ctx context.Context, productID uuid.UUID) (bool, error)
+	CreateVariant(ctx context.Context, arg dbgen.CreateProductVariantParams) (dbgen.ProductVariant, error)
+	UpdateVariant(ctx context.Context, arg dbgen.UpdateProductVariantParams) (dbgen.ProductVariant, error)
+	DeleteVariant(ctx context.Context, productID, variantID uuid.UUID) (int64, error)
+	UpsertOption(ctx context.Context, productID uuid.UUID, name string) (dbgen.ProductOption, error)
+	UpsertOptionValue(ctx context.Context, optionID uuid.UUID, value string) (dbgen.ProductOptionValue, error)
+	AddVariantValue(ctx context.Context, variantID, optionValueID uuid.UUID) error
+
+	// Attributes (spesifikasi terstruktur)
+	ListAttributeValues(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductAttributeValuesRow, error)
+	UpsertAttributeValue(ctx context.Context, arg dbgen.UpsertProductAttributeValueParams) error
+	DeleteAttributeValue(ctx context.Context, productID, attributeID uuid.UUID) error
+
+	// Gallery
+	ListImages(ctx context.Context, productID uuid.UUID) ([]dbgen.ProductImage, error)
+	GetImage(ctx context.Context, productID, imageID uuid.UUID) (dbgen.ProductImage, error)
+	NextImageSortOrder(ctx context.Context, productID uuid.UUID) (int32, error)
+	CreateImage(ctx context.Context, arg dbgen.CreateProductImageParams) (dbgen.ProductImage, error)
+	UpsertPrimaryImage(ctx context.Context, productID uuid.UUID, imageURL string) error
+	UpdateImageAltText(ctx context.Context, productID, imageID uuid.UUID, altText sql.NullString) (dbgen.ProductImage, error)
+	UpdateImageSortOrder(ctx context.Context, productID, imageID uuid.UUID, sortOrder int32) (int64, error)
+	ClearPrimaryImage(ctx context.Context, productID uuid.UUID) error
+	SetPrimaryImage(ctx context.Context, productID, imageID uuid.UUID) (dbgen.ProductImage, error)
+	DeleteImage(ctx context.Context, productID, imageID uuid.UUID) (int64, error)
+	SetImageURL(ctx context.Context, productID uuid.UUID, imageURL sql.NullString) error
+
+	// Import/export CSV
+	GetImportTargetBySKU(ctx context.Context, sku string) (dbgen.GetProductImportTargetBySKURow, error)
+	UpsertBySKU(ctx context.Context, arg dbgen.UpsertProductBySKUParams) (dbgen.UpsertProductBySKURow, error)
+	ListExistingSKUs(ctx context.Context, skus []string) ([]dbgen.ListExistingProductSKUsRow, error)
+	ListBrandRefsBySlugs(ctx context.Context, slugs []string) ([]dbgen.ListBrandRefsBySlugsRow, error)
+	ListCategoryRefsBySlugs(ctx context.Context, slugs []string) ([]dbgen.ListCategoryRefsBySlugsRow, error)
+	ListForExport(ctx context.Context) ([]dbgen.ListProductsForExportRow, error)
+	CreateImportJob(ctx context.Context, arg dbgen.CreateProductImportJobParams) (dbgen.ProductImportJob, error)
+	GetImportJob(ctx context.Context, id uuid.UUID) (dbgen.ProductImportJob, error)
+	StartImportJob(ctx context.Context, id uuid.UUID) error
+	UpdateImportJobProgress(ctx context.Context, arg dbgen.UpdateProductImportJobProgressParams) error
+	FinishImportJob(ctx context.Context, arg dbgen.FinishProductImportJobParams) error
+	FailImportJob(ctx context.Context, id uuid.UUID, message string) error
+	FailStaleImportJobs(ctx context.Context, startedBefore time.Time) (int64, error)
+
+	// Ledger stok
+	ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error)
+	ListStockMovements(ctx context.Context, arg dbgen.ListStockMovementsParams) ([]dbgen.ListStockMovementsRow, error)
+	GetWarehouse(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error)
+	CreateOutboxEvent(ctx context.Context, arg dbgen.CreateOutboxEventParams) error
+
+	// Langganan "kabari saya saat tersedia"
+	UpsertStockNotification(ctx context.Context, arg dbgen.UpsertStockNotificationParams) (dbgen.StockNotification, error)
+	DeleteStockNotification(ctx context.Context, arg dbgen.DeleteStockNotificationParams) (int64, error)
+
+	// Jadwal & riwayat harga
+	CreatePriceSchedule(ctx context.Context, arg dbgen.CreatePriceScheduleParams) (dbgen.ProductPriceSchedule, error)
+	CountOverlappingPriceSchedules(ctx context.Context, arg dbgen.CountOverlappingPriceSchedulesParams) (int64, error)
+	GetPriceSchedule(ctx context.Context, id, productID uuid.UUID) (dbgen.ProductPriceSchedule, error)
+	ListPriceSchedules(ctx context.Context, arg dbgen.ListPriceSchedulesParams) ([]dbgen.ListPriceSchedulesRow, error)
+	CancelPendingPriceSchedule(ctx context.Context, id uuid.UUID) (dbgen.ProductPriceSchedule, error)
+	ListDuePriceSchedules(ctx context.Context, now time.Time, limit int32) ([]dbgen.ProductPriceSchedule, error)
+	ListEndingPriceSchedules(ctx context.Context, now time.Time, limit int32) ([]dbgen.ProductPriceSchedule, error)
+	ExpirePriceSchedules(ctx context.Context, now time.Time) (int64, error)
+	StartPriceSchedule(ctx context.Context, id uuid.UUID) (dbgen.ProductPriceSchedule, error)
+	FinishPriceSchedule(ctx context.Context, id uuid.UUID, status string) (dbgen.ProductPriceSchedule, error)
+	SetPrice(ctx context.Context, arg dbgen.SetProductPriceParams) error
+	RevertScheduledPrice(ctx context.Context, scheduleID uuid.UUID) (int64, error)
+	RecordPriceChange(ctx context.Context, arg dbgen.RecordPriceChangeParams) error
+	ListPriceHistory(ctx context.Context, arg dbgen.ListPriceHistoryParams) ([]dbgen.ListPriceHistoryRow, error)
+
+	// Rekomendasi: produk terkait & sering dibeli bersama
+	ListRelated(ctx context.Context, arg dbgen.ListRelatedProductsParams) ([]dbgen.ListRelatedProductsRow, error)
+	ListBoughtTogether(ctx context.Context, productID uuid.UUID, limit int32) ([]dbgen.ListBoughtTogetherRow, error)
+	ListSummariesByIDs(ctx context.Context, ids []uuid.UUID) ([]dbgen.ListProductSummariesByIDsRow, error)
+
+	// Status publikasi
+	SetPublication(ctx context.Context, arg dbgen.SetProductPublicationParams) (dbgen.Product, error)
+	PublishDue(ctx context.Context, now time.Time) (int64, error)
+
+	// Counter popularitas (product_stats)
+	RefreshStats(ctx context.Context, arg dbgen.RefreshProductStatsParams) (int64, error)
 }
 
 type repository struct {
@@ -41,6 +141,80 @@ func (r *repository) ListPublic(ctx context.Context, arg dbgen.ListProductsPubli
 	return r.queries.ListProductsPublic(ctx, arg)
 }
 
+func (r *repository) ListPublicByCreated(ctx context.Context, arg dbgen.ListProductsPublicByCreatedDescParams, desc bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+	if desc {
+		return r.queries.ListProductsPublicByCreatedDesc(ctx, arg)
+	}
+	rows, err := r.queries.ListProductsPublicByCreatedAsc(ctx, dbgen.ListProductsPublicByCreatedAscParams(arg))
+	return keysetRows(rows), err
+}
+
+func (r *repository) ListPublicByPopularity(ctx context.Context, arg dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+	params := dbgen.ListProductsPublicBestSellingParams{
+		Limit:       arg.Limit,
+		Offset:      arg.Offset,
+		CategoryIds: arg.CategoryIds,
+		Search:      arg.Search,
+		BrandSlug:   arg.BrandSlug,
+		MinPrice:    arg.MinPrice,
+		MaxPrice:    arg.MaxPrice,
+		AttrCodes:   arg.AttrCodes,
+		AttrValues:  arg.AttrValues,
+		MinRating:   arg.MinRating,
+		InStock:     arg.InStock,
+	}
+
+	switch strings.ToLower(arg.SortBy) {
+	case "top_rated":
+		rows, err := r.queries.ListProductsPublicTopRated(ctx, dbgen.ListProductsPublicTopRatedParams(params))
+		return popularityRows(rows), err
+	case "trending":
+		rows, err := r.queries.ListProductsPublicTrending(ctx, dbgen.ListProductsPublicTrendingParams(params))
+		return popularityRows(rows), err
+	default:
+		rows, err := r.queries.ListProductsPublicBestSelling(ctx, params)
+		return popularityRows(rows), err
+	}
+}
+
+// popularityRows menyeragamkan hasil query popularitas (kolomnya identik dengan ListProductsPublic)
+func popularityRows[T dbgen.ListProductsPublicBestSellingRow | dbgen.ListProductsPublicTopRatedRow | dbgen.ListProductsPublicTrendingRow](rows []T) []dbgen.ListProductsPublicRow {
+	out := make([]dbgen.ListProductsPublicRow, len(rows))
+	for i, row := range rows {
+		out[i] = dbgen.ListProductsPublicRow(row)
+	}
+	return out
+}
+
+func (r *repository) ListPublicByPrice(ctx context.Context, arg dbgen.ListProductsPublicByPriceDescParams, desc bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+	if desc {
+		rows, err := r.queries.ListProductsPublicByPriceDesc(ctx, arg)
+		return keysetRows(rows), err
+	}
+	rows, err := r.queries.ListProductsPublicByPriceAsc(ctx, dbgen.ListProductsPublicByPriceAscParams(arg))
+	return keysetRows(rows), err
+}
+
+// keysetRows menyeragamkan hasil keempat query keyset (kolomnya identik)
+func keysetRows[T dbgen.ListProductsPublicByCreatedAscRow | dbgen.ListProductsPublicByPriceAscRow | dbgen.ListProductsPublicByPriceDescRow](rows []T) []dbgen.ListProductsPublicByCreatedDescRow {
+	out := make([]dbgen.ListProductsPublicByCreatedDescRow, len(rows))
+	for i, row := range rows {
+		out[i] = dbgen.ListProductsPublicByCreatedDescRow(row)
+	}
+	return out
+}
+
+func (r *repository) GetFacets(ctx context.Context, arg dbgen.GetProductFacetsParams) ([]dbgen.GetProductFacetsRow, error) {
+	return r.queries.GetProductFacets(ctx, arg)
+}
+
+func (r *repository) Suggest(ctx context.Context, query string, limitPerKind int32) ([]dbgen.SuggestSearchTermsRow, error) {
+	return r.queries.SuggestSearchTerms(ctx, dbgen.SuggestSearchTermsParams{
+		Query:        query,
+		LimitPerKind: limitPerKind,
+	})
+}
+
 // Implementasi List untuk Admin (Semua barang & filter dashboard)
 func (r *repository) ListAdmin(ctx context.Context, arg dbgen.ListProductsAdminParams) ([]dbgen.ListProductsAdminRow, error) {
 	return r.queries.ListProductsAdmin(ctx, arg)
@@ -50,10 +224,30 @@ func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetProduc
 	return r.queries.GetProductByID(ctx, id)
 }
 
+func (r *repository) GetStockForUpdate(ctx context.Context, id uuid.UUID) (int32, error) {
+	return r.queries.GetProductStockForUpdate(ctx, id)
+}
+
 func (r *repository) GetBySlug(ctx context.Context, slug string) (dbgen.GetProductBySlugRow, error) {
 	return r.queries.GetProductBySlug(ctx, slug)
 }
 
+func (r *repository) FindSlugOwner(ctx context.Context, slug string) (uuid.UUID, error) {
+	return r.queries.GetSlugHistoryOwner(ctx, dbgen.GetSlugHistoryOwnerParams{EntityType: constants.SlugEntityProduct, Slug: slug})
+}
+
+func (r *repository) IsSlugRetired(ctx context.Context, slug string, id uuid.UUID) (bool, error) {
+	return r.queries.IsSlugRetired(ctx, dbgen.IsSlugRetiredParams{EntityType: constants.SlugEntityProduct, Slug: slug, EntityID: id})
+}
+
+// RecordSlugChange menyimpan slug lama ke riwayat; slug baru yang dulu milik entitas ini dikeluarkan dari riwayat
+func (r *repository) RecordSlugChange(ctx context.Context, id uuid.UUID, oldSlug, newSlug string) error {
+	if err := r.queries.DeleteSlugHistory(ctx, dbgen.DeleteSlugHistoryParams{EntityType: constants.SlugEntityProduct, EntityID: id, Slug: newSlug}); err != nil {
+		return err
+	}
+	return r.queries.InsertSlugHistory(ctx, dbgen.InsertSlugHistoryParams{EntityType: constants.SlugEntityProduct, EntityID: id, Slug: oldSlug})
+}
+
 func (r *repository) Update(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
 	return r.queries.UpdateProduct(ctx, arg)
 }
@@ -66,6 +260,311 @@ func (r *repository) Restore(ctx context.Context, id uuid.UUID) (dbgen.Product,
 	return r.queries.RestoreProduct(ctx, id)
 }
 
+func (r *repository) ListVariants(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductVariantsRow, error) {
+	return r.queries.ListProductVariants(ctx, productID)
+}
+
+func (r *repository) ListOptions(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductOptionsRow, error) {
+	return r.queries.ListProductOptions(ctx, productID)
+}
+
+func (r *repository) GetVariant(ctx context.Context, productID, variantID uuid.UUID) (dbgen.ProductVariant, error) {
+	return r.queries.GetProductVariantByID(ctx, dbgen.GetProductVariantByIDParams{
+		ID:        variantID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) HasVariants(ctx context.Context, productID uuid.UUID) (bool, error) {
+	return r.queries.ProductHasVariants(ctx, productID)
+}
+
+func (r *repository) CreateVariant(ctx context.Context, arg dbgen.CreateProductVariantParams) (dbgen.ProductVariant, error) {
+	return r.queries.CreateProductVariant(ctx, arg)
+}
+
+func (r *repository) UpdateVariant(ctx context.Context, arg dbgen.UpdateProductVariantParams) (dbgen.ProductVariant, error) {
+	return r.queries.UpdateProductVariant(ctx, arg)
+}
+
+func (r *repository) DeleteVariant(ctx context.Context, productID, variantID uuid.UUID) (int64, error) {
+	return r.queries.SoftDeleteProductVariant(ctx, dbgen.SoftDeleteProductVariantParams{
+		ID:        variantID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) UpsertOption(ctx context.Context, productID uuid.UUID, name string) (dbgen.ProductOption, error) {
+	return r.queries.UpsertProductOption(ctx, dbgen.UpsertProductOptionParams{
+		ProductID: productID,
+		Name:      name,
+	})
+}
+
+func (r *repository) UpsertOptionValue(ctx context.Context, optionID uuid.UUID, value string) (dbgen.ProductOptionValue, error) {
+	return r.queries.UpsertProductOptionValue(ctx, dbgen.UpsertProductOptionValueParams{
+		OptionID: optionID,
+		Value:    value,
+	})
+}
+
+func (r *repository) AddVariantValue(ctx context.Context, variantID, optionValueID uuid.UUID) error {
+	return r.queries.AddProductVariantValue(ctx, dbgen.AddProductVariantValueParams{
+		VariantID:     variantID,
+		OptionValueID: optionValueID,
+	})
+}
+
+func (r *repository) ListAttributeValues(ctx context.Context, productID uuid.UUID) ([]dbgen.ListProductAttributeValuesRow, error) {
+	return r.queries.ListProductAttributeValues(ctx, productID)
+}
+
+func (r *repository) UpsertAttributeValue(ctx context.Context, arg dbgen.UpsertProductAttributeValueParams) error {
+	return r.queries.UpsertProductAttributeValue(ctx, arg)
+}
+
+func (r *repository) DeleteAttributeValue(ctx context.Context, productID, attributeID uuid.UUID) error {
+	return r.queries.DeleteProductAttributeValue(ctx, dbgen.DeleteProductAttributeValueParams{
+		ProductID:   productID,
+		AttributeID: attributeID,
+	})
+}
+
+func (r *repository) ListImages(ctx context.Context, productID uuid.UUID) ([]dbgen.ProductImage, error) {
+	return r.queries.ListProductImages(ctx, productID)
+}
+
+func (r *repository) GetImage(ctx context.Context, productID, imageID uuid.UUID) (dbgen.ProductImage, error) {
+	return r.queries.GetProductImageByID(ctx, dbgen.GetProductImageByIDParams{
+		ID:        imageID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) NextImageSortOrder(ctx context.Context, productID uuid.UUID) (int32, error) {
+	return r.queries.GetNextProductImageSortOrder(ctx, productID)
+}
+
+func (r *repository) CreateImage(ctx context.Context, arg dbgen.CreateProductImageParams) (dbgen.ProductImage, error) {
+	return r.queries.CreateProductImage(ctx, arg)
+}
+
+func (r *repository) UpsertPrimaryImage(ctx context.Context, productID uuid.UUID, imageURL string) error {
+	return r.queries.UpsertPrimaryProductImage(ctx, dbgen.UpsertPrimaryProductImageParams{
+		ProductID: productID,
+		ImageUrl:  imageURL,
+	})
+}
+
+func (r *repository) UpdateImageAltText(ctx context.Context, productID, imageID uuid.UUID, altText sql.NullString) (dbgen.ProductImage, error) {
+	return r.queries.UpdateProductImageAltText(ctx, dbgen.UpdateProductImageAltTextParams{
+		ID:        imageID,
+		ProductID: productID,
+		AltText:   altText,
+	})
+}
+
+func (r *repository) UpdateImageSortOrder(ctx context.Context, productID, imageID uuid.UUID, sortOrder int32) (int64, error) {
+	return r.queries.UpdateProductImageSortOrder(ctx, dbgen.UpdateProductImageSortOrderParams{
+		ID:        imageID,
+		ProductID: productID,
+		SortOrder: sortOrder,
+	})
+}
+
+func (r *repository) ClearPrimaryImage(ctx context.Context, productID uuid.UUID) error {
+	return r.queries.ClearPrimaryProductImage(ctx, productID)
+}
+
+func (r *repository) SetPrimaryImage(ctx context.Context, productID, imageID uuid.UUID) (dbgen.ProductImage, error) {
+	return r.queries.SetPrimaryProductImage(ctx, dbgen.SetPrimaryProductImageParams{
+		ID:        imageID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) DeleteImage(ctx context.Context, productID, imageID uuid.UUID) (int64, error) {
+	return r.queries.DeleteProductImage(ctx, dbgen.DeleteProductImageParams{
+		ID:        imageID,
+		ProductID: productID,
+	})
+}
+
+func (r *repository) SetImageURL(ctx context.Context, productID uuid.UUID, imageURL sql.NullString) error {
+	return r.queries.SetProductImageURL(ctx, dbgen.SetProductImageURLParams{
+		ID:       productID,
+		ImageUrl: imageURL,
+	})
+}
+
+// ==================== IMPORT / EXPORT ====================
+
+func (r *repository) GetImportTargetBySKU(ctx context.Context, sku string) (dbgen.GetProductImportTargetBySKURow, error) {
+	return r.queries.GetProductImportTargetBySKU(ctx, sql.NullString{String: sku, Valid: true})
+}
+
+func (r *repository) UpsertBySKU(ctx context.Context, arg dbgen.UpsertProductBySKUParams) (dbgen.UpsertProductBySKURow, error) {
+	return r.queries.UpsertProductBySKU(ctx, arg)
+}
+
+func (r *repository) ListExistingSKUs(ctx context.Context, skus []string) ([]dbgen.ListExistingProductSKUsRow, error) {
+	return r.queries.ListExistingProductSKUs(ctx, skus)
+}
+
+func (r *repository) ListBrandRefsBySlugs(ctx context.Context, slugs []string) ([]dbgen.ListBrandRefsBySlugsRow, error) {
+	return r.queries.ListBrandRefsBySlugs(ctx, slugs)
+}
+
+func (r *repository) ListCategoryRefsBySlugs(ctx context.Context, slugs []string) ([]dbgen.ListCategoryRefsBySlugsRow, error) {
+	return r.queries.ListCategoryRefsBySlugs(ctx, slugs)
+}
+
+func (r *repository) ListForExport(ctx context.Context) ([]dbgen.ListProductsForExportRow, error) {
+	return r.queries.ListProductsForExport(ctx)
+}
+
+func (r *repository) CreateImportJob(ctx context.Context, arg dbgen.CreateProductImportJobParams) (dbgen.ProductImportJob, error) {
+	return r.queries.CreateProductImportJob(ctx, arg)
+}
+
+func (r *repository) GetImportJob(ctx context.Context, id uuid.UUID) (dbgen.ProductImportJob, error) {
+	return r.queries.GetProductImportJob(ctx, id)
+}
+
+func (r *repository) StartImportJob(ctx context.Context, id uuid.UUID) error {
+	return r.queries.StartProductImportJob(ctx, id)
+}
+
+func (r *repository) UpdateImportJobProgress(ctx context.Context, arg dbgen.UpdateProductImportJobProgressParams) error {
+	return r.queries.UpdateProductImportJobProgress(ctx, arg)
+}
+
+func (r *repository) FinishImportJob(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+	return r.queries.FinishProductImportJob(ctx, arg)
+}
+
+func (r *repository) FailImportJob(ctx context.Context, id uuid.UUID, message string) error {
+	return r.queries.FailProductImportJob(ctx, dbgen.FailProductImportJobParams{
+		ID:      id,
+		Message: sql.NullString{String: message, Valid: true},
+	})
+}
+
+func (r *repository) FailStaleImportJobs(ctx context.Context, startedBefore time.Time) (int64, error) {
+	return r.queries.FailStaleProductImportJobs(ctx, startedBefore)
+}
+
+// ==================== STOCK LEDGER ====================
+
+func (r *repository) ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+	return r.queries.ApplyStockMovement(ctx, arg)
+}
+
+func (r *repository) ListStockMovements(ctx context.Context, arg dbgen.ListStockMovementsParams) ([]dbgen.ListStockMovementsRow, error) {
+	return r.queries.ListStockMovements(ctx, arg)
+}
+
+func (r *repository) GetWarehouse(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error) {
+	return r.queries.GetWarehouseByID(ctx, id)
+}
+
+// Alert stok dikirim lewat outbox di transaksi yang sama dengan mutasinya
+func (r *repository) CreateOutboxEvent(ctx context.Context, arg dbgen.CreateOutboxEventParams) error {
+	return r.queries.CreateOutboxEvent(ctx, arg)
+}
+
+// ==================== STOCK NOTIFICATIONS ====================
+
+func (r *repository) UpsertStockNotification(ctx context.Context, arg dbgen.UpsertStockNotificationParams) (dbgen.StockNotification, error) {
+	return r.queries.UpsertStockNotification(ctx, arg)
+}
+
+func (r *repository) DeleteStockNotification(ctx context.Context, arg dbgen.DeleteStockNotificationParams) (int64, error) {
+	return r.queries.DeleteStockNotification(ctx, arg)
+}
+
+// ==================== PRICE SCHEDULES ====================
+
+func (r *repository) CreatePriceSchedule(ctx context.Context, arg dbgen.CreatePriceScheduleParams) (dbgen.ProductPriceSchedule, error) {
+	return r.queries.CreatePriceSchedule(ctx, arg)
+}
+
+func (r *repository) CountOverlappingPriceSchedules(ctx context.Context, arg dbgen.CountOverlappingPriceSchedulesParams) (int64, error) {
+	return r.queries.CountOverlappingPriceSchedules(ctx, arg)
+}
+
+func (r *repository) GetPriceSchedule(ctx context.Context, id, productID uuid.UUID) (dbgen.ProductPriceSchedule, error) {
+	return r.queries.GetPriceSchedule(ctx, dbgen.GetPriceScheduleParams{ID: id, ProductID: productID})
+}
+
+func (r *repository) ListPriceSchedules(ctx context.Context, arg dbgen.ListPriceSchedulesParams) ([]dbgen.ListPriceSchedulesRow, error) {
+	return r.queries.ListPriceSchedules(ctx, arg)
+}
+
+func (r *repository) CancelPendingPriceSchedule(ctx context.Context, id uuid.UUID) (dbgen.ProductPriceSchedule, error) {
+	return r.queries.CancelPendingPriceSchedule(ctx, id)
+}
+
+func (r *repository) ListDuePriceSchedules(ctx context.Context, now time.Time, limit int32) ([]dbgen.ProductPriceSchedule, error) {
+	return r.queries.ListDuePriceSchedules(ctx, dbgen.ListDuePriceSchedulesParams{Now: now, RowLimit: limit})
+}
+
+func (r *repository) ListEndingPriceSchedules(ctx context.Context, now time.Time, limit int32) ([]dbgen.ProductPriceSchedule, error) {
+	return r.queries.ListEndingPriceSchedules(ctx, dbgen.ListEndingPriceSchedulesParams{Now: now, RowLimit: limit})
+}
+
+func (r *repository) ExpirePriceSchedules(ctx context.Context, now time.Time) (int64, error) {
+	return r.queries.ExpirePriceSchedules(ctx, now)
+}
+
+func (r *repository) StartPriceSchedule(ctx context.Context, id uuid.UUID) (dbgen.ProductPriceSchedule, error) {
+	return r.queries.StartPriceSchedule(ctx, id)
+}
+
+func (r *repository) FinishPriceSchedule(ctx context.Context, id uuid.UUID, status string) (dbgen.ProductPriceSchedule, error) {
+	return r.queries.FinishPriceSchedule(ctx, dbgen.FinishPriceScheduleParams{ID: id, Status: status})
+}
+
+func (r *repository) SetPrice(ctx context.Context, arg dbgen.SetProductPriceParams) error {
+	return r.queries.SetProductPrice(ctx, arg)
+}
+
+func (r *repository) RevertScheduledPrice(ctx context.Context, scheduleID uuid.UUID) (int64, error) {
+	return r.queries.RevertScheduledPrice(ctx, scheduleID)
+}
+
+func (r *repository) RecordPriceChange(ctx context.Context, arg dbgen.RecordPriceChangeParams) error {
+	return r.queries.RecordPriceChange(ctx, arg)
+}
+
+func (r *repository) ListPriceHistory(ctx context.Context, arg dbgen.ListPriceHistoryParams) ([]dbgen.ListPriceHistoryRow, error) {
+	return r.queries.ListPriceHistory(ctx, arg)
+}
+
+func (r *repository) ListRelated(ctx context.Context, arg dbgen.ListRelatedProductsParams) ([]dbgen.ListRelatedProductsRow, error) {
+	return r.queries.ListRelatedProducts(ctx, arg)
+}
+
+func (r *repository) ListBoughtTogether(ctx context.Context, productID uuid.UUID, limit int32) ([]dbgen.ListBoughtTogetherRow, error) {
+	return r.queries.ListBoughtTogether(ctx, dbgen.ListBoughtTogetherParams{ProductID: productID, Limit: limit})
+}
+
+func (r *repository) ListSummariesByIDs(ctx context.Context, ids []uuid.UUID) ([]dbgen.ListProductSummariesByIDsRow, error) {
+	return r.queries.ListProductSummariesByIDs(ctx, ids)
+}
+
+func (r *repository) RefreshStats(ctx context.Context, arg dbgen.RefreshProductStatsParams) (int64, error) {
+	return r.queries.RefreshProductStats(ctx, arg)
+}
+
+func (r *repository) SetPublication(ctx context.Context, arg dbgen.SetProductPublicationParams) (dbgen.Product, error) {
+	return r.queries.SetProductPublication(ctx, arg)
+}
+
+func (r *repository) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	return r.queries.PublishDueProducts(ctx, sql.NullTime{Time: now, Valid: true})
+}
+
 func (r *repository) WithTx(tx dbgen.DBTX) Repository {
 	// Lakukan type assertion dari interface dbgen.DBTX ke *sql.Tx
 	// Karena s.db.BeginTx(ctx, nil) di service menghasilkan *sql.Tx
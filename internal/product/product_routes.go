@@ -17,12 +17,28 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 			handler.GetPublicList,
 		)
 
+		// Autocomplete dipanggil tiap ketikan (sudah di-debounce di FE), jadi burst lebih longgar.
+		// Harus didaftarkan sebelum /:slug.
+		products.GET("/suggest",
+			middleware.RateLimitByIP(15, 30),
+			handler.Suggest,
+		)
+
 		// 2. Detail Product (Per IP)
 		// Sedikit lebih ketat dari list karena biasanya memicu query join yang lebih berat.
+		// Optional auth: user login dicatat ke riwayat "terakhir dilihat"
 		products.GET("/:slug",
 			middleware.RateLimitByIP(5, 10),
+			middleware.OptionalAuthMiddleware(),
 			handler.GetBySlug,
 		)
+
+		// Preview produk yang belum terbit, akses cukup dengan token dari admin (tidak di-cache)
+		products.GET("/:slug/preview", middleware.RateLimitByIP(5, 10), handler.GetPreview)
+
+		// Widget rekomendasi di halaman detail (hasil di-cache, jadi cukup longgar)
+		products.GET("/:slug/related", middleware.RateLimitByIP(5, 10), handler.GetRelated)
+		products.GET("/:slug/bought-together", middleware.RateLimitByIP(5, 10), handler.GetBoughtTogether)
 	}
 
 	// 3. Review Eligibility (Per User - Optional Auth)
@@ -37,7 +53,24 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 		)
 	}
 
-	// 4. Admin Product Routes (Per User/Admin)
+	// 4. Notify-me stok (Per User - Wajib Login)
+	// Email PRODUCT_BACK_IN_STOCK dikirim consumer saat stok kembali tersedia
+	customer := products.Group("")
+	customer.Use(middleware.AuthMiddleware())
+	{
+		customer.POST("/:slug/stock-notifications", middleware.RateLimitByUser(1, 3), handler.SubscribeStockNotification)
+		customer.DELETE("/:slug/stock-notifications", middleware.RateLimitByUser(1, 3), handler.UnsubscribeStockNotification)
+	}
+
+	// 5. Terakhir dilihat (Per User - Wajib Login), disimpan di Redis
+	me := r.Group("/me")
+	me.Use(middleware.AuthMiddleware())
+	{
+		me.GET("/recently-viewed", middleware.RateLimitByUser(5, 10), handler.ListRecentlyViewed)
+		me.DELETE("/recently-viewed", middleware.RateLimitByUser(1, 3), handler.ClearRecentlyViewed)
+	}
+
+	// 6. Admin Product Routes (Per User/Admin)
 	adminProducts := r.Group("/admin/products")
 	adminProducts.Use(middleware.AuthMiddleware())
 	adminProducts.Use(middleware.RoleMiddleware("ADMIN", "SUPERADMIN"))
@@ -53,6 +86,10 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 			handler.GetByID,
 		)
 
+		// Bulk import/export CSV (import diproses async, status lewat /import/:jobId)
+		adminProducts.GET("/export", middleware.RateLimitByUser(1, 3), handler.Export)
+		adminProducts.GET("/import/:jobId", middleware.RateLimitByUser(10, 20), handler.GetImportJob)
+
 		// Create, Update, Delete, Restore (Ketat)
 		// Mencegah ketidaksengajaan double-click atau script malfungsi yang merusak data.
 		// limit 1 rps, burst 3
@@ -62,5 +99,37 @@ func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
 		adminProducts.PATCH("/:id", adminMutationLimit, handler.Update)
 		adminProducts.DELETE("/:id", adminMutationLimit, handler.Delete)
 		adminProducts.PATCH("/:id/restore", adminMutationLimit, handler.Restore)
+		adminProducts.POST("/import", adminMutationLimit, handler.Import)
+
+		// Variants (kombinasi opsi seperti warna/storage, masing-masing punya SKU, harga & stok)
+		adminProducts.GET("/:id/variants", middleware.RateLimitByUser(10, 20), handler.ListVariants)
+		adminProducts.POST("/:id/variants", adminMutationLimit, handler.CreateVariant)
+		adminProducts.PATCH("/:id/variants/:variantId", adminMutationLimit, handler.UpdateVariant)
+		adminProducts.DELETE("/:id/variants/:variantId", adminMutationLimit, handler.DeleteVariant)
+
+		// Spesifikasi terstruktur, schema-nya didefinisikan per kategori di /admin/categories/:id/attributes
+		adminProducts.GET("/:id/attributes", middleware.RateLimitByUser(10, 20), handler.GetAttributes)
+		adminProducts.PUT("/:id/attributes", adminMutationLimit, handler.SetAttributes)
+
+		// Galeri gambar (gambar primary = thumbnail di list produk)
+		adminProducts.GET("/:id/images", middleware.RateLimitByUser(10, 20), handler.ListImages)
+		adminProducts.POST("/:id/images", adminMutationLimit, handler.UploadImages)
+		adminProducts.PUT("/:id/images/order", adminMutationLimit, handler.ReorderImages)
+		adminProducts.PATCH("/:id/images/:imageId", adminMutationLimit, handler.UpdateImage)
+		adminProducts.DELETE("/:id/images/:imageId", adminMutationLimit, handler.DeleteImage)
+
+		// Ledger stok: stok hanya berubah lewat mutasi (penjualan, pembatalan, refund, adjustment, import)
+		adminProducts.GET("/:id/stock-movements", middleware.RateLimitByUser(10, 20), handler.ListStockMovements)
+		adminProducts.POST("/:id/stock-adjustments", adminMutationLimit, handler.AdjustStock)
+
+		// Jadwal harga diterapkan worker saat startsAt, harga lama dikembalikan saat endsAt
+		adminProducts.GET("/:id/price-schedules", middleware.RateLimitByUser(10, 20), handler.ListPriceSchedules)
+		adminProducts.POST("/:id/price-schedules", adminMutationLimit, handler.CreatePriceSchedule)
+		adminProducts.PATCH("/:id/price-schedules/:scheduleId/cancel", adminMutationLimit, handler.CancelPriceSchedule)
+		adminProducts.GET("/:id/price-history", middleware.RateLimitByUser(10, 20), handler.ListPriceHistory)
+
+		// Publikasi (DRAFT/SCHEDULED/PUBLISHED/ARCHIVED) & link preview sebelum terbit
+		adminProducts.PATCH("/:id/publication", adminMutationLimit, handler.SetPublication)
+		adminProducts.POST("/:id/preview-token", adminMutationLimit, handler.CreatePreviewToken)
 	}
 }
@@ -3,15 +3,22 @@ package product
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"go-gadget-api/internal/category"
+	"go-gadget-api/internal/cloudinary"
 	"go-gadget-api/internal/pkg/apperror"
 	"go-gadget-api/internal/pkg/constants"
+	"go-gadget-api/internal/pkg/cursor"
 	producterrors "go-gadget-api/internal/product/errors"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"go-gadget-api/internal/shared/database/helper"
+	"html"
+	"io"
 	"log"
 	"mime/multipart"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,6 +26,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type ReviewRepository interface {
@@ -41,9 +49,23 @@ type CloudinaryService interface {
 	DeleteImage(ctx context.Context, publicID string) error
 }
 
+// ViewHistory daftar produk terakhir dilihat per user (terbaru di depan)
+// dan hitungan view harian per produk untuk skor trending
+type ViewHistory interface {
+	Add(ctx context.Context, userID string, productID uuid.UUID) error
+	List(ctx context.Context, userID string) ([]uuid.UUID, error)
+	Clear(ctx context.Context, userID string) error
+	// CountView hanya menambah hitungan sekali per viewer per produk per hari
+	CountView(ctx context.Context, productID uuid.UUID, viewer string, at time.Time) error
+	ViewCounts(ctx context.Context, since, until time.Time) (map[uuid.UUID]int64, error)
+}
+
 //go:generate mockgen -source=product_service.go -destination=../mock/product/product_service_mock.go -package=mock
 type Service interface {
 	ListPublic(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, int64, error)
+	ListPublicCursor(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, cursor.Page, error)
+	Suggest(ctx context.Context, query string) (SuggestResponse, error)
+	GetFacets(ctx context.Context, req ListPublicRequest) (ProductFacetsResponse, error)
 	ListAdmin(ctx context.Context, req ListProductAdminRequest) ([]ProductAdminResponse, int64, error)
 	Create(ctx context.Context, req CreateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error)
 	Update(ctx context.Context, idStr string, req UpdateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error)
@@ -52,24 +74,106 @@ type Service interface {
 
 	GetByID(ctx context.Context, id string) (ProductAdminResponse, error)
 	GetBySlug(ctx context.Context, slug string) (ProductDetailResponse, error)
+
+	ListVariants(ctx context.Context, productID string) ([]ProductVariantResponse, error)
+	CreateVariant(ctx context.Context, productID string, req CreateVariantRequest) (ProductVariantResponse, error)
+	UpdateVariant(ctx context.Context, productID, variantID string, req UpdateVariantRequest) (ProductVariantResponse, error)
+	DeleteVariant(ctx context.Context, productID, variantID string) error
+
+	GetAttributes(ctx context.Context, productID string) ([]ProductAttributeResponse, error)
+	SetAttributes(ctx context.Context, productID string, req SetAttributesRequest) ([]ProductAttributeResponse, error)
+
+	ListImages(ctx context.Context, productID string) ([]ProductImageResponse, error)
+	UploadImages(ctx context.Context, productID string, files []ImageUpload) ([]ProductImageResponse, error)
+	UpdateImage(ctx context.Context, productID, imageID string, req UpdateImageRequest) (ProductImageResponse, error)
+	ReorderImages(ctx context.Context, productID string, req ReorderImagesRequest) ([]ProductImageResponse, error)
+	DeleteImage(ctx context.Context, productID, imageID string) error
+
+	// Import (async, status lewat GetImportJob) & export CSV
+	StartImport(ctx context.Context, req ImportProductsRequest, file io.Reader) (ImportJobResponse, error)
+	GetImportJob(ctx context.Context, id string) (ImportJobResponse, error)
+	FailStaleImports(ctx context.Context, olderThan time.Duration) (int64, error)
+	Export(ctx context.Context, w io.Writer) error
+
+	// Ledger stok: riwayat mutasi & koreksi manual
+	ListStockMovements(ctx context.Context, productID string, req ListStockMovementsRequest) ([]StockMovementResponse, int64, error)
+	AdjustStock(ctx context.Context, productID string, req StockAdjustmentRequest) (StockMovementResponse, error)
+
+	// Jadwal harga (diterapkan worker lewat RunPriceSchedules) & riwayat harga
+	CreatePriceSchedule(ctx context.Context, productID string, req CreatePriceScheduleRequest) (PriceScheduleResponse, error)
+	ListPriceSchedules(ctx context.Context, productID string, req ListPriceSchedulesRequest) ([]PriceScheduleResponse, int64, error)
+	CancelPriceSchedule(ctx context.Context, productID, scheduleID string) (PriceScheduleResponse, error)
+	ListPriceHistory(ctx context.Context, productID string, page, limit int) ([]PriceHistoryResponse, int64, error)
+	RunPriceSchedules(ctx context.Context, now time.Time) (PriceScheduleRunResult, error)
+
+	// Rekomendasi publik di halaman detail
+	ListRelated(ctx context.Context, slug string, limit int) ([]ProductPublicResponse, error)
+	ListBoughtTogether(ctx context.Context, slug string, limit int) ([]ProductPublicResponse, error)
+
+	// Publikasi: DRAFT/SCHEDULED/PUBLISHED/ARCHIVED & preview produk yang belum terbit
+	SetPublication(ctx context.Context, id string, req SetPublicationRequest) (ProductAdminResponse, error)
+	PublishDueProducts(ctx context.Context, now time.Time) (int64, error)
+	CreatePreviewToken(ctx context.Context, id string, req PreviewTokenRequest) (PreviewTokenResponse, error)
+	GetPreview(ctx context.Context, slug, token string) (ProductDetailResponse, error)
+
+	// Counter popularitas untuk sort best_selling/top_rated/trending (dijalankan worker)
+	RefreshPopularity(ctx context.Context, now time.Time) (int64, error)
+
+	// Terakhir dilihat (customer) & hitungan view; tanpa ViewHistory semua method ini no-op
+	RecordView(ctx context.Context, userID, clientIP, productID string) error
+	ListRecentlyViewed(ctx context.Context, userID string) ([]ProductPublicResponse, error)
+	ClearRecentlyViewed(ctx context.Context, userID string) error
+
+	// Notify-me (customer)
+	SubscribeStockNotification(ctx context.Context, userID, slug string, req StockNotificationRequest) (StockNotificationResponse, error)
+	UnsubscribeStockNotification(ctx context.Context, userID, slug, variantID string) error
+}
+
+// maxProductImages batas jumlah gambar galeri per produk
+const maxProductImages = 10
+
+// defaultMaxPrice dipakai saat filter max_price tidak diisi
+const defaultMaxPrice = 999999999
+
+// priceFacetBuckets rentang harga untuk facet sidebar, Max 0 = tanpa batas atas
+var priceFacetBuckets = []struct {
+	Min   float64
+	Max   float64
+	Label string
+}{
+	{0, 1_000_000, "Di bawah Rp1 juta"},
+	{1_000_000, 3_000_000, "Rp1 juta - Rp3 juta"},
+	{3_000_000, 5_000_000, "Rp3 juta - Rp5 juta"},
+	{5_000_000, 10_000_000, "Rp5 juta - Rp10 juta"},
+	{10_000_000, 0, "Di atas Rp10 juta"},
 }
 
+// Autocomplete dipanggil tiap ketikan, jadi dibatasi ketat waktunya (cache di cachedService)
+const (
+	suggestMinQueryLen  = 2
+	suggestMaxQueryLen  = 50
+	suggestLimitPerKind = 5
+	suggestQueryTimeout = 300 * time.Millisecond
+)
+
 type service struct {
 	db             *sql.DB
 	repo           Repository
 	categoryRepo   category.Repository
 	reviewRepo     ReviewRepository
 	cloudinaryRepo CloudinaryService
+	viewHistory    ViewHistory
 	validate       *validator.Validate
 }
 
-func NewService(db *sql.DB, repo Repository, categoryRepo category.Repository, reviewRepo ReviewRepository, cloudinaryRepo CloudinaryService) Service {
+func NewService(db *sql.DB, repo Repository, categoryRepo category.Repository, reviewRepo ReviewRepository, cloudinaryRepo CloudinaryService, viewHistory ViewHistory) Service {
 	return &service{
 		db:             db,
 		repo:           repo,
 		categoryRepo:   categoryRepo,
 		reviewRepo:     reviewRepo,
 		cloudinaryRepo: cloudinaryRepo,
+		viewHistory:    viewHistory,
 		validate:       validator.New(),
 	}
 }
@@ -79,59 +183,353 @@ func (s *service) ListPublic(ctx context.Context, req ListPublicRequest) ([]Prod
 	log.Printf("[ListPublic] Incoming request: Page=%d, Limit=%d, Search='%s', BrandSlug='%s', Categories=%v, Price Range=%.2f-%.2f",
 		req.Page, req.Limit, req.Search, req.BrandSlug, req.CategoryIDs, req.MinPrice, req.MaxPrice)
 
-	offset := (req.Page - 1) * req.Limit
+	params, err := s.publicListParams(ctx, req)
+	if err != nil {
+		// 2. Log error jika mapping slug ke ID gagal
+		log.Printf("[ListPublic] Error mapping slugs to IDs: %v", err)
+		return nil, 0, err
+	}
+	params.Limit = int32(req.Limit)
+	params.Offset = int32((req.Page - 1) * req.Limit)
+
+	// 3. Log sebelum memanggil repository (berguna untuk melihat final query params)
+	log.Printf("[ListPublic] Querying repository with params: %+v", params)
 
-	if req.MaxPrice == 0 {
-		req.MaxPrice = 999999999
+	var rows []dbgen.ListProductsPublicRow
+	if isPopularitySort(strings.ToLower(req.SortBy)) {
+		rows, err = s.repo.ListPublicByPopularity(ctx, params)
+	} else {
+		rows, err = s.repo.ListPublic(ctx, params)
+	}
+	if err != nil {
+		// 4. Log error dari sisi database/repo
+		log.Printf("[ListPublic] Repository error: %v", err)
+		return nil, 0, err
 	}
 
-	var categoryIDs []uuid.UUID
-	if len(req.CategoryIDs) > 0 {
-		var err error
-		categoryIDs, err = s.categoryRepo.GetIDsBySlugs(ctx, req.CategoryIDs)
-		if err != nil {
-			// 2. Log error jika mapping slug ke ID gagal
-			log.Printf("[ListPublic] Error mapping slugs to IDs: %v", err)
-			return nil, 0, err
+	results, total, err := s.mapToPublicResponse(rows)
+	if err != nil {
+		log.Printf("[ListPublic] Error mapping to response: %v", err)
+		return nil, 0, err
+	}
+
+	// 5. Log sukses dengan jumlah data yang didapat
+	log.Printf("[ListPublic] Success: Found %d records, Total: %d", len(results), total)
+
+	return results, total, nil
+}
+
+// ListPublicCursor listing publik dengan keyset pagination: posisi halaman tidak bergeser
+// saat ada produk baru (infinite scroll) dan tidak melambat di halaman dalam seperti OFFSET.
+func (s *service) ListPublicCursor(ctx context.Context, req ListPublicRequest) ([]ProductPublicResponse, cursor.Page, error) {
+	sortBy := strings.ToLower(req.SortBy)
+	if sortBy == "relevance" || isPopularitySort(sortBy) {
+		return nil, cursor.Page{}, producterrors.ErrCursorSortUnsupported
+	}
+
+	cur, err := cursor.Decode(req.Cursor)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	params, err := s.publicListParams(ctx, req)
+	if err != nil {
+		log.Printf("[ListPublicCursor] Error mapping slugs to IDs: %v", err)
+		return nil, cursor.Page{}, err
+	}
+
+	if cur != nil && (cur.Sort != sortBy || (isPriceSort(sortBy) && cur.Price == "")) {
+		return nil, cursor.Page{}, cursor.ErrInvalidCursor
+	}
+	desc := cursor.Descending(cur, sortBy != "oldest" && sortBy != "price_low")
+	createdAt, id, err := cursor.Bound(cur, desc)
+	if err != nil {
+		return nil, cursor.Page{}, err
+	}
+
+	// Ambil satu baris lebih untuk mengetahui masih ada halaman berikutnya
+	keyset := dbgen.ListProductsPublicByCreatedDescParams{
+		Limit:           int32(req.Limit + 1),
+		Search:          params.Search,
+		CategoryIds:     params.CategoryIds,
+		BrandSlug:       params.BrandSlug,
+		MinPrice:        params.MinPrice,
+		MaxPrice:        params.MaxPrice,
+		AttrCodes:       params.AttrCodes,
+		AttrValues:      params.AttrValues,
+		MinRating:       params.MinRating,
+		InStock:         params.InStock,
+		CursorCreatedAt: createdAt,
+		CursorID:        id,
+	}
+
+	var rows []dbgen.ListProductsPublicByCreatedDescRow
+	if isPriceSort(sortBy) {
+		rows, err = s.repo.ListPublicByPrice(ctx, dbgen.ListProductsPublicByPriceDescParams{
+			Limit:           keyset.Limit,
+			Search:          keyset.Search,
+			CategoryIds:     keyset.CategoryIds,
+			BrandSlug:       keyset.BrandSlug,
+			MinPrice:        keyset.MinPrice,
+			MaxPrice:        keyset.MaxPrice,
+			AttrCodes:       keyset.AttrCodes,
+			AttrValues:      keyset.AttrValues,
+			MinRating:       keyset.MinRating,
+			InStock:         keyset.InStock,
+			CursorPrice:     keysetPrice(cur, desc),
+			CursorCreatedAt: keyset.CursorCreatedAt,
+			CursorID:        keyset.CursorID,
+		}, desc)
+	} else {
+		rows, err = s.repo.ListPublicByCreated(ctx, keyset, desc)
+	}
+	if err != nil {
+		log.Printf("[ListPublicCursor] Repository error: %v", err)
+		return nil, cursor.Page{}, err
+	}
+
+	rows, page := cursor.Paginate(rows, req.Limit, cur, func(r dbgen.ListProductsPublicByCreatedDescRow) cursor.Cursor {
+		c := cursor.Cursor{ID: r.ID.String(), CreatedAt: r.CreatedAt, Sort: sortBy}
+		if isPriceSort(sortBy) {
+			c.Price = r.Price
 		}
-		log.Printf("[ListPublic] Mapped Category Slugs to IDs: %v", categoryIDs)
+		return c
+	})
+
+	results := make([]ProductPublicResponse, 0, len(rows))
+	for _, row := range rows {
+		price, _ := strconv.ParseFloat(row.Price, 64)
+		results = append(results, ProductPublicResponse{
+			ID:           row.ID.String(),
+			CategoryName: row.CategoryName,
+			CategoryId:   row.CategoryID.String(),
+			Name:         row.Name,
+			Slug:         row.Slug,
+			Price:        price,
+			ImageURL:     row.ImageUrl.String,
+			Highlight:    searchHighlight(row.NameHighlight, row.SearchSnippet, row.SearchRank),
+		})
+	}
+	return results, page, nil
+}
+
+// Batas harga halaman pertama sort harga (di luar rentang harga yang mungkin)
+const (
+	keysetMinPrice = "-1"
+	keysetMaxPrice = "1e18"
+)
+
+func keysetPrice(cur *cursor.Cursor, desc bool) string {
+	switch {
+	case cur != nil:
+		return cur.Price
+	case desc:
+		return keysetMaxPrice
+	default:
+		return keysetMinPrice
+	}
+}
+
+// Sort popularitas hanya mendukung offset pagination (skornya berubah tiap refresh)
+func isPopularitySort(sortBy string) bool {
+	return sortBy == "best_selling" || sortBy == "top_rated" || sortBy == "trending"
+}
+
+func isPriceSort(sortBy string) bool {
+	return sortBy == "price_high" || sortBy == "price_low"
+}
+
+// publicListParams filter bersama listing publik (tanpa limit/offset/cursor)
+func (s *service) publicListParams(ctx context.Context, req ListPublicRequest) (dbgen.ListProductsPublicParams, error) {
+	if req.MaxPrice == 0 {
+		req.MaxPrice = defaultMaxPrice
 	}
 
-	if len(categoryIDs) == 0 {
-		categoryIDs = nil
+	categoryIDs, err := s.resolveCategoryIDs(ctx, req.CategoryIDs)
+	if err != nil {
+		return dbgen.ListProductsPublicParams{}, err
 	}
 
 	params := dbgen.ListProductsPublicParams{
 		CategoryIds: categoryIDs,
-		Limit:       int32(req.Limit),
-		Offset:      int32(offset),
 		Search:      helper.StringToNull(&req.Search),
 		BrandSlug:   helper.StringToNull(&req.BrandSlug),
 		MinPrice:    fmt.Sprintf("%.2f", req.MinPrice),
 		MaxPrice:    fmt.Sprintf("%.2f", req.MaxPrice),
+		MinRating:   fmt.Sprintf("%.2f", req.MinRating),
+		InStock:     boolToNull(req.InStock),
 		SortBy:      req.SortBy,
 	}
+	params.AttrCodes, params.AttrValues = splitAttributeFilters(req.Attributes)
+	return params, nil
+}
 
-	// 3. Log sebelum memanggil repository (berguna untuk melihat final query params)
-	log.Printf("[ListPublic] Querying repository with params: %+v", params)
+func (s *service) GetFacets(ctx context.Context, req ListPublicRequest) (ProductFacetsResponse, error) {
+	if req.MaxPrice == 0 {
+		req.MaxPrice = defaultMaxPrice
+	}
 
-	rows, err := s.repo.ListPublic(ctx, params)
+	categoryIDs, err := s.resolveCategoryIDs(ctx, req.CategoryIDs)
 	if err != nil {
-		// 4. Log error dari sisi database/repo
-		log.Printf("[ListPublic] Repository error: %v", err)
-		return nil, 0, err
+		return ProductFacetsResponse{}, producterrors.ErrProductFailed
 	}
 
-	results, total, err := s.mapToPublicResponse(rows)
+	params := dbgen.GetProductFacetsParams{
+		CategoryIds: categoryIDs,
+		BrandSlug:   helper.StringToNull(&req.BrandSlug),
+		MinPrice:    fmt.Sprintf("%.2f", req.MinPrice),
+		MaxPrice:    fmt.Sprintf("%.2f", req.MaxPrice),
+		MinRating:   fmt.Sprintf("%.2f", req.MinRating),
+		InStock:     boolToNull(req.InStock),
+		Search:      helper.StringToNull(&req.Search),
+	}
+	params.AttrCodes, params.AttrValues = splitAttributeFilters(req.Attributes)
+	for _, b := range priceFacetBuckets {
+		max := b.Max
+		if max == 0 {
+			max = defaultMaxPrice + 1
+		}
+		params.PriceBucketMins = append(params.PriceBucketMins, fmt.Sprintf("%.2f", b.Min))
+		params.PriceBucketMaxs = append(params.PriceBucketMaxs, fmt.Sprintf("%.2f", max))
+	}
+
+	rows, err := s.repo.GetFacets(ctx, params)
 	if err != nil {
-		log.Printf("[ListPublic] Error mapping to response: %v", err)
-		return nil, 0, err
+		log.Printf("[GetFacets] Repository error: %v", err)
+		return ProductFacetsResponse{}, producterrors.ErrProductFailed
 	}
 
-	// 5. Log sukses dengan jumlah data yang didapat
-	log.Printf("[ListPublic] Success: Found %d records, Total: %d", len(results), total)
+	return mapFacets(rows), nil
+}
 
-	return results, total, nil
+func (s *service) Suggest(ctx context.Context, query string) (SuggestResponse, error) {
+	res := SuggestResponse{
+		Products:   []SuggestionItem{},
+		Brands:     []SuggestionItem{},
+		Categories: []SuggestionItem{},
+	}
+
+	query = normalizeSuggestQuery(query)
+	if len([]rune(query)) < suggestMinQueryLen {
+		return res, nil
+	}
+
+	// Query DB dengan budget waktu ketat
+	queryCtx, cancel := context.WithTimeout(ctx, suggestQueryTimeout)
+	defer cancel()
+
+	rows, err := s.repo.Suggest(queryCtx, query, suggestLimitPerKind)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			log.Printf("[Suggest] Query timeout for '%s'", query)
+			return SuggestResponse{}, producterrors.ErrSuggestTimeout
+		}
+		return SuggestResponse{}, producterrors.ErrProductFailed
+	}
+
+	for _, row := range rows {
+		item := SuggestionItem{Name: row.Name, Slug: row.Slug}
+		switch row.Kind {
+		case "product":
+			res.Products = append(res.Products, item)
+		case "brand":
+			res.Brands = append(res.Brands, item)
+		case "category":
+			res.Categories = append(res.Categories, item)
+		}
+	}
+
+	return res, nil
+}
+
+// resolveCategoryIDs memetakan slug kategori dari query string ke ID beserta seluruh subkategorinya, nil = tanpa filter
+func (s *service) resolveCategoryIDs(ctx context.Context, slugs []string) ([]uuid.UUID, error) {
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+
+	ids, err := s.categoryRepo.GetDescendantIDsBySlugs(ctx, slugs)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return ids, nil
+}
+
+func boolToNull(b *bool) sql.NullBool {
+	if b == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *b, Valid: true}
+}
+
+func mapFacets(rows []dbgen.GetProductFacetsRow) ProductFacetsResponse {
+	res := ProductFacetsResponse{
+		Brands:      []FacetBucket{},
+		Categories:  []FacetBucket{},
+		PriceRanges: make([]PriceFacetBucket, 0, len(priceFacetBuckets)),
+		Ratings:     []FacetBucket{},
+		Stock:       []FacetBucket{},
+	}
+
+	for _, b := range priceFacetBuckets {
+		bucket := PriceFacetBucket{Min: b.Min, Label: b.Label}
+		if b.Max > 0 {
+			max := b.Max
+			bucket.Max = &max
+		}
+		res.PriceRanges = append(res.PriceRanges, bucket)
+	}
+
+	for _, row := range rows {
+		switch row.Facet {
+		case "brand":
+			res.Brands = append(res.Brands, FacetBucket{Key: row.Key, Label: row.Label, Count: row.Count})
+		case "category":
+			res.Categories = append(res.Categories, FacetBucket{Key: row.Key, Label: row.Label, Count: row.Count})
+		case "price":
+			// key = urutan bucket (1-based) sesuai priceFacetBuckets
+			if idx, err := strconv.Atoi(row.Key); err == nil && idx >= 1 && idx <= len(res.PriceRanges) {
+				res.PriceRanges[idx-1].Count = row.Count
+			}
+		case "rating":
+			res.Ratings = append(res.Ratings, FacetBucket{Key: row.Key, Label: row.Key + " ke atas", Count: row.Count})
+		case "stock":
+			label := "Stok tersedia"
+			if row.Key == "out_of_stock" {
+				label = "Stok habis"
+			}
+			res.Stock = append(res.Stock, FacetBucket{Key: row.Key, Label: label, Count: row.Count})
+		}
+	}
+
+	byCount := func(items []FacetBucket) {
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Count != items[j].Count {
+				return items[i].Count > items[j].Count
+			}
+			return items[i].Label < items[j].Label
+		})
+	}
+	byCount(res.Brands)
+	byCount(res.Categories)
+	// Rating ditampilkan dari band tertinggi
+	sort.SliceStable(res.Ratings, func(i, j int) bool { return res.Ratings[i].Key > res.Ratings[j].Key })
+	sort.SliceStable(res.Stock, func(i, j int) bool { return res.Stock[i].Key < res.Stock[j].Key })
+
+	return res
+}
+
+// normalizeSuggestQuery menyeragamkan input agar cache key konsisten dan wildcard LIKE tidak ikut terkirim
+func normalizeSuggestQuery(query string) string {
+	query = strings.NewReplacer("%", " ", "_", " ", "\\", " ").Replace(query)
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+	if r := []rune(query); len(r) > suggestMaxQueryLen {
+		query = string(r[:suggestMaxQueryLen])
+	}
+	return query
 }
 
 func (s *service) GetBySlug(ctx context.Context, slug string) (ProductDetailResponse, error) {
@@ -139,11 +537,22 @@ func (s *service) GetBySlug(ctx context.Context, slug string) (ProductDetailResp
 	product, err := s.repo.GetBySlug(ctx, slug)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return ProductDetailResponse{}, producterrors.ErrProductNotFound
+			// Link lama setelah rename diarahkan ke slug kanonik
+			return ProductDetailResponse{}, s.redirectFromHistory(ctx, slug)
 		}
 		return ProductDetailResponse{}, producterrors.ErrProductFailed
 	}
 
+	// Produk yang belum terbit hanya bisa dilihat admin lewat GetPreview
+	if !isPublished(product.Status, product.PublishAt, time.Now()) {
+		return ProductDetailResponse{}, producterrors.ErrProductNotFound
+	}
+
+	return s.buildDetail(ctx, product)
+}
+
+// buildDetail melengkapi data produk dengan review, variant, spesifikasi & galeri
+func (s *service) buildDetail(ctx context.Context, product dbgen.GetProductBySlugRow) (ProductDetailResponse, error) {
 	// Gunakan Context dengan Timeout agar proses paralel tidak menggantung
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
@@ -154,10 +563,15 @@ func (s *service) GetBySlug(ctx context.Context, slug string) (ProductDetailResp
 		reviews     []dbgen.GetReviewsByProductIDRow
 		avgRating   float64
 		ratingCount int64
+		variants    []dbgen.ListProductVariantsRow
+		options     []dbgen.ListProductOptionsRow
+		attributes  []dbgen.ListProductAttributeValuesRow
+		images      []dbgen.ProductImage
+		breadcrumbs []dbgen.ListCategoryAncestorsRow
 	)
 
-	// Jalankan 3 tugas review secara paralel
-	wg.Add(3)
+	// Jalankan 3 tugas review + 2 tugas variant + spesifikasi + galeri + breadcrumb secara paralel
+	wg.Add(8)
 
 	//  Get reviews (Goroutine)
 	go func() {
@@ -192,11 +606,91 @@ func (s *service) GetBySlug(ctx context.Context, slug string) (ProductDetailResp
 		}
 	}()
 
-	// Tunggu semua informasi review selesai diambil
+	// Get variants & options (Goroutine)
+	go func() {
+		defer wg.Done()
+		res, err := s.repo.ListVariants(ctx, product.ID)
+		if err == nil {
+			mu.Lock()
+			variants = res
+			mu.Unlock()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		res, err := s.repo.ListOptions(ctx, product.ID)
+		if err == nil {
+			mu.Lock()
+			options = res
+			mu.Unlock()
+		}
+	}()
+
+	// Get spesifikasi (Goroutine)
+	go func() {
+		defer wg.Done()
+		res, err := s.repo.ListAttributeValues(ctx, product.ID)
+		if err == nil {
+			mu.Lock()
+			attributes = res
+			mu.Unlock()
+		}
+	}()
+
+	// Get galeri (Goroutine)
+	go func() {
+		defer wg.Done()
+		res, err := s.repo.ListImages(ctx, product.ID)
+		if err == nil {
+			mu.Lock()
+			images = res
+			mu.Unlock()
+		}
+	}()
+
+	// Get breadcrumb kategori root -> kategori produk (Goroutine)
+	go func() {
+		defer wg.Done()
+		res, err := s.categoryRepo.ListAncestors(ctx, product.CategoryID)
+		if err == nil {
+			mu.Lock()
+			breadcrumbs = res
+			mu.Unlock()
+		}
+	}()
+
+	// Tunggu semua informasi review, variant, spesifikasi, galeri & breadcrumb selesai diambil
 	wg.Wait()
 
 	// 5. Map to response (Gunakan mapper fungsi terpisah agar bersih)
-	return s.mapToDetailResponse(product, reviews, avgRating, int64(ratingCount)), nil
+	res := s.mapToDetailResponse(product, reviews, avgRating, int64(ratingCount))
+
+	// Customer hanya melihat variant yang aktif
+	for _, v := range variants {
+		if v.IsActive {
+			res.Variants = append(res.Variants, mapVariantRow(v))
+		}
+	}
+	for _, o := range options {
+		var values []string
+		_ = json.Unmarshal(o.OptionValues, &values)
+		res.Options = append(res.Options, ProductOptionResponse{Name: o.Name, Values: values})
+	}
+
+	res.Images = mapImages(images)
+	res.Breadcrumbs = mapBreadcrumbs(breadcrumbs)
+
+	if len(attributes) > 0 {
+		res.Specifications = make(map[string]string, len(attributes))
+		for _, a := range attributes {
+			attr := mapAttributeRow(a)
+			res.Attributes = append(res.Attributes, attr)
+			res.Specifications[attr.Name] = formatAttributeValue(attr)
+		}
+	}
+
+	return res, nil
 }
 
 func (s *service) ListAdmin(
@@ -277,8 +771,16 @@ func (s *service) Create(ctx context.Context, req CreateProductRequest, file mul
 		return ProductAdminResponse{}, producterrors.ErrCategoryNotFound
 	}
 
+	status, publishAt, err := publicationFor(req.Status, req.PublishAt, sql.NullTime{}, time.Now())
+	if err != nil {
+		return ProductAdminResponse{}, err
+	}
+
 	// 3. Persiapan Data (Slug & Price)
-	slug := strings.ToLower(strings.ReplaceAll(req.Name, " ", "-")) + "-" + uuid.New().String()[:5]
+	slug, err := s.availableSlug(ctx, req.Name, uuid.Nil)
+	if err != nil {
+		return ProductAdminResponse{}, err
+	}
 	priceStr := fmt.Sprintf("%.2f", req.Price)
 
 	// 4. Start Transaction
@@ -292,20 +794,40 @@ func (s *service) Create(ctx context.Context, req CreateProductRequest, file mul
 
 	// 5. Create Product (Tanpa Image dulu)
 	product, err := qtx.Create(ctx, dbgen.CreateProductParams{
-		BrandID:     uuid.NullUUID{UUID: brandID, Valid: true},
-		CategoryID:  catID,
-		Name:        req.Name,
-		Slug:        slug,
-		Description: helper.StringToNull(&req.Description),
-		Price:       priceStr,
-		Stock:       req.Stock,
-		Sku:         helper.StringToNull(&req.SKU),
-		ImageUrl:    sql.NullString{},
+		BrandID:           uuid.NullUUID{UUID: brandID, Valid: true},
+		CategoryID:        catID,
+		Name:              req.Name,
+		Slug:              slug,
+		Description:       helper.StringToNull(&req.Description),
+		Price:             priceStr,
+		Sku:               helper.StringToNull(&req.SKU),
+		ImageUrl:          sql.NullString{},
+		MaxQtyPerOrder:    maxQtyToNull(req.MaxQtyPerOrder),
+		LowStockThreshold: req.LowStockThreshold,
+		Status:            status,
+		PublishAt:         publishAt,
 	})
 	if err != nil {
 		return ProductAdminResponse{}, producterrors.ErrProductFailed
 	}
 
+	// Stok awal tercatat di ledger
+	if _, err := applyStock(ctx, qtx, dbgen.ApplyStockMovementParams{
+		Delta:     req.Stock,
+		ProductID: product.ID,
+		Reason:    constants.StockReasonAdjustment,
+		Note:      sql.NullString{String: "Initial stock", Valid: true},
+	}); err != nil {
+		return ProductAdminResponse{}, producterrors.ErrProductFailed
+	}
+
+	if err := recordPrice(ctx, qtx, dbgen.RecordPriceChangeParams{
+		ProductID: product.ID,
+		Source:    constants.PriceSourceManual,
+	}); err != nil {
+		return ProductAdminResponse{}, producterrors.ErrProductFailed
+	}
+
 	// 6. Handle Image Upload (Jika ada)
 	var imageURL string
 	if file != nil && filename != "" {
@@ -319,22 +841,30 @@ func (s *service) Create(ctx context.Context, req CreateProductRequest, file mul
 
 		// 7. Update Product dengan Image URL
 		_, err = qtx.Update(ctx, dbgen.UpdateProductParams{
-			ID:          product.ID,
-			BrandID:     product.BrandID,
-			CategoryID:  product.CategoryID,
-			Name:        product.Name,
-			Description: product.Description,
-			Price:       product.Price,
-			Stock:       product.Stock,
-			Sku:         product.Sku,
-			ImageUrl:    helper.StringToNull(&imageURL),
-			IsActive:    product.IsActive,
+			ID:                product.ID,
+			BrandID:           product.BrandID,
+			CategoryID:        product.CategoryID,
+			Name:              product.Name,
+			Description:       product.Description,
+			Price:             product.Price,
+			Sku:               product.Sku,
+			ImageUrl:          helper.StringToNull(&imageURL),
+			IsActive:          product.IsActive,
+			MaxQtyPerOrder:    product.MaxQtyPerOrder,
+			LowStockThreshold: product.LowStockThreshold,
+			Slug:              product.Slug,
 		})
 		if err != nil {
 			// Cleanup: Hapus gambar yang sudah terlanjur diupload jika update DB gagal
 			_ = s.cloudinaryRepo.DeleteImage(ctx, uniqueFilename)
 			return ProductAdminResponse{}, producterrors.ErrProductFailed
 		}
+
+		// Gambar utama juga masuk galeri sebagai primary
+		if err := qtx.UpsertPrimaryImage(ctx, product.ID, imageURL); err != nil {
+			_ = s.cloudinaryRepo.DeleteImage(ctx, uniqueFilename)
+			return ProductAdminResponse{}, producterrors.ErrProductFailed
+		}
 	}
 
 	// 8. Commit Transaction
@@ -363,18 +893,30 @@ func (s *service) GetByID(ctx context.Context, idStr string) (ProductAdminRespon
 
 	priceFloat, _ := strconv.ParseFloat(p.Price, 64)
 	return ProductAdminResponse{
-		ID:           p.ID.String(),
-		CategoryName: p.CategoryName,
-		Name:         p.Name,
-		Slug:         p.Slug,
-		Price:        priceFloat,
-		Stock:        p.Stock,
-		SKU:          p.Sku.String,
-		IsActive:     p.IsActive.Bool,
-		CreatedAt:    p.CreatedAt,
+		ID:                p.ID.String(),
+		CategoryName:      p.CategoryName,
+		Name:              p.Name,
+		Slug:              p.Slug,
+		Price:             priceFloat,
+		Stock:             p.Stock,
+		SKU:               p.Sku.String,
+		IsActive:          p.IsActive.Bool,
+		MaxQtyPerOrder:    p.MaxQtyPerOrder.Int32,
+		LowStockThreshold: p.LowStockThreshold,
+		Status:            p.Status,
+		PublishAt:         nullTime(p.PublishAt),
+		CreatedAt:         p.CreatedAt,
 	}, nil
 }
 
+// maxQtyToNull: nil/0 berarti produk tidak punya batas pembelian
+func maxQtyToNull(v *int32) sql.NullInt32 {
+	if v == nil || *v <= 0 {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *v, Valid: true}
+}
+
 // Update updates a product with optional image upload
 func (s *service) Update(ctx context.Context, idStr string, req UpdateProductRequest, file multipart.File, filename string) (ProductAdminResponse, error) {
 	// 1. Validate product ID
@@ -391,21 +933,28 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 
 	// 3. Prepare update params
 	params := dbgen.UpdateProductParams{
-		ID:          id,
-		Name:        existingProduct.Name,
-		Description: existingProduct.Description,
-		Price:       existingProduct.Price,
-		Stock:       existingProduct.Stock,
-		Sku:         existingProduct.Sku,
-		ImageUrl:    existingProduct.ImageUrl,
-		CategoryID:  existingProduct.CategoryID,
-		BrandID:     existingProduct.BrandID,
-		IsActive:    existingProduct.IsActive,
+		ID:                id,
+		Name:              existingProduct.Name,
+		Description:       existingProduct.Description,
+		Price:             existingProduct.Price,
+		Sku:               existingProduct.Sku,
+		ImageUrl:          existingProduct.ImageUrl,
+		CategoryID:        existingProduct.CategoryID,
+		BrandID:           existingProduct.BrandID,
+		IsActive:          existingProduct.IsActive,
+		MaxQtyPerOrder:    existingProduct.MaxQtyPerOrder,
+		LowStockThreshold: existingProduct.LowStockThreshold,
+		Slug:              existingProduct.Slug,
 	}
 
 	// 4. Update fields if provided
-	if req.Name != "" {
+	// Rename ikut mengganti slug; slug lama disimpan di slug_history untuk redirect
+	if req.Name != "" && req.Name != existingProduct.Name {
 		params.Name = req.Name
+		params.Slug, err = s.availableSlug(ctx, req.Name, id)
+		if err != nil {
+			return ProductAdminResponse{}, err
+		}
 	}
 	if req.CategoryID != "" {
 		catID, err := uuid.Parse(req.CategoryID)
@@ -422,9 +971,6 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 	if req.Price > 0 {
 		params.Price = fmt.Sprintf("%.2f", req.Price)
 	}
-	if req.Stock != 0 {
-		params.Stock = req.Stock
-	}
 	if req.SKU != "" {
 		params.Sku = helper.StringToNull(&req.SKU)
 	}
@@ -434,6 +980,12 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 	if req.IsActive != nil {
 		params.IsActive = sql.NullBool{Bool: *req.IsActive, Valid: true}
 	}
+	if req.MaxQtyPerOrder != nil {
+		params.MaxQtyPerOrder = maxQtyToNull(req.MaxQtyPerOrder)
+	}
+	if req.LowStockThreshold != nil {
+		params.LowStockThreshold = *req.LowStockThreshold
+	}
 
 	// 5. Start transaction
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -444,6 +996,20 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 
 	qtx := s.repo.WithTx(tx)
 
+	// Stok dari form tidak menimpa saldo, selisihnya dicatat sebagai adjustment.
+	// Saldo dibaca ulang dengan row lock agar movement yang masuk bersamaan tidak tertimpa.
+	var stockDelta int32
+	if req.Stock != 0 {
+		currentStock, err := qtx.GetStockForUpdate(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ProductAdminResponse{}, producterrors.ErrProductNotFound
+			}
+			return ProductAdminResponse{}, producterrors.ErrProductFailed
+		}
+		stockDelta = req.Stock - currentStock
+	}
+
 	// 6. Handle image upload if provided
 	var newImageURL string
 	var oldImageURL string
@@ -471,6 +1037,51 @@ func (s *service) Update(ctx context.Context, idStr string, req UpdateProductReq
 		return ProductAdminResponse{}, producterrors.ErrProductFailed
 	}
 
+	if params.Slug != existingProduct.Slug {
+		if err := qtx.RecordSlugChange(ctx, id, existingProduct.Slug, params.Slug); err != nil {
+			if newImageURL != "" {
+				_ = s.cloudinaryRepo.DeleteImage(ctx, fmt.Sprintf("%s-%s", id.String(), filename))
+			}
+			return ProductAdminResponse{}, producterrors.ErrProductFailed
+		}
+	}
+
+	// Riwayat harga untuk "harga terendah 30 hari"
+	if req.Price > 0 {
+		if err := recordPrice(ctx, qtx, dbgen.RecordPriceChangeParams{
+			ProductID: id,
+			Source:    constants.PriceSourceManual,
+		}); err != nil {
+			if newImageURL != "" {
+				_ = s.cloudinaryRepo.DeleteImage(ctx, fmt.Sprintf("%s-%s", id.String(), filename))
+			}
+			return ProductAdminResponse{}, producterrors.ErrProductFailed
+		}
+	}
+
+	if _, err := applyStock(ctx, qtx, dbgen.ApplyStockMovementParams{
+		Delta:     stockDelta,
+		ProductID: id,
+		Reason:    constants.StockReasonAdjustment,
+		Note:      sql.NullString{String: "Stock updated from product form", Valid: true},
+	}); err != nil {
+		if newImageURL != "" {
+			_ = s.cloudinaryRepo.DeleteImage(ctx, fmt.Sprintf("%s-%s", id.String(), filename))
+		}
+		if errors.Is(err, producterrors.ErrInsufficientStock) {
+			return ProductAdminResponse{}, err
+		}
+		return ProductAdminResponse{}, producterrors.ErrProductFailed
+	}
+
+	// Gambar baru menggantikan gambar primary di galeri
+	if newImageURL != "" {
+		if err := qtx.UpsertPrimaryImage(ctx, id, newImageURL); err != nil {
+			_ = s.cloudinaryRepo.DeleteImage(ctx, fmt.Sprintf("%s-%s", id.String(), filename))
+			return ProductAdminResponse{}, producterrors.ErrProductFailed
+		}
+	}
+
 	// 8. Commit transaction
 	if err := tx.Commit(); err != nil {
 		// Commit failed, cleanup new image
@@ -549,32 +1160,57 @@ func (s *service) mapToPublicResponse(rows []dbgen.ListProductsPublicRow) ([]Pro
 			Slug:         row.Slug,
 			Price:        priceFloat,
 			ImageURL:     row.ImageUrl.String,
+			Highlight:    searchHighlight(row.NameHighlight, row.SearchSnippet, row.SearchRank),
 		})
 	}
 	return res, total, nil
 }
 
-func (s *service) mapToAdminResponse(rows []dbgen.ListProductsAdminRow) ([]ProductAdminResponse, int64, error) {
-	var total int64
-	res := make([]ProductAdminResponse, 0)
-	for _, row := range rows {
-		if total == 0 {
+func searchHighlight(name, snippet sql.NullString, score float64) *SearchHighlight {
+	if !name.Valid {
+		return nil
+	}
+	return &SearchHighlight{
+		Name:    markHighlight(name.String),
+		Snippet: markHighlight(snippet.String),
+		Score:   score,
+	}
+}
+
+// Penanda kata yang cocok dari ts_headline (StartSel/StopSel di query listing publik)
+var highlightMarks = strings.NewReplacer("\x01", "<mark>", "\x02", "</mark>")
+
+// markHighlight meng-escape nama/deskripsi produk dulu agar HTML di dalamnya tidak ikut dirender,
+// baru penanda diganti <mark>
+func markHighlight(s string) string {
+	return highlightMarks.Replace(html.EscapeString(s))
+}
+
+func (s *service) mapToAdminResponse(rows []dbgen.ListProductsAdminRow) ([]ProductAdminResponse, int64, error) {
+	var total int64
+	res := make([]ProductAdminResponse, 0)
+	for _, row := range rows {
+		if total == 0 {
 			total = row.TotalCount
 		}
 		priceFloat, _ := strconv.ParseFloat(row.Price, 64)
 		res = append(res, ProductAdminResponse{
-			ID:           row.ID.String(),
-			CategoryID:   row.CategoryID.String(),
-			CategoryName: row.CategoryName,
-			BrandID:      row.BrandID.UUID.String(),
-			Name:         row.Name,
-			Slug:         row.Slug,
-			ImageURL:     row.ImageUrl.String,
-			Price:        priceFloat,
-			Stock:        row.Stock,
-			SKU:          row.Sku.String,
-			IsActive:     row.IsActive.Bool,
-			CreatedAt:    row.CreatedAt,
+			ID:                row.ID.String(),
+			CategoryID:        row.CategoryID.String(),
+			CategoryName:      row.CategoryName,
+			BrandID:           row.BrandID.UUID.String(),
+			Name:              row.Name,
+			Slug:              row.Slug,
+			ImageURL:          row.ImageUrl.String,
+			Price:             priceFloat,
+			Stock:             row.Stock,
+			SKU:               row.Sku.String,
+			IsActive:          row.IsActive.Bool,
+			MaxQtyPerOrder:    row.MaxQtyPerOrder.Int32,
+			LowStockThreshold: row.LowStockThreshold,
+			Status:            row.Status,
+			PublishAt:         nullTime(row.PublishAt),
+			CreatedAt:         row.CreatedAt,
 		})
 	}
 	return res, total, nil
@@ -587,6 +1223,7 @@ func (s *service) mapToDetailResponse(
 	ratingCount int64,
 ) ProductDetailResponse {
 	price, _ := strconv.ParseFloat(product.Price, 64)
+	lowest30d, _ := strconv.ParseFloat(product.LowestPrice30d, 64)
 
 	reviewSummaries := make([]ReviewSummary, 0)
 
@@ -601,21 +1238,792 @@ func (s *service) mapToDetailResponse(
 	}
 
 	return ProductDetailResponse{
-		ID:            product.ID.String(),
-		Name:          product.Name,
-		Slug:          product.Slug,
-		Description:   product.Description.String, // Handle sql.NullString
-		Price:         price,
-		Stock:         product.Stock,
-		ImageURL:      product.ImageUrl.String, // Handle sql.NullString
-		SKU:           product.Sku.String,
-		CategoryID:    product.CategoryID.String(),
-		Reviews:       reviewSummaries,
-		AverageRating: avgRating,
-		RatingCount:   ratingCount,
-		CreatedAt:     product.CreatedAt,
-		UpdatedAt:     product.UpdatedAt,
+		ID:             product.ID.String(),
+		Name:           product.Name,
+		Slug:           product.Slug,
+		Description:    product.Description.String, // Handle sql.NullString
+		Price:          price,
+		DiscountPrice:  nullPrice(product.DiscountPrice),
+		LowestPrice30d: lowest30d,
+		Stock:          product.Stock,
+		ImageURL:       product.ImageUrl.String, // Handle sql.NullString
+		SKU:            product.Sku.String,
+		CategoryID:     product.CategoryID.String(),
+		MaxQtyPerOrder: product.MaxQtyPerOrder.Int32,
+		Reviews:        reviewSummaries,
+		AverageRating:  avgRating,
+		RatingCount:    ratingCount,
+		CreatedAt:      product.CreatedAt,
+		UpdatedAt:      product.UpdatedAt,
+	}
+}
+
+// ==================== VARIANTS ====================
+
+func (s *service) ListVariants(ctx context.Context, productID string) ([]ProductVariantResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	rows, err := s.repo.ListVariants(ctx, pid)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	res := make([]ProductVariantResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapVariantRow(r))
+	}
+	return res, nil
+}
+
+func (s *service) CreateVariant(ctx context.Context, productID string, req CreateVariantRequest) (ProductVariantResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return ProductVariantResponse{}, producterrors.ErrInvalidProductID
+	}
+
+	// Normalisasi opsi; urutkan nama agar insert deterministik
+	options := make(map[string]string, len(req.Options))
+	names := make([]string, 0, len(req.Options))
+	for name, value := range req.Options {
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if name == "" || value == "" {
+			return ProductVariantResponse{}, producterrors.ErrInvalidVariantInput
+		}
+		options[name] = value
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ProductVariantResponse{}, producterrors.ErrInvalidVariantInput
+	}
+	sort.Strings(names)
+
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if err == sql.ErrNoRows {
+			return ProductVariantResponse{}, producterrors.ErrProductNotFound
+		}
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	variant, err := qtx.CreateVariant(ctx, dbgen.CreateProductVariantParams{
+		ProductID:     pid,
+		Sku:           strings.TrimSpace(req.SKU),
+		Price:         fmt.Sprintf("%.2f", req.Price),
+		DiscountPrice: discountToNull(req.DiscountPrice),
+		ImageUrl:      helper.StringToNull(&req.ImageUrl),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ProductVariantResponse{}, producterrors.ErrVariantSKUExists
+		}
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	m, err := applyStock(ctx, qtx, dbgen.ApplyStockMovementParams{
+		Delta:     req.Stock,
+		ProductID: pid,
+		VariantID: uuid.NullUUID{UUID: variant.ID, Valid: true},
+		Reason:    constants.StockReasonAdjustment,
+		Note:      sql.NullString{String: "Initial stock", Valid: true},
+	})
+	if err != nil {
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+	if req.Stock != 0 {
+		variant.Stock = m.BalanceAfter
+	}
+
+	for _, name := range names {
+		opt, err := qtx.UpsertOption(ctx, pid, name)
+		if err != nil {
+			return ProductVariantResponse{}, producterrors.ErrProductFailed
+		}
+		val, err := qtx.UpsertOptionValue(ctx, opt.ID, options[name])
+		if err != nil {
+			return ProductVariantResponse{}, producterrors.ErrProductFailed
+		}
+		if err := qtx.AddVariantValue(ctx, variant.ID, val.ID); err != nil {
+			return ProductVariantResponse{}, producterrors.ErrProductFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	return mapVariant(variant, options), nil
+}
+
+func (s *service) UpdateVariant(ctx context.Context, productID, variantID string, req UpdateVariantRequest) (ProductVariantResponse, error) {
+	pid, vid, err := parseVariantIDs(productID, variantID)
+	if err != nil {
+		return ProductVariantResponse{}, err
+	}
+
+	existing, err := s.repo.GetVariant(ctx, pid, vid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ProductVariantResponse{}, producterrors.ErrVariantNotFound
+		}
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	params := dbgen.UpdateProductVariantParams{
+		ID:            existing.ID,
+		ProductID:     existing.ProductID,
+		Sku:           existing.Sku,
+		Price:         existing.Price,
+		DiscountPrice: existing.DiscountPrice,
+		ImageUrl:      existing.ImageUrl,
+		IsActive:      existing.IsActive,
+	}
+	if sku := strings.TrimSpace(req.SKU); sku != "" {
+		params.Sku = sku
+	}
+	if req.Price > 0 {
+		params.Price = fmt.Sprintf("%.2f", req.Price)
+	}
+	if req.DiscountPrice != nil {
+		params.DiscountPrice = discountToNull(req.DiscountPrice)
+	}
+	var stockDelta int32
+	if req.Stock != nil {
+		stockDelta = *req.Stock - existing.Stock
+	}
+	if req.ImageUrl != nil {
+		params.ImageUrl = helper.StringToNull(req.ImageUrl)
+	}
+	if req.IsActive != nil {
+		params.IsActive = *req.IsActive
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	if _, err := qtx.UpdateVariant(ctx, params); err != nil {
+		if isUniqueViolation(err) {
+			return ProductVariantResponse{}, producterrors.ErrVariantSKUExists
+		}
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	// Stok baru dari request dicatat sebagai adjustment (selisih terhadap saldo lama)
+	if _, err := applyStock(ctx, qtx, dbgen.ApplyStockMovementParams{
+		Delta:     stockDelta,
+		ProductID: pid,
+		VariantID: uuid.NullUUID{UUID: vid, Valid: true},
+		Reason:    constants.StockReasonAdjustment,
+		Note:      sql.NullString{String: "Stock updated from variant form", Valid: true},
+	}); err != nil {
+		if errors.Is(err, producterrors.ErrInsufficientStock) {
+			return ProductVariantResponse{}, err
+		}
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+
+	// Ambil ulang beserta opsi
+	rows, err := s.repo.ListVariants(ctx, pid)
+	if err != nil {
+		return ProductVariantResponse{}, producterrors.ErrProductFailed
+	}
+	for _, r := range rows {
+		if r.ID == vid {
+			return mapVariantRow(r), nil
+		}
+	}
+	return ProductVariantResponse{}, producterrors.ErrVariantNotFound
+}
+
+func (s *service) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	pid, vid, err := parseVariantIDs(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := s.repo.DeleteVariant(ctx, pid, vid)
+	if err != nil {
+		return producterrors.ErrProductFailed
+	}
+	if affected == 0 {
+		return producterrors.ErrVariantNotFound
+	}
+	return nil
+}
+
+func parseVariantIDs(productID, variantID string) (uuid.UUID, uuid.UUID, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, producterrors.ErrInvalidProductID
+	}
+	vid, err := uuid.Parse(variantID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, producterrors.ErrInvalidVariantID
+	}
+	return pid, vid, nil
+}
+
+// discountToNull: nil/0 berarti variant tidak sedang diskon
+func discountToNull(v *float64) sql.NullString {
+	if v == nil || *v <= 0 {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: fmt.Sprintf("%.2f", *v), Valid: true}
+}
+
+// isUniqueViolation mendeteksi pelanggaran unique constraint postgres (mis. SKU duplikat)
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func mapVariant(v dbgen.ProductVariant, options map[string]string) ProductVariantResponse {
+	price, _ := strconv.ParseFloat(v.Price, 64)
+
+	res := ProductVariantResponse{
+		ID:       v.ID.String(),
+		SKU:      v.Sku,
+		Price:    price,
+		Stock:    v.Stock,
+		ImageURL: v.ImageUrl.String,
+		Options:  options,
+		IsActive: v.IsActive,
+	}
+	if v.DiscountPrice.Valid {
+		discount, _ := strconv.ParseFloat(v.DiscountPrice.String, 64)
+		res.DiscountPrice = &discount
+	}
+	return res
+}
+
+func mapVariantRow(r dbgen.ListProductVariantsRow) ProductVariantResponse {
+	options := map[string]string{}
+	_ = json.Unmarshal(r.Options, &options)
+
+	return mapVariant(dbgen.ProductVariant{
+		ID:            r.ID,
+		ProductID:     r.ProductID,
+		Sku:           r.Sku,
+		Price:         r.Price,
+		DiscountPrice: r.DiscountPrice,
+		Stock:         r.Stock,
+		ImageUrl:      r.ImageUrl,
+		IsActive:      r.IsActive,
+	}, options)
+}
+
+// ==================== ATTRIBUTES ====================
+
+func (s *service) GetAttributes(ctx context.Context, productID string) ([]ProductAttributeResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	rows, err := s.repo.ListAttributeValues(ctx, pid)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	res := make([]ProductAttributeResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, mapAttributeRow(r))
+	}
+	return res, nil
+}
+
+// SetAttributes mengisi/menghapus nilai spesifikasi produk sesuai schema atribut kategorinya
+func (s *service) SetAttributes(ctx context.Context, productID string, req SetAttributesRequest) ([]ProductAttributeResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	product, err := s.repo.GetByID(ctx, pid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, producterrors.ErrProductNotFound
+		}
+		return nil, producterrors.ErrProductFailed
+	}
+
+	schema, err := s.categoryRepo.ListAttributes(ctx, product.CategoryID)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+	byCode := make(map[string]dbgen.CategoryAttribute, len(schema))
+	for _, a := range schema {
+		byCode[a.Code] = a
+	}
+
+	// Validasi semua nilai dulu sebelum menulis apa pun
+	upserts := make([]dbgen.UpsertProductAttributeValueParams, 0, len(req.Values))
+	var deletes []uuid.UUID
+	for code, raw := range req.Values {
+		attr, ok := byCode[code]
+		if !ok {
+			return nil, producterrors.ErrUnknownAttribute
+		}
+		if raw == nil {
+			deletes = append(deletes, attr.ID)
+			continue
+		}
+		arg, err := toAttributeValueParams(attr, raw)
+		if err != nil {
+			return nil, err
+		}
+		arg.ProductID = pid
+		upserts = append(upserts, arg)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	for _, arg := range upserts {
+		if err := qtx.UpsertAttributeValue(ctx, arg); err != nil {
+			return nil, producterrors.ErrProductFailed
+		}
+	}
+	for _, attrID := range deletes {
+		if err := qtx.DeleteAttributeValue(ctx, pid, attrID); err != nil {
+			return nil, producterrors.ErrProductFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	return s.GetAttributes(ctx, productID)
+}
+
+// toAttributeValueParams mengisi kolom value sesuai data_type atribut
+func toAttributeValueParams(attr dbgen.CategoryAttribute, raw any) (dbgen.UpsertProductAttributeValueParams, error) {
+	arg := dbgen.UpsertProductAttributeValueParams{AttributeID: attr.ID}
+
+	switch attr.DataType {
+	case "number":
+		var n float64
+		switch v := raw.(type) {
+		case float64:
+			n = v
+		case string:
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return arg, producterrors.ErrInvalidAttributeValue
+			}
+			n = parsed
+		default:
+			return arg, producterrors.ErrInvalidAttributeValue
+		}
+		arg.ValueNumber = sql.NullString{String: strconv.FormatFloat(n, 'f', -1, 64), Valid: true}
+	case "boolean":
+		var b bool
+		switch v := raw.(type) {
+		case bool:
+			b = v
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return arg, producterrors.ErrInvalidAttributeValue
+			}
+			b = parsed
+		default:
+			return arg, producterrors.ErrInvalidAttributeValue
+		}
+		arg.ValueBoolean = sql.NullBool{Bool: b, Valid: true}
+	default:
+		v, ok := raw.(string)
+		if !ok || strings.TrimSpace(v) == "" {
+			return arg, producterrors.ErrInvalidAttributeValue
+		}
+		arg.ValueText = sql.NullString{String: strings.TrimSpace(v), Valid: true}
+	}
+
+	return arg, nil
+}
+
+func mapAttributeRow(r dbgen.ListProductAttributeValuesRow) ProductAttributeResponse {
+	res := ProductAttributeResponse{
+		Code:     r.Code,
+		Name:     r.Name,
+		DataType: r.DataType,
+		Unit:     r.Unit.String,
+	}
+
+	switch {
+	case r.ValueNumber.Valid:
+		n, _ := strconv.ParseFloat(r.ValueNumber.String, 64)
+		res.Value = n
+	case r.ValueBoolean.Valid:
+		res.Value = r.ValueBoolean.Bool
+	default:
+		res.Value = r.ValueText.String
+	}
+	return res
+}
+
+// formatAttributeValue: 8 + "GB" -> "8 GB", true -> "Yes"
+func formatAttributeValue(a ProductAttributeResponse) string {
+	switch v := a.Value.(type) {
+	case bool:
+		if v {
+			return "Yes"
+		}
+		return "No"
+	case float64:
+		s := strconv.FormatFloat(v, 'f', -1, 64)
+		if a.Unit != "" {
+			return s + " " + a.Unit
+		}
+		return s
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// splitAttributeFilters mengubah map filter menjadi dua array sejajar untuk query.
+// "8GB,12GB" pada satu code dipecah menjadi dua pasangan (OR).
+func splitAttributeFilters(filters map[string]string) ([]string, []string) {
+	var codes, values []string
+	for code, raw := range filters {
+		code = strings.ToLower(strings.TrimSpace(code))
+		if code == "" {
+			continue
+		}
+		for _, v := range strings.Split(raw, ",") {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				continue
+			}
+			codes = append(codes, code)
+			values = append(values, v)
+		}
+	}
+	return codes, values
+}
+
+// ==================== GALLERY ====================
+
+func (s *service) ListImages(ctx context.Context, productID string) ([]ProductImageResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	images, err := s.repo.ListImages(ctx, pid)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+	return mapImages(images), nil
+}
+
+// UploadImages mengunggah beberapa gambar sekaligus ke galeri.
+// Jika produk belum punya gambar, gambar pertama otomatis menjadi primary (thumbnail).
+func (s *service) UploadImages(ctx context.Context, productID string, files []ImageUpload) ([]ProductImageResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, producterrors.ErrProductNotFound
+		}
+		return nil, producterrors.ErrProductFailed
+	}
+
+	existing, err := s.repo.ListImages(ctx, pid)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+	if len(existing)+len(files) > maxProductImages {
+		return nil, producterrors.ErrTooManyImages
+	}
+
+	// 1. Upload ke Cloudinary dulu, rollback asset jika salah satu gagal
+	uploaded := make([]string, 0, len(files))
+	cleanup := func() {
+		for _, u := range uploaded {
+			if publicID, err := cloudinary.ExtractPublicID(u, constants.CloudinaryProductFolder); err == nil {
+				_ = s.cloudinaryRepo.DeleteImage(ctx, publicID)
+			}
+		}
+	}
+	for _, f := range files {
+		uniqueFilename := fmt.Sprintf("%s-%s-%s", pid.String(), uuid.New().String()[:8], f.Filename)
+		imageURL, err := s.cloudinaryRepo.UploadImage(ctx, f.File, uniqueFilename, constants.CloudinaryProductFolder)
+		if err != nil {
+			cleanup()
+			return nil, producterrors.ErrImageUploadFailed
+		}
+		uploaded = append(uploaded, imageURL)
+	}
+
+	// 2. Simpan ke DB
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		cleanup()
+		return nil, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	sortOrder, err := qtx.NextImageSortOrder(ctx, pid)
+	if err != nil {
+		cleanup()
+		return nil, producterrors.ErrProductFailed
+	}
+
+	hasPrimary := false
+	for _, img := range existing {
+		if img.IsPrimary {
+			hasPrimary = true
+			break
+		}
+	}
+
+	for i, imageURL := range uploaded {
+		isPrimary := !hasPrimary && i == 0
+		_, err := qtx.CreateImage(ctx, dbgen.CreateProductImageParams{
+			ProductID: pid,
+			ImageUrl:  imageURL,
+			AltText:   helper.StringToNull(&files[i].AltText),
+			SortOrder: sortOrder + int32(i),
+			IsPrimary: isPrimary,
+		})
+		if err != nil {
+			cleanup()
+			return nil, producterrors.ErrProductFailed
+		}
+		if isPrimary {
+			if err := qtx.SetImageURL(ctx, pid, helper.StringToNull(&imageURL)); err != nil {
+				cleanup()
+				return nil, producterrors.ErrProductFailed
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		cleanup()
+		return nil, producterrors.ErrProductFailed
+	}
+
+	return s.ListImages(ctx, productID)
+}
+
+func (s *service) UpdateImage(ctx context.Context, productID, imageID string, req UpdateImageRequest) (ProductImageResponse, error) {
+	pid, iid, err := parseImageIDs(productID, imageID)
+	if err != nil {
+		return ProductImageResponse{}, err
+	}
+
+	img, err := s.repo.GetImage(ctx, pid, iid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ProductImageResponse{}, producterrors.ErrProductImageNotFound
+		}
+		return ProductImageResponse{}, producterrors.ErrProductFailed
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProductImageResponse{}, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	if req.AltText != nil {
+		img, err = qtx.UpdateImageAltText(ctx, pid, iid, helper.StringToNull(req.AltText))
+		if err != nil {
+			return ProductImageResponse{}, producterrors.ErrProductFailed
+		}
+	}
+
+	// Set primary: lepas primary lama, lalu sinkronkan thumbnail produk
+	if req.IsPrimary != nil && *req.IsPrimary && !img.IsPrimary {
+		if err := qtx.ClearPrimaryImage(ctx, pid); err != nil {
+			return ProductImageResponse{}, producterrors.ErrProductFailed
+		}
+		img, err = qtx.SetPrimaryImage(ctx, pid, iid)
+		if err != nil {
+			return ProductImageResponse{}, producterrors.ErrProductFailed
+		}
+		if err := qtx.SetImageURL(ctx, pid, helper.StringToNull(&img.ImageUrl)); err != nil {
+			return ProductImageResponse{}, producterrors.ErrProductFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ProductImageResponse{}, producterrors.ErrProductFailed
+	}
+
+	return mapImage(img), nil
+}
+
+func (s *service) ReorderImages(ctx context.Context, productID string, req ReorderImagesRequest) ([]ProductImageResponse, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, producterrors.ErrInvalidProductID
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.ImageIDs))
+	for _, raw := range req.ImageIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, producterrors.ErrInvalidImageID
+		}
+		ids = append(ids, id)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	for i, id := range ids {
+		affected, err := qtx.UpdateImageSortOrder(ctx, pid, id, int32(i))
+		if err != nil {
+			return nil, producterrors.ErrProductFailed
+		}
+		if affected == 0 {
+			return nil, producterrors.ErrProductImageNotFound
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, producterrors.ErrProductFailed
+	}
+
+	return s.ListImages(ctx, productID)
+}
+
+// DeleteImage menghapus gambar dari galeri & Cloudinary.
+// Jika yang dihapus adalah primary, gambar berikutnya dipromosikan menjadi primary.
+func (s *service) DeleteImage(ctx context.Context, productID, imageID string) error {
+	pid, iid, err := parseImageIDs(productID, imageID)
+	if err != nil {
+		return err
+	}
+
+	img, err := s.repo.GetImage(ctx, pid, iid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return producterrors.ErrProductImageNotFound
+		}
+		return producterrors.ErrProductFailed
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+
+	affected, err := qtx.DeleteImage(ctx, pid, iid)
+	if err != nil {
+		return producterrors.ErrProductFailed
+	}
+	if affected == 0 {
+		return producterrors.ErrProductImageNotFound
+	}
+
+	if img.IsPrimary {
+		remaining, err := qtx.ListImages(ctx, pid)
+		if err != nil {
+			return producterrors.ErrProductFailed
+		}
+
+		thumbnail := sql.NullString{}
+		if len(remaining) > 0 {
+			next, err := qtx.SetPrimaryImage(ctx, pid, remaining[0].ID)
+			if err != nil {
+				return producterrors.ErrProductFailed
+			}
+			thumbnail = helper.StringToNull(&next.ImageUrl)
+		}
+		if err := qtx.SetImageURL(ctx, pid, thumbnail); err != nil {
+			return producterrors.ErrProductFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return producterrors.ErrProductFailed
+	}
+
+	// Fire and forget: data DB sudah konsisten walau asset gagal dihapus
+	if publicID, err := cloudinary.ExtractPublicID(img.ImageUrl, constants.CloudinaryProductFolder); err == nil {
+		_ = s.cloudinaryRepo.DeleteImage(ctx, publicID)
+	}
+
+	return nil
+}
+
+func parseImageIDs(productID, imageID string) (uuid.UUID, uuid.UUID, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, producterrors.ErrInvalidProductID
+	}
+	iid, err := uuid.Parse(imageID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, producterrors.ErrInvalidImageID
+	}
+	return pid, iid, nil
+}
+
+func mapImage(img dbgen.ProductImage) ProductImageResponse {
+	return ProductImageResponse{
+		ID:        img.ID.String(),
+		ImageURL:  img.ImageUrl,
+		AltText:   img.AltText.String,
+		SortOrder: img.SortOrder,
+		IsPrimary: img.IsPrimary,
+	}
+}
+
+func mapImages(images []dbgen.ProductImage) []ProductImageResponse {
+	res := make([]ProductImageResponse, 0, len(images))
+	for _, img := range images {
+		res = append(res, mapImage(img))
+	}
+	return res
+}
+
+// mapBreadcrumbs kosong jika kategori gagal diambil, halaman detail tetap tampil
+func mapBreadcrumbs(rows []dbgen.ListCategoryAncestorsRow) []BreadcrumbItem {
+	res := make([]BreadcrumbItem, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, BreadcrumbItem{Name: row.Name, Slug: row.Slug})
 	}
+	return res
 }
 
 // Helper function to calculate average rating (if needed in other methods)
@@ -5,12 +5,16 @@ import (
 	"database/sql"
 	"errors"
 	"mime/multipart"
+	"strings"
 	"testing"
 	"time"
 
+	"go-gadget-api/internal/pkg/apperror"
 	"go-gadget-api/internal/pkg/constants"
+	"go-gadget-api/internal/pkg/cursor"
 	"go-gadget-api/internal/product"
 	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/contextutil"
 	"go-gadget-api/internal/shared/database/dbgen"
 
 	categoryMock "go-gadget-api/internal/mock/category"
@@ -19,8 +23,10 @@ import (
 	reviewMock "go-gadget-api/internal/mock/review"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -36,6 +42,7 @@ type serviceDeps struct {
 	catRepo    *categoryMock.MockRepository
 	reviewRepo *reviewMock.MockRepository
 	cloudinary *cloudinaryMock.MockService
+	views      *productMock.MockViewHistory
 }
 
 func setupServiceTest(t *testing.T) *serviceDeps {
@@ -48,8 +55,9 @@ func setupServiceTest(t *testing.T) *serviceDeps {
 	catRepo := categoryMock.NewMockRepository(ctrl)
 	reviewRepo := reviewMock.NewMockRepository(ctrl)
 	cloudinary := cloudinaryMock.NewMockService(ctrl)
+	views := productMock.NewMockViewHistory(ctrl)
 
-	svc := product.NewService(db, repo, catRepo, reviewRepo, cloudinary)
+	svc := product.NewService(db, repo, catRepo, reviewRepo, cloudinary, views)
 
 	return &serviceDeps{
 		db:         db,
@@ -59,6 +67,7 @@ func setupServiceTest(t *testing.T) *serviceDeps {
 		catRepo:    catRepo,
 		reviewRepo: reviewRepo,
 		cloudinary: cloudinary,
+		views:      views,
 	}
 }
 
@@ -109,6 +118,7 @@ func TestProductService_Create(t *testing.T) {
 
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
 		deps.catRepo.EXPECT().GetByID(gomock.Any(), catID).Return(dbgen.Category{ID: catID}, nil)
+		deps.repo.EXPECT().IsSlugRetired(gomock.Any(), gomock.Any(), uuid.Nil).Return(false, nil)
 		deps.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
 			func(_ context.Context, arg dbgen.CreateProductParams) (dbgen.Product, error) {
 				assert.True(t, arg.BrandID.Valid)
@@ -121,12 +131,28 @@ func TestProductService_Create(t *testing.T) {
 			},
 		)
 
+		// Stok awal tidak ditulis langsung ke products, tapi lewat ledger
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), dbgen.ApplyStockMovementParams{
+			Delta:     10,
+			ProductID: productID,
+			Reason:    constants.StockReasonAdjustment,
+			Note:      sql.NullString{String: "Initial stock", Valid: true},
+		}).Return(dbgen.ApplyStockMovementRow{Delta: 10, BalanceAfter: 10}, nil)
+		// Saldo naik dari 0 -> event back-in-stock (consumer melewatinya bila belum ada subscriber)
+		deps.repo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+		// Harga awal menjadi entri pertama riwayat harga
+		deps.repo.EXPECT().RecordPriceChange(gomock.Any(), dbgen.RecordPriceChangeParams{
+			ProductID: productID,
+			Source:    constants.PriceSourceManual,
+		}).Return(nil)
+
 		// UploadImage akan dipanggil karena kita akan passing 'not nil' value di pemanggilan service
 		deps.cloudinary.EXPECT().
 			UploadImage(gomock.Any(), gomock.Any(), gomock.Any(), constants.CloudinaryProductFolder).
 			Return("https://img.jpg", nil)
 
 		deps.repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(dbgen.Product{}, nil)
+		deps.repo.EXPECT().UpsertPrimaryImage(gomock.Any(), productID, "https://img.jpg").Return(nil)
 
 		deps.repo.EXPECT().
 			GetByID(gomock.Any(), productID).
@@ -159,6 +185,7 @@ func TestProductService_Create(t *testing.T) {
 		deps.catRepo.EXPECT().
 			GetByID(gomock.Any(), gomock.Any()).
 			Return(dbgen.Category{ID: catID}, nil)
+		deps.repo.EXPECT().IsSlugRetired(gomock.Any(), gomock.Any(), uuid.Nil).Return(false, nil)
 
 		deps.repo.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
@@ -172,6 +199,9 @@ func TestProductService_Create(t *testing.T) {
 				}, nil
 			})
 
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Return(dbgen.ApplyStockMovementRow{}, nil)
+		deps.repo.EXPECT().RecordPriceChange(gomock.Any(), gomock.Any()).Return(nil)
+
 		// PERBAIKAN DI SINI:
 		// Gunakan gomock.Any() untuk argumen kedua (file)
 		deps.cloudinary.EXPECT().
@@ -183,6 +213,14 @@ func TestProductService_Create(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("negative - every slug candidate retired", func(t *testing.T) {
+		deps.catRepo.EXPECT().GetByID(gomock.Any(), catID).Return(dbgen.Category{ID: catID}, nil)
+		deps.repo.EXPECT().IsSlugRetired(gomock.Any(), gomock.Any(), uuid.Nil).Return(true, nil).Times(3)
+
+		_, err := deps.service.Create(ctx, req, nil, "")
+		assert.ErrorIs(t, err, producterrors.ErrSlugUnavailable)
+	})
 }
 
 //
@@ -199,6 +237,7 @@ func TestProductService_Update(t *testing.T) {
 	existing := dbgen.GetProductByIDRow{
 		ID:   id,
 		Name: "Old Name",
+		Slug: "old-name-abcde",
 		ImageUrl: sql.NullString{
 			String: "https://old.jpg",
 			Valid:  true,
@@ -221,14 +260,26 @@ func TestProductService_Update(t *testing.T) {
 
 		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
 
+		// Rename -> slug baru dicek terhadap slug_history
+		deps.repo.EXPECT().IsSlugRetired(ctx, gomock.Any(), id).Return(false, nil)
+
 		// verify the brand id is set in params passed to Update
+		var newSlug string
 		deps.repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(
 			func(ctx context.Context, arg dbgen.UpdateProductParams) (dbgen.Product, error) {
 				assert.True(t, arg.BrandID.Valid)
 				assert.Equal(t, newBrandID, arg.BrandID.UUID)
+				assert.True(t, strings.HasPrefix(arg.Slug, "new-name-"))
+				newSlug = arg.Slug
 				return dbgen.Product{}, nil
 			},
 		)
+		deps.repo.EXPECT().RecordSlugChange(ctx, id, existing.Slug, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, _, slug string) error {
+				assert.Equal(t, newSlug, slug)
+				return nil
+			},
+		)
 
 		// final fetch for response
 		deps.repo.EXPECT().
@@ -239,6 +290,42 @@ func TestProductService_Update(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("positive - stock delta from locked row", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, true)
+
+		// Snapshot di luar transaksi sudah basi (stok 5), saldo terkunci 8
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Slug: existing.Slug, Stock: 5}, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
+
+		gomock.InOrder(
+			deps.repo.EXPECT().GetStockForUpdate(ctx, id).Return(int32(8), nil),
+			deps.repo.EXPECT().Update(ctx, gomock.Any()).Return(dbgen.Product{}, nil),
+			deps.repo.EXPECT().ApplyStockMovement(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+					assert.Equal(t, int32(2), arg.Delta)
+					assert.Equal(t, constants.StockReasonAdjustment, arg.Reason)
+					return dbgen.ApplyStockMovementRow{ProductID: id, Delta: 2, BalanceAfter: 10}, nil
+				},
+			),
+		)
+
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Stock: 10}, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), product.UpdateProductRequest{Stock: 10}, nil, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("negative - locked product gone", func(t *testing.T) {
+		expectTx(t, deps.sqlMock, false)
+
+		deps.repo.EXPECT().GetByID(ctx, id).Return(dbgen.GetProductByIDRow{ID: id, Slug: existing.Slug, Stock: 5}, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).AnyTimes()
+		deps.repo.EXPECT().GetStockForUpdate(ctx, id).Return(int32(0), sql.ErrNoRows)
+
+		_, err := deps.service.Update(ctx, id.String(), product.UpdateProductRequest{Stock: 10}, nil, "")
+		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
+	})
+
 	t.Run("negative - product not found", func(t *testing.T) {
 		deps.repo.EXPECT().
 			GetByID(ctx, id).
@@ -351,6 +438,234 @@ func TestProductService_ListPublic(t *testing.T) {
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, res, 1)
 	})
+
+	t.Run("positive - popularity sort uses dedicated query", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublicByPopularity(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				assert.Equal(t, "Top_Rated", params.SortBy)
+				return []dbgen.ListProductsPublicRow{{ID: uuid.New(), Price: "100.00", TotalCount: 1}}, nil
+			})
+
+		res, total, err := deps.service.ListPublic(ctx, product.ListPublicRequest{Page: 1, Limit: 10, SortBy: "Top_Rated"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("positive - category filter includes subcategories", func(t *testing.T) {
+		accessoriesID, chargerID := uuid.New(), uuid.New()
+		deps.catRepo.EXPECT().
+			GetDescendantIDsBySlugs(gomock.Any(), []string{"accessories"}).
+			Return([]uuid.UUID{accessoriesID, chargerID}, nil)
+		deps.repo.EXPECT().
+			ListPublic(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				assert.Equal(t, []uuid.UUID{accessoriesID, chargerID}, params.CategoryIds)
+				return nil, nil
+			})
+
+		_, _, err := deps.service.ListPublic(ctx, product.ListPublicRequest{Page: 1, Limit: 10, CategoryIDs: []string{"accessories"}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("positive - search returns highlight", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublic(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				assert.Equal(t, sql.NullString{String: "iphon", Valid: true}, params.Search)
+				assert.Equal(t, "relevance", params.SortBy)
+				return []dbgen.ListProductsPublicRow{
+					{
+						ID:            uuid.New(),
+						Name:          "iPhone 15",
+						Price:         "100.00",
+						TotalCount:    2,
+						SearchRank:    0.83,
+						NameHighlight: sql.NullString{String: "\x01iPhone\x02 15", Valid: true},
+						SearchSnippet: sql.NullString{String: "Chip A16 untuk \x01iPhone\x02 <script>alert(1)</script>", Valid: true},
+					},
+					// Tanpa search, highlight tidak dikirim
+					{ID: uuid.New(), Name: "Case", Price: "10.00", TotalCount: 2},
+				}, nil
+			})
+
+		res, _, err := deps.service.ListPublic(ctx, product.ListPublicRequest{Page: 1, Limit: 10, Search: "iphon", SortBy: "relevance"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &product.SearchHighlight{
+			Name:    "<mark>iPhone</mark> 15",
+			Snippet: "Chip A16 untuk <mark>iPhone</mark> &lt;script&gt;alert(1)&lt;/script&gt;",
+			Score:   0.83,
+		}, res[0].Highlight)
+		assert.Nil(t, res[1].Highlight)
+	})
+
+	t.Run("positive - attribute filters", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublic(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				// Nilai dipisah koma menjadi pasangan code/value tersendiri
+				assert.ElementsMatch(t, []string{"ram", "ram"}, params.AttrCodes)
+				assert.ElementsMatch(t, []string{"8GB", "12GB"}, params.AttrValues)
+				return nil, nil
+			})
+
+		_, _, err := deps.service.ListPublic(ctx, product.ListPublicRequest{
+			Page:       1,
+			Limit:      10,
+			Attributes: map[string]string{"RAM": "8GB, 12GB"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("positive - page mode routes every sort_by", func(t *testing.T) {
+		// Sort non-popularitas tetap lewat ListProductsPublic (CASE sort_by), popularitas lewat query khusus
+		cases := []struct {
+			sortBy     string
+			popularity bool
+		}{
+			{"newest", false},
+			{"oldest", false},
+			{"price_high", false},
+			{"price_low", false},
+			{"relevance", false},
+			{"best_selling", true},
+			{"top_rated", true},
+			{"trending", true},
+		}
+		for _, tc := range cases {
+			assertParams := func(_ context.Context, params dbgen.ListProductsPublicParams) ([]dbgen.ListProductsPublicRow, error) {
+				assert.Equal(t, tc.sortBy, params.SortBy)
+				assert.Equal(t, int32(10), params.Limit)
+				assert.Equal(t, int32(10), params.Offset)
+				return nil, nil
+			}
+			if tc.popularity {
+				deps.repo.EXPECT().ListPublicByPopularity(ctx, gomock.Any()).DoAndReturn(assertParams)
+			} else {
+				deps.repo.EXPECT().ListPublic(ctx, gomock.Any()).DoAndReturn(assertParams)
+			}
+
+			_, _, err := deps.service.ListPublic(ctx, product.ListPublicRequest{Page: 2, Limit: 10, SortBy: tc.sortBy})
+			assert.NoError(t, err, tc.sortBy)
+		}
+	})
+}
+
+func TestProductService_ListPublicCursor(t *testing.T) {
+	deps := setupServiceTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []dbgen.ListProductsPublicByCreatedDescRow{
+		{ID: uuid.New(), Name: "P3", Price: "300.00", CreatedAt: base.Add(3 * time.Hour)},
+		{ID: uuid.New(), Name: "P2", Price: "200.00", CreatedAt: base.Add(2 * time.Hour)},
+		{ID: uuid.New(), Name: "P1", Price: "100.00", CreatedAt: base.Add(time.Hour)},
+	}
+
+	t.Run("positive - first page returns next cursor", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublicByCreated(ctx, gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicByCreatedDescParams, _ bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+				// limit+1 untuk deteksi halaman berikutnya; tanpa cursor dipakai batas terluar
+				assert.Equal(t, int32(3), params.Limit)
+				assert.Equal(t, uuid.Max, params.CursorID)
+				assert.True(t, params.CursorCreatedAt.After(time.Now()))
+				return rows, nil
+			})
+
+		res, page, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "newest"})
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Empty(t, page.Prev)
+
+		next, err := cursor.Decode(page.Next)
+		assert.NoError(t, err)
+		assert.Equal(t, rows[1].ID.String(), next.ID)
+		assert.Equal(t, "newest", next.Sort)
+		assert.False(t, next.Prev)
+	})
+
+	t.Run("positive - next page of oldest walks ascending from cursor", func(t *testing.T) {
+		id := uuid.New()
+		cur := cursor.Encode(cursor.Cursor{ID: id.String(), CreatedAt: base, Sort: "oldest"})
+
+		deps.repo.EXPECT().
+			ListPublicByCreated(ctx, gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicByCreatedDescParams, _ bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+				assert.Equal(t, id, params.CursorID)
+				assert.True(t, base.Equal(params.CursorCreatedAt))
+				return []dbgen.ListProductsPublicByCreatedDescRow{rows[2]}, nil
+			})
+
+		res, page, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "oldest", Cursor: cur})
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Empty(t, page.Next)
+		assert.NotEmpty(t, page.Prev)
+	})
+
+	t.Run("positive - prev cursor restores order", func(t *testing.T) {
+		cur := cursor.Encode(cursor.Cursor{ID: uuid.NewString(), CreatedAt: base, Price: "50.00", Sort: "price_low", Prev: true})
+
+		// price_low menaik, jadi halaman sebelumnya berjalan menurun
+		deps.repo.EXPECT().
+			ListPublicByPrice(ctx, gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicByPriceDescParams, _ bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+				assert.Equal(t, "50.00", params.CursorPrice)
+				// Query arah mundur mengembalikan urutan terbalik
+				return []dbgen.ListProductsPublicByCreatedDescRow{rows[2], rows[1]}, nil
+			})
+
+		res, page, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "price_low", Cursor: cur})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"P2", "P1"}, []string{res[0].Name, res[1].Name})
+		assert.NotEmpty(t, page.Next)
+		assert.Empty(t, page.Prev)
+	})
+
+	t.Run("positive - first page of price_high starts above every price", func(t *testing.T) {
+		deps.repo.EXPECT().
+			ListPublicByPrice(ctx, gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, params dbgen.ListProductsPublicByPriceDescParams, _ bool) ([]dbgen.ListProductsPublicByCreatedDescRow, error) {
+				assert.Equal(t, "1e18", params.CursorPrice)
+				return rows[:1], nil
+			})
+
+		_, page, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "price_high"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Next)
+	})
+
+	t.Run("negative - relevance sort not supported", func(t *testing.T) {
+		_, _, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, Search: "iphone", SortBy: "relevance"})
+		assert.ErrorIs(t, err, producterrors.ErrCursorSortUnsupported)
+	})
+
+	t.Run("negative - popularity sort not supported", func(t *testing.T) {
+		_, _, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "best_selling"})
+		assert.ErrorIs(t, err, producterrors.ErrCursorSortUnsupported)
+	})
+
+	t.Run("negative - cursor from another sort", func(t *testing.T) {
+		cur := cursor.Encode(cursor.Cursor{ID: uuid.NewString(), CreatedAt: base, Sort: "oldest"})
+
+		_, _, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "newest", Cursor: cur})
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor)
+	})
+
+	t.Run("negative - malformed cursor", func(t *testing.T) {
+		_, _, err := deps.service.ListPublicCursor(ctx, product.ListPublicRequest{Limit: 2, SortBy: "newest", Cursor: "!!"})
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor)
+	})
 }
 
 //
@@ -433,6 +748,112 @@ func TestProductService_ListAdmin(t *testing.T) {
 // ======================= GET BY SLUG =======================
 //
 
+func TestProductService_GetFacets(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	inStock := true
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().
+			GetFacets(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params dbgen.GetProductFacetsParams) ([]dbgen.GetProductFacetsRow, error) {
+				assert.Equal(t, sql.NullString{String: "apple", Valid: true}, params.BrandSlug)
+				assert.Equal(t, sql.NullBool{Bool: true, Valid: true}, params.InStock)
+				assert.Equal(t, "4.00", params.MinRating)
+				assert.Len(t, params.PriceBucketMins, len(params.PriceBucketMaxs))
+				assert.Equal(t, "0.00", params.PriceBucketMins[0])
+				return []dbgen.GetProductFacetsRow{
+					{Facet: "brand", Key: "apple", Label: "Apple", Count: 3},
+					{Facet: "brand", Key: "samsung", Label: "Samsung", Count: 42},
+					{Facet: "category", Key: "smartphone", Label: "Smartphone", Count: 45},
+					{Facet: "price", Key: "2", Count: 7},
+					{Facet: "rating", Key: "3", Count: 20},
+					{Facet: "rating", Key: "4", Count: 10},
+					{Facet: "stock", Key: "out_of_stock", Count: 1},
+					{Facet: "stock", Key: "in_stock", Count: 44},
+				}, nil
+			})
+
+		res, err := deps.service.GetFacets(ctx, product.ListPublicRequest{
+			BrandSlug: "apple",
+			MinRating: 4,
+			InStock:   &inStock,
+		})
+
+		assert.NoError(t, err)
+		// Diurutkan berdasarkan jumlah terbanyak
+		assert.Equal(t, "samsung", res.Brands[0].Key)
+		assert.Equal(t, int64(45), res.Categories[0].Count)
+		// Semua bucket harga tetap tampil, walau kosong
+		assert.Len(t, res.PriceRanges, 5)
+		assert.Equal(t, int64(0), res.PriceRanges[0].Count)
+		assert.Equal(t, int64(7), res.PriceRanges[1].Count)
+		assert.Nil(t, res.PriceRanges[4].Max)
+		assert.Equal(t, "4", res.Ratings[0].Key)
+		assert.Equal(t, "in_stock", res.Stock[0].Key)
+	})
+
+	t.Run("repo_error", func(t *testing.T) {
+		deps.repo.EXPECT().GetFacets(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+		_, err := deps.service.GetFacets(ctx, product.ListPublicRequest{})
+
+		assert.ErrorIs(t, err, producterrors.ErrProductFailed)
+	})
+}
+
+func TestProductService_Suggest(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+
+	t.Run("groups_by_kind", func(t *testing.T) {
+		// Query dinormalisasi: lowercase, spasi dirapikan, wildcard LIKE dibuang
+		deps.repo.EXPECT().
+			Suggest(gomock.Any(), "iphone pro", int32(5)).
+			Return([]dbgen.SuggestSearchTermsRow{
+				{Kind: "product", Name: "iPhone 15 Pro", Slug: "iphone-15-pro"},
+				{Kind: "brand", Name: "Apple", Slug: "apple"},
+				{Kind: "category", Name: "Smartphone", Slug: "smartphone"},
+			}, nil)
+
+		res, err := deps.service.Suggest(ctx, "  iPhone%   Pro ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []product.SuggestionItem{{Name: "iPhone 15 Pro", Slug: "iphone-15-pro"}}, res.Products)
+		assert.Equal(t, []product.SuggestionItem{{Name: "Apple", Slug: "apple"}}, res.Brands)
+		assert.Equal(t, []product.SuggestionItem{{Name: "Smartphone", Slug: "smartphone"}}, res.Categories)
+	})
+
+	t.Run("query_too_short_skips_db", func(t *testing.T) {
+		res, err := deps.service.Suggest(ctx, "i")
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Products)
+		assert.NotNil(t, res.Brands)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		deps.repo.EXPECT().
+			Suggest(gomock.Any(), "sams", int32(5)).
+			Return(nil, context.DeadlineExceeded)
+
+		_, err := deps.service.Suggest(ctx, "sams")
+
+		// Tidak di-cache; handler membalas saran kosong
+		assert.ErrorIs(t, err, producterrors.ErrSuggestTimeout)
+	})
+
+	t.Run("db_error", func(t *testing.T) {
+		deps.repo.EXPECT().
+			Suggest(gomock.Any(), "sams", int32(5)).
+			Return(nil, errors.New("db down"))
+
+		_, err := deps.service.Suggest(ctx, "sams")
+
+		assert.ErrorIs(t, err, producterrors.ErrProductFailed)
+	})
+}
+
 func TestProductService_GetBySlug(t *testing.T) {
 	deps := setupServiceTest(t)
 	// Jangan lupa menutup mock controller jika tidak otomatis di setupServiceTest
@@ -440,6 +861,7 @@ func TestProductService_GetBySlug(t *testing.T) {
 
 	ctx := context.Background()
 	id := uuid.New()
+	categoryID := uuid.New()
 	slug := "iphone-15-abcde"
 
 	t.Run("success", func(t *testing.T) {
@@ -447,7 +869,8 @@ func TestProductService_GetBySlug(t *testing.T) {
 		deps.repo.EXPECT().
 			GetBySlug(gomock.Any(), slug).
 			Return(dbgen.GetProductBySlugRow{
-				ID: id, Name: "iPhone 15", Slug: slug, Price: "1500.00",
+				Status: "PUBLISHED", ID: id, CategoryID: categoryID, Name: "iPhone 15", Slug: slug, Price: "1500.00",
+				DiscountPrice: sql.NullString{String: "1400.00", Valid: true}, LowestPrice30d: "1350.00",
 			}, nil)
 
 		// 2. Tiga pemanggilan ini terjadi di dalam Goroutine (Paralel)
@@ -464,6 +887,17 @@ func TestProductService_GetBySlug(t *testing.T) {
 			CountByProductID(gomock.Any(), id).
 			Return(int64(10), nil)
 
+		deps.repo.EXPECT().ListVariants(gomock.Any(), id).Return(nil, nil)
+		deps.repo.EXPECT().ListOptions(gomock.Any(), id).Return(nil, nil)
+		deps.repo.EXPECT().ListAttributeValues(gomock.Any(), id).Return(nil, nil)
+		deps.repo.EXPECT().ListImages(gomock.Any(), id).Return(nil, nil)
+		deps.catRepo.EXPECT().
+			ListAncestors(gomock.Any(), categoryID).
+			Return([]dbgen.ListCategoryAncestorsRow{
+				{ID: uuid.New(), Name: "Handphone", Slug: "handphone"},
+				{ID: categoryID, Name: "iPhone", Slug: "iphone"},
+			}, nil)
+
 		// Execution
 		res, err := deps.service.GetBySlug(ctx, slug)
 
@@ -472,16 +906,115 @@ func TestProductService_GetBySlug(t *testing.T) {
 		assert.Equal(t, slug, res.Slug)
 		assert.Equal(t, 4.5, res.AverageRating)
 		assert.Equal(t, int64(10), res.RatingCount)
+		assert.Equal(t, 1400.0, *res.DiscountPrice)
+		assert.Equal(t, 1350.0, res.LowestPrice30d)
+		assert.Empty(t, res.Variants)
+		assert.Equal(t, []product.BreadcrumbItem{{Name: "Handphone", Slug: "handphone"}, {Name: "iPhone", Slug: "iphone"}}, res.Breadcrumbs)
+	})
+
+	t.Run("success_with_variants", func(t *testing.T) {
+		activeID := uuid.New()
+
+		deps.repo.EXPECT().
+			GetBySlug(gomock.Any(), slug).
+			Return(dbgen.GetProductBySlugRow{Status: "PUBLISHED", ID: id, Slug: slug, Price: "1500.00"}, nil)
+
+		deps.reviewRepo.EXPECT().GetByProductID(gomock.Any(), id, int32(5), int32(0)).Return(nil, nil)
+		deps.reviewRepo.EXPECT().GetAverageRating(gomock.Any(), id).Return(0.0, nil)
+		deps.reviewRepo.EXPECT().CountByProductID(gomock.Any(), id).Return(int64(0), nil)
+
+		// Variant nonaktif tidak boleh tampil ke customer
+		deps.repo.EXPECT().
+			ListVariants(gomock.Any(), id).
+			Return([]dbgen.ListProductVariantsRow{
+				{ID: activeID, Sku: "IP15-BLK", Price: "1600.00", DiscountPrice: sql.NullString{String: "1550.00", Valid: true}, Stock: 3, IsActive: true, Options: []byte(`{"Color":"Black"}`)},
+				{ID: uuid.New(), Sku: "IP15-WHT", Price: "1600.00", Stock: 0, IsActive: false, Options: []byte(`{"Color":"White"}`)},
+			}, nil)
+		deps.repo.EXPECT().
+			ListOptions(gomock.Any(), id).
+			Return([]dbgen.ListProductOptionsRow{
+				{ID: uuid.New(), Name: "Color", OptionValues: []byte(`["Black"]`)},
+			}, nil)
+
+		deps.repo.EXPECT().
+			ListAttributeValues(gomock.Any(), id).
+			Return([]dbgen.ListProductAttributeValuesRow{
+				{Code: "ram", Name: "RAM", DataType: "number", Unit: sql.NullString{String: "GB", Valid: true}, ValueNumber: sql.NullString{String: "8.0000", Valid: true}},
+				{Code: "5g", Name: "5G", DataType: "boolean", ValueBoolean: sql.NullBool{Bool: true, Valid: true}},
+				{Code: "chipset", Name: "Chipset", DataType: "text", ValueText: sql.NullString{String: "A16 Bionic", Valid: true}},
+			}, nil)
+
+		deps.repo.EXPECT().
+			ListImages(gomock.Any(), id).
+			Return([]dbgen.ProductImage{
+				{ID: uuid.New(), ProductID: id, ImageUrl: "https://img/1.jpg", SortOrder: 0, IsPrimary: true},
+				{ID: uuid.New(), ProductID: id, ImageUrl: "https://img/2.jpg", SortOrder: 1},
+			}, nil)
+
+		// Breadcrumb gagal tidak menggagalkan detail produk
+		deps.catRepo.EXPECT().ListAncestors(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+		res, err := deps.service.GetBySlug(ctx, slug)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"RAM": "8 GB", "5G": "Yes", "Chipset": "A16 Bionic"}, res.Specifications)
+		assert.Equal(t, 8.0, res.Attributes[0].Value)
+		assert.Equal(t, true, res.Attributes[1].Value)
+		assert.Len(t, res.Variants, 1)
+		assert.Equal(t, activeID.String(), res.Variants[0].ID)
+		assert.Equal(t, "Black", res.Variants[0].Options["Color"])
+		assert.Equal(t, 1550.0, *res.Variants[0].DiscountPrice)
+		assert.Equal(t, []product.ProductOptionResponse{{Name: "Color", Values: []string{"Black"}}}, res.Options)
+		assert.Len(t, res.Images, 2)
+		assert.True(t, res.Images[0].IsPrimary)
+		assert.Empty(t, res.Breadcrumbs)
 	})
 
 	t.Run("product_not_found", func(t *testing.T) {
 		deps.repo.EXPECT().
 			GetBySlug(gomock.Any(), slug).
 			Return(dbgen.GetProductBySlugRow{}, sql.ErrNoRows)
+		deps.repo.EXPECT().FindSlugOwner(gomock.Any(), slug).Return(uuid.Nil, sql.ErrNoRows)
 
 		_, err := deps.service.GetBySlug(ctx, slug)
 		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
 	})
+
+	t.Run("old_slug_moved", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-old").Return(dbgen.GetProductBySlugRow{}, sql.ErrNoRows)
+		deps.repo.EXPECT().FindSlugOwner(gomock.Any(), "iphone-old").Return(id, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Slug: slug, Status: "PUBLISHED"}, nil)
+
+		_, err := deps.service.GetBySlug(ctx, "iphone-old")
+
+		var moved *apperror.MovedError
+		assert.True(t, errors.As(err, &moved))
+		assert.Equal(t, slug, moved.Slug)
+	})
+
+	t.Run("old_slug_of_draft_hidden", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-old").Return(dbgen.GetProductBySlugRow{}, sql.ErrNoRows)
+		deps.repo.EXPECT().FindSlugOwner(gomock.Any(), "iphone-old").Return(id, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Slug: slug, Status: "DRAFT"}, nil)
+
+		_, err := deps.service.GetBySlug(ctx, "iphone-old")
+		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
+	})
+
+	t.Run("unpublished_hidden", func(t *testing.T) {
+		future := sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}
+		for _, row := range []dbgen.GetProductBySlugRow{
+			{ID: id, Slug: slug, Status: "DRAFT"},
+			{ID: id, Slug: slug, Status: "SCHEDULED", PublishAt: future},
+			{ID: id, Slug: slug, Status: "ARCHIVED"},
+		} {
+			deps.repo.EXPECT().GetBySlug(gomock.Any(), slug).Return(row, nil)
+
+			_, err := deps.service.GetBySlug(ctx, slug)
+
+			assert.ErrorIs(t, err, producterrors.ErrProductNotFound, row.Status)
+		}
+	})
 }
 
 //
@@ -548,3 +1081,1229 @@ func TestProductService_Restore(t *testing.T) {
 		assert.Equal(t, id.String(), res.ID)
 	})
 }
+
+func TestProductService_DeleteVariant(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	variantID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().
+			DeleteVariant(gomock.Any(), productID, variantID).
+			Return(int64(1), nil)
+
+		err := deps.service.DeleteVariant(ctx, productID.String(), variantID.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		deps.repo.EXPECT().
+			DeleteVariant(gomock.Any(), productID, variantID).
+			Return(int64(0), nil)
+
+		err := deps.service.DeleteVariant(ctx, productID.String(), variantID.String())
+		assert.ErrorIs(t, err, producterrors.ErrVariantNotFound)
+	})
+
+	t.Run("invalid_variant_id", func(t *testing.T) {
+		err := deps.service.DeleteVariant(ctx, productID.String(), "invalid-uuid")
+		assert.ErrorIs(t, err, producterrors.ErrInvalidVariantID)
+	})
+}
+
+func TestProductService_CreateVariant(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	variantID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		colorID, storageID := uuid.New(), uuid.New()
+		blackID, gbID := uuid.New(), uuid.New()
+
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID}, nil)
+
+		deps.sqlMock.ExpectBegin()
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			CreateVariant(gomock.Any(), dbgen.CreateProductVariantParams{
+				ProductID: productID,
+				Sku:       "IP15-BLK-128",
+				Price:     "15000000.00",
+			}).
+			Return(dbgen.ProductVariant{ID: variantID, ProductID: productID, Sku: "IP15-BLK-128", Price: "15000000.00", IsActive: true}, nil)
+		// Stok awal variant masuk ledger
+		deps.repo.EXPECT().
+			ApplyStockMovement(gomock.Any(), dbgen.ApplyStockMovementParams{
+				Delta:     5,
+				ProductID: productID,
+				VariantID: uuid.NullUUID{UUID: variantID, Valid: true},
+				Reason:    constants.StockReasonAdjustment,
+				Note:      sql.NullString{String: "Initial stock", Valid: true},
+			}).
+			Return(dbgen.ApplyStockMovementRow{Delta: 5, BalanceAfter: 5}, nil)
+		deps.repo.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		// Opsi diproses berurutan sesuai nama
+		gomock.InOrder(
+			deps.repo.EXPECT().UpsertOption(gomock.Any(), productID, "Color").Return(dbgen.ProductOption{ID: colorID}, nil),
+			deps.repo.EXPECT().UpsertOptionValue(gomock.Any(), colorID, "Black").Return(dbgen.ProductOptionValue{ID: blackID}, nil),
+			deps.repo.EXPECT().AddVariantValue(gomock.Any(), variantID, blackID).Return(nil),
+			deps.repo.EXPECT().UpsertOption(gomock.Any(), productID, "Storage").Return(dbgen.ProductOption{ID: storageID}, nil),
+			deps.repo.EXPECT().UpsertOptionValue(gomock.Any(), storageID, "128GB").Return(dbgen.ProductOptionValue{ID: gbID}, nil),
+			deps.repo.EXPECT().AddVariantValue(gomock.Any(), variantID, gbID).Return(nil),
+		)
+		deps.sqlMock.ExpectCommit()
+
+		res, err := deps.service.CreateVariant(ctx, productID.String(), product.CreateVariantRequest{
+			SKU:     "IP15-BLK-128",
+			Price:   15000000,
+			Stock:   5,
+			Options: map[string]string{"Storage": "128GB", " Color ": "Black"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, variantID.String(), res.ID)
+		assert.Equal(t, int32(5), res.Stock)
+		assert.Equal(t, map[string]string{"Color": "Black", "Storage": "128GB"}, res.Options)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("empty_option_value", func(t *testing.T) {
+		_, err := deps.service.CreateVariant(ctx, productID.String(), product.CreateVariantRequest{
+			SKU:     "IP15",
+			Price:   100,
+			Options: map[string]string{"Color": " "},
+		})
+		assert.ErrorIs(t, err, producterrors.ErrInvalidVariantInput)
+	})
+}
+
+func TestProductService_SetAttributes(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	categoryID := uuid.New()
+	ramID, fiveGID, chipsetID := uuid.New(), uuid.New(), uuid.New()
+
+	schema := []dbgen.CategoryAttribute{
+		{ID: ramID, CategoryID: categoryID, Code: "ram", DataType: "number"},
+		{ID: fiveGID, CategoryID: categoryID, Code: "5g", DataType: "boolean"},
+		{ID: chipsetID, CategoryID: categoryID, Code: "chipset", DataType: "text"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, CategoryID: categoryID}, nil)
+		deps.catRepo.EXPECT().ListAttributes(gomock.Any(), categoryID).Return(schema, nil)
+
+		deps.sqlMock.ExpectBegin()
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().UpsertAttributeValue(gomock.Any(), dbgen.UpsertProductAttributeValueParams{
+			ProductID:   productID,
+			AttributeID: ramID,
+			ValueNumber: sql.NullString{String: "8", Valid: true},
+		}).Return(nil)
+		deps.repo.EXPECT().UpsertAttributeValue(gomock.Any(), dbgen.UpsertProductAttributeValueParams{
+			ProductID:    productID,
+			AttributeID:  fiveGID,
+			ValueBoolean: sql.NullBool{Bool: true, Valid: true},
+		}).Return(nil)
+		// null = hapus nilai
+		deps.repo.EXPECT().DeleteAttributeValue(gomock.Any(), productID, chipsetID).Return(nil)
+		deps.sqlMock.ExpectCommit()
+
+		deps.repo.EXPECT().ListAttributeValues(gomock.Any(), productID).Return(nil, nil)
+
+		_, err := deps.service.SetAttributes(ctx, productID.String(), product.SetAttributesRequest{
+			Values: map[string]any{"ram": float64(8), "5g": "true", "chipset": nil},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown_attribute", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, CategoryID: categoryID}, nil)
+		deps.catRepo.EXPECT().ListAttributes(gomock.Any(), categoryID).Return(schema, nil)
+
+		_, err := deps.service.SetAttributes(ctx, productID.String(), product.SetAttributesRequest{
+			Values: map[string]any{"battery": float64(5000)},
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrUnknownAttribute)
+	})
+
+	t.Run("type_mismatch", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, CategoryID: categoryID}, nil)
+		deps.catRepo.EXPECT().ListAttributes(gomock.Any(), categoryID).Return(schema, nil)
+
+		_, err := deps.service.SetAttributes(ctx, productID.String(), product.SetAttributesRequest{
+			Values: map[string]any{"ram": "eight"},
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidAttributeValue)
+	})
+}
+
+//
+// ======================= GALLERY =======================
+//
+
+func TestProductService_UploadImages(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID := uuid.New()
+
+	t.Run("too_many_images", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID}, nil)
+		deps.repo.EXPECT().ListImages(gomock.Any(), productID).Return(make([]dbgen.ProductImage, 9), nil)
+
+		_, err := deps.service.UploadImages(ctx, productID.String(), []product.ImageUpload{
+			{File: &mockFile{}, Filename: "a.jpg"},
+			{File: &mockFile{}, Filename: "b.jpg"},
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrTooManyImages)
+	})
+}
+
+func TestProductService_DeleteImage(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID := uuid.New()
+	imageID := uuid.New()
+	nextID := uuid.New()
+	imgURL := "https://res.cloudinary.com/demo/image/upload/v1/" + constants.CloudinaryProductFolder + "/a.jpg"
+
+	t.Run("primary_promotes_next_image", func(t *testing.T) {
+		deps.repo.EXPECT().
+			GetImage(gomock.Any(), productID, imageID).
+			Return(dbgen.ProductImage{ID: imageID, ProductID: productID, ImageUrl: imgURL, IsPrimary: true}, nil)
+
+		deps.sqlMock.ExpectBegin()
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().DeleteImage(gomock.Any(), productID, imageID).Return(int64(1), nil)
+		deps.repo.EXPECT().
+			ListImages(gomock.Any(), productID).
+			Return([]dbgen.ProductImage{{ID: nextID, ProductID: productID, ImageUrl: "https://img/next.jpg"}}, nil)
+		deps.repo.EXPECT().
+			SetPrimaryImage(gomock.Any(), productID, nextID).
+			Return(dbgen.ProductImage{ID: nextID, ImageUrl: "https://img/next.jpg", IsPrimary: true}, nil)
+		deps.repo.EXPECT().
+			SetImageURL(gomock.Any(), productID, sql.NullString{String: "https://img/next.jpg", Valid: true}).
+			Return(nil)
+		deps.sqlMock.ExpectCommit()
+
+		deps.cloudinary.EXPECT().DeleteImage(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := deps.service.DeleteImage(ctx, productID.String(), imageID.String())
+
+		assert.NoError(t, err)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		deps.repo.EXPECT().GetImage(gomock.Any(), productID, imageID).Return(dbgen.ProductImage{}, sql.ErrNoRows)
+
+		err := deps.service.DeleteImage(ctx, productID.String(), imageID.String())
+
+		assert.ErrorIs(t, err, producterrors.ErrProductImageNotFound)
+	})
+}
+
+func TestStartImport(t *testing.T) {
+	brandID, catID := uuid.New(), uuid.New()
+
+	t.Run("dry_run_reports_row_errors", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		jobID := uuid.New()
+		done := make(chan dbgen.FinishProductImportJobParams, 1)
+
+		csv := "Name,SKU,Brand_Slug,Category_Slug,Price,Discount_Price,Stock\n" +
+			"iPhone 15,IP15,apple,smartphone,15000000,14000000,0\n" +
+			"iPhone 14,IP14,Apple,smartphone,12000000,,5\n" +
+			"Nokia 3310,N3310,nokia,smartphone,500000,,5\n" +
+			"iPhone 15 Dup,IP15,apple,smartphone,abc,,5\n"
+
+		deps.repo.EXPECT().CreateImportJob(gomock.Any(), dbgen.CreateProductImportJobParams{
+			DryRun:    true,
+			Filename:  sql.NullString{String: "products.csv", Valid: true},
+			TotalRows: 4,
+		}).Return(dbgen.ProductImportJob{ID: jobID, Status: product.ImportStatusPending, DryRun: true, TotalRows: 4}, nil)
+		deps.repo.EXPECT().StartImportJob(gomock.Any(), jobID).Return(nil)
+		deps.repo.EXPECT().ListBrandRefsBySlugs(gomock.Any(), []string{"apple", "apple", "nokia", "apple"}).
+			Return([]dbgen.ListBrandRefsBySlugsRow{{ID: brandID, Slug: "apple"}}, nil)
+		deps.repo.EXPECT().ListCategoryRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListCategoryRefsBySlugsRow{{ID: catID, Slug: "smartphone"}}, nil)
+		deps.repo.EXPECT().ListExistingSKUs(gomock.Any(), []string{"IP15", "IP14", "N3310", "IP15"}).
+			Return([]dbgen.ListExistingProductSKUsRow{{Sku: "IP14"}}, nil)
+		deps.repo.EXPECT().FinishImportJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+				done <- arg
+				return nil
+			})
+
+		res, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{
+			UserID:   "not-a-uuid",
+			Filename: "products.csv",
+			DryRun:   true,
+		}, strings.NewReader(csv))
+
+		assert.NoError(t, err)
+		assert.Equal(t, jobID.String(), res.ID)
+		assert.Equal(t, product.ImportStatusPending, res.Status)
+
+		select {
+		case arg := <-done:
+			assert.Equal(t, product.ImportStatusCompleted, arg.Status)
+			assert.Equal(t, int32(4), arg.ProcessedRows)
+			assert.Equal(t, int32(1), arg.CreatedCount)
+			assert.Equal(t, int32(1), arg.UpdatedCount)
+			assert.Equal(t, int32(2), arg.FailedCount)
+			assert.JSONEq(t, `[
+				{"row":4,"sku":"N3310","message":"Brand not found: nokia"},
+				{"row":5,"sku":"IP15","message":"Price must be a number"}
+			]`, string(arg.Errors))
+		case <-time.After(2 * time.Second):
+			t.Fatal("import job did not finish")
+		}
+	})
+
+	t.Run("upsert_rows", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		jobID, adminID := uuid.New(), uuid.New()
+		done := make(chan dbgen.FinishProductImportJobParams, 1)
+
+		csv := "name,sku,brand_slug,category_slug,price,stock,image_url\n" +
+			"iPhone 15,IP15,apple,smartphone,15000000,10,https://img.example.com/ip15.png\n"
+
+		deps.repo.EXPECT().CreateImportJob(gomock.Any(), gomock.Any()).
+			Return(dbgen.ProductImportJob{ID: jobID, Status: product.ImportStatusPending, TotalRows: 1}, nil)
+		deps.repo.EXPECT().StartImportJob(gomock.Any(), jobID).Return(nil)
+		deps.repo.EXPECT().ListBrandRefsBySlugs(gomock.Any(), []string{"apple"}).
+			Return([]dbgen.ListBrandRefsBySlugsRow{{ID: brandID, Slug: "apple"}}, nil)
+		deps.repo.EXPECT().ListCategoryRefsBySlugs(gomock.Any(), []string{"smartphone"}).
+			Return([]dbgen.ListCategoryRefsBySlugsRow{{ID: catID, Slug: "smartphone"}}, nil)
+
+		productID := uuid.New()
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().GetImportTargetBySKU(gomock.Any(), "IP15").Return(dbgen.GetProductImportTargetBySKURow{}, sql.ErrNoRows)
+		deps.repo.EXPECT().IsSlugRetired(gomock.Any(), gomock.Any(), uuid.Nil).Return(false, nil)
+		deps.repo.EXPECT().UpsertBySKU(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.UpsertProductBySKUParams) (dbgen.UpsertProductBySKURow, error) {
+				assert.Equal(t, "IP15", arg.Sku.String)
+				assert.Regexp(t, `^iphone-15-[0-9a-f]{5}$`, arg.Slug)
+				assert.Equal(t, "15000000.00", arg.Price)
+				assert.Equal(t, catID, arg.CategoryID)
+				assert.Equal(t, brandID, arg.BrandID.UUID)
+				return dbgen.UpsertProductBySKURow{ID: productID, Stock: 0, Inserted: true}, nil
+			})
+		deps.repo.EXPECT().RecordPriceChange(gomock.Any(), dbgen.RecordPriceChangeParams{
+			ProductID: productID,
+			Source:    constants.PriceSourceImport,
+			ChangedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+		}).Return(nil)
+		// Stok target CSV dicatat sebagai mutasi IMPORT dengan referensi job
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), dbgen.ApplyStockMovementParams{
+			Delta:       10,
+			ProductID:   productID,
+			Reason:      constants.StockReasonImport,
+			ReferenceID: uuid.NullUUID{UUID: jobID, Valid: true},
+			ActorID:     uuid.NullUUID{UUID: adminID, Valid: true},
+		}).Return(dbgen.ApplyStockMovementRow{BalanceAfter: 10}, nil)
+		deps.repo.EXPECT().UpsertPrimaryImage(gomock.Any(), productID, "https://img.example.com/ip15.png").Return(nil)
+		deps.repo.EXPECT().FinishImportJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+				done <- arg
+				return nil
+			})
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{UserID: adminID.String()}, strings.NewReader(csv))
+		assert.NoError(t, err)
+
+		select {
+		case arg := <-done:
+			assert.Equal(t, product.ImportStatusCompleted, arg.Status)
+			assert.Equal(t, int32(1), arg.CreatedCount)
+			assert.Equal(t, int32(0), arg.FailedCount)
+			assert.JSONEq(t, `[]`, string(arg.Errors))
+		case <-time.After(2 * time.Second):
+			t.Fatal("import job did not finish")
+		}
+	})
+
+	t.Run("deleted_sku_is_reported_not_revived", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		jobID := uuid.New()
+		done := make(chan dbgen.FinishProductImportJobParams, 1)
+
+		csv := "name,sku,brand_slug,category_slug,price,stock\n" +
+			"iPhone 12,IP12,apple,smartphone,9000000,3\n"
+
+		deps.repo.EXPECT().CreateImportJob(gomock.Any(), gomock.Any()).
+			Return(dbgen.ProductImportJob{ID: jobID, Status: product.ImportStatusPending, TotalRows: 1}, nil)
+		deps.repo.EXPECT().StartImportJob(gomock.Any(), jobID).Return(nil)
+		deps.repo.EXPECT().ListBrandRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListBrandRefsBySlugsRow{{ID: brandID, Slug: "apple"}}, nil)
+		deps.repo.EXPECT().ListCategoryRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListCategoryRefsBySlugsRow{{ID: catID, Slug: "smartphone"}}, nil)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().GetImportTargetBySKU(gomock.Any(), "IP12").Return(dbgen.GetProductImportTargetBySKURow{
+			ID:        uuid.New(),
+			Name:      "iPhone 12",
+			Slug:      "iphone-12-abcde",
+			DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}, nil)
+		deps.repo.EXPECT().FinishImportJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+				done <- arg
+				return nil
+			})
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{}, strings.NewReader(csv))
+		assert.NoError(t, err)
+
+		select {
+		case arg := <-done:
+			assert.Equal(t, product.ImportStatusCompleted, arg.Status)
+			assert.Equal(t, int32(0), arg.UpdatedCount)
+			assert.JSONEq(t, `[{"row":2,"sku":"IP12","message":"Product with this SKU was deleted, restore it before importing"}]`, string(arg.Errors))
+		case <-time.After(2 * time.Second):
+			t.Fatal("import job did not finish")
+		}
+	})
+
+	t.Run("rename_changes_slug_and_records_history", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		jobID, productID := uuid.New(), uuid.New()
+		done := make(chan dbgen.FinishProductImportJobParams, 1)
+
+		csv := "name,sku,brand_slug,category_slug,price,stock\n" +
+			"iPhone 15 Pro,IP15,apple,smartphone,17000000,4\n"
+
+		deps.repo.EXPECT().CreateImportJob(gomock.Any(), gomock.Any()).
+			Return(dbgen.ProductImportJob{ID: jobID, Status: product.ImportStatusPending, TotalRows: 1}, nil)
+		deps.repo.EXPECT().StartImportJob(gomock.Any(), jobID).Return(nil)
+		deps.repo.EXPECT().ListBrandRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListBrandRefsBySlugsRow{{ID: brandID, Slug: "apple"}}, nil)
+		deps.repo.EXPECT().ListCategoryRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListCategoryRefsBySlugsRow{{ID: catID, Slug: "smartphone"}}, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().GetImportTargetBySKU(gomock.Any(), "IP15").
+			Return(dbgen.GetProductImportTargetBySKURow{ID: productID, Name: "iPhone 15", Slug: "iphone-15-abcde"}, nil)
+		// Slug baru tidak boleh bentrok dengan riwayat produk lain (sama seperti admin Update)
+		deps.repo.EXPECT().IsSlugRetired(gomock.Any(), gomock.Any(), productID).Return(false, nil)
+
+		var newSlug string
+		deps.repo.EXPECT().UpsertBySKU(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.UpsertProductBySKUParams) (dbgen.UpsertProductBySKURow, error) {
+				assert.Regexp(t, `^iphone-15-pro-[0-9a-f]{5}$`, arg.Slug)
+				newSlug = arg.Slug
+				return dbgen.UpsertProductBySKURow{ID: productID, Stock: 4}, nil
+			})
+		deps.repo.EXPECT().RecordSlugChange(gomock.Any(), productID, "iphone-15-abcde", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID, oldSlug, slug string) error {
+				assert.Equal(t, newSlug, slug)
+				return nil
+			})
+		deps.repo.EXPECT().RecordPriceChange(gomock.Any(), gomock.Any()).Return(nil)
+		deps.repo.EXPECT().FinishImportJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+				done <- arg
+				return nil
+			})
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{}, strings.NewReader(csv))
+		assert.NoError(t, err)
+
+		select {
+		case arg := <-done:
+			assert.Equal(t, int32(1), arg.UpdatedCount)
+			assert.Equal(t, int32(0), arg.FailedCount)
+		case <-time.After(2 * time.Second):
+			t.Fatal("import job did not finish")
+		}
+	})
+
+	t.Run("dry_run_reports_deleted_sku", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		jobID := uuid.New()
+		done := make(chan dbgen.FinishProductImportJobParams, 1)
+
+		deps.repo.EXPECT().CreateImportJob(gomock.Any(), gomock.Any()).
+			Return(dbgen.ProductImportJob{ID: jobID, Status: product.ImportStatusPending, DryRun: true, TotalRows: 1}, nil)
+		deps.repo.EXPECT().StartImportJob(gomock.Any(), jobID).Return(nil)
+		deps.repo.EXPECT().ListBrandRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListBrandRefsBySlugsRow{{ID: brandID, Slug: "apple"}}, nil)
+		deps.repo.EXPECT().ListCategoryRefsBySlugs(gomock.Any(), gomock.Any()).
+			Return([]dbgen.ListCategoryRefsBySlugsRow{{ID: catID, Slug: "smartphone"}}, nil)
+		deps.repo.EXPECT().ListExistingSKUs(gomock.Any(), []string{"IP12"}).
+			Return([]dbgen.ListExistingProductSKUsRow{{Sku: "IP12", Deleted: true}}, nil)
+		deps.repo.EXPECT().FinishImportJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, arg dbgen.FinishProductImportJobParams) error {
+				done <- arg
+				return nil
+			})
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{DryRun: true},
+			strings.NewReader("name,sku,brand_slug,category_slug,price,stock\niPhone 12,IP12,apple,smartphone,9000000,3\n"))
+		assert.NoError(t, err)
+
+		select {
+		case arg := <-done:
+			assert.Equal(t, int32(0), arg.UpdatedCount)
+			assert.Equal(t, int32(1), arg.FailedCount)
+		case <-time.After(2 * time.Second):
+			t.Fatal("import job did not finish")
+		}
+	})
+
+	t.Run("panic_marks_job_failed", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		jobID := uuid.New()
+		failed := make(chan string, 1)
+
+		deps.repo.EXPECT().CreateImportJob(gomock.Any(), gomock.Any()).
+			Return(dbgen.ProductImportJob{ID: jobID, Status: product.ImportStatusPending, TotalRows: 1}, nil)
+		deps.repo.EXPECT().StartImportJob(gomock.Any(), jobID).Return(nil)
+		deps.repo.EXPECT().ListBrandRefsBySlugs(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, slugs []string) ([]dbgen.ListBrandRefsBySlugsRow, error) {
+				panic("boom")
+			})
+		deps.repo.EXPECT().FailImportJob(gomock.Any(), jobID, "Import aborted, please retry").
+			DoAndReturn(func(ctx context.Context, id uuid.UUID, message string) error {
+				failed <- message
+				return nil
+			})
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{},
+			strings.NewReader("name,sku,brand_slug,category_slug,price,stock\niPhone 12,IP12,apple,smartphone,9000000,3\n"))
+		assert.NoError(t, err)
+
+		select {
+		case <-failed:
+		case <-time.After(2 * time.Second):
+			t.Fatal("panicking import job was not marked failed")
+		}
+	})
+
+	t.Run("missing_required_column", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{},
+			strings.NewReader("name,sku,price\niPhone,IP15,100\n"))
+
+		assert.EqualError(t, err, "Missing required CSV column: brand_slug")
+	})
+
+	t.Run("empty_file", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.StartImport(context.Background(), product.ImportProductsRequest{}, strings.NewReader(""))
+
+		assert.ErrorIs(t, err, producterrors.ErrImportInvalidFile)
+	})
+}
+
+func TestFailStaleImports(t *testing.T) {
+	deps := setupServiceTest(t)
+
+	deps.repo.EXPECT().FailStaleImportJobs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, startedBefore time.Time) (int64, error) {
+			assert.WithinDuration(t, time.Now().Add(-time.Hour), startedBefore, time.Minute)
+			return 2, nil
+		})
+
+	n, err := deps.service.FailStaleImports(context.Background(), time.Hour)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+}
+
+func TestExport(t *testing.T) {
+	deps := setupServiceTest(t)
+
+	deps.repo.EXPECT().ListForExport(gomock.Any()).Return([]dbgen.ListProductsForExportRow{{
+		Name:          "iPhone 15, 128GB",
+		Sku:           sql.NullString{String: "IP15", Valid: true},
+		BrandSlug:     sql.NullString{String: "apple", Valid: true},
+		CategorySlug:  "smartphone",
+		Price:         "15000000.00",
+		DiscountPrice: sql.NullString{String: "14000000.00", Valid: true},
+		Stock:         3,
+	}}, nil)
+
+	var buf strings.Builder
+	err := deps.service.Export(context.Background(), &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"name,sku,brand_slug,category_slug,price,discount_price,stock,description,image_url\n"+
+			"\"iPhone 15, 128GB\",IP15,apple,smartphone,15000000.00,14000000.00,3,,\n",
+		buf.String())
+}
+
+func TestProductService_AdjustStock(t *testing.T) {
+	deps := setupServiceTest(t)
+	productID, adminID := uuid.New(), uuid.New()
+	ctx := contextutil.WithUserID(context.Background(), adminID.String())
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, Stock: 12}, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), dbgen.ApplyStockMovementParams{
+			Delta:     -2,
+			ProductID: productID,
+			Reason:    constants.StockReasonAdjustment,
+			ActorID:   uuid.NullUUID{UUID: adminID, Valid: true},
+			Note:      sql.NullString{String: "Barang rusak", Valid: true},
+		}).Return(dbgen.ApplyStockMovementRow{ID: uuid.New(), ProductID: productID, Delta: -2, BalanceAfter: 10, Reason: constants.StockReasonAdjustment}, nil)
+
+		res, err := deps.service.AdjustStock(ctx, productID.String(), product.StockAdjustmentRequest{Delta: -2, Note: " Barang rusak "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(10), res.BalanceAfter)
+	})
+
+	t.Run("insufficient_stock", func(t *testing.T) {
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, Stock: 1}, nil)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		// Query tidak mengembalikan baris jika saldo akan minus
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Return(dbgen.ApplyStockMovementRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.AdjustStock(ctx, productID.String(), product.StockAdjustmentRequest{Delta: -5, Note: "Stock opname"})
+
+		assert.ErrorIs(t, err, producterrors.ErrInsufficientStock)
+	})
+
+	t.Run("specific_warehouse", func(t *testing.T) {
+		warehouseID := uuid.New()
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, Stock: 12}, nil)
+		deps.repo.EXPECT().GetWarehouse(gomock.Any(), warehouseID).Return(dbgen.Warehouse{ID: warehouseID, Code: "SBY"}, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+				assert.Equal(t, uuid.NullUUID{UUID: warehouseID, Valid: true}, p.WarehouseID)
+				return dbgen.ApplyStockMovementRow{ID: uuid.New(), ProductID: productID, WarehouseID: warehouseID, Delta: 5, BalanceAfter: 17}, nil
+			})
+
+		res, err := deps.service.AdjustStock(ctx, productID.String(), product.StockAdjustmentRequest{
+			WarehouseID: warehouseID.String(), Delta: 5, Note: "Barang masuk",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, warehouseID.String(), res.WarehouseID)
+	})
+
+	t.Run("warehouse_not_found", func(t *testing.T) {
+		warehouseID := uuid.New()
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID, Stock: 12}, nil)
+		deps.repo.EXPECT().GetWarehouse(gomock.Any(), warehouseID).Return(dbgen.Warehouse{}, sql.ErrNoRows)
+
+		_, err := deps.service.AdjustStock(ctx, productID.String(), product.StockAdjustmentRequest{
+			WarehouseID: warehouseID.String(), Delta: 5, Note: "Barang masuk",
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrWarehouseNotFound)
+	})
+
+	t.Run("zero_delta_rejected", func(t *testing.T) {
+		_, err := deps.service.AdjustStock(ctx, productID.String(), product.StockAdjustmentRequest{Delta: 0, Note: "x"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestProductService_ListStockMovements_InvalidReason(t *testing.T) {
+	deps := setupServiceTest(t)
+
+	_, _, err := deps.service.ListStockMovements(context.Background(), uuid.NewString(), product.ListStockMovementsRequest{Page: 1, Limit: 20, Reason: "THEFT"})
+
+	assert.ErrorIs(t, err, producterrors.ErrInvalidStockReason)
+}
+
+func TestStockAlertEvents(t *testing.T) {
+	productID := uuid.New()
+
+	t.Run("crossing_below_threshold", func(t *testing.T) {
+		events, err := product.StockAlertEvents(dbgen.ApplyStockMovementRow{
+			ProductID: productID, Delta: -2, BalanceAfter: 4, LowStockThreshold: 5,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, product.EventProductLowStock, events[0].EventType)
+		assert.Equal(t, productID, events[0].AggregateID)
+		assert.JSONEq(t, `{"product_id":"`+productID.String()+`","stock":4,"threshold":5}`, string(events[0].Payload))
+	})
+
+	t.Run("already_below_threshold", func(t *testing.T) {
+		events, err := product.StockAlertEvents(dbgen.ApplyStockMovementRow{
+			ProductID: productID, Delta: -1, BalanceAfter: 3, LowStockThreshold: 5,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("back_in_stock", func(t *testing.T) {
+		variantID := uuid.New()
+		events, err := product.StockAlertEvents(dbgen.ApplyStockMovementRow{
+			ProductID: productID, VariantID: uuid.NullUUID{UUID: variantID, Valid: true}, Delta: 3, BalanceAfter: 3,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, product.EventProductBackInStock, events[0].EventType)
+		assert.Contains(t, string(events[0].Payload), variantID.String())
+	})
+}
+
+func TestProductService_SubscribeStockNotification(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	userID, productID := uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+			Status: "PUBLISHED", ID: productID, Stock: 0, IsActive: sql.NullBool{Bool: true, Valid: true},
+		}, nil)
+		deps.repo.EXPECT().HasVariants(gomock.Any(), productID).Return(false, nil)
+		deps.repo.EXPECT().UpsertStockNotification(gomock.Any(), dbgen.UpsertStockNotificationParams{
+			UserID: userID, ProductID: productID,
+		}).Return(dbgen.StockNotification{ProductID: productID}, nil)
+
+		res, err := deps.service.SubscribeStockNotification(ctx, userID.String(), "iphone-15", product.StockNotificationRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, productID.String(), res.ProductID)
+	})
+
+	t.Run("product_in_stock", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+			Status: "PUBLISHED", ID: productID, Stock: 3, IsActive: sql.NullBool{Bool: true, Valid: true},
+		}, nil)
+		deps.repo.EXPECT().HasVariants(gomock.Any(), productID).Return(false, nil)
+
+		_, err := deps.service.SubscribeStockNotification(ctx, userID.String(), "iphone-15", product.StockNotificationRequest{})
+
+		assert.ErrorIs(t, err, producterrors.ErrProductInStock)
+	})
+
+	t.Run("variant_required", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+			Status: "PUBLISHED", ID: productID, IsActive: sql.NullBool{Bool: true, Valid: true},
+		}, nil)
+		deps.repo.EXPECT().HasVariants(gomock.Any(), productID).Return(true, nil)
+
+		_, err := deps.service.SubscribeStockNotification(ctx, userID.String(), "iphone-15", product.StockNotificationRequest{})
+
+		assert.ErrorIs(t, err, producterrors.ErrVariantRequired)
+	})
+
+	t.Run("variant_out_of_stock", func(t *testing.T) {
+		variantID := uuid.New()
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+			Status: "PUBLISHED", ID: productID, IsActive: sql.NullBool{Bool: true, Valid: true},
+		}, nil)
+		deps.repo.EXPECT().GetVariant(gomock.Any(), productID, variantID).Return(dbgen.ProductVariant{ID: variantID, Stock: 0}, nil)
+		deps.repo.EXPECT().UpsertStockNotification(gomock.Any(), dbgen.UpsertStockNotificationParams{
+			UserID: userID, ProductID: productID, VariantID: uuid.NullUUID{UUID: variantID, Valid: true},
+		}).Return(dbgen.StockNotification{ProductID: productID, VariantID: uuid.NullUUID{UUID: variantID, Valid: true}}, nil)
+
+		res, err := deps.service.SubscribeStockNotification(ctx, userID.String(), "iphone-15", product.StockNotificationRequest{VariantID: variantID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, variantID.String(), res.VariantID)
+	})
+}
+
+//
+// ======================= PRICE SCHEDULES =======================
+//
+
+func TestProductService_CreatePriceSchedule(t *testing.T) {
+	productID := uuid.New()
+	adminID := uuid.New()
+	ctx := contextutil.WithUserID(context.Background(), adminID.String())
+	startsAt := time.Now().Add(24 * time.Hour)
+	endsAt := startsAt.Add(48 * time.Hour)
+	discount := 12500000.0
+
+	t.Run("success", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID}, nil)
+		deps.repo.EXPECT().CountOverlappingPriceSchedules(gomock.Any(), dbgen.CountOverlappingPriceSchedulesParams{
+			ProductID: productID,
+			StartsAt:  startsAt.UTC(),
+			EndsAt:    sql.NullTime{Time: endsAt.UTC(), Valid: true},
+		}).Return(int64(0), nil)
+		deps.repo.EXPECT().CreatePriceSchedule(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg dbgen.CreatePriceScheduleParams) (dbgen.ProductPriceSchedule, error) {
+				assert.Equal(t, "14000000.00", arg.Price)
+				assert.Equal(t, sql.NullString{String: "12500000.00", Valid: true}, arg.DiscountPrice)
+				assert.Equal(t, uuid.NullUUID{UUID: adminID, Valid: true}, arg.CreatedBy)
+				return dbgen.ProductPriceSchedule{
+					ID: uuid.New(), ProductID: productID, Price: arg.Price, DiscountPrice: arg.DiscountPrice,
+					StartsAt: arg.StartsAt, EndsAt: arg.EndsAt, Status: constants.PriceSchedulePending, CreatedBy: arg.CreatedBy,
+				}, nil
+			})
+
+		res, err := deps.service.CreatePriceSchedule(ctx, productID.String(), product.CreatePriceScheduleRequest{
+			Price: 14000000, DiscountPrice: &discount, StartsAt: startsAt, EndsAt: &endsAt, Note: "Harbolnas 11.11",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, constants.PriceSchedulePending, res.Status)
+		assert.Equal(t, 12500000.0, *res.DiscountPrice)
+		assert.Nil(t, res.PreviousPrice)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByID(gomock.Any(), productID).Return(dbgen.GetProductByIDRow{ID: productID}, nil)
+		deps.repo.EXPECT().CountOverlappingPriceSchedules(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+
+		_, err := deps.service.CreatePriceSchedule(ctx, productID.String(), product.CreatePriceScheduleRequest{
+			Price: 14000000, StartsAt: startsAt,
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrPriceScheduleOverlap)
+	})
+
+	t.Run("starts_in_past", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.CreatePriceSchedule(ctx, productID.String(), product.CreatePriceScheduleRequest{
+			Price: 14000000, StartsAt: time.Now().Add(-time.Minute),
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrPriceScheduleInPast)
+	})
+
+	t.Run("ends_before_start", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		before := startsAt.Add(-time.Hour)
+
+		_, err := deps.service.CreatePriceSchedule(ctx, productID.String(), product.CreatePriceScheduleRequest{
+			Price: 14000000, StartsAt: startsAt, EndsAt: &before,
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPriceSchedulePeriod)
+	})
+
+	t.Run("discount_not_lower", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		same := 14000000.0
+
+		_, err := deps.service.CreatePriceSchedule(ctx, productID.String(), product.CreatePriceScheduleRequest{
+			Price: 14000000, DiscountPrice: &same, StartsAt: startsAt,
+		})
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidDiscountPrice)
+	})
+}
+
+func TestProductService_CancelPriceSchedule(t *testing.T) {
+	productID := uuid.New()
+	scheduleID := uuid.New()
+	adminID := uuid.New()
+	ctx := contextutil.WithUserID(context.Background(), adminID.String())
+
+	t.Run("pending", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetPriceSchedule(gomock.Any(), scheduleID, productID).
+			Return(dbgen.ProductPriceSchedule{ID: scheduleID, ProductID: productID, Status: constants.PriceSchedulePending}, nil)
+		deps.repo.EXPECT().CancelPendingPriceSchedule(gomock.Any(), scheduleID).
+			Return(dbgen.ProductPriceSchedule{ID: scheduleID, ProductID: productID, Price: "100.00", Status: constants.PriceScheduleCancelled}, nil)
+
+		res, err := deps.service.CancelPriceSchedule(ctx, productID.String(), scheduleID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, constants.PriceScheduleCancelled, res.Status)
+	})
+
+	t.Run("active_reverts_price", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		sched := dbgen.ProductPriceSchedule{ID: scheduleID, ProductID: productID, Price: "90.00", Status: constants.PriceScheduleActive}
+
+		deps.repo.EXPECT().GetPriceSchedule(gomock.Any(), scheduleID, productID).Return(sched, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().FinishPriceSchedule(gomock.Any(), scheduleID, constants.PriceScheduleCancelled).
+			Return(dbgen.ProductPriceSchedule{ID: scheduleID, ProductID: productID, Price: "90.00", Status: constants.PriceScheduleCancelled}, nil)
+		deps.repo.EXPECT().RevertScheduledPrice(gomock.Any(), scheduleID).Return(int64(1), nil)
+		deps.repo.EXPECT().RecordPriceChange(gomock.Any(), dbgen.RecordPriceChangeParams{
+			ProductID:  productID,
+			Source:     constants.PriceSourceScheduleEnd,
+			ScheduleID: uuid.NullUUID{UUID: scheduleID, Valid: true},
+			ChangedBy:  uuid.NullUUID{UUID: adminID, Valid: true},
+		}).Return(nil)
+
+		res, err := deps.service.CancelPriceSchedule(ctx, productID.String(), scheduleID.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, constants.PriceScheduleCancelled, res.Status)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("already_completed", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetPriceSchedule(gomock.Any(), scheduleID, productID).
+			Return(dbgen.ProductPriceSchedule{ID: scheduleID, Status: constants.PriceScheduleCompleted}, nil)
+
+		_, err := deps.service.CancelPriceSchedule(ctx, productID.String(), scheduleID.String())
+
+		assert.ErrorIs(t, err, producterrors.ErrPriceScheduleNotCancellable)
+	})
+}
+
+func TestProductService_RunPriceSchedules(t *testing.T) {
+	now := time.Date(2026, 11, 11, 0, 0, 30, 0, time.UTC)
+	productID := uuid.New()
+	adminID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+	t.Run("ends_then_applies", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		ending := dbgen.ProductPriceSchedule{ID: uuid.New(), ProductID: productID, Price: "90.00", Status: constants.PriceScheduleActive}
+		due := dbgen.ProductPriceSchedule{
+			ID: uuid.New(), ProductID: productID, Price: "80.00",
+			DiscountPrice: sql.NullString{String: "75.00", Valid: true}, Status: constants.PriceSchedulePending, CreatedBy: adminID,
+		}
+
+		gomock.InOrder(
+			deps.repo.EXPECT().ListEndingPriceSchedules(gomock.Any(), now, int32(100)).Return([]dbgen.ProductPriceSchedule{ending}, nil),
+			deps.repo.EXPECT().FinishPriceSchedule(gomock.Any(), ending.ID, constants.PriceScheduleCompleted).Return(ending, nil),
+			// Harga sudah diubah manual selama jadwal berjalan: tidak dikembalikan, tidak ada riwayat baru
+			deps.repo.EXPECT().RevertScheduledPrice(gomock.Any(), ending.ID).Return(int64(0), nil),
+			deps.repo.EXPECT().ExpirePriceSchedules(gomock.Any(), now).Return(int64(2), nil),
+			deps.repo.EXPECT().ListDuePriceSchedules(gomock.Any(), now, int32(100)).Return([]dbgen.ProductPriceSchedule{due}, nil),
+			deps.repo.EXPECT().StartPriceSchedule(gomock.Any(), due.ID).Return(due, nil),
+			deps.repo.EXPECT().SetPrice(gomock.Any(), dbgen.SetProductPriceParams{
+				ID:            productID,
+				Price:         "80.00",
+				DiscountPrice: sql.NullString{String: "75.00", Valid: true},
+			}).Return(nil),
+			deps.repo.EXPECT().RecordPriceChange(gomock.Any(), dbgen.RecordPriceChangeParams{
+				ProductID:  productID,
+				Source:     constants.PriceSourceSchedule,
+				ScheduleID: uuid.NullUUID{UUID: due.ID, Valid: true},
+				ChangedBy:  adminID,
+			}).Return(nil),
+		)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo).Times(2)
+		expectTx(t, deps.sqlMock, true)
+		expectTx(t, deps.sqlMock, true)
+
+		res, err := deps.service.RunPriceSchedules(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, product.PriceScheduleRunResult{Applied: 1, Ended: 1, Expired: 2}, res)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("already_claimed_is_skipped", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		due := dbgen.ProductPriceSchedule{ID: uuid.New(), ProductID: productID, Price: "80.00", Status: constants.PriceSchedulePending}
+
+		deps.repo.EXPECT().ListEndingPriceSchedules(gomock.Any(), now, int32(100)).Return(nil, nil)
+		deps.repo.EXPECT().ExpirePriceSchedules(gomock.Any(), now).Return(int64(0), nil)
+		deps.repo.EXPECT().ListDuePriceSchedules(gomock.Any(), now, int32(100)).Return([]dbgen.ProductPriceSchedule{due}, nil)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().StartPriceSchedule(gomock.Any(), due.ID).Return(dbgen.ProductPriceSchedule{}, sql.ErrNoRows)
+		expectTx(t, deps.sqlMock, false)
+
+		res, err := deps.service.RunPriceSchedules(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.Applied)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestProductService_ListRelated(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID, categoryID, brandID := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+			Status: "PUBLISHED", IsActive: sql.NullBool{Bool: true, Valid: true}, ID: productID, CategoryID: categoryID, BrandID: uuid.NullUUID{UUID: brandID, Valid: true}, Price: "15000000.00",
+		}, nil)
+		deps.repo.EXPECT().ListRelated(gomock.Any(), dbgen.ListRelatedProductsParams{
+			ProductID:  productID,
+			CategoryID: categoryID,
+			BrandID:    uuid.NullUUID{UUID: brandID, Valid: true},
+			Price:      "15000000.00",
+			Limit:      20,
+		}).Return([]dbgen.ListRelatedProductsRow{
+			{ID: uuid.New(), CategoryID: categoryID, Name: "iPhone 15 Plus", Slug: "iphone-15-plus", Price: "17000000.00"},
+		}, nil)
+
+		res, err := deps.service.ListRelated(ctx, "iphone-15", 100)
+
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "iphone-15-plus", res[0].Slug)
+		assert.Equal(t, float64(17000000), res[0].Price)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "unknown").Return(dbgen.GetProductBySlugRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.ListRelated(ctx, "unknown", 0)
+
+		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
+	})
+
+	t.Run("inactive_product_not_found", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+			Status: "PUBLISHED", IsActive: sql.NullBool{Bool: false, Valid: true}, ID: productID,
+		}, nil)
+
+		_, err := deps.service.ListRelated(ctx, "iphone-15", 0)
+
+		assert.ErrorIs(t, err, producterrors.ErrProductNotFound)
+	})
+}
+
+func TestProductService_ListBoughtTogether(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	productID := uuid.New()
+
+	deps.repo.EXPECT().GetBySlug(gomock.Any(), "iphone-15").Return(dbgen.GetProductBySlugRow{
+		Status: "PUBLISHED", IsActive: sql.NullBool{Bool: true, Valid: true}, ID: productID,
+	}, nil)
+	deps.repo.EXPECT().ListBoughtTogether(gomock.Any(), productID, int32(8)).Return([]dbgen.ListBoughtTogetherRow{
+		{ID: uuid.New(), Slug: "magsafe-charger", Price: "799000.00", OrderCount: 12},
+	}, nil)
+
+	res, err := deps.service.ListBoughtTogether(ctx, "iphone-15", 0)
+
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "magsafe-charger", res[0].Slug)
+}
+
+func TestProductService_RecordView(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	userID, productID := uuid.NewString(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		// User login dihitung per user, bukan per IP
+		deps.views.EXPECT().CountView(gomock.Any(), productID, "user:"+userID, gomock.Any()).Return(nil)
+		deps.views.EXPECT().Add(gomock.Any(), userID, productID).Return(nil)
+
+		err := deps.service.RecordView(ctx, userID, "10.0.0.1", productID.String())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("guest_only_counted", func(t *testing.T) {
+		deps.views.EXPECT().CountView(gomock.Any(), productID, "ip:10.0.0.1", gomock.Any()).Return(nil)
+
+		err := deps.service.RecordView(ctx, "", "10.0.0.1", productID.String())
+
+		require.NoError(t, err)
+	})
+}
+
+func TestProductService_ListRecentlyViewed(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	userID := uuid.NewString()
+	newest, inactive, oldest := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("keeps_history_order_and_skips_unavailable", func(t *testing.T) {
+		ids := []uuid.UUID{newest, inactive, oldest}
+		deps.views.EXPECT().List(gomock.Any(), userID).Return(ids, nil)
+		// Produk nonaktif/terhapus tidak dikembalikan query
+		deps.repo.EXPECT().ListSummariesByIDs(gomock.Any(), ids).Return([]dbgen.ListProductSummariesByIDsRow{
+			{ID: oldest, Slug: "pixel-8", Price: "9000000.00"},
+			{ID: newest, Slug: "iphone-15", Price: "15000000.00"},
+		}, nil)
+
+		res, err := deps.service.ListRecentlyViewed(ctx, userID)
+
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "iphone-15", res[0].Slug)
+		assert.Equal(t, "pixel-8", res[1].Slug)
+	})
+
+	t.Run("empty_history", func(t *testing.T) {
+		deps.views.EXPECT().List(gomock.Any(), userID).Return(nil, nil)
+
+		res, err := deps.service.ListRecentlyViewed(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("redis_error", func(t *testing.T) {
+		deps.views.EXPECT().List(gomock.Any(), userID).Return(nil, errors.New("connection refused"))
+
+		_, err := deps.service.ListRecentlyViewed(ctx, userID)
+
+		assert.ErrorIs(t, err, producterrors.ErrProductFailed)
+	})
+}
+
+func TestProductService_RefreshPopularity(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	productID := uuid.New()
+
+	t.Run("with_view_counts", func(t *testing.T) {
+		deps.views.EXPECT().ViewCounts(gomock.Any(), now.Add(-7*24*time.Hour), now).
+			Return(map[uuid.UUID]int64{productID: 42}, nil)
+		deps.repo.EXPECT().RefreshStats(gomock.Any(), dbgen.RefreshProductStatsParams{
+			Since:          now.Add(-7 * 24 * time.Hour),
+			ViewProductIds: []uuid.UUID{productID},
+			ViewCounts:     []int32{42},
+		}).Return(int64(10), nil)
+
+		n, err := deps.service.RefreshPopularity(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), n)
+	})
+
+	t.Run("redis_error_still_refreshes_sales", func(t *testing.T) {
+		deps.views.EXPECT().ViewCounts(gomock.Any(), gomock.Any(), now).Return(nil, errors.New("connection refused"))
+		deps.repo.EXPECT().RefreshStats(gomock.Any(), dbgen.RefreshProductStatsParams{
+			Since:          now.Add(-7 * 24 * time.Hour),
+			ViewProductIds: []uuid.UUID{},
+			ViewCounts:     []int32{},
+		}).Return(int64(10), nil)
+
+		_, err := deps.service.RefreshPopularity(ctx, now)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestProductService_SetPublication(t *testing.T) {
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("schedule", func(t *testing.T) {
+		publishAt := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Status: "DRAFT"}, nil)
+		deps.repo.EXPECT().SetPublication(gomock.Any(), dbgen.SetProductPublicationParams{
+			ID:        id,
+			Status:    "SCHEDULED",
+			PublishAt: sql.NullTime{Time: publishAt, Valid: true},
+		}).Return(dbgen.Product{ID: id}, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{
+			ID: id, Status: "SCHEDULED", PublishAt: sql.NullTime{Time: publishAt, Valid: true},
+		}, nil)
+
+		res, err := deps.service.SetPublication(ctx, id.String(), product.SetPublicationRequest{Status: "SCHEDULED", PublishAt: &publishAt})
+
+		require.NoError(t, err)
+		assert.Equal(t, "SCHEDULED", res.Status)
+		assert.Equal(t, publishAt, *res.PublishAt)
+	})
+
+	t.Run("archive_keeps_publish_at", func(t *testing.T) {
+		published := sql.NullTime{Time: time.Now().Add(-24 * time.Hour), Valid: true}
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Status: "PUBLISHED", PublishAt: published}, nil)
+		deps.repo.EXPECT().SetPublication(gomock.Any(), dbgen.SetProductPublicationParams{
+			ID: id, Status: "ARCHIVED", PublishAt: published,
+		}).Return(dbgen.Product{ID: id}, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Status: "ARCHIVED"}, nil)
+
+		_, err := deps.service.SetPublication(ctx, id.String(), product.SetPublicationRequest{Status: "ARCHIVED"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("schedule_in_past", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Status: "DRAFT"}, nil)
+
+		_, err := deps.service.SetPublication(ctx, id.String(), product.SetPublicationRequest{Status: "SCHEDULED", PublishAt: &past})
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPublishAt)
+	})
+
+	t.Run("invalid_status", func(t *testing.T) {
+		_, err := deps.service.SetPublication(ctx, id.String(), product.SetPublicationRequest{Status: "HIDDEN"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestProductService_Preview(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	deps := setupServiceTest(t)
+	ctx := context.Background()
+	id := uuid.New()
+	draft := dbgen.GetProductBySlugRow{ID: id, Slug: "pixel-10", Status: "DRAFT", Price: "12000000.00"}
+
+	deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.GetProductByIDRow{ID: id, Slug: "pixel-10", Status: "DRAFT"}, nil)
+	tok, err := deps.service.CreatePreviewToken(ctx, id.String(), product.PreviewTokenRequest{ExpiresInHours: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "pixel-10", tok.Slug)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), tok.ExpiresAt, time.Minute)
+
+	t.Run("valid_token_shows_draft", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "pixel-10").Return(draft, nil)
+		deps.reviewRepo.EXPECT().GetByProductID(gomock.Any(), id, int32(5), int32(0)).Return(nil, nil)
+		deps.reviewRepo.EXPECT().GetAverageRating(gomock.Any(), id).Return(0.0, nil)
+		deps.reviewRepo.EXPECT().CountByProductID(gomock.Any(), id).Return(int64(0), nil)
+		deps.repo.EXPECT().ListVariants(gomock.Any(), id).Return(nil, nil)
+		deps.repo.EXPECT().ListOptions(gomock.Any(), id).Return(nil, nil)
+		deps.repo.EXPECT().ListAttributeValues(gomock.Any(), id).Return(nil, nil)
+		deps.repo.EXPECT().ListImages(gomock.Any(), id).Return(nil, nil)
+		deps.catRepo.EXPECT().ListAncestors(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		res, err := deps.service.GetPreview(ctx, "pixel-10", tok.Token)
+
+		require.NoError(t, err)
+		assert.Equal(t, "pixel-10", res.Slug)
+	})
+
+	t.Run("token_for_other_product", func(t *testing.T) {
+		deps.repo.EXPECT().GetBySlug(gomock.Any(), "pixel-9").Return(dbgen.GetProductBySlugRow{ID: uuid.New(), Slug: "pixel-9"}, nil)
+
+		_, err := deps.service.GetPreview(ctx, "pixel-9", tok.Token)
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPreviewToken)
+	})
+
+	t.Run("tampered_token", func(t *testing.T) {
+		_, err := deps.service.GetPreview(ctx, "pixel-10", tok.Token+"x")
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPreviewToken)
+	})
+
+	t.Run("access_token_rejected", func(t *testing.T) {
+		// Token login memakai secret yang sama tapi tanpa purpose preview
+		access, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"user_id":    uuid.NewString(),
+			"product_id": id.String(),
+			"exp":        time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("test-secret"))
+
+		_, err := deps.service.GetPreview(ctx, "pixel-10", access)
+
+		assert.ErrorIs(t, err, producterrors.ErrInvalidPreviewToken)
+	})
+}
@@ -0,0 +1,51 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/utils"
+	producterrors "go-gadget-api/internal/product/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Suffix acak dicoba ulang beberapa kali jika bentrok dengan slug lama produk lain
+const slugAttempts = 3
+
+// availableSlug membuat slug "nama-produk-xxxxx" yang belum pernah dipakai produk lain
+func (s *service) availableSlug(ctx context.Context, name string, id uuid.UUID) (string, error) {
+	base := utils.GenerateSlug(name)
+	for i := 0; i < slugAttempts; i++ {
+		slug := base + "-" + uuid.New().String()[:5]
+
+		retired, err := s.repo.IsSlugRetired(ctx, slug, id)
+		if err != nil {
+			return "", producterrors.ErrProductFailed
+		}
+		if !retired {
+			return slug, nil
+		}
+	}
+	return "", producterrors.ErrSlugUnavailable
+}
+
+// redirectFromHistory dipanggil saat slug tidak ditemukan: slug lama produk yang masih tampil
+// publik menghasilkan MovedError berisi slug kanonik, selain itu tetap 404.
+func (s *service) redirectFromHistory(ctx context.Context, slug string) error {
+	id, err := s.repo.FindSlugOwner(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return producterrors.ErrProductNotFound
+		}
+		return producterrors.ErrProductFailed
+	}
+
+	p, err := s.repo.GetByID(ctx, id)
+	if err != nil || p.Slug == slug || !isPublished(p.Status, p.PublishAt, time.Now()) {
+		return producterrors.ErrProductNotFound
+	}
+	return &apperror.MovedError{Slug: p.Slug}
+}
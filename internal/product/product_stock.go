@@ -0,0 +1,327 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	autherrors "go-gadget-api/internal/auth/errors"
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/constants"
+	producterrors "go-gadget-api/internal/product/errors"
+	"go-gadget-api/internal/shared/contextutil"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var stockReasons = map[string]bool{
+	constants.StockReasonSale:         true,
+	constants.StockReasonCancellation: true,
+	constants.StockReasonRefund:       true,
+	constants.StockReasonAdjustment:   true,
+	constants.StockReasonImport:       true,
+	constants.StockReasonTransfer:     true,
+}
+
+// actorFromContext user yang memicu mutasi (diisi handler admin lewat contextutil.WithUserID)
+func actorFromContext(ctx context.Context) uuid.NullUUID {
+	uid, err := uuid.Parse(contextutil.GetUserID(ctx))
+	if err != nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: uid, Valid: true}
+}
+
+// applyStock mencatat mutasi di ledger sekaligus mengubah saldo stok, lalu membuat
+// event alert (stok menipis / tersedia kembali) bila saldo melewati batasnya.
+// repo boleh hasil WithTx agar ikut transaksi pemanggil; delta 0 diabaikan.
+func applyStock(ctx context.Context, repo Repository, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+	if arg.Delta == 0 {
+		return dbgen.ApplyStockMovementRow{}, nil
+	}
+	if !arg.ActorID.Valid {
+		arg.ActorID = actorFromContext(ctx)
+	}
+
+	m, err := repo.ApplyStockMovement(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return m, producterrors.ErrInsufficientStock
+		}
+		return m, err
+	}
+
+	events, err := StockAlertEvents(m)
+	if err != nil {
+		return m, err
+	}
+	for _, e := range events {
+		if err := repo.CreateOutboxEvent(ctx, e); err != nil {
+			return m, err
+		}
+	}
+	return m, nil
+}
+
+// AdjustStock koreksi stok manual; stok tidak pernah di-overwrite, selalu lewat delta
+func (s *service) AdjustStock(ctx context.Context, productID string, req StockAdjustmentRequest) (StockMovementResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return StockMovementResponse{}, apperror.MapValidationError(err)
+	}
+
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return StockMovementResponse{}, producterrors.ErrInvalidProductID
+	}
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return StockMovementResponse{}, producterrors.ErrProductNotFound
+		}
+		return StockMovementResponse{}, producterrors.ErrProductFailed
+	}
+
+	var variantID uuid.NullUUID
+	if req.VariantID != "" {
+		vid, _ := uuid.Parse(req.VariantID) // format sudah divalidasi
+		if _, err := s.repo.GetVariant(ctx, pid, vid); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return StockMovementResponse{}, producterrors.ErrVariantNotFound
+			}
+			return StockMovementResponse{}, producterrors.ErrProductFailed
+		}
+		variantID = uuid.NullUUID{UUID: vid, Valid: true}
+	}
+
+	var warehouseID uuid.NullUUID
+	if req.WarehouseID != "" {
+		wid, _ := uuid.Parse(req.WarehouseID) // format sudah divalidasi
+		if _, err := s.repo.GetWarehouse(ctx, wid); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return StockMovementResponse{}, producterrors.ErrWarehouseNotFound
+			}
+			return StockMovementResponse{}, producterrors.ErrProductFailed
+		}
+		warehouseID = uuid.NullUUID{UUID: wid, Valid: true}
+	}
+
+	// Transaksi agar mutasi & event alert-nya tersimpan bersamaan
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return StockMovementResponse{}, producterrors.ErrProductFailed
+	}
+	defer tx.Rollback()
+
+	note := strings.TrimSpace(req.Note)
+	m, err := applyStock(ctx, s.repo.WithTx(tx), dbgen.ApplyStockMovementParams{
+		WarehouseID: warehouseID,
+		Delta:       req.Delta,
+		ProductID:   pid,
+		VariantID:   variantID,
+		Reason:      constants.StockReasonAdjustment,
+		Note:        sql.NullString{String: note, Valid: note != ""},
+	})
+	if err != nil {
+		if errors.Is(err, producterrors.ErrInsufficientStock) {
+			return StockMovementResponse{}, err
+		}
+		return StockMovementResponse{}, producterrors.ErrProductFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return StockMovementResponse{}, producterrors.ErrProductFailed
+	}
+
+	return mapStockMovement(m), nil
+}
+
+func (s *service) ListStockMovements(ctx context.Context, productID string, req ListStockMovementsRequest) ([]StockMovementResponse, int64, error) {
+	pid, err := uuid.Parse(productID)
+	if err != nil {
+		return nil, 0, producterrors.ErrInvalidProductID
+	}
+
+	reason := strings.ToUpper(strings.TrimSpace(req.Reason))
+	if reason != "" && !stockReasons[reason] {
+		return nil, 0, producterrors.ErrInvalidStockReason
+	}
+
+	var warehouseID uuid.NullUUID
+	if req.WarehouseID != "" {
+		wid, err := uuid.Parse(req.WarehouseID)
+		if err != nil {
+			return nil, 0, producterrors.ErrInvalidWarehouseID
+		}
+		warehouseID = uuid.NullUUID{UUID: wid, Valid: true}
+	}
+
+	if _, err := s.repo.GetByID(ctx, pid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, producterrors.ErrProductNotFound
+		}
+		return nil, 0, producterrors.ErrProductFailed
+	}
+
+	rows, err := s.repo.ListStockMovements(ctx, dbgen.ListStockMovementsParams{
+		ProductID:   pid,
+		Limit:       int32(req.Limit),
+		Offset:      int32((req.Page - 1) * req.Limit),
+		Reason:      sql.NullString{String: reason, Valid: reason != ""},
+		WarehouseID: warehouseID,
+	})
+	if err != nil {
+		return nil, 0, producterrors.ErrProductFailed
+	}
+
+	var total int64
+	res := make([]StockMovementResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		res = append(res, StockMovementResponse{
+			ID:            r.ID.String(),
+			ProductID:     r.ProductID.String(),
+			VariantID:     nullUUIDString(r.VariantID),
+			VariantSKU:    r.VariantSku.String,
+			WarehouseID:   r.WarehouseID.String(),
+			WarehouseName: r.WarehouseName,
+			Delta:         r.Delta,
+			BalanceAfter:  r.BalanceAfter,
+			Reason:        r.Reason,
+			ReferenceID:   nullUUIDString(r.ReferenceID),
+			ActorID:       nullUUIDString(r.ActorID),
+			ActorName:     r.ActorName.String,
+			Note:          r.Note.String,
+			CreatedAt:     r.CreatedAt,
+		})
+	}
+
+	return res, total, nil
+}
+
+func mapStockMovement(m dbgen.ApplyStockMovementRow) StockMovementResponse {
+	return StockMovementResponse{
+		ID:           m.ID.String(),
+		ProductID:    m.ProductID.String(),
+		VariantID:    nullUUIDString(m.VariantID),
+		WarehouseID:  m.WarehouseID.String(),
+		Delta:        m.Delta,
+		BalanceAfter: m.BalanceAfter,
+		Reason:       m.Reason,
+		ReferenceID:  nullUUIDString(m.ReferenceID),
+		ActorID:      nullUUIDString(m.ActorID),
+		Note:         m.Note.String,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+// SubscribeStockNotification mendaftarkan user untuk email PRODUCT_BACK_IN_STOCK.
+// Hanya untuk produk/variant yang stoknya sedang habis.
+func (s *service) SubscribeStockNotification(ctx context.Context, userID, slug string, req StockNotificationRequest) (StockNotificationResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return StockNotificationResponse{}, apperror.MapValidationError(err)
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return StockNotificationResponse{}, autherrors.ErrUnauthorized
+	}
+
+	p, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return StockNotificationResponse{}, producterrors.ErrProductNotFound
+		}
+		return StockNotificationResponse{}, producterrors.ErrProductFailed
+	}
+	if !p.IsActive.Bool || !isPublished(p.Status, p.PublishAt, time.Now()) {
+		return StockNotificationResponse{}, producterrors.ErrProductNotFound
+	}
+
+	stock := p.Stock
+	var variantID uuid.NullUUID
+	if req.VariantID != "" {
+		vid, _ := uuid.Parse(req.VariantID) // format sudah divalidasi
+		v, err := s.repo.GetVariant(ctx, p.ID, vid)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return StockNotificationResponse{}, producterrors.ErrVariantNotFound
+			}
+			return StockNotificationResponse{}, producterrors.ErrProductFailed
+		}
+		stock = v.Stock
+		variantID = uuid.NullUUID{UUID: vid, Valid: true}
+	} else {
+		// Produk bervariant stoknya ada di tiap variant, saldo produk tidak dipakai
+		hasVariants, err := s.repo.HasVariants(ctx, p.ID)
+		if err != nil {
+			return StockNotificationResponse{}, producterrors.ErrProductFailed
+		}
+		if hasVariants {
+			return StockNotificationResponse{}, producterrors.ErrVariantRequired
+		}
+	}
+
+	if stock > 0 {
+		return StockNotificationResponse{}, producterrors.ErrProductInStock
+	}
+
+	n, err := s.repo.UpsertStockNotification(ctx, dbgen.UpsertStockNotificationParams{
+		UserID:    uid,
+		ProductID: p.ID,
+		VariantID: variantID,
+	})
+	if err != nil {
+		return StockNotificationResponse{}, producterrors.ErrProductFailed
+	}
+
+	return StockNotificationResponse{
+		ProductID: n.ProductID.String(),
+		VariantID: nullUUIDString(n.VariantID),
+		CreatedAt: n.CreatedAt,
+	}, nil
+}
+
+func (s *service) UnsubscribeStockNotification(ctx context.Context, userID, slug, variantID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return autherrors.ErrUnauthorized
+	}
+
+	var vid uuid.NullUUID
+	if variantID != "" {
+		id, err := uuid.Parse(variantID)
+		if err != nil {
+			return producterrors.ErrInvalidVariantID
+		}
+		vid = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	p, err := s.repo.GetBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return producterrors.ErrProductNotFound
+		}
+		return producterrors.ErrProductFailed
+	}
+
+	affected, err := s.repo.DeleteStockNotification(ctx, dbgen.DeleteStockNotificationParams{
+		UserID:    uid,
+		ProductID: p.ID,
+		VariantID: vid,
+	})
+	if err != nil {
+		return producterrors.ErrProductFailed
+	}
+	if affected == 0 {
+		return producterrors.ErrStockNotificationNotFound
+	}
+	return nil
+}
+
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.UUID.String()
+}
@@ -0,0 +1,41 @@
+package review
+
+import (
+	"context"
+	"go-gadget-api/internal/shared/cache"
+)
+
+// cachedService menaikkan versi cache review setelah mutasi, agar rating & review
+// di detail/listing produk yang ter-cache ikut diperbarui
+type cachedService struct {
+	Service
+	cache *cache.Cache
+}
+
+func NewCachedService(svc Service, c *cache.Cache) Service {
+	return &cachedService{Service: svc, cache: c}
+}
+
+func (s *cachedService) invalidate(ctx context.Context, err error) {
+	if err == nil {
+		s.cache.Invalidate(ctx, cache.EntityReview)
+	}
+}
+
+func (s *cachedService) Create(ctx context.Context, userID, productSlug string, req CreateReviewRequest) (ReviewResponse, error) {
+	res, err := s.Service.Create(ctx, userID, productSlug, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Update(ctx context.Context, reviewID, userID string, req UpdateReviewRequest) (ReviewResponse, error) {
+	res, err := s.Service.Update(ctx, reviewID, userID, req)
+	s.invalidate(ctx, err)
+	return res, err
+}
+
+func (s *cachedService) Delete(ctx context.Context, reviewID, userID string) error {
+	err := s.Service.Delete(ctx, reviewID, userID)
+	s.invalidate(ctx, err)
+	return err
+}
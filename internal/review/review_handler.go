@@ -47,6 +47,22 @@ func (h *Handler) GetReviewsByProductSlug(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
+	// Mode cursor (keyset) aktif jika parameter cursor dikirim, kosong = halaman pertama
+	if cur, ok := c.GetQuery("cursor"); ok {
+		res, p, err := h.service.GetByProductSlugCursor(c.Request.Context(), productSlug, cur, limit)
+		if err != nil {
+			httpErr := apperror.ToHTTP(err)
+			response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+			return
+		}
+
+		response.Success(c, http.StatusOK, gin.H{
+			"items": res,
+			"meta":  response.NewCursorMeta(res.Limit, p.Next, p.Prev),
+		}, nil)
+		return
+	}
+
 	res, err := h.service.GetByProductSlug(c.Request.Context(), productSlug, page, limit)
 	if err != nil {
 		httpErr := apperror.ToHTTP(err)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"go-gadget-api/internal/pkg/cursor"
 	"go-gadget-api/internal/review"
 	reviewerrors "go-gadget-api/internal/review/errors"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 type fakeReviewService struct {
 	createFunc           func(ctx context.Context, userID, productSlug string, req review.CreateReviewRequest) (review.ReviewResponse, error)
 	getByProductSlugFunc func(ctx context.Context, productSlug string, page, limit int) (review.ReviewListResponse, error)
+	getByProductCursor   func(ctx context.Context, productSlug string, cursorStr string, limit int) (review.ReviewListResponse, cursor.Page, error)
 	getByUserIDFunc      func(ctx context.Context, userID string, page, limit int) (review.UserReviewListResponse, error)
 	checkEligibilityFunc func(ctx context.Context, userID, productSlug string) (review.ReviewEligibilityResponse, error)
 	updateFunc           func(ctx context.Context, reviewID, userID string, req review.UpdateReviewRequest) (review.ReviewResponse, error)
@@ -32,6 +34,9 @@ func (f *fakeReviewService) Create(ctx context.Context, u, s string, r review.Cr
 func (f *fakeReviewService) GetByProductSlug(ctx context.Context, s string, p, l int) (review.ReviewListResponse, error) {
 	return f.getByProductSlugFunc(ctx, s, p, l)
 }
+func (f *fakeReviewService) GetByProductSlugCursor(ctx context.Context, s string, c string, l int) (review.ReviewListResponse, cursor.Page, error) {
+	return f.getByProductCursor(ctx, s, c, l)
+}
 func (f *fakeReviewService) GetByUserID(ctx context.Context, u string, p, l int) (review.UserReviewListResponse, error) {
 	return f.getByUserIDFunc(ctx, u, p, l)
 }
@@ -130,6 +135,21 @@ func TestReviewHandler_GetReviewsByProductSlug(t *testing.T) {
 		d.ctrl.GetReviewsByProductSlug(d.ctx)
 		assert.Equal(t, http.StatusOK, d.w.Code)
 	})
+
+	t.Run("positive - cursor mode", func(t *testing.T) {
+		d := setupReviewHandlerTest()
+		d.ctx.Params = gin.Params{{Key: "slug", Value: "iphone"}}
+		d.performRequest(http.MethodGet, "/?cursor=&limit=5", nil)
+
+		d.svc.getByProductCursor = func(ctx context.Context, slug string, cursorStr string, limit int) (review.ReviewListResponse, cursor.Page, error) {
+			assert.Equal(t, "", cursorStr)
+			return review.ReviewListResponse{Limit: limit}, cursor.Page{Next: "n1"}, nil
+		}
+
+		d.ctrl.GetReviewsByProductSlug(d.ctx)
+		assert.Equal(t, http.StatusOK, d.w.Code)
+		assert.Contains(t, d.w.Body.String(), `"meta":{"limit":5,"nextCursor":"n1"}`)
+	})
 }
 
 // ==================== GET BY USER ID ====================
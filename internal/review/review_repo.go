@@ -16,6 +16,7 @@ type Repository interface {
 	Create(ctx context.Context, arg dbgen.CreateReviewParams) (dbgen.Review, error)
 	GetByID(ctx context.Context, id uuid.UUID) (dbgen.GetReviewByIDRow, error)
 	GetByProductID(ctx context.Context, productID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByProductIDRow, error)
+	GetByProductIDCursor(ctx context.Context, arg dbgen.GetReviewsByProductIDCreatedDescParams, desc bool) ([]dbgen.GetReviewsByProductIDRow, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByUserIDRow, error)
 	CountByProductID(ctx context.Context, productID uuid.UUID) (int64, error)
 	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
@@ -61,6 +62,30 @@ func (r *repository) GetByProductID(ctx context.Context, productID uuid.UUID, li
 	})
 }
 
+// GetByProductIDCursor keyset pagination (created_at, id), desc menentukan arah pembacaan
+func (r *repository) GetByProductIDCursor(ctx context.Context, arg dbgen.GetReviewsByProductIDCreatedDescParams, desc bool) ([]dbgen.GetReviewsByProductIDRow, error) {
+	var out []dbgen.GetReviewsByProductIDRow
+	if desc {
+		rows, err := r.queries.GetReviewsByProductIDCreatedDesc(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			out = append(out, dbgen.GetReviewsByProductIDRow(row))
+		}
+		return out, nil
+	}
+
+	rows, err := r.queries.GetReviewsByProductIDCreatedAsc(ctx, dbgen.GetReviewsByProductIDCreatedAscParams(arg))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out = append(out, dbgen.GetReviewsByProductIDRow(row))
+	}
+	return out, nil
+}
+
 func (r *repository) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]dbgen.GetReviewsByUserIDRow, error) {
 	return r.queries.GetReviewsByUserID(ctx, dbgen.GetReviewsByUserIDParams{
 		UserID: userID,
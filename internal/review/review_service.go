@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/cursor"
 	"go-gadget-api/internal/product"
 	reviewerrors "go-gadget-api/internal/review/errors"
 	"go-gadget-api/internal/shared/database/dbgen"
@@ -24,6 +25,7 @@ type Product struct {
 type Service interface {
 	Create(ctx context.Context, userID, productSlug string, req CreateReviewRequest) (ReviewResponse, error)
 	GetByProductSlug(ctx context.Context, productSlug string, page, limit int) (ReviewListResponse, error)
+	GetByProductSlugCursor(ctx context.Context, productSlug string, cursorStr string, limit int) (ReviewListResponse, cursor.Page, error)
 	GetByUserID(ctx context.Context, userID string, page, limit int) (UserReviewListResponse, error)
 	CheckEligibility(ctx context.Context, userID, productSlug string) (ReviewEligibilityResponse, error)
 	Update(ctx context.Context, reviewID, userID string, req UpdateReviewRequest) (ReviewResponse, error)
@@ -181,6 +183,64 @@ func (s *service) GetByProductSlug(ctx context.Context, productSlug string, page
 	}, nil
 }
 
+// GetByProductSlugCursor review produk dengan keyset pagination (created_at, id), tanpa COUNT total
+func (s *service) GetByProductSlugCursor(ctx context.Context, productSlug string, cursorStr string, limit int) (ReviewListResponse, cursor.Page, error) {
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	cur, err := cursor.Decode(cursorStr)
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, err
+	}
+
+	product, err := s.productRepo.GetBySlug(ctx, productSlug)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrProductNotFound
+		}
+		return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrReviewFailed
+	}
+
+	// Review terbaru dulu; halaman sebelumnya dibaca ke arah sebaliknya
+	desc := cursor.Descending(cur, true)
+	createdAt, cursorID, err := cursor.Bound(cur, desc)
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, err
+	}
+
+	rows, err := s.repo.GetByProductIDCursor(ctx, dbgen.GetReviewsByProductIDCreatedDescParams{
+		Limit:           int32(limit + 1),
+		ProductID:       product.ID,
+		CursorCreatedAt: createdAt,
+		CursorID:        cursorID,
+	}, desc)
+	if err != nil {
+		return ReviewListResponse{}, cursor.Page{}, reviewerrors.ErrReviewFailed
+	}
+
+	rows, page := cursor.Paginate(rows, limit, cur, func(r dbgen.GetReviewsByProductIDRow) cursor.Cursor {
+		return cursor.Cursor{ID: r.ID.String(), CreatedAt: r.CreatedAt}
+	})
+
+	reviewResponses := make([]ReviewResponse, 0, len(rows))
+	for _, r := range rows {
+		reviewResponses = append(reviewResponses, ReviewResponse{
+			ID:                 r.ID.String(),
+			UserID:             r.UserID.String(),
+			UserName:           r.UserName,
+			ProductID:          r.ProductID.String(),
+			Rating:             r.Rating,
+			Comment:            r.Comment,
+			IsVerifiedPurchase: r.IsVerifiedPurchase,
+			CreatedAt:          r.CreatedAt,
+			UpdatedAt:          r.UpdatedAt,
+		})
+	}
+
+	return ReviewListResponse{Reviews: reviewResponses, Limit: limit}, page, nil
+}
+
 // GetByUserID retrieves all reviews by a user
 func (s *service) GetByUserID(ctx context.Context, userID string, page, limit int) (UserReviewListResponse, error) {
 	uid, err := uuid.Parse(userID)
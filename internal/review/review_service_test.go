@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/cursor"
 	"go-gadget-api/internal/review"
 	"go-gadget-api/internal/shared/database/dbgen"
 
@@ -286,3 +288,40 @@ func TestReviewService_GetByProductSlug(t *testing.T) {
 		assert.Equal(t, 1, res.Page)
 	})
 }
+
+func TestReviewService_GetByProductSlugCursor(t *testing.T) {
+	deps := setupReviewTest(t)
+	defer deps.db.Close()
+
+	ctx := context.Background()
+	slug := "iphone"
+	pid := uuid.New()
+	now := time.Now()
+
+	t.Run("positive - next page without count", func(t *testing.T) {
+		deps.productRepo.EXPECT().GetBySlug(ctx, slug).Return(dbgen.GetProductBySlugRow{ID: pid}, nil)
+		deps.repo.EXPECT().
+			GetByProductIDCursor(ctx, gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, arg dbgen.GetReviewsByProductIDCreatedDescParams, _ bool) ([]dbgen.GetReviewsByProductIDRow, error) {
+				assert.Equal(t, pid, arg.ProductID)
+				assert.Equal(t, int32(3), arg.Limit)
+				assert.Equal(t, uuid.Max, arg.CursorID)
+				return []dbgen.GetReviewsByProductIDRow{
+					{ID: uuid.New(), CreatedAt: now},
+					{ID: uuid.New(), CreatedAt: now.Add(-time.Minute)},
+					{ID: uuid.New(), CreatedAt: now.Add(-2 * time.Minute)},
+				}, nil
+			})
+
+		res, page, err := deps.service.GetByProductSlugCursor(ctx, slug, "", 2)
+		assert.NoError(t, err)
+		assert.Len(t, res.Reviews, 2)
+		assert.NotEmpty(t, page.Next)
+		assert.Empty(t, page.Prev)
+	})
+
+	t.Run("negative - invalid cursor", func(t *testing.T) {
+		_, _, err := deps.service.GetByProductSlugCursor(ctx, slug, "%%%", 2)
+		assert.ErrorIs(t, err, cursor.ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,224 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// Entity yang dipakai sebagai dependensi versi cache katalog.
+// Mutasi pada entity cukup menaikkan versinya, key lama otomatis tidak terbaca dan hilang lewat TTL.
+const (
+	EntityProduct  = "product"
+	EntityBrand    = "brand"
+	EntityCategory = "category"
+	EntityReview   = "review"
+)
+
+const (
+	keyPrefix        = "catalog:"
+	versionKeyPrefix = "catalog:version:"
+
+	// Redis dianggap lambat/mati jika melewati batas ini, request langsung ke DB
+	redisTimeout = 100 * time.Millisecond
+)
+
+// Cache read-through di atas Redis dengan key berversi per entity dan proteksi stampede (singleflight).
+// Nil *Cache aman dipakai: semua pemanggilan langsung diteruskan ke loader.
+type Cache struct {
+	rdb   *redis.Client
+	group singleflight.Group
+	stats sync.Map // name -> *counter
+}
+
+type counter struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+	errors atomic.Int64
+}
+
+// Stats jumlah hit/miss per nama cache sejak proses berjalan
+type Stats struct {
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	Errors   int64   `json:"errors"`
+	HitRatio float64 `json:"hitRatio"`
+}
+
+func New(rdb *redis.Client) *Cache {
+	return &Cache{rdb: rdb}
+}
+
+// Remember mengembalikan nilai dari cache, atau memanggil load lalu menyimpannya.
+// name mengelompokkan statistik (mis. "product:detail"), deps = entity yang mempengaruhi isi cache,
+// args membentuk bagian unik key (mis. slug atau parameter filter).
+func Remember[T any](ctx context.Context, c *Cache, name string, deps []string, ttl time.Duration, args any, load func(context.Context) (T, error)) (T, error) {
+	if c == nil || c.rdb == nil {
+		return load(ctx)
+	}
+
+	stat := c.counter(name)
+
+	key, err := c.buildKey(ctx, name, deps, args)
+	if err == nil {
+		var cached T
+		if found, err := c.get(ctx, key, &cached); err == nil && found {
+			stat.hits.Add(1)
+			return cached, nil
+		} else if err != nil {
+			stat.errors.Add(1)
+		}
+	} else {
+		stat.errors.Add(1)
+		// Tanpa versi tidak aman menulis cache, tapi singleflight tetap melindungi DB
+		key = ""
+	}
+	stat.misses.Add(1)
+
+	flightKey := key
+	if flightKey == "" {
+		flightKey = name + ":" + hashArgs(args)
+	}
+
+	// Hanya satu loader per key yang jalan bersamaan, sisanya menunggu hasil yang sama.
+	// Context dilepas dari cancel agar request pertama yang batal tidak menggagalkan yang lain.
+	v, err, _ := c.group.Do(flightKey, func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		res, err := load(loadCtx)
+		if err != nil {
+			return res, err
+		}
+		if key != "" {
+			c.set(loadCtx, key, res, ttl)
+		}
+		return res, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.(T), nil
+}
+
+// Invalidate menaikkan versi entity sehingga semua key yang bergantung padanya tidak terpakai lagi
+func (c *Cache) Invalidate(ctx context.Context, entities ...string) {
+	if c == nil || c.rdb == nil || len(entities) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), redisTimeout)
+	defer cancel()
+
+	pipe := c.rdb.Pipeline()
+	for _, e := range entities {
+		pipe.Incr(ctx, versionKeyPrefix+e)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("[Cache] Failed to invalidate %v: %v", entities, err)
+	}
+}
+
+// Stats snapshot statistik per nama cache
+func (c *Cache) Stats() map[string]Stats {
+	res := map[string]Stats{}
+	if c == nil {
+		return res
+	}
+
+	c.stats.Range(func(k, v any) bool {
+		cnt := v.(*counter)
+		s := Stats{
+			Hits:   cnt.hits.Load(),
+			Misses: cnt.misses.Load(),
+			Errors: cnt.errors.Load(),
+		}
+		if total := s.Hits + s.Misses; total > 0 {
+			s.HitRatio = float64(s.Hits) / float64(total)
+		}
+		res[k.(string)] = s
+		return true
+	})
+	return res
+}
+
+func (c *Cache) counter(name string) *counter {
+	v, _ := c.stats.LoadOrStore(name, &counter{})
+	return v.(*counter)
+}
+
+// buildKey: catalog:{name}:{versi tiap dep}:{hash args}
+func (c *Cache) buildKey(ctx context.Context, name string, deps []string, args any) (string, error) {
+	versions := make([]string, 0, len(deps))
+	if len(deps) > 0 {
+		keys := make([]string, len(deps))
+		for i, d := range deps {
+			keys[i] = versionKeyPrefix + d
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+		defer cancel()
+
+		vals, err := c.rdb.MGet(ctx, keys...).Result()
+		if err != nil {
+			return "", err
+		}
+		for i, v := range vals {
+			ver := "0"
+			if s, ok := v.(string); ok {
+				ver = s
+			}
+			versions = append(versions, deps[i]+ver)
+		}
+	}
+
+	return fmt.Sprintf("%s%s:%s:%s", keyPrefix, name, strings.Join(versions, "."), hashArgs(args)), nil
+}
+
+func (c *Cache) get(ctx context.Context, key string, dest any) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	raw, err := c.rdb.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(raw, dest); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *Cache) set(ctx context.Context, key string, value any, ttl time.Duration) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	// Best effort: gagal simpan cache tidak boleh menggagalkan request
+	if err := c.rdb.Set(ctx, key, payload, ttl).Err(); err != nil {
+		log.Printf("[Cache] Failed to set %s: %v", key, err)
+	}
+}
+
+func hashArgs(args any) string {
+	raw, _ := json.Marshal(args)
+	sum := sha1.Sum(raw)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,21 @@
+package cache
+
+import (
+	"go-gadget-api/internal/middleware"
+	"go-gadget-api/internal/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoutes endpoint observability cache katalog (hit/miss per nama cache)
+func RegisterRoutes(r *gin.RouterGroup, c *Cache) {
+	admin := r.Group("/admin/cache")
+	admin.Use(middleware.AuthMiddleware())
+	admin.Use(middleware.RoleMiddleware("ADMIN", "SUPERADMIN"))
+	{
+		admin.GET("/stats", middleware.RateLimitByUser(5, 10), func(ctx *gin.Context) {
+			response.Success(ctx, http.StatusOK, c.Stats(), nil)
+		})
+	}
+}
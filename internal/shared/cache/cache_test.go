@@ -0,0 +1,114 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-gadget-api/internal/shared/cache"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// unreachableRedis client yang selalu gagal konek, untuk menguji jalur fallback ke loader
+func unreachableRedis() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 50 * time.Millisecond,
+		MaxRetries:  -1,
+	})
+}
+
+func TestRemember_NilCachePassthrough(t *testing.T) {
+	var c *cache.Cache
+
+	res, err := cache.Remember(context.Background(), c, "product:detail", []string{cache.EntityProduct}, time.Minute, "iphone-15",
+		func(ctx context.Context) (string, error) {
+			return "loaded", nil
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "loaded", res)
+	assert.Empty(t, c.Stats())
+
+	// Invalidate pada nil cache tidak panic
+	c.Invalidate(context.Background(), cache.EntityProduct)
+}
+
+func TestRemember_RedisDownFallsBackToLoader(t *testing.T) {
+	rdb := unreachableRedis()
+	defer rdb.Close()
+	c := cache.New(rdb)
+
+	t.Run("success", func(t *testing.T) {
+		res, err := cache.Remember(context.Background(), c, "brand:list", []string{cache.EntityBrand}, time.Minute, []int{1, 10},
+			func(ctx context.Context) ([]string, error) {
+				return []string{"apple"}, nil
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"apple"}, res)
+	})
+
+	t.Run("loader_error_propagates", func(t *testing.T) {
+		_, err := cache.Remember(context.Background(), c, "brand:list", []string{cache.EntityBrand}, time.Minute, []int{2, 10},
+			func(ctx context.Context) ([]string, error) {
+				return nil, errors.New("db down")
+			})
+
+		assert.EqualError(t, err, "db down")
+	})
+
+	stats := c.Stats()["brand:list"]
+	assert.Equal(t, int64(0), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Equal(t, int64(2), stats.Errors)
+}
+
+func TestRemember_Singleflight(t *testing.T) {
+	rdb := unreachableRedis()
+	defer rdb.Close()
+	c := cache.New(rdb)
+
+	var (
+		calls   atomic.Int32
+		wg      sync.WaitGroup
+		release = make(chan struct{})
+		started = make(chan struct{}, 1)
+	)
+
+	load := func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return 42, nil
+	}
+
+	const concurrent = 10
+	results := make([]int, concurrent)
+	for i := 0; i < concurrent; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cache.Remember(context.Background(), c, "product:detail", nil, time.Minute, "same-slug", load)
+		}(i)
+	}
+
+	// Beri waktu semua goroutine bergabung ke flight yang sama sebelum loader selesai
+	<-started
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, r := range results {
+		assert.Equal(t, 42, r)
+	}
+}
@@ -14,19 +14,21 @@ import (
 )
 
 const addCartItem = `-- name: AddCartItem :exec
-INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (cart_id, product_id)
+INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add, variant_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (cart_id, product_id, variant_id)
 DO UPDATE SET
   quantity = cart_items.quantity + EXCLUDED.quantity,
+  saved_for_later = false,
   updated_at = NOW()
 `
 
 type AddCartItemParams struct {
-	CartID     uuid.UUID `json:"cart_id"`
-	ProductID  uuid.UUID `json:"product_id"`
-	Quantity   int32     `json:"quantity"`
-	PriceAtAdd int32     `json:"price_at_add"`
+	CartID     uuid.UUID     `json:"cart_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	Quantity   int32         `json:"quantity"`
+	PriceAtAdd int32         `json:"price_at_add"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) AddCartItem(ctx context.Context, arg AddCartItemParams) error {
@@ -35,6 +37,7 @@ func (q *Queries) AddCartItem(ctx context.Context, arg AddCartItemParams) error
 		arg.ProductID,
 		arg.Quantity,
 		arg.PriceAtAdd,
+		arg.VariantID,
 	)
 	return err
 }
@@ -43,6 +46,7 @@ const countCartItems = `-- name: CountCartItems :one
 SELECT COALESCE(SUM(quantity), 0)::bigint
 FROM cart_items
 WHERE cart_id = $1
+  AND saved_for_later = false
 `
 
 func (q *Queries) CountCartItems(ctx context.Context, cartID uuid.UUID) (int64, error) {
@@ -56,10 +60,10 @@ const createCart = `-- name: CreateCart :one
 INSERT INTO carts (user_id)
 VALUES ($1)
 ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
-RETURNING id, user_id, created_at, updated_at, deleted_at
+RETURNING id, user_id, created_at, updated_at, deleted_at, cart_token
 `
 
-func (q *Queries) CreateCart(ctx context.Context, userID uuid.UUID) (Cart, error) {
+func (q *Queries) CreateCart(ctx context.Context, userID uuid.NullUUID) (Cart, error) {
 	row := q.queryRow(ctx, q.createCartStmt, createCart, userID)
 	var i Cart
 	err := row.Scan(
@@ -68,6 +72,27 @@ func (q *Queries) CreateCart(ctx context.Context, userID uuid.UUID) (Cart, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.CartToken,
+	)
+	return i, err
+}
+
+const createGuestCart = `-- name: CreateGuestCart :one
+INSERT INTO carts (cart_token)
+VALUES ($1)
+RETURNING id, user_id, created_at, updated_at, deleted_at, cart_token
+`
+
+func (q *Queries) CreateGuestCart(ctx context.Context, cartToken sql.NullString) (Cart, error) {
+	row := q.queryRow(ctx, q.createGuestCartStmt, createGuestCart, cartToken)
+	var i Cart
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CartToken,
 	)
 	return i, err
 }
@@ -77,16 +102,18 @@ UPDATE cart_items
 SET quantity = quantity - 1,
     updated_at = NOW()
 WHERE cart_id = $1 AND product_id = $2
-RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at
+  AND variant_id IS NOT DISTINCT FROM $3
+RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at, saved_for_later, variant_id
 `
 
 type DecrementCartItemQtyParams struct {
-	CartID    uuid.UUID `json:"cart_id"`
-	ProductID uuid.UUID `json:"product_id"`
+	CartID    uuid.UUID     `json:"cart_id"`
+	ProductID uuid.UUID     `json:"product_id"`
+	VariantID uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) DecrementCartItemQty(ctx context.Context, arg DecrementCartItemQtyParams) (CartItem, error) {
-	row := q.queryRow(ctx, q.decrementCartItemQtyStmt, decrementCartItemQty, arg.CartID, arg.ProductID)
+	row := q.queryRow(ctx, q.decrementCartItemQtyStmt, decrementCartItemQty, arg.CartID, arg.ProductID, arg.VariantID)
 	var i CartItem
 	err := row.Scan(
 		&i.ID,
@@ -97,10 +124,23 @@ func (q *Queries) DecrementCartItemQty(ctx context.Context, arg DecrementCartIte
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SavedForLater,
+		&i.VariantID,
 	)
 	return i, err
 }
 
+const deleteActiveCartItems = `-- name: DeleteActiveCartItems :exec
+DELETE FROM cart_items
+WHERE cart_id = $1
+  AND saved_for_later = false
+`
+
+func (q *Queries) DeleteActiveCartItems(ctx context.Context, cartID uuid.UUID) error {
+	_, err := q.exec(ctx, q.deleteActiveCartItemsStmt, deleteActiveCartItems, cartID)
+	return err
+}
+
 const deleteAllCartItems = `-- name: DeleteAllCartItems :exec
 DELETE FROM cart_items
 WHERE cart_id = $1
@@ -124,26 +164,64 @@ func (q *Queries) DeleteCart(ctx context.Context, id uuid.UUID) error {
 const deleteCartItem = `-- name: DeleteCartItem :exec
 DELETE FROM cart_items
 WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3
 `
 
 type DeleteCartItemParams struct {
-	CartID    uuid.UUID `json:"cart_id"`
-	ProductID uuid.UUID `json:"product_id"`
+	CartID    uuid.UUID     `json:"cart_id"`
+	ProductID uuid.UUID     `json:"product_id"`
+	VariantID uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) DeleteCartItem(ctx context.Context, arg DeleteCartItemParams) error {
-	_, err := q.exec(ctx, q.deleteCartItemStmt, deleteCartItem, arg.CartID, arg.ProductID)
+	_, err := q.exec(ctx, q.deleteCartItemStmt, deleteCartItem, arg.CartID, arg.ProductID, arg.VariantID)
 	return err
 }
 
+const deleteStaleGuestCarts = `-- name: DeleteStaleGuestCarts :execrows
+DELETE FROM carts
+WHERE user_id IS NULL
+  AND updated_at < $1
+`
+
+func (q *Queries) DeleteStaleGuestCarts(ctx context.Context, updatedAt time.Time) (int64, error) {
+	result, err := q.exec(ctx, q.deleteStaleGuestCartsStmt, deleteStaleGuestCarts, updatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getCartByToken = `-- name: GetCartByToken :one
+SELECT id, user_id, created_at, updated_at, deleted_at, cart_token
+FROM carts
+WHERE cart_token = $1
+  AND user_id IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetCartByToken(ctx context.Context, cartToken sql.NullString) (Cart, error) {
+	row := q.queryRow(ctx, q.getCartByTokenStmt, getCartByToken, cartToken)
+	var i Cart
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CartToken,
+	)
+	return i, err
+}
+
 const getCartByUserID = `-- name: GetCartByUserID :one
-SELECT id, user_id, created_at, updated_at, deleted_at
+SELECT id, user_id, created_at, updated_at, deleted_at, cart_token
 FROM carts
 WHERE user_id = $1
 LIMIT 1
 `
 
-func (q *Queries) GetCartByUserID(ctx context.Context, userID uuid.UUID) (Cart, error) {
+func (q *Queries) GetCartByUserID(ctx context.Context, userID uuid.NullUUID) (Cart, error) {
 	row := q.queryRow(ctx, q.getCartByUserIDStmt, getCartByUserID, userID)
 	var i Cart
 	err := row.Scan(
@@ -152,6 +230,7 @@ func (q *Queries) GetCartByUserID(ctx context.Context, userID uuid.UUID) (Cart,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.CartToken,
 	)
 	return i, err
 }
@@ -162,13 +241,23 @@ SELECT
     ci.product_id,
     p.name AS product_name,
     p.slug AS product_slug,
-    p.image_url AS product_image_url,
+    COALESCE(v.image_url, p.image_url) AS product_image_url,
+    ci.variant_id,
+    (
+        SELECT string_agg(o.name || ': ' || ov.value, ', ' ORDER BY o.created_at)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = ci.variant_id
+    )::text AS variant_name,
     ci.quantity,
     ci.price_at_add,
-    ci.created_at
+    ci.created_at,
+    ci.saved_for_later
 FROM carts c
 JOIN cart_items ci ON ci.cart_id = c.id
 JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
 WHERE c.user_id = $1
 ORDER BY ci.created_at DESC
 `
@@ -179,12 +268,15 @@ type GetCartDetailRow struct {
 	ProductName     string         `json:"product_name"`
 	ProductSlug     string         `json:"product_slug"`
 	ProductImageUrl sql.NullString `json:"product_image_url"`
+	VariantID       uuid.NullUUID  `json:"variant_id"`
+	VariantName     sql.NullString `json:"variant_name"`
 	Quantity        int32          `json:"quantity"`
 	PriceAtAdd      int32          `json:"price_at_add"`
 	CreatedAt       time.Time      `json:"created_at"`
+	SavedForLater   bool           `json:"saved_for_later"`
 }
 
-func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.UUID) ([]GetCartDetailRow, error) {
+func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.NullUUID) ([]GetCartDetailRow, error) {
 	rows, err := q.query(ctx, q.getCartDetailStmt, getCartDetail, userID)
 	if err != nil {
 		return nil, err
@@ -199,6 +291,83 @@ func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.UUID) ([]GetCar
 			&i.ProductName,
 			&i.ProductSlug,
 			&i.ProductImageUrl,
+			&i.VariantID,
+			&i.VariantName,
+			&i.Quantity,
+			&i.PriceAtAdd,
+			&i.CreatedAt,
+			&i.SavedForLater,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCartDetailByToken = `-- name: GetCartDetailByToken :many
+SELECT
+    ci.id,
+    ci.product_id,
+    p.name AS product_name,
+    p.slug AS product_slug,
+    COALESCE(v.image_url, p.image_url) AS product_image_url,
+    ci.variant_id,
+    (
+        SELECT string_agg(o.name || ': ' || ov.value, ', ' ORDER BY o.created_at)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = ci.variant_id
+    )::text AS variant_name,
+    ci.quantity,
+    ci.price_at_add,
+    ci.created_at
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE c.cart_token = $1
+  AND c.user_id IS NULL
+ORDER BY ci.created_at DESC
+`
+
+type GetCartDetailByTokenRow struct {
+	ID              uuid.UUID      `json:"id"`
+	ProductID       uuid.UUID      `json:"product_id"`
+	ProductName     string         `json:"product_name"`
+	ProductSlug     string         `json:"product_slug"`
+	ProductImageUrl sql.NullString `json:"product_image_url"`
+	VariantID       uuid.NullUUID  `json:"variant_id"`
+	VariantName     sql.NullString `json:"variant_name"`
+	Quantity        int32          `json:"quantity"`
+	PriceAtAdd      int32          `json:"price_at_add"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+func (q *Queries) GetCartDetailByToken(ctx context.Context, cartToken sql.NullString) ([]GetCartDetailByTokenRow, error) {
+	rows, err := q.query(ctx, q.getCartDetailByTokenStmt, getCartDetailByToken, cartToken)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCartDetailByTokenRow
+	for rows.Next() {
+		var i GetCartDetailByTokenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.ProductName,
+			&i.ProductSlug,
+			&i.ProductImageUrl,
+			&i.VariantID,
+			&i.VariantName,
 			&i.Quantity,
 			&i.PriceAtAdd,
 			&i.CreatedAt,
@@ -217,20 +386,22 @@ func (q *Queries) GetCartDetail(ctx context.Context, userID uuid.UUID) ([]GetCar
 }
 
 const getCartItemByCartAndProduct = `-- name: GetCartItemByCartAndProduct :one
-SELECT id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at
+SELECT id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at, saved_for_later, variant_id
 FROM cart_items
 WHERE cart_id = $1
   AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3
 LIMIT 1
 `
 
 type GetCartItemByCartAndProductParams struct {
-	CartID    uuid.UUID `json:"cart_id"`
-	ProductID uuid.UUID `json:"product_id"`
+	CartID    uuid.UUID     `json:"cart_id"`
+	ProductID uuid.UUID     `json:"product_id"`
+	VariantID uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) GetCartItemByCartAndProduct(ctx context.Context, arg GetCartItemByCartAndProductParams) (CartItem, error) {
-	row := q.queryRow(ctx, q.getCartItemByCartAndProductStmt, getCartItemByCartAndProduct, arg.CartID, arg.ProductID)
+	row := q.queryRow(ctx, q.getCartItemByCartAndProductStmt, getCartItemByCartAndProduct, arg.CartID, arg.ProductID, arg.VariantID)
 	var i CartItem
 	err := row.Scan(
 		&i.ID,
@@ -241,25 +412,133 @@ func (q *Queries) GetCartItemByCartAndProduct(ctx context.Context, arg GetCartIt
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SavedForLater,
+		&i.VariantID,
 	)
 	return i, err
 }
 
+const getCartValidation = `-- name: GetCartValidation :many
+SELECT
+    ci.id,
+    ci.product_id,
+    p.name AS product_name,
+    p.slug AS product_slug,
+    ci.quantity,
+    ci.price_at_add,
+    -- variant punya harga, stok & status sendiri
+    COALESCE(v.price, p.price)::decimal AS current_price,
+    CASE WHEN v.id IS NULL THEN p.discount_price ELSE v.discount_price END AS current_discount_price,
+    COALESCE(v.stock, p.stock)::int AS stock,
+    -- produk yang belum terbit diperlakukan seperti nonaktif
+    (p.is_active AND COALESCE(v.is_active, true)
+      AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW())))::bool AS is_active,
+    COALESCE(v.deleted_at, p.deleted_at)::timestamp AS deleted_at,
+    p.max_qty_per_order,
+    ci.variant_id,
+    (
+        SELECT string_agg(o.name || ': ' || ov.value, ', ' ORDER BY o.created_at)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = ci.variant_id
+    )::text AS variant_name
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE c.user_id = $1
+  AND ci.saved_for_later = false
+ORDER BY ci.created_at DESC
+`
+
+type GetCartValidationRow struct {
+	ID                   uuid.UUID      `json:"id"`
+	ProductID            uuid.UUID      `json:"product_id"`
+	ProductName          string         `json:"product_name"`
+	ProductSlug          string         `json:"product_slug"`
+	Quantity             int32          `json:"quantity"`
+	PriceAtAdd           int32          `json:"price_at_add"`
+	CurrentPrice         string         `json:"current_price"`
+	CurrentDiscountPrice sql.NullString `json:"current_discount_price"`
+	Stock                int32          `json:"stock"`
+	IsActive             sql.NullBool   `json:"is_active"`
+	DeletedAt            sql.NullTime   `json:"deleted_at"`
+	MaxQtyPerOrder       sql.NullInt32  `json:"max_qty_per_order"`
+	VariantID            uuid.NullUUID  `json:"variant_id"`
+	VariantName          sql.NullString `json:"variant_name"`
+}
+
+func (q *Queries) GetCartValidation(ctx context.Context, userID uuid.NullUUID) ([]GetCartValidationRow, error) {
+	rows, err := q.query(ctx, q.getCartValidationStmt, getCartValidation, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCartValidationRow
+	for rows.Next() {
+		var i GetCartValidationRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.ProductName,
+			&i.ProductSlug,
+			&i.Quantity,
+			&i.PriceAtAdd,
+			&i.CurrentPrice,
+			&i.CurrentDiscountPrice,
+			&i.Stock,
+			&i.IsActive,
+			&i.DeletedAt,
+			&i.MaxQtyPerOrder,
+			&i.VariantID,
+			&i.VariantName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductPurchaseLimit = `-- name: GetProductPurchaseLimit :one
+SELECT max_qty_per_order
+FROM products
+WHERE id = $1
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetProductPurchaseLimit(ctx context.Context, id uuid.UUID) (sql.NullInt32, error) {
+	row := q.queryRow(ctx, q.getProductPurchaseLimitStmt, getProductPurchaseLimit, id)
+	var max_qty_per_order sql.NullInt32
+	err := row.Scan(&max_qty_per_order)
+	return max_qty_per_order, err
+}
+
 const incrementCartItemQty = `-- name: IncrementCartItemQty :one
 UPDATE cart_items
 SET quantity = quantity + 1,
     updated_at = NOW()
 WHERE cart_id = $1 AND product_id = $2
-RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at
+  AND variant_id IS NOT DISTINCT FROM $3
+RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at, saved_for_later, variant_id
 `
 
 type IncrementCartItemQtyParams struct {
-	CartID    uuid.UUID `json:"cart_id"`
-	ProductID uuid.UUID `json:"product_id"`
+	CartID    uuid.UUID     `json:"cart_id"`
+	ProductID uuid.UUID     `json:"product_id"`
+	VariantID uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) IncrementCartItemQty(ctx context.Context, arg IncrementCartItemQtyParams) (CartItem, error) {
-	row := q.queryRow(ctx, q.incrementCartItemQtyStmt, incrementCartItemQty, arg.CartID, arg.ProductID)
+	row := q.queryRow(ctx, q.incrementCartItemQtyStmt, incrementCartItemQty, arg.CartID, arg.ProductID, arg.VariantID)
 	var i CartItem
 	err := row.Scan(
 		&i.ID,
@@ -270,25 +549,289 @@ func (q *Queries) IncrementCartItemQty(ctx context.Context, arg IncrementCartIte
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SavedForLater,
+		&i.VariantID,
 	)
 	return i, err
 }
 
+const listAbandonedCarts = `-- name: ListAbandonedCarts :many
+SELECT
+    c.id AS cart_id,
+    c.user_id,
+    MAX(ci.updated_at)::timestamp AS last_activity_at
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id AND ci.saved_for_later = false
+JOIN users u ON u.id = c.user_id
+LEFT JOIN notification_preferences np ON np.user_id = c.user_id
+WHERE c.user_id IS NOT NULL
+  AND u.is_active = true
+  AND (np.user_id IS NULL OR np.cart_reminder_enabled = true)
+  AND (
+    np.cart_reminder_sent_at IS NULL
+    OR np.cart_reminder_sent_at < $1::timestamp
+  )
+GROUP BY c.id, c.user_id, np.cart_reminder_sent_at
+HAVING MAX(ci.updated_at) < $2::timestamp
+  -- hanya kirim lagi jika cart disentuh setelah pengingat terakhir
+  AND (
+    np.cart_reminder_sent_at IS NULL
+    OR np.cart_reminder_sent_at < MAX(ci.updated_at)
+  )
+  -- user belum checkout sejak terakhir menyentuh cart
+  AND NOT EXISTS (
+    SELECT 1
+    FROM orders o
+    WHERE o.user_id = c.user_id
+      AND o.created_at >= MAX(ci.updated_at)
+  )
+ORDER BY last_activity_at ASC
+LIMIT $3::int
+`
+
+type ListAbandonedCartsParams struct {
+	CooldownBefore time.Time `json:"cooldown_before"`
+	IdleBefore     time.Time `json:"idle_before"`
+	RowLimit       int32     `json:"row_limit"`
+}
+
+type ListAbandonedCartsRow struct {
+	CartID         uuid.UUID     `json:"cart_id"`
+	UserID         uuid.NullUUID `json:"user_id"`
+	LastActivityAt time.Time     `json:"last_activity_at"`
+}
+
+func (q *Queries) ListAbandonedCarts(ctx context.Context, arg ListAbandonedCartsParams) ([]ListAbandonedCartsRow, error) {
+	rows, err := q.query(ctx, q.listAbandonedCartsStmt, listAbandonedCarts, arg.CooldownBefore, arg.IdleBefore, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAbandonedCartsRow
+	for rows.Next() {
+		var i ListAbandonedCartsRow
+		if err := rows.Scan(&i.CartID, &i.UserID, &i.LastActivityAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCartItemsWithStock = `-- name: ListCartItemsWithStock :many
+SELECT
+    ci.product_id,
+    ci.variant_id,
+    ci.quantity,
+    ci.price_at_add,
+    COALESCE(v.stock, p.stock)::int AS stock
+FROM cart_items ci
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE ci.cart_id = $1
+  AND p.deleted_at IS NULL
+  AND v.deleted_at IS NULL
+`
+
+type ListCartItemsWithStockRow struct {
+	ProductID  uuid.UUID     `json:"product_id"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
+	Quantity   int32         `json:"quantity"`
+	PriceAtAdd int32         `json:"price_at_add"`
+	Stock      int32         `json:"stock"`
+}
+
+func (q *Queries) ListCartItemsWithStock(ctx context.Context, cartID uuid.UUID) ([]ListCartItemsWithStockRow, error) {
+	rows, err := q.query(ctx, q.listCartItemsWithStockStmt, listCartItemsWithStock, cartID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCartItemsWithStockRow
+	for rows.Next() {
+		var i ListCartItemsWithStockRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.VariantID,
+			&i.Quantity,
+			&i.PriceAtAdd,
+			&i.Stock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCartReminderItems = `-- name: ListCartReminderItems :many
+SELECT
+    p.name AS product_name,
+    ci.quantity,
+    COALESCE(v.price, p.price)::decimal AS price,
+    CASE WHEN v.id IS NULL THEN p.discount_price ELSE v.discount_price END AS discount_price
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE c.user_id = $1
+  AND ci.saved_for_later = false
+  AND p.deleted_at IS NULL
+ORDER BY ci.created_at DESC
+`
+
+type ListCartReminderItemsRow struct {
+	ProductName   string         `json:"product_name"`
+	Quantity      int32          `json:"quantity"`
+	Price         string         `json:"price"`
+	DiscountPrice sql.NullString `json:"discount_price"`
+}
+
+func (q *Queries) ListCartReminderItems(ctx context.Context, userID uuid.NullUUID) ([]ListCartReminderItemsRow, error) {
+	rows, err := q.query(ctx, q.listCartReminderItemsStmt, listCartReminderItems, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCartReminderItemsRow
+	for rows.Next() {
+		var i ListCartReminderItemsRow
+		if err := rows.Scan(
+			&i.ProductName,
+			&i.Quantity,
+			&i.Price,
+			&i.DiscountPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockCart = `-- name: LockCart :one
+SELECT id
+FROM carts
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockCart(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.queryRow(ctx, q.lockCartStmt, lockCart, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const setCartItemSavedForLater = `-- name: SetCartItemSavedForLater :one
+UPDATE cart_items
+SET saved_for_later = $3,
+    updated_at = NOW()
+WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $4
+RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at, saved_for_later, variant_id
+`
+
+type SetCartItemSavedForLaterParams struct {
+	CartID        uuid.UUID     `json:"cart_id"`
+	ProductID     uuid.UUID     `json:"product_id"`
+	SavedForLater bool          `json:"saved_for_later"`
+	VariantID     uuid.NullUUID `json:"variant_id"`
+}
+
+func (q *Queries) SetCartItemSavedForLater(ctx context.Context, arg SetCartItemSavedForLaterParams) (CartItem, error) {
+	row := q.queryRow(ctx, q.setCartItemSavedForLaterStmt, setCartItemSavedForLater,
+		arg.CartID,
+		arg.ProductID,
+		arg.SavedForLater,
+		arg.VariantID,
+	)
+	var i CartItem
+	err := row.Scan(
+		&i.ID,
+		&i.CartID,
+		&i.ProductID,
+		&i.Quantity,
+		&i.PriceAtAdd,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.SavedForLater,
+		&i.VariantID,
+	)
+	return i, err
+}
+
+const sumCartProductQty = `-- name: SumCartProductQty :one
+SELECT COALESCE(SUM(quantity), 0)::bigint
+FROM cart_items
+WHERE cart_id = $1
+  AND product_id = $2
+  AND saved_for_later = false
+`
+
+type SumCartProductQtyParams struct {
+	CartID    uuid.UUID `json:"cart_id"`
+	ProductID uuid.UUID `json:"product_id"`
+}
+
+func (q *Queries) SumCartProductQty(ctx context.Context, arg SumCartProductQtyParams) (int64, error) {
+	row := q.queryRow(ctx, q.sumCartProductQtyStmt, sumCartProductQty, arg.CartID, arg.ProductID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const touchCart = `-- name: TouchCart :exec
+UPDATE carts
+SET updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) TouchCart(ctx context.Context, id uuid.UUID) error {
+	_, err := q.exec(ctx, q.touchCartStmt, touchCart, id)
+	return err
+}
+
 const updateCartItemQty = `-- name: UpdateCartItemQty :one
 UPDATE cart_items
 SET quantity = $3, updated_at = NOW()
 WHERE cart_id = $1 AND product_id = $2
-RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at
+  AND variant_id IS NOT DISTINCT FROM $4
+RETURNING id, cart_id, product_id, quantity, price_at_add, created_at, updated_at, deleted_at, saved_for_later, variant_id
 `
 
 type UpdateCartItemQtyParams struct {
-	CartID    uuid.UUID `json:"cart_id"`
-	ProductID uuid.UUID `json:"product_id"`
-	Quantity  int32     `json:"quantity"`
+	CartID    uuid.UUID     `json:"cart_id"`
+	ProductID uuid.UUID     `json:"product_id"`
+	Quantity  int32         `json:"quantity"`
+	VariantID uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) UpdateCartItemQty(ctx context.Context, arg UpdateCartItemQtyParams) (CartItem, error) {
-	row := q.queryRow(ctx, q.updateCartItemQtyStmt, updateCartItemQty, arg.CartID, arg.ProductID, arg.Quantity)
+	row := q.queryRow(ctx, q.updateCartItemQtyStmt, updateCartItemQty,
+		arg.CartID,
+		arg.ProductID,
+		arg.Quantity,
+		arg.VariantID,
+	)
 	var i CartItem
 	err := row.Scan(
 		&i.ID,
@@ -299,6 +842,38 @@ func (q *Queries) UpdateCartItemQty(ctx context.Context, arg UpdateCartItemQtyPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SavedForLater,
+		&i.VariantID,
 	)
 	return i, err
 }
+
+const upsertCartItemQty = `-- name: UpsertCartItemQty :exec
+INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add, variant_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (cart_id, product_id, variant_id)
+DO UPDATE SET
+  quantity = EXCLUDED.quantity,
+  -- hasil merge selalu aktif, termasuk baris user yang sebelumnya disimpan untuk nanti
+  saved_for_later = false,
+  updated_at = NOW()
+`
+
+type UpsertCartItemQtyParams struct {
+	CartID     uuid.UUID     `json:"cart_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	Quantity   int32         `json:"quantity"`
+	PriceAtAdd int32         `json:"price_at_add"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
+}
+
+func (q *Queries) UpsertCartItemQty(ctx context.Context, arg UpsertCartItemQtyParams) error {
+	_, err := q.exec(ctx, q.upsertCartItemQtyStmt, upsertCartItemQty,
+		arg.CartID,
+		arg.ProductID,
+		arg.Quantity,
+		arg.PriceAtAdd,
+		arg.VariantID,
+	)
+	return err
+}
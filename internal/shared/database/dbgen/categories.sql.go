@@ -11,12 +11,34 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const countCategoryDependents = `-- name: CountCategoryDependents :one
+SELECT
+    (SELECT COUNT(*) FROM categories ch WHERE ch.parent_id = $1 AND ch.deleted_at IS NULL) AS child_count,
+    (SELECT COUNT(*) FROM products p WHERE p.category_id = $1 AND p.deleted_at IS NULL) AS product_count
+`
+
+type CountCategoryDependentsRow struct {
+	ChildCount   int64 `json:"child_count"`
+	ProductCount int64 `json:"product_count"`
+}
+
+func (q *Queries) CountCategoryDependents(ctx context.Context, id uuid.UUID) (CountCategoryDependentsRow, error) {
+	row := q.queryRow(ctx, q.countCategoryDependentsStmt, countCategoryDependents, id)
+	var i CountCategoryDependentsRow
+	err := row.Scan(
+		&i.ChildCount,
+		&i.ProductCount,
+	)
+	return i, err
+}
+
 const createCategory = `-- name: CreateCategory :one
-INSERT INTO categories (name, slug, description, image_url)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+INSERT INTO categories (name, slug, description, image_url, parent_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id
 `
 
 type CreateCategoryParams struct {
@@ -24,6 +46,7 @@ type CreateCategoryParams struct {
 	Slug        string         `json:"slug"`
 	Description sql.NullString `json:"description"`
 	ImageUrl    sql.NullString `json:"image_url"`
+	ParentID    uuid.NullUUID  `json:"parent_id"`
 }
 
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
@@ -32,6 +55,7 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 		arg.Slug,
 		arg.Description,
 		arg.ImageUrl,
+		arg.ParentID,
 	)
 	var i Category
 	err := row.Scan(
@@ -44,12 +68,13 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
 	)
 	return i, err
 }
 
 const getCategoryByID = `-- name: GetCategoryByID :one
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at FROM categories WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id FROM categories WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category, error) {
@@ -65,12 +90,13 @@ func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
 	)
 	return i, err
 }
 
 const getCategoryBySlug = `-- name: GetCategoryBySlug :one
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at FROM categories WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id FROM categories WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetCategoryBySlug(ctx context.Context, slug string) (Category, error) {
@@ -86,13 +112,58 @@ func (q *Queries) GetCategoryBySlug(ctx context.Context, slug string) (Category,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
 	)
 	return i, err
 }
 
+const getCategoryDescendantIDsBySlugs = `-- name: GetCategoryDescendantIDsBySlugs :many
+WITH RECURSIVE tree AS (
+    SELECT id
+    FROM categories
+    WHERE (
+        slug = ANY($1::text[])
+        OR id IN (
+            SELECT sh.entity_id FROM slug_history sh
+            WHERE sh.entity_type = 'category' AND sh.slug = ANY($1::text[])
+        )
+    )
+      AND deleted_at IS NULL
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+    WHERE c.deleted_at IS NULL
+)
+SELECT id FROM tree
+`
+
+func (q *Queries) GetCategoryDescendantIDsBySlugs(ctx context.Context, dollar_1 []string) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.getCategoryDescendantIDsBySlugsStmt, getCategoryDescendantIDsBySlugs, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCategoriesAdmin = `-- name: ListCategoriesAdmin :many
 SELECT 
-    id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, 
+    id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id, 
     COUNT(*) OVER() AS total_count
 FROM categories
 WHERE 
@@ -142,6 +213,7 @@ type ListCategoriesAdminRow struct {
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   sql.NullTime   `json:"deleted_at"`
+	ParentID    uuid.NullUUID  `json:"parent_id"`
 	TotalCount  int64          `json:"total_count"`
 }
 
@@ -170,6 +242,7 @@ func (q *Queries) ListCategoriesAdmin(ctx context.Context, arg ListCategoriesAdm
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ParentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -186,7 +259,7 @@ func (q *Queries) ListCategoriesAdmin(ctx context.Context, arg ListCategoriesAdm
 }
 
 const listCategoriesPublic = `-- name: ListCategoriesPublic :many
-SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, count(*) OVER() AS total_count
+SELECT id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id, count(*) OVER() AS total_count
 FROM categories
 WHERE deleted_at IS NULL
   AND is_active = true
@@ -209,6 +282,7 @@ type ListCategoriesPublicRow struct {
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   sql.NullTime   `json:"deleted_at"`
+	ParentID    uuid.NullUUID  `json:"parent_id"`
 	TotalCount  int64          `json:"total_count"`
 }
 
@@ -231,6 +305,7 @@ func (q *Queries) ListCategoriesPublic(ctx context.Context, arg ListCategoriesPu
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ParentID,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -246,8 +321,132 @@ func (q *Queries) ListCategoriesPublic(ctx context.Context, arg ListCategoriesPu
 	return items, nil
 }
 
+const listCategoryAncestors = `-- name: ListCategoryAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT c.id, c.parent_id, c.name, c.slug, 0 AS depth
+    FROM categories c
+    WHERE c.id = $1 AND c.deleted_at IS NULL
+    UNION ALL
+    SELECT p.id, p.parent_id, p.name, p.slug, a.depth + 1
+    FROM categories p
+    JOIN ancestors a ON p.id = a.parent_id
+    WHERE p.deleted_at IS NULL AND a.depth < 32
+)
+SELECT id, name, slug
+FROM ancestors
+ORDER BY depth DESC
+`
+
+type ListCategoryAncestorsRow struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug"`
+}
+
+func (q *Queries) ListCategoryAncestors(ctx context.Context, id uuid.UUID) ([]ListCategoryAncestorsRow, error) {
+	rows, err := q.query(ctx, q.listCategoryAncestorsStmt, listCategoryAncestors, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryAncestorsRow
+	for rows.Next() {
+		var i ListCategoryAncestorsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryTree = `-- name: ListCategoryTree :many
+SELECT id, parent_id, name, slug, image_url
+FROM categories
+WHERE deleted_at IS NULL
+  AND is_active = true
+ORDER BY name ASC
+`
+
+type ListCategoryTreeRow struct {
+	ID       uuid.UUID      `json:"id"`
+	ParentID uuid.NullUUID  `json:"parent_id"`
+	Name     string         `json:"name"`
+	Slug     string         `json:"slug"`
+	ImageUrl sql.NullString `json:"image_url"`
+}
+
+func (q *Queries) ListCategoryTree(ctx context.Context) ([]ListCategoryTreeRow, error) {
+	rows, err := q.query(ctx, q.listCategoryTreeStmt, listCategoryTree)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryTreeRow
+	for rows.Next() {
+		var i ListCategoryTreeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParentID,
+			&i.Name,
+			&i.Slug,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockCategoriesForUpdate = `-- name: LockCategoriesForUpdate :many
+SELECT id FROM categories
+WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE
+`
+
+func (q *Queries) LockCategoriesForUpdate(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.lockCategoriesForUpdateStmt, lockCategoriesForUpdate, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const restoreCategory = `-- name: RestoreCategory :one
-UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id
 `
 
 func (q *Queries) RestoreCategory(ctx context.Context, id uuid.UUID) (Category, error) {
@@ -263,6 +462,7 @@ func (q *Queries) RestoreCategory(ctx context.Context, id uuid.UUID) (Category,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
 	)
 	return i, err
 }
@@ -278,9 +478,9 @@ func (q *Queries) SoftDeleteCategory(ctx context.Context, id uuid.UUID) error {
 
 const updateCategory = `-- name: UpdateCategory :one
 UPDATE categories 
-SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, updated_at = NOW()
+SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, parent_id = $7, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at
+RETURNING id, name, slug, description, image_url, is_active, created_at, updated_at, deleted_at, parent_id
 `
 
 type UpdateCategoryParams struct {
@@ -290,6 +490,7 @@ type UpdateCategoryParams struct {
 	Description sql.NullString `json:"description"`
 	ImageUrl    sql.NullString `json:"image_url"`
 	IsActive    sql.NullBool   `json:"is_active"`
+	ParentID    uuid.NullUUID  `json:"parent_id"`
 }
 
 func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
@@ -300,6 +501,7 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		arg.Description,
 		arg.ImageUrl,
 		arg.IsActive,
+		arg.ParentID,
 	)
 	var i Category
 	err := row.Scan(
@@ -312,6 +514,7 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
 	)
 	return i, err
 }
@@ -27,9 +27,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.addCartItemStmt, err = db.PrepareContext(ctx, addCartItem); err != nil {
 		return nil, fmt.Errorf("error preparing query AddCartItem: %w", err)
 	}
+	if q.addProductVariantValueStmt, err = db.PrepareContext(ctx, addProductVariantValue); err != nil {
+		return nil, fmt.Errorf("error preparing query AddProductVariantValue: %w", err)
+	}
 	if q.addWishlistItemStmt, err = db.PrepareContext(ctx, addWishlistItem); err != nil {
 		return nil, fmt.Errorf("error preparing query AddWishlistItem: %w", err)
 	}
+	if q.applyStockMovementStmt, err = db.PrepareContext(ctx, applyStockMovement); err != nil {
+		return nil, fmt.Errorf("error preparing query ApplyStockMovement: %w", err)
+	}
+	if q.cancelPendingPriceScheduleStmt, err = db.PrepareContext(ctx, cancelPendingPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query CancelPendingPriceSchedule: %w", err)
+	}
 	if q.checkPhoneExistsStmt, err = db.PrepareContext(ctx, checkPhoneExists); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckPhoneExists: %w", err)
 	}
@@ -42,9 +51,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.checkWishlistItemExistsStmt, err = db.PrepareContext(ctx, checkWishlistItemExists); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckWishlistItemExists: %w", err)
 	}
+	if q.clearDefaultWarehouseStmt, err = db.PrepareContext(ctx, clearDefaultWarehouse); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearDefaultWarehouse: %w", err)
+	}
+	if q.clearPrimaryProductImageStmt, err = db.PrepareContext(ctx, clearPrimaryProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query ClearPrimaryProductImage: %w", err)
+	}
 	if q.countCartItemsStmt, err = db.PrepareContext(ctx, countCartItems); err != nil {
 		return nil, fmt.Errorf("error preparing query CountCartItems: %w", err)
 	}
+	if q.countCategoryDependentsStmt, err = db.PrepareContext(ctx, countCategoryDependents); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCategoryDependents: %w", err)
+	}
+	if q.countOverlappingPriceSchedulesStmt, err = db.PrepareContext(ctx, countOverlappingPriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query CountOverlappingPriceSchedules: %w", err)
+	}
 	if q.countReviewsByProductIDStmt, err = db.PrepareContext(ctx, countReviewsByProductID); err != nil {
 		return nil, fmt.Errorf("error preparing query CountReviewsByProductID: %w", err)
 	}
@@ -63,6 +84,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createCategoryStmt, err = db.PrepareContext(ctx, createCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCategory: %w", err)
 	}
+	if q.createCategoryAttributeStmt, err = db.PrepareContext(ctx, createCategoryAttribute); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCategoryAttribute: %w", err)
+	}
+	if q.createGuestCartStmt, err = db.PrepareContext(ctx, createGuestCart); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateGuestCart: %w", err)
+	}
 	if q.createOrderStmt, err = db.PrepareContext(ctx, createOrder); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOrder: %w", err)
 	}
@@ -72,18 +99,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createOutboxEventStmt, err = db.PrepareContext(ctx, createOutboxEvent); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateOutboxEvent: %w", err)
 	}
+	if q.createPriceScheduleStmt, err = db.PrepareContext(ctx, createPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePriceSchedule: %w", err)
+	}
 	if q.createProductStmt, err = db.PrepareContext(ctx, createProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProduct: %w", err)
 	}
+	if q.createProductImageStmt, err = db.PrepareContext(ctx, createProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductImage: %w", err)
+	}
+	if q.createProductImportJobStmt, err = db.PrepareContext(ctx, createProductImportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductImportJob: %w", err)
+	}
+	if q.createProductVariantStmt, err = db.PrepareContext(ctx, createProductVariant); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProductVariant: %w", err)
+	}
 	if q.createReviewStmt, err = db.PrepareContext(ctx, createReview); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateReview: %w", err)
 	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
+	if q.createWarehouseStmt, err = db.PrepareContext(ctx, createWarehouse); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWarehouse: %w", err)
+	}
+	if q.createWarehouseTransferStmt, err = db.PrepareContext(ctx, createWarehouseTransfer); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateWarehouseTransfer: %w", err)
+	}
 	if q.decrementCartItemQtyStmt, err = db.PrepareContext(ctx, decrementCartItemQty); err != nil {
 		return nil, fmt.Errorf("error preparing query DecrementCartItemQty: %w", err)
 	}
+	if q.deleteActiveCartItemsStmt, err = db.PrepareContext(ctx, deleteActiveCartItems); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteActiveCartItems: %w", err)
+	}
 	if q.deleteAllCartItemsStmt, err = db.PrepareContext(ctx, deleteAllCartItems); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAllCartItems: %w", err)
 	}
@@ -93,6 +141,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteCartItemStmt, err = db.PrepareContext(ctx, deleteCartItem); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCartItem: %w", err)
 	}
+	if q.deleteCategoryAttributeStmt, err = db.PrepareContext(ctx, deleteCategoryAttribute); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCategoryAttribute: %w", err)
+	}
 	if q.deleteEmailConfirmationTokenByPinStmt, err = db.PrepareContext(ctx, deleteEmailConfirmationTokenByPin); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEmailConfirmationTokenByPin: %w", err)
 	}
@@ -105,12 +156,45 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deletePasswordResetTokenByTokenStmt, err = db.PrepareContext(ctx, deletePasswordResetTokenByToken); err != nil {
 		return nil, fmt.Errorf("error preparing query DeletePasswordResetTokenByToken: %w", err)
 	}
+	if q.deleteProductAttributeValueStmt, err = db.PrepareContext(ctx, deleteProductAttributeValue); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductAttributeValue: %w", err)
+	}
+	if q.deleteProductCoPurchasesStmt, err = db.PrepareContext(ctx, deleteProductCoPurchases); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductCoPurchases: %w", err)
+	}
+	if q.deleteProductImageStmt, err = db.PrepareContext(ctx, deleteProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProductImage: %w", err)
+	}
 	if q.deleteReviewStmt, err = db.PrepareContext(ctx, deleteReview); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteReview: %w", err)
 	}
+	if q.deleteSlugHistoryStmt, err = db.PrepareContext(ctx, deleteSlugHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteSlugHistory: %w", err)
+	}
+	if q.deleteStaleGuestCartsStmt, err = db.PrepareContext(ctx, deleteStaleGuestCarts); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteStaleGuestCarts: %w", err)
+	}
+	if q.deleteStockNotificationStmt, err = db.PrepareContext(ctx, deleteStockNotification); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteStockNotification: %w", err)
+	}
 	if q.deleteWishlistItemStmt, err = db.PrepareContext(ctx, deleteWishlistItem); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteWishlistItem: %w", err)
 	}
+	if q.expirePriceSchedulesStmt, err = db.PrepareContext(ctx, expirePriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpirePriceSchedules: %w", err)
+	}
+	if q.failProductImportJobStmt, err = db.PrepareContext(ctx, failProductImportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FailProductImportJob: %w", err)
+	}
+	if q.failStaleProductImportJobsStmt, err = db.PrepareContext(ctx, failStaleProductImportJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query FailStaleProductImportJobs: %w", err)
+	}
+	if q.finishPriceScheduleStmt, err = db.PrepareContext(ctx, finishPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishPriceSchedule: %w", err)
+	}
+	if q.finishProductImportJobStmt, err = db.PrepareContext(ctx, finishProductImportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FinishProductImportJob: %w", err)
+	}
 	if q.getAddressByIDStmt, err = db.PrepareContext(ctx, getAddressByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAddressByID: %w", err)
 	}
@@ -123,21 +207,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getBrandBySlugStmt, err = db.PrepareContext(ctx, getBrandBySlug); err != nil {
 		return nil, fmt.Errorf("error preparing query GetBrandBySlug: %w", err)
 	}
+	if q.getCartByTokenStmt, err = db.PrepareContext(ctx, getCartByToken); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCartByToken: %w", err)
+	}
 	if q.getCartByUserIDStmt, err = db.PrepareContext(ctx, getCartByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCartByUserID: %w", err)
 	}
 	if q.getCartDetailStmt, err = db.PrepareContext(ctx, getCartDetail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCartDetail: %w", err)
 	}
+	if q.getCartDetailByTokenStmt, err = db.PrepareContext(ctx, getCartDetailByToken); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCartDetailByToken: %w", err)
+	}
 	if q.getCartItemByCartAndProductStmt, err = db.PrepareContext(ctx, getCartItemByCartAndProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCartItemByCartAndProduct: %w", err)
 	}
+	if q.getCartValidationStmt, err = db.PrepareContext(ctx, getCartValidation); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCartValidation: %w", err)
+	}
+	if q.getCategoryAttributeByIDStmt, err = db.PrepareContext(ctx, getCategoryAttributeByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCategoryAttributeByID: %w", err)
+	}
 	if q.getCategoryByIDStmt, err = db.PrepareContext(ctx, getCategoryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryByID: %w", err)
 	}
 	if q.getCategoryBySlugStmt, err = db.PrepareContext(ctx, getCategoryBySlug); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryBySlug: %w", err)
 	}
+	if q.getCategoryDescendantIDsBySlugsStmt, err = db.PrepareContext(ctx, getCategoryDescendantIDsBySlugs); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCategoryDescendantIDsBySlugs: %w", err)
+	}
 	if q.getCategoryDistributionStmt, err = db.PrepareContext(ctx, getCategoryDistribution); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCategoryDistribution: %w", err)
 	}
@@ -159,6 +258,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getLatestPasswordResetTokenByUserIDStmt, err = db.PrepareContext(ctx, getLatestPasswordResetTokenByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetLatestPasswordResetTokenByUserID: %w", err)
 	}
+	if q.getNextProductImageSortOrderStmt, err = db.PrepareContext(ctx, getNextProductImageSortOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNextProductImageSortOrder: %w", err)
+	}
+	if q.getNotificationPreferencesStmt, err = db.PrepareContext(ctx, getNotificationPreferences); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNotificationPreferences: %w", err)
+	}
 	if q.getOrCreateWishlistStmt, err = db.PrepareContext(ctx, getOrCreateWishlist); err != nil {
 		return nil, fmt.Errorf("error preparing query GetOrCreateWishlist: %w", err)
 	}
@@ -180,21 +285,54 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPasswordResetTokenStmt, err = db.PrepareContext(ctx, getPasswordResetToken); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPasswordResetToken: %w", err)
 	}
+	if q.getPriceScheduleStmt, err = db.PrepareContext(ctx, getPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPriceSchedule: %w", err)
+	}
 	if q.getProductByIDStmt, err = db.PrepareContext(ctx, getProductByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProductByID: %w", err)
 	}
 	if q.getProductBySlugStmt, err = db.PrepareContext(ctx, getProductBySlug); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProductBySlug: %w", err)
 	}
+	if q.getProductFacetsStmt, err = db.PrepareContext(ctx, getProductFacets); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductFacets: %w", err)
+	}
+	if q.getProductImageByIDStmt, err = db.PrepareContext(ctx, getProductImageByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductImageByID: %w", err)
+	}
+	if q.getProductImportJobStmt, err = db.PrepareContext(ctx, getProductImportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductImportJob: %w", err)
+	}
+	if q.getProductImportTargetBySKUStmt, err = db.PrepareContext(ctx, getProductImportTargetBySKU); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductImportTargetBySKU: %w", err)
+	}
+	if q.getProductPurchaseLimitStmt, err = db.PrepareContext(ctx, getProductPurchaseLimit); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductPurchaseLimit: %w", err)
+	}
+	if q.getProductStockForUpdateStmt, err = db.PrepareContext(ctx, getProductStockForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductStockForUpdate: %w", err)
+	}
+	if q.getProductVariantByIDStmt, err = db.PrepareContext(ctx, getProductVariantByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProductVariantByID: %w", err)
+	}
 	if q.getReviewByIDStmt, err = db.PrepareContext(ctx, getReviewByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewByID: %w", err)
 	}
 	if q.getReviewsByProductIDStmt, err = db.PrepareContext(ctx, getReviewsByProductID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewsByProductID: %w", err)
 	}
+	if q.getReviewsByProductIDCreatedAscStmt, err = db.PrepareContext(ctx, getReviewsByProductIDCreatedAsc); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReviewsByProductIDCreatedAsc: %w", err)
+	}
+	if q.getReviewsByProductIDCreatedDescStmt, err = db.PrepareContext(ctx, getReviewsByProductIDCreatedDesc); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReviewsByProductIDCreatedDesc: %w", err)
+	}
 	if q.getReviewsByUserIDStmt, err = db.PrepareContext(ctx, getReviewsByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetReviewsByUserID: %w", err)
 	}
+	if q.getSlugHistoryOwnerStmt, err = db.PrepareContext(ctx, getSlugHistoryOwner); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSlugHistoryOwner: %w", err)
+	}
 	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
 	}
@@ -204,6 +342,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getUserRatingBreakdownStmt, err = db.PrepareContext(ctx, getUserRatingBreakdown); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserRatingBreakdown: %w", err)
 	}
+	if q.getWarehouseByIDStmt, err = db.PrepareContext(ctx, getWarehouseByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetWarehouseByID: %w", err)
+	}
 	if q.getWishlistByUserIDStmt, err = db.PrepareContext(ctx, getWishlistByUserID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetWishlistByUserID: %w", err)
 	}
@@ -216,54 +357,207 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.incrementCartItemQtyStmt, err = db.PrepareContext(ctx, incrementCartItemQty); err != nil {
 		return nil, fmt.Errorf("error preparing query IncrementCartItemQty: %w", err)
 	}
+	if q.insertProductCoPurchasesStmt, err = db.PrepareContext(ctx, insertProductCoPurchases); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertProductCoPurchases: %w", err)
+	}
+	if q.insertSlugHistoryStmt, err = db.PrepareContext(ctx, insertSlugHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertSlugHistory: %w", err)
+	}
+	if q.isSlugRetiredStmt, err = db.PrepareContext(ctx, isSlugRetired); err != nil {
+		return nil, fmt.Errorf("error preparing query IsSlugRetired: %w", err)
+	}
+	if q.listAbandonedCartsStmt, err = db.PrepareContext(ctx, listAbandonedCarts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAbandonedCarts: %w", err)
+	}
+	if q.listActiveAdminsStmt, err = db.PrepareContext(ctx, listActiveAdmins); err != nil {
+		return nil, fmt.Errorf("error preparing query ListActiveAdmins: %w", err)
+	}
 	if q.listAddressesAdminStmt, err = db.PrepareContext(ctx, listAddressesAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAddressesAdmin: %w", err)
 	}
 	if q.listAddressesByUserStmt, err = db.PrepareContext(ctx, listAddressesByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAddressesByUser: %w", err)
 	}
+	if q.listBoughtTogetherStmt, err = db.PrepareContext(ctx, listBoughtTogether); err != nil {
+		return nil, fmt.Errorf("error preparing query ListBoughtTogether: %w", err)
+	}
+	if q.listBrandRefsBySlugsStmt, err = db.PrepareContext(ctx, listBrandRefsBySlugs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListBrandRefsBySlugs: %w", err)
+	}
 	if q.listBrandsAdminStmt, err = db.PrepareContext(ctx, listBrandsAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListBrandsAdmin: %w", err)
 	}
 	if q.listBrandsPublicStmt, err = db.PrepareContext(ctx, listBrandsPublic); err != nil {
 		return nil, fmt.Errorf("error preparing query ListBrandsPublic: %w", err)
 	}
+	if q.listCartItemsWithStockStmt, err = db.PrepareContext(ctx, listCartItemsWithStock); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCartItemsWithStock: %w", err)
+	}
+	if q.listCartReminderItemsStmt, err = db.PrepareContext(ctx, listCartReminderItems); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCartReminderItems: %w", err)
+	}
 	if q.listCategoriesAdminStmt, err = db.PrepareContext(ctx, listCategoriesAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListCategoriesAdmin: %w", err)
 	}
 	if q.listCategoriesPublicStmt, err = db.PrepareContext(ctx, listCategoriesPublic); err != nil {
 		return nil, fmt.Errorf("error preparing query ListCategoriesPublic: %w", err)
 	}
+	if q.listCategoryAncestorsStmt, err = db.PrepareContext(ctx, listCategoryAncestors); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCategoryAncestors: %w", err)
+	}
+	if q.listCategoryAttributesStmt, err = db.PrepareContext(ctx, listCategoryAttributes); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCategoryAttributes: %w", err)
+	}
+	if q.listCategoryRefsBySlugsStmt, err = db.PrepareContext(ctx, listCategoryRefsBySlugs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCategoryRefsBySlugs: %w", err)
+	}
+	if q.listCategoryTreeStmt, err = db.PrepareContext(ctx, listCategoryTree); err != nil {
+		return nil, fmt.Errorf("error preparing query ListCategoryTree: %w", err)
+	}
 	if q.listCustomersStmt, err = db.PrepareContext(ctx, listCustomers); err != nil {
 		return nil, fmt.Errorf("error preparing query ListCustomers: %w", err)
 	}
+	if q.listDuePriceSchedulesStmt, err = db.PrepareContext(ctx, listDuePriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListDuePriceSchedules: %w", err)
+	}
+	if q.listEndingPriceSchedulesStmt, err = db.PrepareContext(ctx, listEndingPriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListEndingPriceSchedules: %w", err)
+	}
+	if q.listExistingProductSKUsStmt, err = db.PrepareContext(ctx, listExistingProductSKUs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListExistingProductSKUs: %w", err)
+	}
+	if q.listFulfilmentWarehousesStmt, err = db.PrepareContext(ctx, listFulfilmentWarehouses); err != nil {
+		return nil, fmt.Errorf("error preparing query ListFulfilmentWarehouses: %w", err)
+	}
 	if q.listOrdersStmt, err = db.PrepareContext(ctx, listOrders); err != nil {
 		return nil, fmt.Errorf("error preparing query ListOrders: %w", err)
 	}
 	if q.listOrdersAdminStmt, err = db.PrepareContext(ctx, listOrdersAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListOrdersAdmin: %w", err)
 	}
+	if q.listOrdersAdminByCreatedAscStmt, err = db.PrepareContext(ctx, listOrdersAdminByCreatedAsc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrdersAdminByCreatedAsc: %w", err)
+	}
+	if q.listOrdersAdminByCreatedDescStmt, err = db.PrepareContext(ctx, listOrdersAdminByCreatedDesc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrdersAdminByCreatedDesc: %w", err)
+	}
+	if q.listOrdersByPlacedAscStmt, err = db.PrepareContext(ctx, listOrdersByPlacedAsc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrdersByPlacedAsc: %w", err)
+	}
+	if q.listOrdersByPlacedDescStmt, err = db.PrepareContext(ctx, listOrdersByPlacedDesc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListOrdersByPlacedDesc: %w", err)
+	}
 	if q.listPendingOutboxStmt, err = db.PrepareContext(ctx, listPendingOutbox); err != nil {
 		return nil, fmt.Errorf("error preparing query ListPendingOutbox: %w", err)
 	}
+	if q.listPendingStockNotificationsStmt, err = db.PrepareContext(ctx, listPendingStockNotifications); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPendingStockNotifications: %w", err)
+	}
+	if q.listPriceHistoryStmt, err = db.PrepareContext(ctx, listPriceHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPriceHistory: %w", err)
+	}
+	if q.listPriceSchedulesStmt, err = db.PrepareContext(ctx, listPriceSchedules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPriceSchedules: %w", err)
+	}
+	if q.listProductAttributeValuesStmt, err = db.PrepareContext(ctx, listProductAttributeValues); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductAttributeValues: %w", err)
+	}
+	if q.listProductImagesStmt, err = db.PrepareContext(ctx, listProductImages); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductImages: %w", err)
+	}
+	if q.listProductOptionsStmt, err = db.PrepareContext(ctx, listProductOptions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductOptions: %w", err)
+	}
+	if q.listProductSummariesByIDsStmt, err = db.PrepareContext(ctx, listProductSummariesByIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductSummariesByIDs: %w", err)
+	}
+	if q.listProductVariantsStmt, err = db.PrepareContext(ctx, listProductVariants); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductVariants: %w", err)
+	}
 	if q.listProductsAdminStmt, err = db.PrepareContext(ctx, listProductsAdmin); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProductsAdmin: %w", err)
 	}
+	if q.listProductsForExportStmt, err = db.PrepareContext(ctx, listProductsForExport); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsForExport: %w", err)
+	}
 	if q.listProductsForInternalStmt, err = db.PrepareContext(ctx, listProductsForInternal); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProductsForInternal: %w", err)
 	}
 	if q.listProductsPublicStmt, err = db.PrepareContext(ctx, listProductsPublic); err != nil {
 		return nil, fmt.Errorf("error preparing query ListProductsPublic: %w", err)
 	}
+	if q.listProductsPublicBestSellingStmt, err = db.PrepareContext(ctx, listProductsPublicBestSelling); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicBestSelling: %w", err)
+	}
+	if q.listProductsPublicByCreatedAscStmt, err = db.PrepareContext(ctx, listProductsPublicByCreatedAsc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicByCreatedAsc: %w", err)
+	}
+	if q.listProductsPublicByCreatedDescStmt, err = db.PrepareContext(ctx, listProductsPublicByCreatedDesc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicByCreatedDesc: %w", err)
+	}
+	if q.listProductsPublicByPriceAscStmt, err = db.PrepareContext(ctx, listProductsPublicByPriceAsc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicByPriceAsc: %w", err)
+	}
+	if q.listProductsPublicByPriceDescStmt, err = db.PrepareContext(ctx, listProductsPublicByPriceDesc); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicByPriceDesc: %w", err)
+	}
+	if q.listProductsPublicTopRatedStmt, err = db.PrepareContext(ctx, listProductsPublicTopRated); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicTopRated: %w", err)
+	}
+	if q.listProductsPublicTrendingStmt, err = db.PrepareContext(ctx, listProductsPublicTrending); err != nil {
+		return nil, fmt.Errorf("error preparing query ListProductsPublicTrending: %w", err)
+	}
 	if q.listRecentOrdersStmt, err = db.PrepareContext(ctx, listRecentOrders); err != nil {
 		return nil, fmt.Errorf("error preparing query ListRecentOrders: %w", err)
 	}
+	if q.listRelatedProductsStmt, err = db.PrepareContext(ctx, listRelatedProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRelatedProducts: %w", err)
+	}
+	if q.listStockMovementsStmt, err = db.PrepareContext(ctx, listStockMovements); err != nil {
+		return nil, fmt.Errorf("error preparing query ListStockMovements: %w", err)
+	}
+	if q.listStockMovementsByReferenceStmt, err = db.PrepareContext(ctx, listStockMovementsByReference); err != nil {
+		return nil, fmt.Errorf("error preparing query ListStockMovementsByReference: %w", err)
+	}
+	if q.listWarehouseStocksStmt, err = db.PrepareContext(ctx, listWarehouseStocks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWarehouseStocks: %w", err)
+	}
+	if q.listWarehouseTransfersStmt, err = db.PrepareContext(ctx, listWarehouseTransfers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWarehouseTransfers: %w", err)
+	}
+	if q.listWarehousesStmt, err = db.PrepareContext(ctx, listWarehouses); err != nil {
+		return nil, fmt.Errorf("error preparing query ListWarehouses: %w", err)
+	}
+	if q.lockCartStmt, err = db.PrepareContext(ctx, lockCart); err != nil {
+		return nil, fmt.Errorf("error preparing query LockCart: %w", err)
+	}
+	if q.lockCategoriesForUpdateStmt, err = db.PrepareContext(ctx, lockCategoriesForUpdate); err != nil {
+		return nil, fmt.Errorf("error preparing query LockCategoriesForUpdate: %w", err)
+	}
+	if q.markCartReminderSentStmt, err = db.PrepareContext(ctx, markCartReminderSent); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkCartReminderSent: %w", err)
+	}
 	if q.markOutboxEventFailedStmt, err = db.PrepareContext(ctx, markOutboxEventFailed); err != nil {
 		return nil, fmt.Errorf("error preparing query MarkOutboxEventFailed: %w", err)
 	}
 	if q.markOutboxEventSentStmt, err = db.PrepareContext(ctx, markOutboxEventSent); err != nil {
 		return nil, fmt.Errorf("error preparing query MarkOutboxEventSent: %w", err)
 	}
+	if q.markStockNotificationSentStmt, err = db.PrepareContext(ctx, markStockNotificationSent); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkStockNotificationSent: %w", err)
+	}
+	if q.productHasVariantsStmt, err = db.PrepareContext(ctx, productHasVariants); err != nil {
+		return nil, fmt.Errorf("error preparing query ProductHasVariants: %w", err)
+	}
+	if q.publishDueProductsStmt, err = db.PrepareContext(ctx, publishDueProducts); err != nil {
+		return nil, fmt.Errorf("error preparing query PublishDueProducts: %w", err)
+	}
+	if q.recordPriceChangeStmt, err = db.PrepareContext(ctx, recordPriceChange); err != nil {
+		return nil, fmt.Errorf("error preparing query RecordPriceChange: %w", err)
+	}
+	if q.refreshProductStatsStmt, err = db.PrepareContext(ctx, refreshProductStats); err != nil {
+		return nil, fmt.Errorf("error preparing query RefreshProductStats: %w", err)
+	}
 	if q.restoreBrandStmt, err = db.PrepareContext(ctx, restoreBrand); err != nil {
 		return nil, fmt.Errorf("error preparing query RestoreBrand: %w", err)
 	}
@@ -273,6 +567,27 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.restoreProductStmt, err = db.PrepareContext(ctx, restoreProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query RestoreProduct: %w", err)
 	}
+	if q.revertScheduledPriceStmt, err = db.PrepareContext(ctx, revertScheduledPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query RevertScheduledPrice: %w", err)
+	}
+	if q.setCartItemSavedForLaterStmt, err = db.PrepareContext(ctx, setCartItemSavedForLater); err != nil {
+		return nil, fmt.Errorf("error preparing query SetCartItemSavedForLater: %w", err)
+	}
+	if q.setDefaultWarehouseStmt, err = db.PrepareContext(ctx, setDefaultWarehouse); err != nil {
+		return nil, fmt.Errorf("error preparing query SetDefaultWarehouse: %w", err)
+	}
+	if q.setPrimaryProductImageStmt, err = db.PrepareContext(ctx, setPrimaryProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query SetPrimaryProductImage: %w", err)
+	}
+	if q.setProductImageURLStmt, err = db.PrepareContext(ctx, setProductImageURL); err != nil {
+		return nil, fmt.Errorf("error preparing query SetProductImageURL: %w", err)
+	}
+	if q.setProductPriceStmt, err = db.PrepareContext(ctx, setProductPrice); err != nil {
+		return nil, fmt.Errorf("error preparing query SetProductPrice: %w", err)
+	}
+	if q.setProductPublicationStmt, err = db.PrepareContext(ctx, setProductPublication); err != nil {
+		return nil, fmt.Errorf("error preparing query SetProductPublication: %w", err)
+	}
 	if q.setUserEmailConfirmedStmt, err = db.PrepareContext(ctx, setUserEmailConfirmed); err != nil {
 		return nil, fmt.Errorf("error preparing query SetUserEmailConfirmed: %w", err)
 	}
@@ -288,6 +603,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.softDeleteProductStmt, err = db.PrepareContext(ctx, softDeleteProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query SoftDeleteProduct: %w", err)
 	}
+	if q.softDeleteProductVariantStmt, err = db.PrepareContext(ctx, softDeleteProductVariant); err != nil {
+		return nil, fmt.Errorf("error preparing query SoftDeleteProductVariant: %w", err)
+	}
+	if q.startPriceScheduleStmt, err = db.PrepareContext(ctx, startPriceSchedule); err != nil {
+		return nil, fmt.Errorf("error preparing query StartPriceSchedule: %w", err)
+	}
+	if q.startProductImportJobStmt, err = db.PrepareContext(ctx, startProductImportJob); err != nil {
+		return nil, fmt.Errorf("error preparing query StartProductImportJob: %w", err)
+	}
+	if q.suggestSearchTermsStmt, err = db.PrepareContext(ctx, suggestSearchTerms); err != nil {
+		return nil, fmt.Errorf("error preparing query SuggestSearchTerms: %w", err)
+	}
+	if q.sumCartProductQtyStmt, err = db.PrepareContext(ctx, sumCartProductQty); err != nil {
+		return nil, fmt.Errorf("error preparing query SumCartProductQty: %w", err)
+	}
+	if q.touchCartStmt, err = db.PrepareContext(ctx, touchCart); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchCart: %w", err)
+	}
 	if q.unsetPrimaryAddressByUserStmt, err = db.PrepareContext(ctx, unsetPrimaryAddressByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query UnsetPrimaryAddressByUser: %w", err)
 	}
@@ -303,6 +636,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateCategoryStmt, err = db.PrepareContext(ctx, updateCategory); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCategory: %w", err)
 	}
+	if q.updateCategoryAttributeStmt, err = db.PrepareContext(ctx, updateCategoryAttribute); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCategoryAttribute: %w", err)
+	}
 	if q.updateCustomerPasswordStmt, err = db.PrepareContext(ctx, updateCustomerPassword); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCustomerPassword: %w", err)
 	}
@@ -324,15 +660,54 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateProductStmt, err = db.PrepareContext(ctx, updateProduct); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateProduct: %w", err)
 	}
+	if q.updateProductImageAltTextStmt, err = db.PrepareContext(ctx, updateProductImageAltText); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductImageAltText: %w", err)
+	}
+	if q.updateProductImageSortOrderStmt, err = db.PrepareContext(ctx, updateProductImageSortOrder); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductImageSortOrder: %w", err)
+	}
+	if q.updateProductImportJobProgressStmt, err = db.PrepareContext(ctx, updateProductImportJobProgress); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductImportJobProgress: %w", err)
+	}
+	if q.updateProductVariantStmt, err = db.PrepareContext(ctx, updateProductVariant); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateProductVariant: %w", err)
+	}
 	if q.updateReviewStmt, err = db.PrepareContext(ctx, updateReview); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateReview: %w", err)
 	}
+	if q.updateWarehouseStmt, err = db.PrepareContext(ctx, updateWarehouse); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateWarehouse: %w", err)
+	}
+	if q.upsertCartItemQtyStmt, err = db.PrepareContext(ctx, upsertCartItemQty); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertCartItemQty: %w", err)
+	}
+	if q.upsertCartReminderPreferenceStmt, err = db.PrepareContext(ctx, upsertCartReminderPreference); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertCartReminderPreference: %w", err)
+	}
 	if q.upsertEmailConfirmationTokenStmt, err = db.PrepareContext(ctx, upsertEmailConfirmationToken); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertEmailConfirmationToken: %w", err)
 	}
 	if q.upsertPasswordResetTokenStmt, err = db.PrepareContext(ctx, upsertPasswordResetToken); err != nil {
 		return nil, fmt.Errorf("error preparing query UpsertPasswordResetToken: %w", err)
 	}
+	if q.upsertPrimaryProductImageStmt, err = db.PrepareContext(ctx, upsertPrimaryProductImage); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertPrimaryProductImage: %w", err)
+	}
+	if q.upsertProductAttributeValueStmt, err = db.PrepareContext(ctx, upsertProductAttributeValue); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductAttributeValue: %w", err)
+	}
+	if q.upsertProductBySKUStmt, err = db.PrepareContext(ctx, upsertProductBySKU); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductBySKU: %w", err)
+	}
+	if q.upsertProductOptionStmt, err = db.PrepareContext(ctx, upsertProductOption); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductOption: %w", err)
+	}
+	if q.upsertProductOptionValueStmt, err = db.PrepareContext(ctx, upsertProductOptionValue); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertProductOptionValue: %w", err)
+	}
+	if q.upsertStockNotificationStmt, err = db.PrepareContext(ctx, upsertStockNotification); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertStockNotification: %w", err)
+	}
 	return &q, nil
 }
 
@@ -343,11 +718,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addCartItemStmt: %w", cerr)
 		}
 	}
+	if q.addProductVariantValueStmt != nil {
+		if cerr := q.addProductVariantValueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addProductVariantValueStmt: %w", cerr)
+		}
+	}
 	if q.addWishlistItemStmt != nil {
 		if cerr := q.addWishlistItemStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addWishlistItemStmt: %w", cerr)
 		}
 	}
+	if q.applyStockMovementStmt != nil {
+		if cerr := q.applyStockMovementStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing applyStockMovementStmt: %w", cerr)
+		}
+	}
+	if q.cancelPendingPriceScheduleStmt != nil {
+		if cerr := q.cancelPendingPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing cancelPendingPriceScheduleStmt: %w", cerr)
+		}
+	}
 	if q.checkPhoneExistsStmt != nil {
 		if cerr := q.checkPhoneExistsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing checkPhoneExistsStmt: %w", cerr)
@@ -368,11 +758,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing checkWishlistItemExistsStmt: %w", cerr)
 		}
 	}
+	if q.clearDefaultWarehouseStmt != nil {
+		if cerr := q.clearDefaultWarehouseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearDefaultWarehouseStmt: %w", cerr)
+		}
+	}
+	if q.clearPrimaryProductImageStmt != nil {
+		if cerr := q.clearPrimaryProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing clearPrimaryProductImageStmt: %w", cerr)
+		}
+	}
 	if q.countCartItemsStmt != nil {
 		if cerr := q.countCartItemsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countCartItemsStmt: %w", cerr)
 		}
 	}
+	if q.countCategoryDependentsStmt != nil {
+		if cerr := q.countCategoryDependentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCategoryDependentsStmt: %w", cerr)
+		}
+	}
+	if q.countOverlappingPriceSchedulesStmt != nil {
+		if cerr := q.countOverlappingPriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countOverlappingPriceSchedulesStmt: %w", cerr)
+		}
+	}
 	if q.countReviewsByProductIDStmt != nil {
 		if cerr := q.countReviewsByProductIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countReviewsByProductIDStmt: %w", cerr)
@@ -403,6 +813,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createCategoryStmt: %w", cerr)
 		}
 	}
+	if q.createCategoryAttributeStmt != nil {
+		if cerr := q.createCategoryAttributeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createCategoryAttributeStmt: %w", cerr)
+		}
+	}
+	if q.createGuestCartStmt != nil {
+		if cerr := q.createGuestCartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createGuestCartStmt: %w", cerr)
+		}
+	}
 	if q.createOrderStmt != nil {
 		if cerr := q.createOrderStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createOrderStmt: %w", cerr)
@@ -418,11 +838,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createOutboxEventStmt: %w", cerr)
 		}
 	}
+	if q.createPriceScheduleStmt != nil {
+		if cerr := q.createPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPriceScheduleStmt: %w", cerr)
+		}
+	}
 	if q.createProductStmt != nil {
 		if cerr := q.createProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createProductStmt: %w", cerr)
 		}
 	}
+	if q.createProductImageStmt != nil {
+		if cerr := q.createProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductImageStmt: %w", cerr)
+		}
+	}
+	if q.createProductImportJobStmt != nil {
+		if cerr := q.createProductImportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductImportJobStmt: %w", cerr)
+		}
+	}
+	if q.createProductVariantStmt != nil {
+		if cerr := q.createProductVariantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProductVariantStmt: %w", cerr)
+		}
+	}
 	if q.createReviewStmt != nil {
 		if cerr := q.createReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createReviewStmt: %w", cerr)
@@ -433,11 +873,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
 		}
 	}
+	if q.createWarehouseStmt != nil {
+		if cerr := q.createWarehouseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWarehouseStmt: %w", cerr)
+		}
+	}
+	if q.createWarehouseTransferStmt != nil {
+		if cerr := q.createWarehouseTransferStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createWarehouseTransferStmt: %w", cerr)
+		}
+	}
 	if q.decrementCartItemQtyStmt != nil {
 		if cerr := q.decrementCartItemQtyStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing decrementCartItemQtyStmt: %w", cerr)
 		}
 	}
+	if q.deleteActiveCartItemsStmt != nil {
+		if cerr := q.deleteActiveCartItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteActiveCartItemsStmt: %w", cerr)
+		}
+	}
 	if q.deleteAllCartItemsStmt != nil {
 		if cerr := q.deleteAllCartItemsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteAllCartItemsStmt: %w", cerr)
@@ -453,6 +908,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteCartItemStmt: %w", cerr)
 		}
 	}
+	if q.deleteCategoryAttributeStmt != nil {
+		if cerr := q.deleteCategoryAttributeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCategoryAttributeStmt: %w", cerr)
+		}
+	}
 	if q.deleteEmailConfirmationTokenByPinStmt != nil {
 		if cerr := q.deleteEmailConfirmationTokenByPinStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteEmailConfirmationTokenByPinStmt: %w", cerr)
@@ -473,16 +933,71 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deletePasswordResetTokenByTokenStmt: %w", cerr)
 		}
 	}
+	if q.deleteProductAttributeValueStmt != nil {
+		if cerr := q.deleteProductAttributeValueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductAttributeValueStmt: %w", cerr)
+		}
+	}
+	if q.deleteProductCoPurchasesStmt != nil {
+		if cerr := q.deleteProductCoPurchasesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductCoPurchasesStmt: %w", cerr)
+		}
+	}
+	if q.deleteProductImageStmt != nil {
+		if cerr := q.deleteProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProductImageStmt: %w", cerr)
+		}
+	}
 	if q.deleteReviewStmt != nil {
 		if cerr := q.deleteReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteReviewStmt: %w", cerr)
 		}
 	}
+	if q.deleteSlugHistoryStmt != nil {
+		if cerr := q.deleteSlugHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteSlugHistoryStmt: %w", cerr)
+		}
+	}
+	if q.deleteStaleGuestCartsStmt != nil {
+		if cerr := q.deleteStaleGuestCartsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteStaleGuestCartsStmt: %w", cerr)
+		}
+	}
+	if q.deleteStockNotificationStmt != nil {
+		if cerr := q.deleteStockNotificationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteStockNotificationStmt: %w", cerr)
+		}
+	}
 	if q.deleteWishlistItemStmt != nil {
 		if cerr := q.deleteWishlistItemStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteWishlistItemStmt: %w", cerr)
 		}
 	}
+	if q.expirePriceSchedulesStmt != nil {
+		if cerr := q.expirePriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expirePriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.failProductImportJobStmt != nil {
+		if cerr := q.failProductImportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing failProductImportJobStmt: %w", cerr)
+		}
+	}
+	if q.failStaleProductImportJobsStmt != nil {
+		if cerr := q.failStaleProductImportJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing failStaleProductImportJobsStmt: %w", cerr)
+		}
+	}
+	if q.finishPriceScheduleStmt != nil {
+		if cerr := q.finishPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishPriceScheduleStmt: %w", cerr)
+		}
+	}
+	if q.finishProductImportJobStmt != nil {
+		if cerr := q.finishProductImportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing finishProductImportJobStmt: %w", cerr)
+		}
+	}
 	if q.getAddressByIDStmt != nil {
 		if cerr := q.getAddressByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAddressByIDStmt: %w", cerr)
@@ -503,6 +1018,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getBrandBySlugStmt: %w", cerr)
 		}
 	}
+	if q.getCartByTokenStmt != nil {
+		if cerr := q.getCartByTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCartByTokenStmt: %w", cerr)
+		}
+	}
 	if q.getCartByUserIDStmt != nil {
 		if cerr := q.getCartByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCartByUserIDStmt: %w", cerr)
@@ -513,11 +1033,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCartDetailStmt: %w", cerr)
 		}
 	}
+	if q.getCartDetailByTokenStmt != nil {
+		if cerr := q.getCartDetailByTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCartDetailByTokenStmt: %w", cerr)
+		}
+	}
 	if q.getCartItemByCartAndProductStmt != nil {
 		if cerr := q.getCartItemByCartAndProductStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCartItemByCartAndProductStmt: %w", cerr)
 		}
 	}
+	if q.getCartValidationStmt != nil {
+		if cerr := q.getCartValidationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCartValidationStmt: %w", cerr)
+		}
+	}
+	if q.getCategoryAttributeByIDStmt != nil {
+		if cerr := q.getCategoryAttributeByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCategoryAttributeByIDStmt: %w", cerr)
+		}
+	}
 	if q.getCategoryByIDStmt != nil {
 		if cerr := q.getCategoryByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCategoryByIDStmt: %w", cerr)
@@ -528,6 +1063,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCategoryBySlugStmt: %w", cerr)
 		}
 	}
+	if q.getCategoryDescendantIDsBySlugsStmt != nil {
+		if cerr := q.getCategoryDescendantIDsBySlugsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCategoryDescendantIDsBySlugsStmt: %w", cerr)
+		}
+	}
 	if q.getCategoryDistributionStmt != nil {
 		if cerr := q.getCategoryDistributionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCategoryDistributionStmt: %w", cerr)
@@ -563,6 +1103,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getLatestPasswordResetTokenByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getNextProductImageSortOrderStmt != nil {
+		if cerr := q.getNextProductImageSortOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNextProductImageSortOrderStmt: %w", cerr)
+		}
+	}
+	if q.getNotificationPreferencesStmt != nil {
+		if cerr := q.getNotificationPreferencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNotificationPreferencesStmt: %w", cerr)
+		}
+	}
 	if q.getOrCreateWishlistStmt != nil {
 		if cerr := q.getOrCreateWishlistStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getOrCreateWishlistStmt: %w", cerr)
@@ -598,6 +1148,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPasswordResetTokenStmt: %w", cerr)
 		}
 	}
+	if q.getPriceScheduleStmt != nil {
+		if cerr := q.getPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPriceScheduleStmt: %w", cerr)
+		}
+	}
 	if q.getProductByIDStmt != nil {
 		if cerr := q.getProductByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getProductByIDStmt: %w", cerr)
@@ -608,6 +1163,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getProductBySlugStmt: %w", cerr)
 		}
 	}
+	if q.getProductFacetsStmt != nil {
+		if cerr := q.getProductFacetsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductFacetsStmt: %w", cerr)
+		}
+	}
+	if q.getProductImageByIDStmt != nil {
+		if cerr := q.getProductImageByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductImageByIDStmt: %w", cerr)
+		}
+	}
+	if q.getProductImportJobStmt != nil {
+		if cerr := q.getProductImportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductImportJobStmt: %w", cerr)
+		}
+	}
+	if q.getProductImportTargetBySKUStmt != nil {
+		if cerr := q.getProductImportTargetBySKUStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductImportTargetBySKUStmt: %w", cerr)
+		}
+	}
+	if q.getProductPurchaseLimitStmt != nil {
+		if cerr := q.getProductPurchaseLimitStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductPurchaseLimitStmt: %w", cerr)
+		}
+	}
+	if q.getProductStockForUpdateStmt != nil {
+		if cerr := q.getProductStockForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductStockForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.getProductVariantByIDStmt != nil {
+		if cerr := q.getProductVariantByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProductVariantByIDStmt: %w", cerr)
+		}
+	}
 	if q.getReviewByIDStmt != nil {
 		if cerr := q.getReviewByIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReviewByIDStmt: %w", cerr)
@@ -618,11 +1208,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getReviewsByProductIDStmt: %w", cerr)
 		}
 	}
+	if q.getReviewsByProductIDCreatedAscStmt != nil {
+		if cerr := q.getReviewsByProductIDCreatedAscStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReviewsByProductIDCreatedAscStmt: %w", cerr)
+		}
+	}
+	if q.getReviewsByProductIDCreatedDescStmt != nil {
+		if cerr := q.getReviewsByProductIDCreatedDescStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReviewsByProductIDCreatedDescStmt: %w", cerr)
+		}
+	}
 	if q.getReviewsByUserIDStmt != nil {
 		if cerr := q.getReviewsByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getReviewsByUserIDStmt: %w", cerr)
 		}
 	}
+	if q.getSlugHistoryOwnerStmt != nil {
+		if cerr := q.getSlugHistoryOwnerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSlugHistoryOwnerStmt: %w", cerr)
+		}
+	}
 	if q.getUserByEmailStmt != nil {
 		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
@@ -638,6 +1243,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserRatingBreakdownStmt: %w", cerr)
 		}
 	}
+	if q.getWarehouseByIDStmt != nil {
+		if cerr := q.getWarehouseByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getWarehouseByIDStmt: %w", cerr)
+		}
+	}
 	if q.getWishlistByUserIDStmt != nil {
 		if cerr := q.getWishlistByUserIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getWishlistByUserIDStmt: %w", cerr)
@@ -658,6 +1268,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing incrementCartItemQtyStmt: %w", cerr)
 		}
 	}
+	if q.insertProductCoPurchasesStmt != nil {
+		if cerr := q.insertProductCoPurchasesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertProductCoPurchasesStmt: %w", cerr)
+		}
+	}
+	if q.insertSlugHistoryStmt != nil {
+		if cerr := q.insertSlugHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertSlugHistoryStmt: %w", cerr)
+		}
+	}
+	if q.isSlugRetiredStmt != nil {
+		if cerr := q.isSlugRetiredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing isSlugRetiredStmt: %w", cerr)
+		}
+	}
+	if q.listAbandonedCartsStmt != nil {
+		if cerr := q.listAbandonedCartsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAbandonedCartsStmt: %w", cerr)
+		}
+	}
+	if q.listActiveAdminsStmt != nil {
+		if cerr := q.listActiveAdminsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listActiveAdminsStmt: %w", cerr)
+		}
+	}
 	if q.listAddressesAdminStmt != nil {
 		if cerr := q.listAddressesAdminStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listAddressesAdminStmt: %w", cerr)
@@ -668,6 +1303,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listAddressesByUserStmt: %w", cerr)
 		}
 	}
+	if q.listBoughtTogetherStmt != nil {
+		if cerr := q.listBoughtTogetherStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listBoughtTogetherStmt: %w", cerr)
+		}
+	}
+	if q.listBrandRefsBySlugsStmt != nil {
+		if cerr := q.listBrandRefsBySlugsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listBrandRefsBySlugsStmt: %w", cerr)
+		}
+	}
 	if q.listBrandsAdminStmt != nil {
 		if cerr := q.listBrandsAdminStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listBrandsAdminStmt: %w", cerr)
@@ -678,6 +1323,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listBrandsPublicStmt: %w", cerr)
 		}
 	}
+	if q.listCartItemsWithStockStmt != nil {
+		if cerr := q.listCartItemsWithStockStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCartItemsWithStockStmt: %w", cerr)
+		}
+	}
+	if q.listCartReminderItemsStmt != nil {
+		if cerr := q.listCartReminderItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCartReminderItemsStmt: %w", cerr)
+		}
+	}
 	if q.listCategoriesAdminStmt != nil {
 		if cerr := q.listCategoriesAdminStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listCategoriesAdminStmt: %w", cerr)
@@ -688,11 +1343,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listCategoriesPublicStmt: %w", cerr)
 		}
 	}
+	if q.listCategoryAncestorsStmt != nil {
+		if cerr := q.listCategoryAncestorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCategoryAncestorsStmt: %w", cerr)
+		}
+	}
+	if q.listCategoryAttributesStmt != nil {
+		if cerr := q.listCategoryAttributesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCategoryAttributesStmt: %w", cerr)
+		}
+	}
+	if q.listCategoryRefsBySlugsStmt != nil {
+		if cerr := q.listCategoryRefsBySlugsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCategoryRefsBySlugsStmt: %w", cerr)
+		}
+	}
+	if q.listCategoryTreeStmt != nil {
+		if cerr := q.listCategoryTreeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listCategoryTreeStmt: %w", cerr)
+		}
+	}
 	if q.listCustomersStmt != nil {
 		if cerr := q.listCustomersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listCustomersStmt: %w", cerr)
 		}
 	}
+	if q.listDuePriceSchedulesStmt != nil {
+		if cerr := q.listDuePriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listDuePriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.listEndingPriceSchedulesStmt != nil {
+		if cerr := q.listEndingPriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listEndingPriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.listExistingProductSKUsStmt != nil {
+		if cerr := q.listExistingProductSKUsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listExistingProductSKUsStmt: %w", cerr)
+		}
+	}
+	if q.listFulfilmentWarehousesStmt != nil {
+		if cerr := q.listFulfilmentWarehousesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listFulfilmentWarehousesStmt: %w", cerr)
+		}
+	}
 	if q.listOrdersStmt != nil {
 		if cerr := q.listOrdersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listOrdersStmt: %w", cerr)
@@ -703,16 +1398,81 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listOrdersAdminStmt: %w", cerr)
 		}
 	}
+	if q.listOrdersAdminByCreatedAscStmt != nil {
+		if cerr := q.listOrdersAdminByCreatedAscStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrdersAdminByCreatedAscStmt: %w", cerr)
+		}
+	}
+	if q.listOrdersAdminByCreatedDescStmt != nil {
+		if cerr := q.listOrdersAdminByCreatedDescStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrdersAdminByCreatedDescStmt: %w", cerr)
+		}
+	}
+	if q.listOrdersByPlacedAscStmt != nil {
+		if cerr := q.listOrdersByPlacedAscStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrdersByPlacedAscStmt: %w", cerr)
+		}
+	}
+	if q.listOrdersByPlacedDescStmt != nil {
+		if cerr := q.listOrdersByPlacedDescStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listOrdersByPlacedDescStmt: %w", cerr)
+		}
+	}
 	if q.listPendingOutboxStmt != nil {
 		if cerr := q.listPendingOutboxStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listPendingOutboxStmt: %w", cerr)
 		}
 	}
+	if q.listPendingStockNotificationsStmt != nil {
+		if cerr := q.listPendingStockNotificationsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPendingStockNotificationsStmt: %w", cerr)
+		}
+	}
+	if q.listPriceHistoryStmt != nil {
+		if cerr := q.listPriceHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPriceHistoryStmt: %w", cerr)
+		}
+	}
+	if q.listPriceSchedulesStmt != nil {
+		if cerr := q.listPriceSchedulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPriceSchedulesStmt: %w", cerr)
+		}
+	}
+	if q.listProductAttributeValuesStmt != nil {
+		if cerr := q.listProductAttributeValuesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductAttributeValuesStmt: %w", cerr)
+		}
+	}
+	if q.listProductImagesStmt != nil {
+		if cerr := q.listProductImagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductImagesStmt: %w", cerr)
+		}
+	}
+	if q.listProductOptionsStmt != nil {
+		if cerr := q.listProductOptionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductOptionsStmt: %w", cerr)
+		}
+	}
+	if q.listProductSummariesByIDsStmt != nil {
+		if cerr := q.listProductSummariesByIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductSummariesByIDsStmt: %w", cerr)
+		}
+	}
+	if q.listProductVariantsStmt != nil {
+		if cerr := q.listProductVariantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductVariantsStmt: %w", cerr)
+		}
+	}
 	if q.listProductsAdminStmt != nil {
 		if cerr := q.listProductsAdminStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listProductsAdminStmt: %w", cerr)
 		}
 	}
+	if q.listProductsForExportStmt != nil {
+		if cerr := q.listProductsForExportStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsForExportStmt: %w", cerr)
+		}
+	}
 	if q.listProductsForInternalStmt != nil {
 		if cerr := q.listProductsForInternalStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listProductsForInternalStmt: %w", cerr)
@@ -723,11 +1483,91 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listProductsPublicStmt: %w", cerr)
 		}
 	}
+	if q.listProductsPublicBestSellingStmt != nil {
+		if cerr := q.listProductsPublicBestSellingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicBestSellingStmt: %w", cerr)
+		}
+	}
+	if q.listProductsPublicByCreatedAscStmt != nil {
+		if cerr := q.listProductsPublicByCreatedAscStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicByCreatedAscStmt: %w", cerr)
+		}
+	}
+	if q.listProductsPublicByCreatedDescStmt != nil {
+		if cerr := q.listProductsPublicByCreatedDescStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicByCreatedDescStmt: %w", cerr)
+		}
+	}
+	if q.listProductsPublicByPriceAscStmt != nil {
+		if cerr := q.listProductsPublicByPriceAscStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicByPriceAscStmt: %w", cerr)
+		}
+	}
+	if q.listProductsPublicByPriceDescStmt != nil {
+		if cerr := q.listProductsPublicByPriceDescStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicByPriceDescStmt: %w", cerr)
+		}
+	}
+	if q.listProductsPublicTopRatedStmt != nil {
+		if cerr := q.listProductsPublicTopRatedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicTopRatedStmt: %w", cerr)
+		}
+	}
+	if q.listProductsPublicTrendingStmt != nil {
+		if cerr := q.listProductsPublicTrendingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listProductsPublicTrendingStmt: %w", cerr)
+		}
+	}
 	if q.listRecentOrdersStmt != nil {
 		if cerr := q.listRecentOrdersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listRecentOrdersStmt: %w", cerr)
 		}
 	}
+	if q.listRelatedProductsStmt != nil {
+		if cerr := q.listRelatedProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRelatedProductsStmt: %w", cerr)
+		}
+	}
+	if q.listStockMovementsStmt != nil {
+		if cerr := q.listStockMovementsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listStockMovementsStmt: %w", cerr)
+		}
+	}
+	if q.listStockMovementsByReferenceStmt != nil {
+		if cerr := q.listStockMovementsByReferenceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listStockMovementsByReferenceStmt: %w", cerr)
+		}
+	}
+	if q.listWarehouseStocksStmt != nil {
+		if cerr := q.listWarehouseStocksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWarehouseStocksStmt: %w", cerr)
+		}
+	}
+	if q.listWarehouseTransfersStmt != nil {
+		if cerr := q.listWarehouseTransfersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWarehouseTransfersStmt: %w", cerr)
+		}
+	}
+	if q.listWarehousesStmt != nil {
+		if cerr := q.listWarehousesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listWarehousesStmt: %w", cerr)
+		}
+	}
+	if q.lockCartStmt != nil {
+		if cerr := q.lockCartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockCartStmt: %w", cerr)
+		}
+	}
+	if q.lockCategoriesForUpdateStmt != nil {
+		if cerr := q.lockCategoriesForUpdateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockCategoriesForUpdateStmt: %w", cerr)
+		}
+	}
+	if q.markCartReminderSentStmt != nil {
+		if cerr := q.markCartReminderSentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markCartReminderSentStmt: %w", cerr)
+		}
+	}
 	if q.markOutboxEventFailedStmt != nil {
 		if cerr := q.markOutboxEventFailedStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing markOutboxEventFailedStmt: %w", cerr)
@@ -738,6 +1578,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing markOutboxEventSentStmt: %w", cerr)
 		}
 	}
+	if q.markStockNotificationSentStmt != nil {
+		if cerr := q.markStockNotificationSentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markStockNotificationSentStmt: %w", cerr)
+		}
+	}
+	if q.productHasVariantsStmt != nil {
+		if cerr := q.productHasVariantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing productHasVariantsStmt: %w", cerr)
+		}
+	}
+	if q.publishDueProductsStmt != nil {
+		if cerr := q.publishDueProductsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing publishDueProductsStmt: %w", cerr)
+		}
+	}
+	if q.recordPriceChangeStmt != nil {
+		if cerr := q.recordPriceChangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recordPriceChangeStmt: %w", cerr)
+		}
+	}
+	if q.refreshProductStatsStmt != nil {
+		if cerr := q.refreshProductStatsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing refreshProductStatsStmt: %w", cerr)
+		}
+	}
 	if q.restoreBrandStmt != nil {
 		if cerr := q.restoreBrandStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing restoreBrandStmt: %w", cerr)
@@ -753,6 +1618,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing restoreProductStmt: %w", cerr)
 		}
 	}
+	if q.revertScheduledPriceStmt != nil {
+		if cerr := q.revertScheduledPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revertScheduledPriceStmt: %w", cerr)
+		}
+	}
+	if q.setCartItemSavedForLaterStmt != nil {
+		if cerr := q.setCartItemSavedForLaterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setCartItemSavedForLaterStmt: %w", cerr)
+		}
+	}
+	if q.setDefaultWarehouseStmt != nil {
+		if cerr := q.setDefaultWarehouseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setDefaultWarehouseStmt: %w", cerr)
+		}
+	}
+	if q.setPrimaryProductImageStmt != nil {
+		if cerr := q.setPrimaryProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setPrimaryProductImageStmt: %w", cerr)
+		}
+	}
+	if q.setProductImageURLStmt != nil {
+		if cerr := q.setProductImageURLStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setProductImageURLStmt: %w", cerr)
+		}
+	}
+	if q.setProductPriceStmt != nil {
+		if cerr := q.setProductPriceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setProductPriceStmt: %w", cerr)
+		}
+	}
+	if q.setProductPublicationStmt != nil {
+		if cerr := q.setProductPublicationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setProductPublicationStmt: %w", cerr)
+		}
+	}
 	if q.setUserEmailConfirmedStmt != nil {
 		if cerr := q.setUserEmailConfirmedStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setUserEmailConfirmedStmt: %w", cerr)
@@ -778,6 +1678,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing softDeleteProductStmt: %w", cerr)
 		}
 	}
+	if q.softDeleteProductVariantStmt != nil {
+		if cerr := q.softDeleteProductVariantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing softDeleteProductVariantStmt: %w", cerr)
+		}
+	}
+	if q.startPriceScheduleStmt != nil {
+		if cerr := q.startPriceScheduleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing startPriceScheduleStmt: %w", cerr)
+		}
+	}
+	if q.startProductImportJobStmt != nil {
+		if cerr := q.startProductImportJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing startProductImportJobStmt: %w", cerr)
+		}
+	}
+	if q.suggestSearchTermsStmt != nil {
+		if cerr := q.suggestSearchTermsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing suggestSearchTermsStmt: %w", cerr)
+		}
+	}
+	if q.sumCartProductQtyStmt != nil {
+		if cerr := q.sumCartProductQtyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sumCartProductQtyStmt: %w", cerr)
+		}
+	}
+	if q.touchCartStmt != nil {
+		if cerr := q.touchCartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchCartStmt: %w", cerr)
+		}
+	}
 	if q.unsetPrimaryAddressByUserStmt != nil {
 		if cerr := q.unsetPrimaryAddressByUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing unsetPrimaryAddressByUserStmt: %w", cerr)
@@ -803,6 +1733,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateCategoryStmt: %w", cerr)
 		}
 	}
+	if q.updateCategoryAttributeStmt != nil {
+		if cerr := q.updateCategoryAttributeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCategoryAttributeStmt: %w", cerr)
+		}
+	}
 	if q.updateCustomerPasswordStmt != nil {
 		if cerr := q.updateCustomerPasswordStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateCustomerPasswordStmt: %w", cerr)
@@ -838,11 +1773,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateProductStmt: %w", cerr)
 		}
 	}
+	if q.updateProductImageAltTextStmt != nil {
+		if cerr := q.updateProductImageAltTextStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductImageAltTextStmt: %w", cerr)
+		}
+	}
+	if q.updateProductImageSortOrderStmt != nil {
+		if cerr := q.updateProductImageSortOrderStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductImageSortOrderStmt: %w", cerr)
+		}
+	}
+	if q.updateProductImportJobProgressStmt != nil {
+		if cerr := q.updateProductImportJobProgressStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductImportJobProgressStmt: %w", cerr)
+		}
+	}
+	if q.updateProductVariantStmt != nil {
+		if cerr := q.updateProductVariantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateProductVariantStmt: %w", cerr)
+		}
+	}
 	if q.updateReviewStmt != nil {
 		if cerr := q.updateReviewStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateReviewStmt: %w", cerr)
 		}
 	}
+	if q.updateWarehouseStmt != nil {
+		if cerr := q.updateWarehouseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateWarehouseStmt: %w", cerr)
+		}
+	}
+	if q.upsertCartItemQtyStmt != nil {
+		if cerr := q.upsertCartItemQtyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertCartItemQtyStmt: %w", cerr)
+		}
+	}
+	if q.upsertCartReminderPreferenceStmt != nil {
+		if cerr := q.upsertCartReminderPreferenceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertCartReminderPreferenceStmt: %w", cerr)
+		}
+	}
 	if q.upsertEmailConfirmationTokenStmt != nil {
 		if cerr := q.upsertEmailConfirmationTokenStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing upsertEmailConfirmationTokenStmt: %w", cerr)
@@ -853,6 +1823,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing upsertPasswordResetTokenStmt: %w", cerr)
 		}
 	}
+	if q.upsertPrimaryProductImageStmt != nil {
+		if cerr := q.upsertPrimaryProductImageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertPrimaryProductImageStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductAttributeValueStmt != nil {
+		if cerr := q.upsertProductAttributeValueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductAttributeValueStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductBySKUStmt != nil {
+		if cerr := q.upsertProductBySKUStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductBySKUStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductOptionStmt != nil {
+		if cerr := q.upsertProductOptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductOptionStmt: %w", cerr)
+		}
+	}
+	if q.upsertProductOptionValueStmt != nil {
+		if cerr := q.upsertProductOptionValueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertProductOptionValueStmt: %w", cerr)
+		}
+	}
+	if q.upsertStockNotificationStmt != nil {
+		if cerr := q.upsertStockNotificationStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertStockNotificationStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -893,43 +1893,76 @@ type Queries struct {
 	db                                          DBTX
 	tx                                          *sql.Tx
 	addCartItemStmt                             *sql.Stmt
+	addProductVariantValueStmt                  *sql.Stmt
 	addWishlistItemStmt                         *sql.Stmt
+	applyStockMovementStmt                      *sql.Stmt
+	cancelPendingPriceScheduleStmt              *sql.Stmt
 	checkPhoneExistsStmt                        *sql.Stmt
 	checkReviewExistsStmt                       *sql.Stmt
 	checkUserPurchasedProductStmt               *sql.Stmt
 	checkWishlistItemExistsStmt                 *sql.Stmt
+	clearDefaultWarehouseStmt                   *sql.Stmt
+	clearPrimaryProductImageStmt                *sql.Stmt
 	countCartItemsStmt                          *sql.Stmt
+	countCategoryDependentsStmt                 *sql.Stmt
+	countOverlappingPriceSchedulesStmt          *sql.Stmt
 	countReviewsByProductIDStmt                 *sql.Stmt
 	countReviewsByUserIDStmt                    *sql.Stmt
 	createAddressStmt                           *sql.Stmt
 	createBrandStmt                             *sql.Stmt
 	createCartStmt                              *sql.Stmt
 	createCategoryStmt                          *sql.Stmt
+	createCategoryAttributeStmt                 *sql.Stmt
+	createGuestCartStmt                         *sql.Stmt
 	createOrderStmt                             *sql.Stmt
 	createOrderItemStmt                         *sql.Stmt
 	createOutboxEventStmt                       *sql.Stmt
+	createPriceScheduleStmt                     *sql.Stmt
 	createProductStmt                           *sql.Stmt
+	createProductImageStmt                      *sql.Stmt
+	createProductImportJobStmt                  *sql.Stmt
+	createProductVariantStmt                    *sql.Stmt
 	createReviewStmt                            *sql.Stmt
 	createUserStmt                              *sql.Stmt
+	createWarehouseStmt                         *sql.Stmt
+	createWarehouseTransferStmt                 *sql.Stmt
 	decrementCartItemQtyStmt                    *sql.Stmt
+	deleteActiveCartItemsStmt                   *sql.Stmt
 	deleteAllCartItemsStmt                      *sql.Stmt
 	deleteCartStmt                              *sql.Stmt
 	deleteCartItemStmt                          *sql.Stmt
+	deleteCategoryAttributeStmt                 *sql.Stmt
 	deleteEmailConfirmationTokenByPinStmt       *sql.Stmt
 	deleteEmailConfirmationTokenByTokenStmt     *sql.Stmt
 	deleteEmailConfirmationTokensByUserIDStmt   *sql.Stmt
 	deletePasswordResetTokenByTokenStmt         *sql.Stmt
+	deleteProductAttributeValueStmt             *sql.Stmt
+	deleteProductCoPurchasesStmt                *sql.Stmt
+	deleteProductImageStmt                      *sql.Stmt
 	deleteReviewStmt                            *sql.Stmt
+	deleteSlugHistoryStmt                       *sql.Stmt
+	deleteStaleGuestCartsStmt                   *sql.Stmt
+	deleteStockNotificationStmt                 *sql.Stmt
 	deleteWishlistItemStmt                      *sql.Stmt
+	expirePriceSchedulesStmt                    *sql.Stmt
+	failProductImportJobStmt                    *sql.Stmt
+	failStaleProductImportJobsStmt              *sql.Stmt
+	finishPriceScheduleStmt                     *sql.Stmt
+	finishProductImportJobStmt                  *sql.Stmt
 	getAddressByIDStmt                          *sql.Stmt
 	getAverageRatingByProductIDStmt             *sql.Stmt
 	getBrandByIDStmt                            *sql.Stmt
 	getBrandBySlugStmt                          *sql.Stmt
+	getCartByTokenStmt                          *sql.Stmt
 	getCartByUserIDStmt                         *sql.Stmt
 	getCartDetailStmt                           *sql.Stmt
+	getCartDetailByTokenStmt                    *sql.Stmt
 	getCartItemByCartAndProductStmt             *sql.Stmt
+	getCartValidationStmt                       *sql.Stmt
+	getCategoryAttributeByIDStmt                *sql.Stmt
 	getCategoryByIDStmt                         *sql.Stmt
 	getCategoryBySlugStmt                       *sql.Stmt
+	getCategoryDescendantIDsBySlugsStmt         *sql.Stmt
 	getCategoryDistributionStmt                 *sql.Stmt
 	getCompletedOrderForReviewStmt              *sql.Stmt
 	getDashboardStatsStmt                       *sql.Stmt
@@ -937,6 +1970,8 @@ type Queries struct {
 	getIDsBySlugsStmt                           *sql.Stmt
 	getLatestEmailConfirmationTokenByUserIDStmt *sql.Stmt
 	getLatestPasswordResetTokenByUserIDStmt     *sql.Stmt
+	getNextProductImageSortOrderStmt            *sql.Stmt
+	getNotificationPreferencesStmt              *sql.Stmt
 	getOrCreateWishlistStmt                     *sql.Stmt
 	getOrderByIDStmt                            *sql.Stmt
 	getOrderItemsStmt                           *sql.Stmt
@@ -944,47 +1979,124 @@ type Queries struct {
 	getOrderPaymentForUpdateByOrderNumberStmt   *sql.Stmt
 	getOrderSummaryByOrderNumberStmt            *sql.Stmt
 	getPasswordResetTokenStmt                   *sql.Stmt
+	getPriceScheduleStmt                        *sql.Stmt
 	getProductByIDStmt                          *sql.Stmt
 	getProductBySlugStmt                        *sql.Stmt
+	getProductFacetsStmt                        *sql.Stmt
+	getProductImageByIDStmt                     *sql.Stmt
+	getProductImportJobStmt                     *sql.Stmt
+	getProductImportTargetBySKUStmt             *sql.Stmt
+	getProductPurchaseLimitStmt                 *sql.Stmt
+	getProductStockForUpdateStmt                *sql.Stmt
+	getProductVariantByIDStmt                   *sql.Stmt
 	getReviewByIDStmt                           *sql.Stmt
 	getReviewsByProductIDStmt                   *sql.Stmt
+	getReviewsByProductIDCreatedAscStmt         *sql.Stmt
+	getReviewsByProductIDCreatedDescStmt        *sql.Stmt
 	getReviewsByUserIDStmt                      *sql.Stmt
+	getSlugHistoryOwnerStmt                     *sql.Stmt
 	getUserByEmailStmt                          *sql.Stmt
 	getUserByIDStmt                             *sql.Stmt
 	getUserRatingBreakdownStmt                  *sql.Stmt
+	getWarehouseByIDStmt                        *sql.Stmt
 	getWishlistByUserIDStmt                     *sql.Stmt
 	getWishlistItemsStmt                        *sql.Stmt
 	getWishlistWithItemsStmt                    *sql.Stmt
 	incrementCartItemQtyStmt                    *sql.Stmt
+	insertProductCoPurchasesStmt                *sql.Stmt
+	insertSlugHistoryStmt                       *sql.Stmt
+	isSlugRetiredStmt                           *sql.Stmt
+	listAbandonedCartsStmt                      *sql.Stmt
+	listActiveAdminsStmt                        *sql.Stmt
 	listAddressesAdminStmt                      *sql.Stmt
 	listAddressesByUserStmt                     *sql.Stmt
+	listBoughtTogetherStmt                      *sql.Stmt
+	listBrandRefsBySlugsStmt                    *sql.Stmt
 	listBrandsAdminStmt                         *sql.Stmt
 	listBrandsPublicStmt                        *sql.Stmt
+	listCartItemsWithStockStmt                  *sql.Stmt
+	listCartReminderItemsStmt                   *sql.Stmt
 	listCategoriesAdminStmt                     *sql.Stmt
 	listCategoriesPublicStmt                    *sql.Stmt
+	listCategoryAncestorsStmt                   *sql.Stmt
+	listCategoryAttributesStmt                  *sql.Stmt
+	listCategoryRefsBySlugsStmt                 *sql.Stmt
+	listCategoryTreeStmt                        *sql.Stmt
 	listCustomersStmt                           *sql.Stmt
+	listDuePriceSchedulesStmt                   *sql.Stmt
+	listEndingPriceSchedulesStmt                *sql.Stmt
+	listExistingProductSKUsStmt                 *sql.Stmt
+	listFulfilmentWarehousesStmt                *sql.Stmt
 	listOrdersStmt                              *sql.Stmt
 	listOrdersAdminStmt                         *sql.Stmt
+	listOrdersAdminByCreatedAscStmt             *sql.Stmt
+	listOrdersAdminByCreatedDescStmt            *sql.Stmt
+	listOrdersByPlacedAscStmt                   *sql.Stmt
+	listOrdersByPlacedDescStmt                  *sql.Stmt
 	listPendingOutboxStmt                       *sql.Stmt
+	listPendingStockNotificationsStmt           *sql.Stmt
+	listPriceHistoryStmt                        *sql.Stmt
+	listPriceSchedulesStmt                      *sql.Stmt
+	listProductAttributeValuesStmt              *sql.Stmt
+	listProductImagesStmt                       *sql.Stmt
+	listProductOptionsStmt                      *sql.Stmt
+	listProductSummariesByIDsStmt               *sql.Stmt
+	listProductVariantsStmt                     *sql.Stmt
 	listProductsAdminStmt                       *sql.Stmt
+	listProductsForExportStmt                   *sql.Stmt
 	listProductsForInternalStmt                 *sql.Stmt
 	listProductsPublicStmt                      *sql.Stmt
+	listProductsPublicBestSellingStmt           *sql.Stmt
+	listProductsPublicByCreatedAscStmt          *sql.Stmt
+	listProductsPublicByCreatedDescStmt         *sql.Stmt
+	listProductsPublicByPriceAscStmt            *sql.Stmt
+	listProductsPublicByPriceDescStmt           *sql.Stmt
+	listProductsPublicTopRatedStmt              *sql.Stmt
+	listProductsPublicTrendingStmt              *sql.Stmt
 	listRecentOrdersStmt                        *sql.Stmt
+	listRelatedProductsStmt                     *sql.Stmt
+	listStockMovementsStmt                      *sql.Stmt
+	listStockMovementsByReferenceStmt           *sql.Stmt
+	listWarehouseStocksStmt                     *sql.Stmt
+	listWarehouseTransfersStmt                  *sql.Stmt
+	listWarehousesStmt                          *sql.Stmt
+	lockCartStmt                                *sql.Stmt
+	lockCategoriesForUpdateStmt                 *sql.Stmt
+	markCartReminderSentStmt                    *sql.Stmt
 	markOutboxEventFailedStmt                   *sql.Stmt
 	markOutboxEventSentStmt                     *sql.Stmt
+	markStockNotificationSentStmt               *sql.Stmt
+	productHasVariantsStmt                      *sql.Stmt
+	publishDueProductsStmt                      *sql.Stmt
+	recordPriceChangeStmt                       *sql.Stmt
+	refreshProductStatsStmt                     *sql.Stmt
 	restoreBrandStmt                            *sql.Stmt
 	restoreCategoryStmt                         *sql.Stmt
 	restoreProductStmt                          *sql.Stmt
+	revertScheduledPriceStmt                    *sql.Stmt
+	setCartItemSavedForLaterStmt                *sql.Stmt
+	setDefaultWarehouseStmt                     *sql.Stmt
+	setPrimaryProductImageStmt                  *sql.Stmt
+	setProductImageURLStmt                      *sql.Stmt
+	setProductPriceStmt                         *sql.Stmt
+	setProductPublicationStmt                   *sql.Stmt
 	setUserEmailConfirmedStmt                   *sql.Stmt
 	softDeleteAddressStmt                       *sql.Stmt
 	softDeleteBrandStmt                         *sql.Stmt
 	softDeleteCategoryStmt                      *sql.Stmt
 	softDeleteProductStmt                       *sql.Stmt
+	softDeleteProductVariantStmt                *sql.Stmt
+	startPriceScheduleStmt                      *sql.Stmt
+	startProductImportJobStmt                   *sql.Stmt
+	suggestSearchTermsStmt                      *sql.Stmt
+	sumCartProductQtyStmt                       *sql.Stmt
+	touchCartStmt                               *sql.Stmt
 	unsetPrimaryAddressByUserStmt               *sql.Stmt
 	updateAddressStmt                           *sql.Stmt
 	updateBrandStmt                             *sql.Stmt
 	updateCartItemQtyStmt                       *sql.Stmt
 	updateCategoryStmt                          *sql.Stmt
+	updateCategoryAttributeStmt                 *sql.Stmt
 	updateCustomerPasswordStmt                  *sql.Stmt
 	updateCustomerProfileStmt                   *sql.Stmt
 	updateCustomerStatusStmt                    *sql.Stmt
@@ -992,9 +2104,22 @@ type Queries struct {
 	updateOrderSnapTokenStmt                    *sql.Stmt
 	updateOrderStatusStmt                       *sql.Stmt
 	updateProductStmt                           *sql.Stmt
+	updateProductImageAltTextStmt               *sql.Stmt
+	updateProductImageSortOrderStmt             *sql.Stmt
+	updateProductImportJobProgressStmt          *sql.Stmt
+	updateProductVariantStmt                    *sql.Stmt
 	updateReviewStmt                            *sql.Stmt
+	updateWarehouseStmt                         *sql.Stmt
+	upsertCartItemQtyStmt                       *sql.Stmt
+	upsertCartReminderPreferenceStmt            *sql.Stmt
 	upsertEmailConfirmationTokenStmt            *sql.Stmt
 	upsertPasswordResetTokenStmt                *sql.Stmt
+	upsertPrimaryProductImageStmt               *sql.Stmt
+	upsertProductAttributeValueStmt             *sql.Stmt
+	upsertProductBySKUStmt                      *sql.Stmt
+	upsertProductOptionStmt                     *sql.Stmt
+	upsertProductOptionValueStmt                *sql.Stmt
+	upsertStockNotificationStmt                 *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
@@ -1002,43 +2127,76 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		db:                                          tx,
 		tx:                                          tx,
 		addCartItemStmt:                             q.addCartItemStmt,
+		addProductVariantValueStmt:                  q.addProductVariantValueStmt,
 		addWishlistItemStmt:                         q.addWishlistItemStmt,
+		applyStockMovementStmt:                      q.applyStockMovementStmt,
+		cancelPendingPriceScheduleStmt:              q.cancelPendingPriceScheduleStmt,
 		checkPhoneExistsStmt:                        q.checkPhoneExistsStmt,
 		checkReviewExistsStmt:                       q.checkReviewExistsStmt,
 		checkUserPurchasedProductStmt:               q.checkUserPurchasedProductStmt,
 		checkWishlistItemExistsStmt:                 q.checkWishlistItemExistsStmt,
+		clearDefaultWarehouseStmt:                   q.clearDefaultWarehouseStmt,
+		clearPrimaryProductImageStmt:                q.clearPrimaryProductImageStmt,
 		countCartItemsStmt:                          q.countCartItemsStmt,
+		countCategoryDependentsStmt:                 q.countCategoryDependentsStmt,
+		countOverlappingPriceSchedulesStmt:          q.countOverlappingPriceSchedulesStmt,
 		countReviewsByProductIDStmt:                 q.countReviewsByProductIDStmt,
 		countReviewsByUserIDStmt:                    q.countReviewsByUserIDStmt,
 		createAddressStmt:                           q.createAddressStmt,
 		createBrandStmt:                             q.createBrandStmt,
 		createCartStmt:                              q.createCartStmt,
 		createCategoryStmt:                          q.createCategoryStmt,
+		createCategoryAttributeStmt:                 q.createCategoryAttributeStmt,
+		createGuestCartStmt:                         q.createGuestCartStmt,
 		createOrderStmt:                             q.createOrderStmt,
 		createOrderItemStmt:                         q.createOrderItemStmt,
 		createOutboxEventStmt:                       q.createOutboxEventStmt,
+		createPriceScheduleStmt:                     q.createPriceScheduleStmt,
 		createProductStmt:                           q.createProductStmt,
+		createProductImageStmt:                      q.createProductImageStmt,
+		createProductImportJobStmt:                  q.createProductImportJobStmt,
+		createProductVariantStmt:                    q.createProductVariantStmt,
 		createReviewStmt:                            q.createReviewStmt,
 		createUserStmt:                              q.createUserStmt,
+		createWarehouseStmt:                         q.createWarehouseStmt,
+		createWarehouseTransferStmt:                 q.createWarehouseTransferStmt,
 		decrementCartItemQtyStmt:                    q.decrementCartItemQtyStmt,
+		deleteActiveCartItemsStmt:                   q.deleteActiveCartItemsStmt,
 		deleteAllCartItemsStmt:                      q.deleteAllCartItemsStmt,
 		deleteCartStmt:                              q.deleteCartStmt,
 		deleteCartItemStmt:                          q.deleteCartItemStmt,
+		deleteCategoryAttributeStmt:                 q.deleteCategoryAttributeStmt,
 		deleteEmailConfirmationTokenByPinStmt:       q.deleteEmailConfirmationTokenByPinStmt,
 		deleteEmailConfirmationTokenByTokenStmt:     q.deleteEmailConfirmationTokenByTokenStmt,
 		deleteEmailConfirmationTokensByUserIDStmt:   q.deleteEmailConfirmationTokensByUserIDStmt,
 		deletePasswordResetTokenByTokenStmt:         q.deletePasswordResetTokenByTokenStmt,
+		deleteProductAttributeValueStmt:             q.deleteProductAttributeValueStmt,
+		deleteProductCoPurchasesStmt:                q.deleteProductCoPurchasesStmt,
+		deleteProductImageStmt:                      q.deleteProductImageStmt,
 		deleteReviewStmt:                            q.deleteReviewStmt,
+		deleteSlugHistoryStmt:                       q.deleteSlugHistoryStmt,
+		deleteStaleGuestCartsStmt:                   q.deleteStaleGuestCartsStmt,
+		deleteStockNotificationStmt:                 q.deleteStockNotificationStmt,
 		deleteWishlistItemStmt:                      q.deleteWishlistItemStmt,
+		expirePriceSchedulesStmt:                    q.expirePriceSchedulesStmt,
+		failProductImportJobStmt:                    q.failProductImportJobStmt,
+		failStaleProductImportJobsStmt:              q.failStaleProductImportJobsStmt,
+		finishPriceScheduleStmt:                     q.finishPriceScheduleStmt,
+		finishProductImportJobStmt:                  q.finishProductImportJobStmt,
 		getAddressByIDStmt:                          q.getAddressByIDStmt,
 		getAverageRatingByProductIDStmt:             q.getAverageRatingByProductIDStmt,
 		getBrandByIDStmt:                            q.getBrandByIDStmt,
 		getBrandBySlugStmt:                          q.getBrandBySlugStmt,
+		getCartByTokenStmt:                          q.getCartByTokenStmt,
 		getCartByUserIDStmt:                         q.getCartByUserIDStmt,
 		getCartDetailStmt:                           q.getCartDetailStmt,
+		getCartDetailByTokenStmt:                    q.getCartDetailByTokenStmt,
 		getCartItemByCartAndProductStmt:             q.getCartItemByCartAndProductStmt,
+		getCartValidationStmt:                       q.getCartValidationStmt,
+		getCategoryAttributeByIDStmt:                q.getCategoryAttributeByIDStmt,
 		getCategoryByIDStmt:                         q.getCategoryByIDStmt,
 		getCategoryBySlugStmt:                       q.getCategoryBySlugStmt,
+		getCategoryDescendantIDsBySlugsStmt:         q.getCategoryDescendantIDsBySlugsStmt,
 		getCategoryDistributionStmt:                 q.getCategoryDistributionStmt,
 		getCompletedOrderForReviewStmt:              q.getCompletedOrderForReviewStmt,
 		getDashboardStatsStmt:                       q.getDashboardStatsStmt,
@@ -1046,6 +2204,8 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getIDsBySlugsStmt:                           q.getIDsBySlugsStmt,
 		getLatestEmailConfirmationTokenByUserIDStmt: q.getLatestEmailConfirmationTokenByUserIDStmt,
 		getLatestPasswordResetTokenByUserIDStmt:     q.getLatestPasswordResetTokenByUserIDStmt,
+		getNextProductImageSortOrderStmt:            q.getNextProductImageSortOrderStmt,
+		getNotificationPreferencesStmt:              q.getNotificationPreferencesStmt,
 		getOrCreateWishlistStmt:                     q.getOrCreateWishlistStmt,
 		getOrderByIDStmt:                            q.getOrderByIDStmt,
 		getOrderItemsStmt:                           q.getOrderItemsStmt,
@@ -1053,47 +2213,124 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		getOrderPaymentForUpdateByOrderNumberStmt:   q.getOrderPaymentForUpdateByOrderNumberStmt,
 		getOrderSummaryByOrderNumberStmt:            q.getOrderSummaryByOrderNumberStmt,
 		getPasswordResetTokenStmt:                   q.getPasswordResetTokenStmt,
+		getPriceScheduleStmt:                        q.getPriceScheduleStmt,
 		getProductByIDStmt:                          q.getProductByIDStmt,
 		getProductBySlugStmt:                        q.getProductBySlugStmt,
+		getProductFacetsStmt:                        q.getProductFacetsStmt,
+		getProductImageByIDStmt:                     q.getProductImageByIDStmt,
+		getProductImportJobStmt:                     q.getProductImportJobStmt,
+		getProductImportTargetBySKUStmt:             q.getProductImportTargetBySKUStmt,
+		getProductPurchaseLimitStmt:                 q.getProductPurchaseLimitStmt,
+		getProductStockForUpdateStmt:                q.getProductStockForUpdateStmt,
+		getProductVariantByIDStmt:                   q.getProductVariantByIDStmt,
 		getReviewByIDStmt:                           q.getReviewByIDStmt,
 		getReviewsByProductIDStmt:                   q.getReviewsByProductIDStmt,
+		getReviewsByProductIDCreatedAscStmt:         q.getReviewsByProductIDCreatedAscStmt,
+		getReviewsByProductIDCreatedDescStmt:        q.getReviewsByProductIDCreatedDescStmt,
 		getReviewsByUserIDStmt:                      q.getReviewsByUserIDStmt,
+		getSlugHistoryOwnerStmt:                     q.getSlugHistoryOwnerStmt,
 		getUserByEmailStmt:                          q.getUserByEmailStmt,
 		getUserByIDStmt:                             q.getUserByIDStmt,
 		getUserRatingBreakdownStmt:                  q.getUserRatingBreakdownStmt,
+		getWarehouseByIDStmt:                        q.getWarehouseByIDStmt,
 		getWishlistByUserIDStmt:                     q.getWishlistByUserIDStmt,
 		getWishlistItemsStmt:                        q.getWishlistItemsStmt,
 		getWishlistWithItemsStmt:                    q.getWishlistWithItemsStmt,
 		incrementCartItemQtyStmt:                    q.incrementCartItemQtyStmt,
+		insertProductCoPurchasesStmt:                q.insertProductCoPurchasesStmt,
+		insertSlugHistoryStmt:                       q.insertSlugHistoryStmt,
+		isSlugRetiredStmt:                           q.isSlugRetiredStmt,
+		listAbandonedCartsStmt:                      q.listAbandonedCartsStmt,
+		listActiveAdminsStmt:                        q.listActiveAdminsStmt,
 		listAddressesAdminStmt:                      q.listAddressesAdminStmt,
 		listAddressesByUserStmt:                     q.listAddressesByUserStmt,
+		listBoughtTogetherStmt:                      q.listBoughtTogetherStmt,
+		listBrandRefsBySlugsStmt:                    q.listBrandRefsBySlugsStmt,
 		listBrandsAdminStmt:                         q.listBrandsAdminStmt,
 		listBrandsPublicStmt:                        q.listBrandsPublicStmt,
+		listCartItemsWithStockStmt:                  q.listCartItemsWithStockStmt,
+		listCartReminderItemsStmt:                   q.listCartReminderItemsStmt,
 		listCategoriesAdminStmt:                     q.listCategoriesAdminStmt,
 		listCategoriesPublicStmt:                    q.listCategoriesPublicStmt,
+		listCategoryAncestorsStmt:                   q.listCategoryAncestorsStmt,
+		listCategoryAttributesStmt:                  q.listCategoryAttributesStmt,
+		listCategoryRefsBySlugsStmt:                 q.listCategoryRefsBySlugsStmt,
+		listCategoryTreeStmt:                        q.listCategoryTreeStmt,
 		listCustomersStmt:                           q.listCustomersStmt,
+		listDuePriceSchedulesStmt:                   q.listDuePriceSchedulesStmt,
+		listEndingPriceSchedulesStmt:                q.listEndingPriceSchedulesStmt,
+		listExistingProductSKUsStmt:                 q.listExistingProductSKUsStmt,
+		listFulfilmentWarehousesStmt:                q.listFulfilmentWarehousesStmt,
 		listOrdersStmt:                              q.listOrdersStmt,
 		listOrdersAdminStmt:                         q.listOrdersAdminStmt,
+		listOrdersAdminByCreatedAscStmt:             q.listOrdersAdminByCreatedAscStmt,
+		listOrdersAdminByCreatedDescStmt:            q.listOrdersAdminByCreatedDescStmt,
+		listOrdersByPlacedAscStmt:                   q.listOrdersByPlacedAscStmt,
+		listOrdersByPlacedDescStmt:                  q.listOrdersByPlacedDescStmt,
 		listPendingOutboxStmt:                       q.listPendingOutboxStmt,
+		listPendingStockNotificationsStmt:           q.listPendingStockNotificationsStmt,
+		listPriceHistoryStmt:                        q.listPriceHistoryStmt,
+		listPriceSchedulesStmt:                      q.listPriceSchedulesStmt,
+		listProductAttributeValuesStmt:              q.listProductAttributeValuesStmt,
+		listProductImagesStmt:                       q.listProductImagesStmt,
+		listProductOptionsStmt:                      q.listProductOptionsStmt,
+		listProductSummariesByIDsStmt:               q.listProductSummariesByIDsStmt,
+		listProductVariantsStmt:                     q.listProductVariantsStmt,
 		listProductsAdminStmt:                       q.listProductsAdminStmt,
+		listProductsForExportStmt:                   q.listProductsForExportStmt,
 		listProductsForInternalStmt:                 q.listProductsForInternalStmt,
 		listProductsPublicStmt:                      q.listProductsPublicStmt,
+		listProductsPublicBestSellingStmt:           q.listProductsPublicBestSellingStmt,
+		listProductsPublicByCreatedAscStmt:          q.listProductsPublicByCreatedAscStmt,
+		listProductsPublicByCreatedDescStmt:         q.listProductsPublicByCreatedDescStmt,
+		listProductsPublicByPriceAscStmt:            q.listProductsPublicByPriceAscStmt,
+		listProductsPublicByPriceDescStmt:           q.listProductsPublicByPriceDescStmt,
+		listProductsPublicTopRatedStmt:              q.listProductsPublicTopRatedStmt,
+		listProductsPublicTrendingStmt:              q.listProductsPublicTrendingStmt,
 		listRecentOrdersStmt:                        q.listRecentOrdersStmt,
+		listRelatedProductsStmt:                     q.listRelatedProductsStmt,
+		listStockMovementsStmt:                      q.listStockMovementsStmt,
+		listStockMovementsByReferenceStmt:           q.listStockMovementsByReferenceStmt,
+		listWarehouseStocksStmt:                     q.listWarehouseStocksStmt,
+		listWarehouseTransfersStmt:                  q.listWarehouseTransfersStmt,
+		listWarehousesStmt:                          q.listWarehousesStmt,
+		lockCartStmt:                                q.lockCartStmt,
+		lockCategoriesForUpdateStmt:                 q.lockCategoriesForUpdateStmt,
+		markCartReminderSentStmt:                    q.markCartReminderSentStmt,
 		markOutboxEventFailedStmt:                   q.markOutboxEventFailedStmt,
 		markOutboxEventSentStmt:                     q.markOutboxEventSentStmt,
+		markStockNotificationSentStmt:               q.markStockNotificationSentStmt,
+		productHasVariantsStmt:                      q.productHasVariantsStmt,
+		publishDueProductsStmt:                      q.publishDueProductsStmt,
+		recordPriceChangeStmt:                       q.recordPriceChangeStmt,
+		refreshProductStatsStmt:                     q.refreshProductStatsStmt,
 		restoreBrandStmt:                            q.restoreBrandStmt,
 		restoreCategoryStmt:                         q.restoreCategoryStmt,
 		restoreProductStmt:                          q.restoreProductStmt,
+		revertScheduledPriceStmt:                    q.revertScheduledPriceStmt,
+		setCartItemSavedForLaterStmt:                q.setCartItemSavedForLaterStmt,
+		setDefaultWarehouseStmt:                     q.setDefaultWarehouseStmt,
+		setPrimaryProductImageStmt:                  q.setPrimaryProductImageStmt,
+		setProductImageURLStmt:                      q.setProductImageURLStmt,
+		setProductPriceStmt:                         q.setProductPriceStmt,
+		setProductPublicationStmt:                   q.setProductPublicationStmt,
 		setUserEmailConfirmedStmt:                   q.setUserEmailConfirmedStmt,
 		softDeleteAddressStmt:                       q.softDeleteAddressStmt,
 		softDeleteBrandStmt:                         q.softDeleteBrandStmt,
 		softDeleteCategoryStmt:                      q.softDeleteCategoryStmt,
 		softDeleteProductStmt:                       q.softDeleteProductStmt,
+		softDeleteProductVariantStmt:                q.softDeleteProductVariantStmt,
+		startPriceScheduleStmt:                      q.startPriceScheduleStmt,
+		startProductImportJobStmt:                   q.startProductImportJobStmt,
+		suggestSearchTermsStmt:                      q.suggestSearchTermsStmt,
+		sumCartProductQtyStmt:                       q.sumCartProductQtyStmt,
+		touchCartStmt:                               q.touchCartStmt,
 		unsetPrimaryAddressByUserStmt:               q.unsetPrimaryAddressByUserStmt,
 		updateAddressStmt:                           q.updateAddressStmt,
 		updateBrandStmt:                             q.updateBrandStmt,
 		updateCartItemQtyStmt:                       q.updateCartItemQtyStmt,
 		updateCategoryStmt:                          q.updateCategoryStmt,
+		updateCategoryAttributeStmt:                 q.updateCategoryAttributeStmt,
 		updateCustomerPasswordStmt:                  q.updateCustomerPasswordStmt,
 		updateCustomerProfileStmt:                   q.updateCustomerProfileStmt,
 		updateCustomerStatusStmt:                    q.updateCustomerStatusStmt,
@@ -1101,8 +2338,21 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		updateOrderSnapTokenStmt:                    q.updateOrderSnapTokenStmt,
 		updateOrderStatusStmt:                       q.updateOrderStatusStmt,
 		updateProductStmt:                           q.updateProductStmt,
+		updateProductImageAltTextStmt:               q.updateProductImageAltTextStmt,
+		updateProductImageSortOrderStmt:             q.updateProductImageSortOrderStmt,
+		updateProductImportJobProgressStmt:          q.updateProductImportJobProgressStmt,
+		updateProductVariantStmt:                    q.updateProductVariantStmt,
 		updateReviewStmt:                            q.updateReviewStmt,
+		updateWarehouseStmt:                         q.updateWarehouseStmt,
+		upsertCartItemQtyStmt:                       q.upsertCartItemQtyStmt,
+		upsertCartReminderPreferenceStmt:            q.upsertCartReminderPreferenceStmt,
 		upsertEmailConfirmationTokenStmt:            q.upsertEmailConfirmationTokenStmt,
 		upsertPasswordResetTokenStmt:                q.upsertPasswordResetTokenStmt,
+		upsertPrimaryProductImageStmt:               q.upsertPrimaryProductImageStmt,
+		upsertProductAttributeValueStmt:             q.upsertProductAttributeValueStmt,
+		upsertProductBySKUStmt:                      q.upsertProductBySKUStmt,
+		upsertProductOptionStmt:                     q.upsertProductOptionStmt,
+		upsertProductOptionValueStmt:                q.upsertProductOptionValueStmt,
+		upsertStockNotificationStmt:                 q.upsertStockNotificationStmt,
 	}
 }
@@ -43,22 +43,25 @@ type Brand struct {
 }
 
 type Cart struct {
-	ID        uuid.UUID    `json:"id"`
-	UserID    uuid.UUID    `json:"user_id"`
-	CreatedAt time.Time    `json:"created_at"`
-	UpdatedAt time.Time    `json:"updated_at"`
-	DeletedAt sql.NullTime `json:"deleted_at"`
+	ID        uuid.UUID      `json:"id"`
+	UserID    uuid.NullUUID  `json:"user_id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt sql.NullTime   `json:"deleted_at"`
+	CartToken sql.NullString `json:"cart_token"`
 }
 
 type CartItem struct {
-	ID         uuid.UUID    `json:"id"`
-	CartID     uuid.UUID    `json:"cart_id"`
-	ProductID  uuid.UUID    `json:"product_id"`
-	Quantity   int32        `json:"quantity"`
-	PriceAtAdd int32        `json:"price_at_add"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
-	DeletedAt  sql.NullTime `json:"deleted_at"`
+	ID            uuid.UUID     `json:"id"`
+	CartID        uuid.UUID     `json:"cart_id"`
+	ProductID     uuid.UUID     `json:"product_id"`
+	Quantity      int32         `json:"quantity"`
+	PriceAtAdd    int32         `json:"price_at_add"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	DeletedAt     sql.NullTime  `json:"deleted_at"`
+	SavedForLater bool          `json:"saved_for_later"`
+	VariantID     uuid.NullUUID `json:"variant_id"`
 }
 
 type Category struct {
@@ -71,6 +74,20 @@ type Category struct {
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   sql.NullTime   `json:"deleted_at"`
+	ParentID    uuid.NullUUID  `json:"parent_id"`
+}
+
+type CategoryAttribute struct {
+	ID           uuid.UUID      `json:"id"`
+	CategoryID   uuid.UUID      `json:"category_id"`
+	Code         string         `json:"code"`
+	Name         string         `json:"name"`
+	DataType     string         `json:"data_type"`
+	Unit         sql.NullString `json:"unit"`
+	IsFilterable bool           `json:"is_filterable"`
+	SortOrder    int32          `json:"sort_order"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
 }
 
 type EmailConfirmationToken struct {
@@ -82,6 +99,14 @@ type EmailConfirmationToken struct {
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
+type NotificationPreference struct {
+	UserID              uuid.UUID    `json:"user_id"`
+	CartReminderEnabled bool         `json:"cart_reminder_enabled"`
+	CartReminderSentAt  sql.NullTime `json:"cart_reminder_sent_at"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+}
+
 type Order struct {
 	ID                 uuid.UUID       `json:"id"`
 	OrderNumber        string          `json:"order_number"`
@@ -108,18 +133,20 @@ type Order struct {
 	DeletedAt          sql.NullTime    `json:"deleted_at"`
 	AddressID          uuid.NullUUID   `json:"address_id"`
 	SnapTokenExpiredAt sql.NullTime    `json:"snap_token_expired_at"`
+	WarehouseID        uuid.NullUUID   `json:"warehouse_id"`
 }
 
 type OrderItem struct {
-	ID           uuid.UUID `json:"id"`
-	OrderID      uuid.UUID `json:"order_id"`
-	ProductID    uuid.UUID `json:"product_id"`
-	NameSnapshot string    `json:"name_snapshot"`
-	UnitPrice    string    `json:"unit_price"`
-	Quantity     int32     `json:"quantity"`
-	TotalPrice   string    `json:"total_price"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           uuid.UUID     `json:"id"`
+	OrderID      uuid.UUID     `json:"order_id"`
+	ProductID    uuid.UUID     `json:"product_id"`
+	NameSnapshot string        `json:"name_snapshot"`
+	UnitPrice    string        `json:"unit_price"`
+	Quantity     int32         `json:"quantity"`
+	TotalPrice   string        `json:"total_price"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	VariantID    uuid.NullUUID `json:"variant_id"`
 }
 
 type OutboxEvent struct {
@@ -142,21 +169,144 @@ type PasswordResetToken struct {
 }
 
 type Product struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+}
+
+type ProductAttributeValue struct {
+	ProductID    uuid.UUID      `json:"product_id"`
+	AttributeID  uuid.UUID      `json:"attribute_id"`
+	ValueText    sql.NullString `json:"value_text"`
+	ValueNumber  sql.NullString `json:"value_number"`
+	ValueBoolean sql.NullBool   `json:"value_boolean"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type ProductCoPurchase struct {
+	ProductID        uuid.UUID `json:"product_id"`
+	RelatedProductID uuid.UUID `json:"related_product_id"`
+	OrderCount       int32     `json:"order_count"`
+	LastOrderedAt    time.Time `json:"last_ordered_at"`
+	ComputedAt       time.Time `json:"computed_at"`
+}
+
+type ProductImage struct {
+	ID        uuid.UUID      `json:"id"`
+	ProductID uuid.UUID      `json:"product_id"`
+	ImageUrl  string         `json:"image_url"`
+	AltText   sql.NullString `json:"alt_text"`
+	SortOrder int32          `json:"sort_order"`
+	IsPrimary bool           `json:"is_primary"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type ProductImportJob struct {
+	ID            uuid.UUID       `json:"id"`
+	Status        string          `json:"status"`
+	DryRun        bool            `json:"dry_run"`
+	Filename      sql.NullString  `json:"filename"`
+	TotalRows     int32           `json:"total_rows"`
+	ProcessedRows int32           `json:"processed_rows"`
+	CreatedCount  int32           `json:"created_count"`
+	UpdatedCount  int32           `json:"updated_count"`
+	FailedCount   int32           `json:"failed_count"`
+	Errors        json.RawMessage `json:"errors"`
+	Message       sql.NullString  `json:"message"`
+	CreatedBy     uuid.NullUUID   `json:"created_by"`
+	CreatedAt     time.Time       `json:"created_at"`
+	StartedAt     sql.NullTime    `json:"started_at"`
+	FinishedAt    sql.NullTime    `json:"finished_at"`
+}
+
+type ProductOption struct {
+	ID        uuid.UUID `json:"id"`
+	ProductID uuid.UUID `json:"product_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProductOptionValue struct {
+	ID        uuid.UUID `json:"id"`
+	OptionID  uuid.UUID `json:"option_id"`
+	Value     string    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProductPriceHistory struct {
 	ID            uuid.UUID      `json:"id"`
-	CategoryID    uuid.UUID      `json:"category_id"`
-	Name          string         `json:"name"`
-	Slug          string         `json:"slug"`
-	Description   sql.NullString `json:"description"`
+	ProductID     uuid.UUID      `json:"product_id"`
 	Price         string         `json:"price"`
+	DiscountPrice sql.NullString `json:"discount_price"`
+	Source        string         `json:"source"`
+	ScheduleID    uuid.NullUUID  `json:"schedule_id"`
+	ChangedBy     uuid.NullUUID  `json:"changed_by"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+type ProductPriceSchedule struct {
+	ID                    uuid.UUID      `json:"id"`
+	ProductID             uuid.UUID      `json:"product_id"`
+	Price                 string         `json:"price"`
+	DiscountPrice         sql.NullString `json:"discount_price"`
+	StartsAt              time.Time      `json:"starts_at"`
+	EndsAt                sql.NullTime   `json:"ends_at"`
+	Status                string         `json:"status"`
+	PreviousPrice         sql.NullString `json:"previous_price"`
+	PreviousDiscountPrice sql.NullString `json:"previous_discount_price"`
+	Note                  sql.NullString `json:"note"`
+	CreatedBy             uuid.NullUUID  `json:"created_by"`
+	AppliedAt             sql.NullTime   `json:"applied_at"`
+	EndedAt               sql.NullTime   `json:"ended_at"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+}
+
+type ProductStat struct {
+	ProductID       uuid.UUID `json:"product_id"`
+	SoldCount       int32     `json:"sold_count"`
+	RatingAvg       string    `json:"rating_avg"`
+	RatingCount     int32     `json:"rating_count"`
+	RecentSoldCount int32     `json:"recent_sold_count"`
+	RecentViewCount int32     `json:"recent_view_count"`
+	TrendingScore   string    `json:"trending_score"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type ProductVariant struct {
+	ID            uuid.UUID      `json:"id"`
+	ProductID     uuid.UUID      `json:"product_id"`
+	Sku           string         `json:"sku"`
+	Price         string         `json:"price"`
+	DiscountPrice sql.NullString `json:"discount_price"`
 	Stock         int32          `json:"stock"`
-	Sku           sql.NullString `json:"sku"`
 	ImageUrl      sql.NullString `json:"image_url"`
-	IsActive      sql.NullBool   `json:"is_active"`
+	IsActive      bool           `json:"is_active"`
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	DiscountPrice sql.NullString `json:"discount_price"`
-	BrandID       uuid.NullUUID  `json:"brand_id"`
+}
+
+type ProductVariantValue struct {
+	VariantID     uuid.UUID `json:"variant_id"`
+	OptionValueID uuid.UUID `json:"option_value_id"`
 }
 
 type Review struct {
@@ -172,6 +322,37 @@ type Review struct {
 	DeletedAt          sql.NullTime `json:"deleted_at"`
 }
 
+type SlugHistory struct {
+	ID         uuid.UUID `json:"id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   uuid.UUID `json:"entity_id"`
+	Slug       string    `json:"slug"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type StockMovement struct {
+	ID           uuid.UUID      `json:"id"`
+	ProductID    uuid.UUID      `json:"product_id"`
+	VariantID    uuid.NullUUID  `json:"variant_id"`
+	Delta        int32          `json:"delta"`
+	BalanceAfter int32          `json:"balance_after"`
+	Reason       string         `json:"reason"`
+	ReferenceID  uuid.NullUUID  `json:"reference_id"`
+	ActorID      uuid.NullUUID  `json:"actor_id"`
+	Note         sql.NullString `json:"note"`
+	CreatedAt    time.Time      `json:"created_at"`
+	WarehouseID  uuid.UUID      `json:"warehouse_id"`
+}
+
+type StockNotification struct {
+	ID         uuid.UUID     `json:"id"`
+	UserID     uuid.UUID     `json:"user_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	NotifiedAt sql.NullTime  `json:"notified_at"`
+}
+
 type User struct {
 	ID             uuid.UUID      `json:"id"`
 	Email          string         `json:"email"`
@@ -185,6 +366,41 @@ type User struct {
 	IsActive       bool           `json:"is_active"`
 }
 
+type Warehouse struct {
+	ID        uuid.UUID      `json:"id"`
+	Code      string         `json:"code"`
+	Name      string         `json:"name"`
+	Province  string         `json:"province"`
+	City      sql.NullString `json:"city"`
+	Address   sql.NullString `json:"address"`
+	Priority  int32          `json:"priority"`
+	IsDefault bool           `json:"is_default"`
+	IsActive  bool           `json:"is_active"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type WarehouseStock struct {
+	ID          uuid.UUID     `json:"id"`
+	WarehouseID uuid.UUID     `json:"warehouse_id"`
+	ProductID   uuid.UUID     `json:"product_id"`
+	VariantID   uuid.NullUUID `json:"variant_id"`
+	Stock       int32         `json:"stock"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+type WarehouseTransfer struct {
+	ID              uuid.UUID      `json:"id"`
+	FromWarehouseID uuid.UUID      `json:"from_warehouse_id"`
+	ToWarehouseID   uuid.UUID      `json:"to_warehouse_id"`
+	ProductID       uuid.UUID      `json:"product_id"`
+	VariantID       uuid.NullUUID  `json:"variant_id"`
+	Quantity        int32          `json:"quantity"`
+	Note            sql.NullString `json:"note"`
+	ActorID         uuid.NullUUID  `json:"actor_id"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
 type Wishlist struct {
 	ID        uuid.UUID `json:"id"`
 	UserID    uuid.UUID `json:"user_id"`
@@ -193,9 +409,10 @@ type Wishlist struct {
 }
 
 type WishlistItem struct {
-	ID         uuid.UUID `json:"id"`
-	WishlistID uuid.UUID `json:"wishlist_id"`
-	ProductID  uuid.UUID `json:"product_id"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID         uuid.UUID     `json:"id"`
+	WishlistID uuid.UUID     `json:"wishlist_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
 }
@@ -18,9 +18,9 @@ const createOrder = `-- name: CreateOrder :one
 INSERT INTO orders (
     order_number, user_id, status, address_id, address_snapshot, 
     subtotal_price, shipping_price, total_price, note, 
-    snap_token, snap_redirect_url, snap_token_expired_at, placed_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW())
-RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at
+    snap_token, snap_redirect_url, snap_token_expired_at, warehouse_id, placed_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW())
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at, warehouse_id
 `
 
 type CreateOrderParams struct {
@@ -36,6 +36,7 @@ type CreateOrderParams struct {
 	SnapToken          sql.NullString  `json:"snap_token"`
 	SnapRedirectUrl    sql.NullString  `json:"snap_redirect_url"`
 	SnapTokenExpiredAt sql.NullTime    `json:"snap_token_expired_at"`
+	WarehouseID        uuid.NullUUID   `json:"warehouse_id"`
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
@@ -52,6 +53,7 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.SnapToken,
 		arg.SnapRedirectUrl,
 		arg.SnapTokenExpiredAt,
+		arg.WarehouseID,
 	)
 	var i Order
 	err := row.Scan(
@@ -80,23 +82,25 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.DeletedAt,
 		&i.AddressID,
 		&i.SnapTokenExpiredAt,
+		&i.WarehouseID,
 	)
 	return i, err
 }
 
 const createOrderItem = `-- name: CreateOrderItem :exec
 INSERT INTO order_items (
-    order_id, product_id, name_snapshot, unit_price, quantity, total_price
-) VALUES ($1, $2, $3, $4, $5, $6)
+    order_id, product_id, name_snapshot, unit_price, quantity, total_price, variant_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateOrderItemParams struct {
-	OrderID      uuid.UUID `json:"order_id"`
-	ProductID    uuid.UUID `json:"product_id"`
-	NameSnapshot string    `json:"name_snapshot"`
-	UnitPrice    string    `json:"unit_price"`
-	Quantity     int32     `json:"quantity"`
-	TotalPrice   string    `json:"total_price"`
+	OrderID      uuid.UUID     `json:"order_id"`
+	ProductID    uuid.UUID     `json:"product_id"`
+	NameSnapshot string        `json:"name_snapshot"`
+	UnitPrice    string        `json:"unit_price"`
+	Quantity     int32         `json:"quantity"`
+	TotalPrice   string        `json:"total_price"`
+	VariantID    uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) error {
@@ -107,6 +111,7 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		arg.UnitPrice,
 		arg.Quantity,
 		arg.TotalPrice,
+		arg.VariantID,
 	)
 	return err
 }
@@ -134,6 +139,8 @@ SELECT
     o.snap_token,
     o.snap_redirect_url,
     o.snap_token_expired_at,
+    o.warehouse_id,
+    w.name AS warehouse_name,
     -- Tambahkan objek customer di sini
     jsonb_build_object(
         'email', u.email,
@@ -145,6 +152,7 @@ SELECT
                 jsonb_build_object(
                     'id', oi.id,
                     'productId', oi.product_id,
+                    'variantId', oi.variant_id,
                     'productSlug', p.slug,
                     'productImageUrl', p.image_url,
                     'nameSnapshot', oi.name_snapshot,
@@ -161,6 +169,7 @@ SELECT
     )::jsonb AS items_json
 FROM orders o
 INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
 WHERE o.id = $1 
   AND o.deleted_at IS NULL
 LIMIT 1
@@ -188,6 +197,8 @@ type GetOrderByIDRow struct {
 	SnapToken          sql.NullString  `json:"snap_token"`
 	SnapRedirectUrl    sql.NullString  `json:"snap_redirect_url"`
 	SnapTokenExpiredAt sql.NullTime    `json:"snap_token_expired_at"`
+	WarehouseID        uuid.NullUUID   `json:"warehouse_id"`
+	WarehouseName      sql.NullString  `json:"warehouse_name"`
 	CustomerJson       json.RawMessage `json:"customer_json"`
 	ItemsJson          json.RawMessage `json:"items_json"`
 }
@@ -218,6 +229,8 @@ func (q *Queries) GetOrderByID(ctx context.Context, id uuid.UUID) (GetOrderByIDR
 		&i.SnapToken,
 		&i.SnapRedirectUrl,
 		&i.SnapTokenExpiredAt,
+		&i.WarehouseID,
+		&i.WarehouseName,
 		&i.CustomerJson,
 		&i.ItemsJson,
 	)
@@ -234,7 +247,8 @@ SELECT
     oi.name_snapshot, 
     oi.unit_price, 
     oi.quantity, 
-    oi.total_price
+    oi.total_price,
+    oi.variant_id
 FROM order_items oi
 LEFT JOIN products p ON oi.product_id = p.id
 WHERE oi.order_id = $1
@@ -250,6 +264,7 @@ type GetOrderItemsRow struct {
 	UnitPrice       string         `json:"unit_price"`
 	Quantity        int32          `json:"quantity"`
 	TotalPrice      string         `json:"total_price"`
+	VariantID       uuid.NullUUID  `json:"variant_id"`
 }
 
 func (q *Queries) GetOrderItems(ctx context.Context, orderID uuid.UUID) ([]GetOrderItemsRow, error) {
@@ -271,6 +286,7 @@ func (q *Queries) GetOrderItems(ctx context.Context, orderID uuid.UUID) ([]GetOr
 			&i.UnitPrice,
 			&i.Quantity,
 			&i.TotalPrice,
+			&i.VariantID,
 		); err != nil {
 			return nil, err
 		}
@@ -420,6 +436,7 @@ SELECT
                 jsonb_build_object(
                     'id', oi.id,
                     'productId', oi.product_id,
+                    'variantId', oi.variant_id,
                     'productSlug', p.slug,
                     'productImageUrl', p.image_url,
                     'nameSnapshot', oi.name_snapshot,
@@ -444,7 +461,9 @@ WHERE o.user_id = $3
       $5::text IS NULL
       OR o.order_number ILIKE '%' || $5::text || '%'
   )
-ORDER BY o.placed_at DESC
+ORDER BY
+  o.placed_at DESC,
+  o.id DESC
 LIMIT $1 OFFSET $2
 `
 
@@ -517,35 +536,44 @@ SELECT
     o.subtotal_price,
     o.shipping_price,
     u.name AS user_name,
+    o.warehouse_id,
+    w.name AS warehouse_name,
     COUNT(*) OVER() AS total_count
 FROM orders o
 INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
 WHERE o.deleted_at IS NULL
   AND ($3::text IS NULL OR o.status = $3::text)
   AND ($4::text IS NULL OR o.order_number ILIKE '%' || $4::text || '%')
-ORDER BY o.created_at DESC
+  AND ($5::uuid IS NULL OR o.warehouse_id = $5::uuid)
+ORDER BY
+  o.created_at DESC,
+  o.id DESC
 LIMIT $1 OFFSET $2
 `
 
 type ListOrdersAdminParams struct {
-	Limit  int32          `json:"limit"`
-	Offset int32          `json:"offset"`
-	Status sql.NullString `json:"status"`
-	Search sql.NullString `json:"search"`
+	Limit       int32          `json:"limit"`
+	Offset      int32          `json:"offset"`
+	Status      sql.NullString `json:"status"`
+	Search      sql.NullString `json:"search"`
+	WarehouseID uuid.NullUUID  `json:"warehouse_id"`
 }
 
 type ListOrdersAdminRow struct {
-	ID            uuid.UUID `json:"id"`
-	OrderNumber   string    `json:"order_number"`
-	TotalPrice    string    `json:"total_price"`
-	Status        string    `json:"status"`
-	CreatedAt     time.Time `json:"created_at"`
-	PlacedAt      time.Time `json:"placed_at"`
-	UserID        uuid.UUID `json:"user_id"`
-	SubtotalPrice string    `json:"subtotal_price"`
-	ShippingPrice string    `json:"shipping_price"`
-	UserName      string    `json:"user_name"`
-	TotalCount    int64     `json:"total_count"`
+	ID            uuid.UUID      `json:"id"`
+	OrderNumber   string         `json:"order_number"`
+	TotalPrice    string         `json:"total_price"`
+	Status        string         `json:"status"`
+	CreatedAt     time.Time      `json:"created_at"`
+	PlacedAt      time.Time      `json:"placed_at"`
+	UserID        uuid.UUID      `json:"user_id"`
+	SubtotalPrice string         `json:"subtotal_price"`
+	ShippingPrice string         `json:"shipping_price"`
+	UserName      string         `json:"user_name"`
+	WarehouseID   uuid.NullUUID  `json:"warehouse_id"`
+	WarehouseName sql.NullString `json:"warehouse_name"`
+	TotalCount    int64          `json:"total_count"`
 }
 
 func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams) ([]ListOrdersAdminRow, error) {
@@ -554,6 +582,7 @@ func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams
 		arg.Offset,
 		arg.Status,
 		arg.Search,
+		arg.WarehouseID,
 	)
 	if err != nil {
 		return nil, err
@@ -573,6 +602,8 @@ func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams
 			&i.SubtotalPrice,
 			&i.ShippingPrice,
 			&i.UserName,
+			&i.WarehouseID,
+			&i.WarehouseName,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -588,6 +619,398 @@ func (q *Queries) ListOrdersAdmin(ctx context.Context, arg ListOrdersAdminParams
 	return items, nil
 }
 
+const listOrdersAdminByCreatedAsc = `-- name: ListOrdersAdminByCreatedAsc :many
+SELECT 
+    o.id, 
+    o.order_number, 
+    o.total_price, 
+    o.status, 
+    o.created_at, 
+    o.placed_at,
+    o.user_id,
+    o.subtotal_price,
+    o.shipping_price,
+    u.name AS user_name,
+    o.warehouse_id,
+    w.name AS warehouse_name
+FROM orders o
+INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
+WHERE o.deleted_at IS NULL
+  AND ($2::text IS NULL OR o.status = $2::text)
+  AND ($3::text IS NULL OR o.order_number ILIKE '%' || $3::text || '%')
+  AND ($4::uuid IS NULL OR o.warehouse_id = $4::uuid)
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.created_at, o.id) > ($5::timestamp, $6::uuid)
+ORDER BY o.created_at ASC, o.id ASC
+LIMIT $1
+`
+
+type ListOrdersAdminByCreatedAscParams struct {
+	Limit           int32          `json:"limit"`
+	Status          sql.NullString `json:"status"`
+	Search          sql.NullString `json:"search"`
+	WarehouseID     uuid.NullUUID  `json:"warehouse_id"`
+	CursorCreatedAt time.Time      `json:"cursor_created_at"`
+	CursorID        uuid.UUID      `json:"cursor_id"`
+}
+
+type ListOrdersAdminByCreatedAscRow struct {
+	ID            uuid.UUID      `json:"id"`
+	OrderNumber   string         `json:"order_number"`
+	TotalPrice    string         `json:"total_price"`
+	Status        string         `json:"status"`
+	CreatedAt     time.Time      `json:"created_at"`
+	PlacedAt      time.Time      `json:"placed_at"`
+	UserID        uuid.UUID      `json:"user_id"`
+	SubtotalPrice string         `json:"subtotal_price"`
+	ShippingPrice string         `json:"shipping_price"`
+	UserName      string         `json:"user_name"`
+	WarehouseID   uuid.NullUUID  `json:"warehouse_id"`
+	WarehouseName sql.NullString `json:"warehouse_name"`
+}
+
+func (q *Queries) ListOrdersAdminByCreatedAsc(ctx context.Context, arg ListOrdersAdminByCreatedAscParams) ([]ListOrdersAdminByCreatedAscRow, error) {
+	rows, err := q.query(ctx, q.listOrdersAdminByCreatedAscStmt, listOrdersAdminByCreatedAsc,
+		arg.Limit,
+		arg.Status,
+		arg.Search,
+		arg.WarehouseID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrdersAdminByCreatedAscRow
+	for rows.Next() {
+		var i ListOrdersAdminByCreatedAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.TotalPrice,
+			&i.Status,
+			&i.CreatedAt,
+			&i.PlacedAt,
+			&i.UserID,
+			&i.SubtotalPrice,
+			&i.ShippingPrice,
+			&i.UserName,
+			&i.WarehouseID,
+			&i.WarehouseName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrdersAdminByCreatedDesc = `-- name: ListOrdersAdminByCreatedDesc :many
+SELECT 
+    o.id, 
+    o.order_number, 
+    o.total_price, 
+    o.status, 
+    o.created_at, 
+    o.placed_at,
+    o.user_id,
+    o.subtotal_price,
+    o.shipping_price,
+    u.name AS user_name,
+    o.warehouse_id,
+    w.name AS warehouse_name
+FROM orders o
+INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
+WHERE o.deleted_at IS NULL
+  AND ($2::text IS NULL OR o.status = $2::text)
+  AND ($3::text IS NULL OR o.order_number ILIKE '%' || $3::text || '%')
+  AND ($4::uuid IS NULL OR o.warehouse_id = $4::uuid)
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.created_at, o.id) < ($5::timestamp, $6::uuid)
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT $1
+`
+
+type ListOrdersAdminByCreatedDescParams struct {
+	Limit           int32          `json:"limit"`
+	Status          sql.NullString `json:"status"`
+	Search          sql.NullString `json:"search"`
+	WarehouseID     uuid.NullUUID  `json:"warehouse_id"`
+	CursorCreatedAt time.Time      `json:"cursor_created_at"`
+	CursorID        uuid.UUID      `json:"cursor_id"`
+}
+
+type ListOrdersAdminByCreatedDescRow struct {
+	ID            uuid.UUID      `json:"id"`
+	OrderNumber   string         `json:"order_number"`
+	TotalPrice    string         `json:"total_price"`
+	Status        string         `json:"status"`
+	CreatedAt     time.Time      `json:"created_at"`
+	PlacedAt      time.Time      `json:"placed_at"`
+	UserID        uuid.UUID      `json:"user_id"`
+	SubtotalPrice string         `json:"subtotal_price"`
+	ShippingPrice string         `json:"shipping_price"`
+	UserName      string         `json:"user_name"`
+	WarehouseID   uuid.NullUUID  `json:"warehouse_id"`
+	WarehouseName sql.NullString `json:"warehouse_name"`
+}
+
+func (q *Queries) ListOrdersAdminByCreatedDesc(ctx context.Context, arg ListOrdersAdminByCreatedDescParams) ([]ListOrdersAdminByCreatedDescRow, error) {
+	rows, err := q.query(ctx, q.listOrdersAdminByCreatedDescStmt, listOrdersAdminByCreatedDesc,
+		arg.Limit,
+		arg.Status,
+		arg.Search,
+		arg.WarehouseID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrdersAdminByCreatedDescRow
+	for rows.Next() {
+		var i ListOrdersAdminByCreatedDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.TotalPrice,
+			&i.Status,
+			&i.CreatedAt,
+			&i.PlacedAt,
+			&i.UserID,
+			&i.SubtotalPrice,
+			&i.ShippingPrice,
+			&i.UserName,
+			&i.WarehouseID,
+			&i.WarehouseName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrdersByPlacedAsc = `-- name: ListOrdersByPlacedAsc :many
+SELECT 
+    o.id,
+    o.order_number,
+    o.status,
+    o.total_price,
+    o.placed_at,
+    o.user_id,
+    (
+        SELECT COALESCE(
+            jsonb_agg(
+                jsonb_build_object(
+                    'id', oi.id,
+                    'productId', oi.product_id,
+                    'variantId', oi.variant_id,
+                    'productSlug', p.slug,
+                    'productImageUrl', p.image_url,
+                    'nameSnapshot', oi.name_snapshot,
+                    'unitPrice', oi.unit_price,
+                    'quantity', oi.quantity,
+                    'subtotal', oi.total_price
+                )
+            ),
+            '[]'::jsonb
+        )
+        FROM order_items oi
+        LEFT JOIN products p ON oi.product_id = p.id -- Join ke tabel produk
+        WHERE oi.order_id = o.id
+    )::jsonb AS items_json
+FROM orders o
+WHERE o.user_id = $2
+  AND (
+      $3::text IS NULL
+      OR o.status = $3::text
+  )
+  AND (
+      $4::text IS NULL
+      OR o.order_number ILIKE '%' || $4::text || '%'
+  )
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.placed_at, o.id) > ($5::timestamp, $6::uuid)
+ORDER BY o.placed_at ASC, o.id ASC
+LIMIT $1
+`
+
+type ListOrdersByPlacedAscParams struct {
+	Limit          int32          `json:"limit"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Status         sql.NullString `json:"status"`
+	Search         sql.NullString `json:"search"`
+	CursorPlacedAt time.Time      `json:"cursor_placed_at"`
+	CursorID       uuid.UUID      `json:"cursor_id"`
+}
+
+type ListOrdersByPlacedAscRow struct {
+	ID          uuid.UUID       `json:"id"`
+	OrderNumber string          `json:"order_number"`
+	Status      string          `json:"status"`
+	TotalPrice  string          `json:"total_price"`
+	PlacedAt    time.Time       `json:"placed_at"`
+	UserID      uuid.UUID       `json:"user_id"`
+	ItemsJson   json.RawMessage `json:"items_json"`
+}
+
+func (q *Queries) ListOrdersByPlacedAsc(ctx context.Context, arg ListOrdersByPlacedAscParams) ([]ListOrdersByPlacedAscRow, error) {
+	rows, err := q.query(ctx, q.listOrdersByPlacedAscStmt, listOrdersByPlacedAsc,
+		arg.Limit,
+		arg.UserID,
+		arg.Status,
+		arg.Search,
+		arg.CursorPlacedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrdersByPlacedAscRow
+	for rows.Next() {
+		var i ListOrdersByPlacedAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.Status,
+			&i.TotalPrice,
+			&i.PlacedAt,
+			&i.UserID,
+			&i.ItemsJson,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrdersByPlacedDesc = `-- name: ListOrdersByPlacedDesc :many
+SELECT 
+    o.id,
+    o.order_number,
+    o.status,
+    o.total_price,
+    o.placed_at,
+    o.user_id,
+    (
+        SELECT COALESCE(
+            jsonb_agg(
+                jsonb_build_object(
+                    'id', oi.id,
+                    'productId', oi.product_id,
+                    'variantId', oi.variant_id,
+                    'productSlug', p.slug,
+                    'productImageUrl', p.image_url,
+                    'nameSnapshot', oi.name_snapshot,
+                    'unitPrice', oi.unit_price,
+                    'quantity', oi.quantity,
+                    'subtotal', oi.total_price
+                )
+            ),
+            '[]'::jsonb
+        )
+        FROM order_items oi
+        LEFT JOIN products p ON oi.product_id = p.id -- Join ke tabel produk
+        WHERE oi.order_id = o.id
+    )::jsonb AS items_json
+FROM orders o
+WHERE o.user_id = $2
+  AND (
+      $3::text IS NULL
+      OR o.status = $3::text
+  )
+  AND (
+      $4::text IS NULL
+      OR o.order_number ILIKE '%' || $4::text || '%'
+  )
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.placed_at, o.id) < ($5::timestamp, $6::uuid)
+ORDER BY o.placed_at DESC, o.id DESC
+LIMIT $1
+`
+
+type ListOrdersByPlacedDescParams struct {
+	Limit          int32          `json:"limit"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Status         sql.NullString `json:"status"`
+	Search         sql.NullString `json:"search"`
+	CursorPlacedAt time.Time      `json:"cursor_placed_at"`
+	CursorID       uuid.UUID      `json:"cursor_id"`
+}
+
+type ListOrdersByPlacedDescRow struct {
+	ID          uuid.UUID       `json:"id"`
+	OrderNumber string          `json:"order_number"`
+	Status      string          `json:"status"`
+	TotalPrice  string          `json:"total_price"`
+	PlacedAt    time.Time       `json:"placed_at"`
+	UserID      uuid.UUID       `json:"user_id"`
+	ItemsJson   json.RawMessage `json:"items_json"`
+}
+
+func (q *Queries) ListOrdersByPlacedDesc(ctx context.Context, arg ListOrdersByPlacedDescParams) ([]ListOrdersByPlacedDescRow, error) {
+	rows, err := q.query(ctx, q.listOrdersByPlacedDescStmt, listOrdersByPlacedDesc,
+		arg.Limit,
+		arg.UserID,
+		arg.Status,
+		arg.Search,
+		arg.CursorPlacedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrdersByPlacedDescRow
+	for rows.Next() {
+		var i ListOrdersByPlacedDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderNumber,
+			&i.Status,
+			&i.TotalPrice,
+			&i.PlacedAt,
+			&i.UserID,
+			&i.ItemsJson,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateOrderPaymentStatus = `-- name: UpdateOrderPaymentStatus :one
 UPDATE orders
 SET
@@ -599,7 +1022,7 @@ SET
     note = CASE WHEN $7::text IS NULL THEN note ELSE $7::text END,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at, warehouse_id
 `
 
 type UpdateOrderPaymentStatusParams struct {
@@ -649,6 +1072,7 @@ func (q *Queries) UpdateOrderPaymentStatus(ctx context.Context, arg UpdateOrderP
 		&i.DeletedAt,
 		&i.AddressID,
 		&i.SnapTokenExpiredAt,
+		&i.WarehouseID,
 	)
 	return i, err
 }
@@ -661,7 +1085,7 @@ SET
     snap_token_expired_at = $4,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at, warehouse_id
 `
 
 type UpdateOrderSnapTokenParams struct {
@@ -705,6 +1129,7 @@ func (q *Queries) UpdateOrderSnapToken(ctx context.Context, arg UpdateOrderSnapT
 		&i.DeletedAt,
 		&i.AddressID,
 		&i.SnapTokenExpiredAt,
+		&i.WarehouseID,
 	)
 	return i, err
 }
@@ -716,7 +1141,7 @@ SET status = $2::text,
     completed_at = CASE WHEN $2::text = 'COMPLETED' THEN NOW() ELSE completed_at END,
     cancelled_at = CASE WHEN $2::text = 'CANCELLED' THEN NOW() ELSE cancelled_at END
 WHERE id = $1
-RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at
+RETURNING id, order_number, user_id, status, payment_method, payment_status, address_snapshot, subtotal_price, discount_price, shipping_price, total_price, note, placed_at, paid_at, cancelled_at, cancel_reason, completed_at, receipt_no, snap_token, snap_redirect_url, created_at, updated_at, deleted_at, address_id, snap_token_expired_at, warehouse_id
 `
 
 type UpdateOrderStatusParams struct {
@@ -753,6 +1178,7 @@ func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusPa
 		&i.DeletedAt,
 		&i.AddressID,
 		&i.SnapTokenExpiredAt,
+		&i.WarehouseID,
 	)
 	return i, err
 }
@@ -15,21 +15,24 @@ import (
 )
 
 const createProduct = `-- name: CreateProduct :one
-INSERT INTO products (brand_id, category_id, name, slug, description, price, stock, sku, image_url)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id
+INSERT INTO products (brand_id, category_id, name, slug, description, price, sku, image_url, max_qty_per_order, low_stock_threshold, status, publish_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id, max_qty_per_order, search_vector, low_stock_threshold, status, publish_at
 `
 
 type CreateProductParams struct {
-	BrandID     uuid.NullUUID  `json:"brand_id"`
-	CategoryID  uuid.UUID      `json:"category_id"`
-	Name        string         `json:"name"`
-	Slug        string         `json:"slug"`
-	Description sql.NullString `json:"description"`
-	Price       string         `json:"price"`
-	Stock       int32          `json:"stock"`
-	Sku         sql.NullString `json:"sku"`
-	ImageUrl    sql.NullString `json:"image_url"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
 }
 
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error) {
@@ -40,9 +43,12 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		arg.Slug,
 		arg.Description,
 		arg.Price,
-		arg.Stock,
 		arg.Sku,
 		arg.ImageUrl,
+		arg.MaxQtyPerOrder,
+		arg.LowStockThreshold,
+		arg.Status,
+		arg.PublishAt,
 	)
 	var i Product
 	err := row.Scan(
@@ -61,6 +67,11 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		&i.DeletedAt,
 		&i.DiscountPrice,
 		&i.BrandID,
+		&i.MaxQtyPerOrder,
+		&i.SearchVector,
+		&i.LowStockThreshold,
+		&i.Status,
+		&i.PublishAt,
 	)
 	return i, err
 }
@@ -96,29 +107,34 @@ func (q *Queries) GetIDsBySlugs(ctx context.Context, dollar_1 []string) ([]uuid.
 }
 
 const getProductByID = `-- name: GetProductByID :one
-SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, c.name as category_name 
+SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at, c.name as category_name 
 FROM products p
 JOIN categories c ON p.category_id = c.id
 WHERE p.id = $1 AND p.deleted_at IS NULL LIMIT 1
 `
 
 type GetProductByIDRow struct {
-	ID            uuid.UUID      `json:"id"`
-	CategoryID    uuid.UUID      `json:"category_id"`
-	Name          string         `json:"name"`
-	Slug          string         `json:"slug"`
-	Description   sql.NullString `json:"description"`
-	Price         string         `json:"price"`
-	Stock         int32          `json:"stock"`
-	Sku           sql.NullString `json:"sku"`
-	ImageUrl      sql.NullString `json:"image_url"`
-	IsActive      sql.NullBool   `json:"is_active"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	DiscountPrice sql.NullString `json:"discount_price"`
-	BrandID       uuid.NullUUID  `json:"brand_id"`
-	CategoryName  string         `json:"category_name"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryName      string         `json:"category_name"`
 }
 
 func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (GetProductByIDRow, error) {
@@ -140,35 +156,59 @@ func (q *Queries) GetProductByID(ctx context.Context, id uuid.UUID) (GetProductB
 		&i.DeletedAt,
 		&i.DiscountPrice,
 		&i.BrandID,
+		&i.MaxQtyPerOrder,
+		&i.SearchVector,
+		&i.LowStockThreshold,
+		&i.Status,
+		&i.PublishAt,
 		&i.CategoryName,
 	)
 	return i, err
 }
 
 const getProductBySlug = `-- name: GetProductBySlug :one
-SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, c.name as category_name 
+SELECT p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at, c.name as category_name,
+  LEAST(
+    COALESCE(p.discount_price, p.price),
+    COALESCE((
+      SELECT MIN(COALESCE(h.discount_price, h.price))
+      FROM product_price_history h
+      WHERE h.product_id = p.id
+        AND h.created_at >= COALESCE((
+          SELECT MAX(prev.created_at)
+          FROM product_price_history prev
+          WHERE prev.product_id = p.id AND prev.created_at <= NOW() - INTERVAL '30 days'
+        ), NOW() - INTERVAL '30 days')
+    ), COALESCE(p.discount_price, p.price))
+  )::text AS lowest_price_30d
 FROM products p
 JOIN categories c ON p.category_id = c.id
 WHERE p.slug = $1 AND p.deleted_at IS NULL LIMIT 1
 `
 
 type GetProductBySlugRow struct {
-	ID            uuid.UUID      `json:"id"`
-	CategoryID    uuid.UUID      `json:"category_id"`
-	Name          string         `json:"name"`
-	Slug          string         `json:"slug"`
-	Description   sql.NullString `json:"description"`
-	Price         string         `json:"price"`
-	Stock         int32          `json:"stock"`
-	Sku           sql.NullString `json:"sku"`
-	ImageUrl      sql.NullString `json:"image_url"`
-	IsActive      sql.NullBool   `json:"is_active"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	DiscountPrice sql.NullString `json:"discount_price"`
-	BrandID       uuid.NullUUID  `json:"brand_id"`
-	CategoryName  string         `json:"category_name"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryName      string         `json:"category_name"`
+	LowestPrice30d    string         `json:"lowest_price_30d"`
 }
 
 func (q *Queries) GetProductBySlug(ctx context.Context, slug string) (GetProductBySlugRow, error) {
@@ -190,14 +230,308 @@ func (q *Queries) GetProductBySlug(ctx context.Context, slug string) (GetProduct
 		&i.DeletedAt,
 		&i.DiscountPrice,
 		&i.BrandID,
+		&i.MaxQtyPerOrder,
+		&i.SearchVector,
+		&i.LowStockThreshold,
+		&i.Status,
+		&i.PublishAt,
 		&i.CategoryName,
+		&i.LowestPrice30d,
+	)
+	return i, err
+}
+
+const getProductFacets = `-- name: GetProductFacets :many
+WITH base AS (
+  SELECT
+    p.id,
+    p.category_id,
+    p.brand_id,
+    p.price,
+    p.stock,
+    COALESCE(rt.avg_rating, 0)::numeric AS avg_rating,
+    (
+      COALESCE(cardinality($1::uuid[]), 0) = 0
+      OR p.category_id = ANY($1::uuid[])
+    ) AS match_category,
+    ($2::text IS NULL OR b.slug = $2::text) AS match_brand,
+    (p.price >= $3::numeric AND p.price <= $4::numeric) AS match_price,
+    ($5::numeric = 0 OR COALESCE(rt.avg_rating, 0) >= $5::numeric) AS match_rating,
+    ($6::bool IS NULL OR (p.stock > 0) = $6::bool) AS match_stock
+  FROM published_products(
+    $7::text,
+    $8::text[],
+    $9::text[]
+  ) p
+  LEFT JOIN brands b ON p.brand_id = b.id
+  LEFT JOIN (
+    SELECT r.product_id, AVG(r.rating) AS avg_rating
+    FROM reviews r
+    WHERE r.deleted_at IS NULL
+    GROUP BY r.product_id
+  ) rt ON rt.product_id = p.id
+)
+SELECT 'brand'::text AS facet, br.slug::text AS key, br.name::text AS label, COUNT(*)::bigint AS count
+FROM base
+JOIN brands br ON br.id = base.brand_id
+WHERE match_category AND match_price AND match_rating AND match_stock
+GROUP BY br.slug, br.name
+
+UNION ALL
+
+SELECT 'category'::text, c.slug::text, c.name::text, COUNT(*)::bigint
+FROM base
+JOIN categories c ON c.id = base.category_id
+WHERE match_brand AND match_price AND match_rating AND match_stock
+GROUP BY c.slug, c.name
+
+UNION ALL
+
+-- key = urutan bucket (1-based), label disusun di aplikasi
+SELECT 'price'::text, bucket.idx::text, ''::text, COUNT(base.id)::bigint
+FROM unnest($10::numeric[], $11::numeric[])
+  WITH ORDINALITY AS bucket(min_price, max_price, idx)
+LEFT JOIN base
+  ON base.price >= bucket.min_price
+  AND base.price < bucket.max_price
+  AND match_category AND match_brand AND match_rating AND match_stock
+GROUP BY bucket.idx
+
+UNION ALL
+
+-- Rating band kumulatif: "4 ke atas", "3 ke atas", dst.
+SELECT 'rating'::text, band.min_rating::text, ''::text, COUNT(base.id)::bigint
+FROM generate_series(1, 4) AS band(min_rating)
+LEFT JOIN base
+  ON base.avg_rating >= band.min_rating
+  AND match_category AND match_brand AND match_price AND match_stock
+GROUP BY band.min_rating
+
+UNION ALL
+
+SELECT 'stock'::text, CASE WHEN base.stock > 0 THEN 'in_stock' ELSE 'out_of_stock' END, ''::text, COUNT(*)::bigint
+FROM base
+WHERE match_category AND match_brand AND match_price AND match_rating
+GROUP BY 2
+`
+
+type GetProductFacetsParams struct {
+	CategoryIds     []uuid.UUID    `json:"category_ids"`
+	BrandSlug       sql.NullString `json:"brand_slug"`
+	MinPrice        string         `json:"min_price"`
+	MaxPrice        string         `json:"max_price"`
+	MinRating       string         `json:"min_rating"`
+	InStock         sql.NullBool   `json:"in_stock"`
+	Search          sql.NullString `json:"search"`
+	AttrCodes       []string       `json:"attr_codes"`
+	AttrValues      []string       `json:"attr_values"`
+	PriceBucketMins []string       `json:"price_bucket_mins"`
+	PriceBucketMaxs []string       `json:"price_bucket_maxs"`
+}
+
+type GetProductFacetsRow struct {
+	Facet string `json:"facet"`
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+func (q *Queries) GetProductFacets(ctx context.Context, arg GetProductFacetsParams) ([]GetProductFacetsRow, error) {
+	rows, err := q.query(ctx, q.getProductFacetsStmt, getProductFacets,
+		pq.Array(arg.CategoryIds),
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MinRating,
+		arg.InStock,
+		arg.Search,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		pq.Array(arg.PriceBucketMins),
+		pq.Array(arg.PriceBucketMaxs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProductFacetsRow
+	for rows.Next() {
+		var i GetProductFacetsRow
+		if err := rows.Scan(
+			&i.Facet,
+			&i.Key,
+			&i.Label,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductImportTargetBySKU = `-- name: GetProductImportTargetBySKU :one
+SELECT id, name, slug, deleted_at
+FROM products
+WHERE sku = $1
+FOR UPDATE
+`
+
+type GetProductImportTargetBySKURow struct {
+	ID        uuid.UUID    `json:"id"`
+	Name      string       `json:"name"`
+	Slug      string       `json:"slug"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+}
+
+func (q *Queries) GetProductImportTargetBySKU(ctx context.Context, sku sql.NullString) (GetProductImportTargetBySKURow, error) {
+	row := q.queryRow(ctx, q.getProductImportTargetBySKUStmt, getProductImportTargetBySKU, sku)
+	var i GetProductImportTargetBySKURow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Slug,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const getProductStockForUpdate = `-- name: GetProductStockForUpdate :one
+SELECT stock
+FROM products
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetProductStockForUpdate(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.queryRow(ctx, q.getProductStockForUpdateStmt, getProductStockForUpdate, id)
+	var stock int32
+	err := row.Scan(&stock)
+	return stock, err
+}
+
+const listBrandRefsBySlugs = `-- name: ListBrandRefsBySlugs :many
+SELECT id, slug
+FROM brands
+WHERE slug = ANY($1::text[])
+  AND deleted_at IS NULL
+`
+
+type ListBrandRefsBySlugsRow struct {
+	ID   uuid.UUID `json:"id"`
+	Slug string    `json:"slug"`
+}
+
+func (q *Queries) ListBrandRefsBySlugs(ctx context.Context, slugs []string) ([]ListBrandRefsBySlugsRow, error) {
+	rows, err := q.query(ctx, q.listBrandRefsBySlugsStmt, listBrandRefsBySlugs, pq.Array(slugs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBrandRefsBySlugsRow
+	for rows.Next() {
+		var i ListBrandRefsBySlugsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryRefsBySlugs = `-- name: ListCategoryRefsBySlugs :many
+SELECT id, slug
+FROM categories
+WHERE slug = ANY($1::text[])
+  AND deleted_at IS NULL
+`
+
+type ListCategoryRefsBySlugsRow struct {
+	ID   uuid.UUID `json:"id"`
+	Slug string    `json:"slug"`
+}
+
+func (q *Queries) ListCategoryRefsBySlugs(ctx context.Context, slugs []string) ([]ListCategoryRefsBySlugsRow, error) {
+	rows, err := q.query(ctx, q.listCategoryRefsBySlugsStmt, listCategoryRefsBySlugs, pq.Array(slugs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCategoryRefsBySlugsRow
+	for rows.Next() {
+		var i ListCategoryRefsBySlugsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExistingProductSKUs = `-- name: ListExistingProductSKUs :many
+SELECT sku::text AS sku, (deleted_at IS NOT NULL)::bool AS deleted
+FROM products
+WHERE sku = ANY($1::text[])
+`
+
+type ListExistingProductSKUsRow struct {
+	Sku     string `json:"sku"`
+	Deleted bool   `json:"deleted"`
+}
+
+func (q *Queries) ListExistingProductSKUs(ctx context.Context, skus []string) ([]ListExistingProductSKUsRow, error) {
+	rows, err := q.query(ctx, q.listExistingProductSKUsStmt, listExistingProductSKUs, pq.Array(skus))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExistingProductSKUsRow
+	for rows.Next() {
+		var i ListExistingProductSKUsRow
+		if err := rows.Scan(
+			&i.Sku,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProductsAdmin = `-- name: ListProductsAdmin :many
 SELECT
-    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id,
+    p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at,
     c.id AS category_id,
     c.name AS category_name,
     b.id AS brand_id,
@@ -272,26 +606,31 @@ type ListProductsAdminParams struct {
 }
 
 type ListProductsAdminRow struct {
-	ID            uuid.UUID      `json:"id"`
-	CategoryID    uuid.UUID      `json:"category_id"`
-	Name          string         `json:"name"`
-	Slug          string         `json:"slug"`
-	Description   sql.NullString `json:"description"`
-	Price         string         `json:"price"`
-	Stock         int32          `json:"stock"`
-	Sku           sql.NullString `json:"sku"`
-	ImageUrl      sql.NullString `json:"image_url"`
-	IsActive      sql.NullBool   `json:"is_active"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	DiscountPrice sql.NullString `json:"discount_price"`
-	BrandID       uuid.NullUUID  `json:"brand_id"`
-	CategoryID_2  uuid.UUID      `json:"category_id_2"`
-	CategoryName  string         `json:"category_name"`
-	BrandID_2     uuid.NullUUID  `json:"brand_id_2"`
-	BrandName     sql.NullString `json:"brand_name"`
-	TotalCount    int64          `json:"total_count"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryID_2      uuid.UUID      `json:"category_id_2"`
+	CategoryName      string         `json:"category_name"`
+	BrandID_2         uuid.NullUUID  `json:"brand_id_2"`
+	BrandName         sql.NullString `json:"brand_name"`
+	TotalCount        int64          `json:"total_count"`
 }
 
 func (q *Queries) ListProductsAdmin(ctx context.Context, arg ListProductsAdminParams) ([]ListProductsAdminRow, error) {
@@ -327,6 +666,11 @@ func (q *Queries) ListProductsAdmin(ctx context.Context, arg ListProductsAdminPa
 			&i.DeletedAt,
 			&i.DiscountPrice,
 			&i.BrandID,
+			&i.MaxQtyPerOrder,
+			&i.SearchVector,
+			&i.LowStockThreshold,
+			&i.Status,
+			&i.PublishAt,
 			&i.CategoryID_2,
 			&i.CategoryName,
 			&i.BrandID_2,
@@ -346,6 +690,69 @@ func (q *Queries) ListProductsAdmin(ctx context.Context, arg ListProductsAdminPa
 	return items, nil
 }
 
+const listProductsForExport = `-- name: ListProductsForExport :many
+SELECT
+  p.name,
+  p.sku,
+  b.slug AS brand_slug,
+  c.slug AS category_slug,
+  p.price,
+  p.discount_price,
+  p.stock,
+  p.description,
+  p.image_url
+FROM products p
+JOIN categories c ON p.category_id = c.id
+LEFT JOIN brands b ON p.brand_id = b.id
+WHERE p.deleted_at IS NULL
+ORDER BY p.created_at ASC, p.id ASC
+`
+
+type ListProductsForExportRow struct {
+	Name          string         `json:"name"`
+	Sku           sql.NullString `json:"sku"`
+	BrandSlug     sql.NullString `json:"brand_slug"`
+	CategorySlug  string         `json:"category_slug"`
+	Price         string         `json:"price"`
+	DiscountPrice sql.NullString `json:"discount_price"`
+	Stock         int32          `json:"stock"`
+	Description   sql.NullString `json:"description"`
+	ImageUrl      sql.NullString `json:"image_url"`
+}
+
+func (q *Queries) ListProductsForExport(ctx context.Context) ([]ListProductsForExportRow, error) {
+	rows, err := q.query(ctx, q.listProductsForExportStmt, listProductsForExport)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsForExportRow
+	for rows.Next() {
+		var i ListProductsForExportRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.Sku,
+			&i.BrandSlug,
+			&i.CategorySlug,
+			&i.Price,
+			&i.DiscountPrice,
+			&i.Stock,
+			&i.Description,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProductsForInternal = `-- name: ListProductsForInternal :many
 SELECT id, name, price
 FROM products
@@ -384,42 +791,46 @@ func (q *Queries) ListProductsForInternal(ctx context.Context) ([]ListProductsFo
 
 const listProductsPublic = `-- name: ListProductsPublic :many
 SELECT 
-  p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, 
+  p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at, 
   c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $4::text))
+    + word_similarity($4::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
   count(*) OVER() AS total_count
-FROM products p
+-- Semua filter listing publik ada di fungsi public_products (migration 000040)
+FROM public_products(
+  $3::uuid[],
+  $4::text,
+  $5::text,
+  $6::numeric,
+  $7::numeric,
+  $8::text[],
+  $9::text[],
+  $10::numeric,
+  $11::bool
+) p
 JOIN categories c ON p.category_id = c.id
-LEFT JOIN brands b ON p.brand_id = b.id
-WHERE 
-  p.deleted_at IS NULL
-  AND p.is_active = true
-  
-  -- Perbaikan filter kategori
-  AND (
-    COALESCE(cardinality($3::uuid[]), 0) = 0
-    OR p.category_id = ANY($3::uuid[])
-  )
-
-  AND (
-    $4::text IS NULL
-    OR p.name ILIKE '%' || $4::text || '%'
-  )
-
-  AND (
-    $5::text IS NULL
-    OR b.slug = $5::text
-  )
-
-  -- Pastikan casting aman
-  AND p.price >= $6::numeric
-  AND p.price <= $7::numeric
-
+-- Urutan selalu diakhiri id agar stabil antar halaman
 ORDER BY 
-  CASE WHEN LOWER($8::text) = 'newest' THEN p.created_at END DESC,
-  CASE WHEN LOWER($8::text) = 'oldest' THEN p.created_at END ASC,
-  CASE WHEN LOWER($8::text) = 'price_high' THEN p.price END DESC,
-  CASE WHEN LOWER($8::text) = 'price_low' THEN p.price END ASC,
-  p.created_at DESC
+  CASE WHEN LOWER($12::text) = 'relevance' THEN
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $4::text))
+    + word_similarity($4::text, p.name)
+  END DESC NULLS LAST,
+  CASE WHEN LOWER($12::text) = 'newest' THEN p.created_at END DESC,
+  CASE WHEN LOWER($12::text) = 'oldest' THEN p.created_at END ASC,
+  CASE WHEN LOWER($12::text) = 'price_high' THEN p.price END DESC,
+  CASE WHEN LOWER($12::text) = 'price_low' THEN p.price END ASC,
+  p.created_at DESC,
+  p.id DESC
 LIMIT $1 OFFSET $2
 `
 
@@ -431,27 +842,39 @@ type ListProductsPublicParams struct {
 	BrandSlug   sql.NullString `json:"brand_slug"`
 	MinPrice    string         `json:"min_price"`
 	MaxPrice    string         `json:"max_price"`
+	AttrCodes   []string       `json:"attr_codes"`
+	AttrValues  []string       `json:"attr_values"`
+	MinRating   string         `json:"min_rating"`
+	InStock     sql.NullBool   `json:"in_stock"`
 	SortBy      string         `json:"sort_by"`
 }
 
 type ListProductsPublicRow struct {
-	ID            uuid.UUID      `json:"id"`
-	CategoryID    uuid.UUID      `json:"category_id"`
-	Name          string         `json:"name"`
-	Slug          string         `json:"slug"`
-	Description   sql.NullString `json:"description"`
-	Price         string         `json:"price"`
-	Stock         int32          `json:"stock"`
-	Sku           sql.NullString `json:"sku"`
-	ImageUrl      sql.NullString `json:"image_url"`
-	IsActive      sql.NullBool   `json:"is_active"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	DiscountPrice sql.NullString `json:"discount_price"`
-	BrandID       uuid.NullUUID  `json:"brand_id"`
-	CategoryName  string         `json:"category_name"`
-	TotalCount    int64          `json:"total_count"`
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryName      string         `json:"category_name"`
+	SearchRank        float64        `json:"search_rank"`
+	NameHighlight     sql.NullString `json:"name_highlight"`
+	SearchSnippet     sql.NullString `json:"search_snippet"`
+	TotalCount        int64          `json:"total_count"`
 }
 
 func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublicParams) ([]ListProductsPublicRow, error) {
@@ -463,6 +886,10 @@ func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublic
 		arg.BrandSlug,
 		arg.MinPrice,
 		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
 		arg.SortBy,
 	)
 	if err != nil {
@@ -488,7 +915,15 @@ func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublic
 			&i.DeletedAt,
 			&i.DiscountPrice,
 			&i.BrandID,
+			&i.MaxQtyPerOrder,
+			&i.SearchVector,
+			&i.LowStockThreshold,
+			&i.Status,
+			&i.PublishAt,
 			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -504,22 +939,973 @@ func (q *Queries) ListProductsPublic(ctx context.Context, arg ListProductsPublic
 	return items, nil
 }
 
-const restoreProduct = `-- name: RestoreProduct :one
-UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id
+const listProductsPublicBestSelling = `-- name: ListProductsPublicBestSelling :many
+SELECT 
+  p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at, 
+  c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $4::text))
+    + word_similarity($4::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
+  count(*) OVER() AS total_count
+FROM public_products(
+  $3::uuid[],
+  $4::text,
+  $5::text,
+  $6::numeric,
+  $7::numeric,
+  $8::text[],
+  $9::text[],
+  $10::numeric,
+  $11::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- INNER JOIN: produk baru ikut setelah refresh worker berikutnya
+JOIN product_stats ps ON ps.product_id = p.id
+-- Urutan mengikuti index idx_product_stats_*, diakhiri id agar stabil antar halaman
+ORDER BY ps.sold_count DESC, p.id
+LIMIT $1 OFFSET $2
 `
 
-func (q *Queries) RestoreProduct(ctx context.Context, id uuid.UUID) (Product, error) {
-	row := q.queryRow(ctx, q.restoreProductStmt, restoreProduct, id)
-	var i Product
-	err := row.Scan(
-		&i.ID,
-		&i.CategoryID,
-		&i.Name,
-		&i.Slug,
-		&i.Description,
-		&i.Price,
-		&i.Stock,
-		&i.Sku,
+type ListProductsPublicBestSellingParams struct {
+	Limit       int32          `json:"limit"`
+	Offset      int32          `json:"offset"`
+	CategoryIds []uuid.UUID    `json:"category_ids"`
+	Search      sql.NullString `json:"search"`
+	BrandSlug   sql.NullString `json:"brand_slug"`
+	MinPrice    string         `json:"min_price"`
+	MaxPrice    string         `json:"max_price"`
+	AttrCodes   []string       `json:"attr_codes"`
+	AttrValues  []string       `json:"attr_values"`
+	MinRating   string         `json:"min_rating"`
+	InStock     sql.NullBool   `json:"in_stock"`
+}
+
+type ListProductsPublicBestSellingRow struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryName      string         `json:"category_name"`
+	SearchRank        float64        `json:"search_rank"`
+	NameHighlight     sql.NullString `json:"name_highlight"`
+	SearchSnippet     sql.NullString `json:"search_snippet"`
+	TotalCount        int64          `json:"total_count"`
+}
+
+func (q *Queries) ListProductsPublicBestSelling(ctx context.Context, arg ListProductsPublicBestSellingParams) ([]ListProductsPublicBestSellingRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicBestSellingStmt, listProductsPublicBestSelling,
+		arg.Limit,
+		arg.Offset,
+		pq.Array(arg.CategoryIds),
+		arg.Search,
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicBestSellingRow
+	for rows.Next() {
+		var i ListProductsPublicBestSellingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.Sku,
+			&i.ImageUrl,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DiscountPrice,
+			&i.BrandID,
+			&i.MaxQtyPerOrder,
+			&i.SearchVector,
+			&i.LowStockThreshold,
+			&i.Status,
+			&i.PublishAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsPublicByCreatedAsc = `-- name: ListProductsPublicByCreatedAsc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $2::text))
+    + word_similarity($2::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  $3::uuid[],
+  $2::text,
+  $4::text,
+  $5::numeric,
+  $6::numeric,
+  $7::text[],
+  $8::text[],
+  $9::numeric,
+  $10::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.created_at, p.id) > ($11::timestamp, $12::uuid)
+ORDER BY p.created_at ASC, p.id ASC
+LIMIT $1
+`
+
+type ListProductsPublicByCreatedAscParams struct {
+	Limit           int32          `json:"limit"`
+	Search          sql.NullString `json:"search"`
+	CategoryIds     []uuid.UUID    `json:"category_ids"`
+	BrandSlug       sql.NullString `json:"brand_slug"`
+	MinPrice        string         `json:"min_price"`
+	MaxPrice        string         `json:"max_price"`
+	AttrCodes       []string       `json:"attr_codes"`
+	AttrValues      []string       `json:"attr_values"`
+	MinRating       string         `json:"min_rating"`
+	InStock         sql.NullBool   `json:"in_stock"`
+	CursorCreatedAt time.Time      `json:"cursor_created_at"`
+	CursorID        uuid.UUID      `json:"cursor_id"`
+}
+
+type ListProductsPublicByCreatedAscRow struct {
+	ID            uuid.UUID      `json:"id"`
+	CategoryID    uuid.UUID      `json:"category_id"`
+	Name          string         `json:"name"`
+	Slug          string         `json:"slug"`
+	Price         string         `json:"price"`
+	ImageUrl      sql.NullString `json:"image_url"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CategoryName  string         `json:"category_name"`
+	SearchRank    float64        `json:"search_rank"`
+	NameHighlight sql.NullString `json:"name_highlight"`
+	SearchSnippet sql.NullString `json:"search_snippet"`
+}
+
+func (q *Queries) ListProductsPublicByCreatedAsc(ctx context.Context, arg ListProductsPublicByCreatedAscParams) ([]ListProductsPublicByCreatedAscRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicByCreatedAscStmt, listProductsPublicByCreatedAsc,
+		arg.Limit,
+		arg.Search,
+		pq.Array(arg.CategoryIds),
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicByCreatedAscRow
+	for rows.Next() {
+		var i ListProductsPublicByCreatedAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Price,
+			&i.ImageUrl,
+			&i.CreatedAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsPublicByCreatedDesc = `-- name: ListProductsPublicByCreatedDesc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $2::text))
+    + word_similarity($2::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  $3::uuid[],
+  $2::text,
+  $4::text,
+  $5::numeric,
+  $6::numeric,
+  $7::text[],
+  $8::text[],
+  $9::numeric,
+  $10::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.created_at, p.id) < ($11::timestamp, $12::uuid)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $1
+`
+
+type ListProductsPublicByCreatedDescParams struct {
+	Limit           int32          `json:"limit"`
+	Search          sql.NullString `json:"search"`
+	CategoryIds     []uuid.UUID    `json:"category_ids"`
+	BrandSlug       sql.NullString `json:"brand_slug"`
+	MinPrice        string         `json:"min_price"`
+	MaxPrice        string         `json:"max_price"`
+	AttrCodes       []string       `json:"attr_codes"`
+	AttrValues      []string       `json:"attr_values"`
+	MinRating       string         `json:"min_rating"`
+	InStock         sql.NullBool   `json:"in_stock"`
+	CursorCreatedAt time.Time      `json:"cursor_created_at"`
+	CursorID        uuid.UUID      `json:"cursor_id"`
+}
+
+type ListProductsPublicByCreatedDescRow struct {
+	ID            uuid.UUID      `json:"id"`
+	CategoryID    uuid.UUID      `json:"category_id"`
+	Name          string         `json:"name"`
+	Slug          string         `json:"slug"`
+	Price         string         `json:"price"`
+	ImageUrl      sql.NullString `json:"image_url"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CategoryName  string         `json:"category_name"`
+	SearchRank    float64        `json:"search_rank"`
+	NameHighlight sql.NullString `json:"name_highlight"`
+	SearchSnippet sql.NullString `json:"search_snippet"`
+}
+
+func (q *Queries) ListProductsPublicByCreatedDesc(ctx context.Context, arg ListProductsPublicByCreatedDescParams) ([]ListProductsPublicByCreatedDescRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicByCreatedDescStmt, listProductsPublicByCreatedDesc,
+		arg.Limit,
+		arg.Search,
+		pq.Array(arg.CategoryIds),
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicByCreatedDescRow
+	for rows.Next() {
+		var i ListProductsPublicByCreatedDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Price,
+			&i.ImageUrl,
+			&i.CreatedAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsPublicByPriceAsc = `-- name: ListProductsPublicByPriceAsc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $2::text))
+    + word_similarity($2::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  $3::uuid[],
+  $2::text,
+  $4::text,
+  $5::numeric,
+  $6::numeric,
+  $7::text[],
+  $8::text[],
+  $9::numeric,
+  $10::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.price, p.created_at, p.id) > ($11::numeric, $12::timestamp, $13::uuid)
+ORDER BY p.price ASC, p.created_at ASC, p.id ASC
+LIMIT $1
+`
+
+type ListProductsPublicByPriceAscParams struct {
+	Limit           int32          `json:"limit"`
+	Search          sql.NullString `json:"search"`
+	CategoryIds     []uuid.UUID    `json:"category_ids"`
+	BrandSlug       sql.NullString `json:"brand_slug"`
+	MinPrice        string         `json:"min_price"`
+	MaxPrice        string         `json:"max_price"`
+	AttrCodes       []string       `json:"attr_codes"`
+	AttrValues      []string       `json:"attr_values"`
+	MinRating       string         `json:"min_rating"`
+	InStock         sql.NullBool   `json:"in_stock"`
+	CursorPrice     string         `json:"cursor_price"`
+	CursorCreatedAt time.Time      `json:"cursor_created_at"`
+	CursorID        uuid.UUID      `json:"cursor_id"`
+}
+
+type ListProductsPublicByPriceAscRow struct {
+	ID            uuid.UUID      `json:"id"`
+	CategoryID    uuid.UUID      `json:"category_id"`
+	Name          string         `json:"name"`
+	Slug          string         `json:"slug"`
+	Price         string         `json:"price"`
+	ImageUrl      sql.NullString `json:"image_url"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CategoryName  string         `json:"category_name"`
+	SearchRank    float64        `json:"search_rank"`
+	NameHighlight sql.NullString `json:"name_highlight"`
+	SearchSnippet sql.NullString `json:"search_snippet"`
+}
+
+func (q *Queries) ListProductsPublicByPriceAsc(ctx context.Context, arg ListProductsPublicByPriceAscParams) ([]ListProductsPublicByPriceAscRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicByPriceAscStmt, listProductsPublicByPriceAsc,
+		arg.Limit,
+		arg.Search,
+		pq.Array(arg.CategoryIds),
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+		arg.CursorPrice,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicByPriceAscRow
+	for rows.Next() {
+		var i ListProductsPublicByPriceAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Price,
+			&i.ImageUrl,
+			&i.CreatedAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsPublicByPriceDesc = `-- name: ListProductsPublicByPriceDesc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $2::text))
+    + word_similarity($2::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $2::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  $3::uuid[],
+  $2::text,
+  $4::text,
+  $5::numeric,
+  $6::numeric,
+  $7::text[],
+  $8::text[],
+  $9::numeric,
+  $10::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.price, p.created_at, p.id) < ($11::numeric, $12::timestamp, $13::uuid)
+ORDER BY p.price DESC, p.created_at DESC, p.id DESC
+LIMIT $1
+`
+
+type ListProductsPublicByPriceDescParams struct {
+	Limit           int32          `json:"limit"`
+	Search          sql.NullString `json:"search"`
+	CategoryIds     []uuid.UUID    `json:"category_ids"`
+	BrandSlug       sql.NullString `json:"brand_slug"`
+	MinPrice        string         `json:"min_price"`
+	MaxPrice        string         `json:"max_price"`
+	AttrCodes       []string       `json:"attr_codes"`
+	AttrValues      []string       `json:"attr_values"`
+	MinRating       string         `json:"min_rating"`
+	InStock         sql.NullBool   `json:"in_stock"`
+	CursorPrice     string         `json:"cursor_price"`
+	CursorCreatedAt time.Time      `json:"cursor_created_at"`
+	CursorID        uuid.UUID      `json:"cursor_id"`
+}
+
+type ListProductsPublicByPriceDescRow struct {
+	ID            uuid.UUID      `json:"id"`
+	CategoryID    uuid.UUID      `json:"category_id"`
+	Name          string         `json:"name"`
+	Slug          string         `json:"slug"`
+	Price         string         `json:"price"`
+	ImageUrl      sql.NullString `json:"image_url"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CategoryName  string         `json:"category_name"`
+	SearchRank    float64        `json:"search_rank"`
+	NameHighlight sql.NullString `json:"name_highlight"`
+	SearchSnippet sql.NullString `json:"search_snippet"`
+}
+
+func (q *Queries) ListProductsPublicByPriceDesc(ctx context.Context, arg ListProductsPublicByPriceDescParams) ([]ListProductsPublicByPriceDescRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicByPriceDescStmt, listProductsPublicByPriceDesc,
+		arg.Limit,
+		arg.Search,
+		pq.Array(arg.CategoryIds),
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+		arg.CursorPrice,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicByPriceDescRow
+	for rows.Next() {
+		var i ListProductsPublicByPriceDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Price,
+			&i.ImageUrl,
+			&i.CreatedAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsPublicTopRated = `-- name: ListProductsPublicTopRated :many
+SELECT 
+  p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at, 
+  c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $4::text))
+    + word_similarity($4::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
+  count(*) OVER() AS total_count
+FROM public_products(
+  $3::uuid[],
+  $4::text,
+  $5::text,
+  $6::numeric,
+  $7::numeric,
+  $8::text[],
+  $9::text[],
+  $10::numeric,
+  $11::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- INNER JOIN: produk baru ikut setelah refresh worker berikutnya
+JOIN product_stats ps ON ps.product_id = p.id
+-- Urutan mengikuti index idx_product_stats_*, diakhiri id agar stabil antar halaman
+ORDER BY ps.rating_avg DESC, ps.rating_count DESC, p.id
+LIMIT $1 OFFSET $2
+`
+
+type ListProductsPublicTopRatedParams struct {
+	Limit       int32          `json:"limit"`
+	Offset      int32          `json:"offset"`
+	CategoryIds []uuid.UUID    `json:"category_ids"`
+	Search      sql.NullString `json:"search"`
+	BrandSlug   sql.NullString `json:"brand_slug"`
+	MinPrice    string         `json:"min_price"`
+	MaxPrice    string         `json:"max_price"`
+	AttrCodes   []string       `json:"attr_codes"`
+	AttrValues  []string       `json:"attr_values"`
+	MinRating   string         `json:"min_rating"`
+	InStock     sql.NullBool   `json:"in_stock"`
+}
+
+type ListProductsPublicTopRatedRow struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryName      string         `json:"category_name"`
+	SearchRank        float64        `json:"search_rank"`
+	NameHighlight     sql.NullString `json:"name_highlight"`
+	SearchSnippet     sql.NullString `json:"search_snippet"`
+	TotalCount        int64          `json:"total_count"`
+}
+
+func (q *Queries) ListProductsPublicTopRated(ctx context.Context, arg ListProductsPublicTopRatedParams) ([]ListProductsPublicTopRatedRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicTopRatedStmt, listProductsPublicTopRated,
+		arg.Limit,
+		arg.Offset,
+		pq.Array(arg.CategoryIds),
+		arg.Search,
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicTopRatedRow
+	for rows.Next() {
+		var i ListProductsPublicTopRatedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.Sku,
+			&i.ImageUrl,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DiscountPrice,
+			&i.BrandID,
+			&i.MaxQtyPerOrder,
+			&i.SearchVector,
+			&i.LowStockThreshold,
+			&i.Status,
+			&i.PublishAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsPublicTrending = `-- name: ListProductsPublicTrending :many
+SELECT 
+  p.id, p.category_id, p.name, p.slug, p.description, p.price, p.stock, p.sku, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.discount_price, p.brand_id, p.max_qty_per_order, p.search_vector, p.low_stock_threshold, p.status, p.publish_at, 
+  c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', $4::text))
+    + word_similarity($4::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', $4::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
+  count(*) OVER() AS total_count
+FROM public_products(
+  $3::uuid[],
+  $4::text,
+  $5::text,
+  $6::numeric,
+  $7::numeric,
+  $8::text[],
+  $9::text[],
+  $10::numeric,
+  $11::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- INNER JOIN: produk baru ikut setelah refresh worker berikutnya
+JOIN product_stats ps ON ps.product_id = p.id
+-- Urutan mengikuti index idx_product_stats_*, diakhiri id agar stabil antar halaman
+ORDER BY ps.trending_score DESC, p.id
+LIMIT $1 OFFSET $2
+`
+
+type ListProductsPublicTrendingParams struct {
+	Limit       int32          `json:"limit"`
+	Offset      int32          `json:"offset"`
+	CategoryIds []uuid.UUID    `json:"category_ids"`
+	Search      sql.NullString `json:"search"`
+	BrandSlug   sql.NullString `json:"brand_slug"`
+	MinPrice    string         `json:"min_price"`
+	MaxPrice    string         `json:"max_price"`
+	AttrCodes   []string       `json:"attr_codes"`
+	AttrValues  []string       `json:"attr_values"`
+	MinRating   string         `json:"min_rating"`
+	InStock     sql.NullBool   `json:"in_stock"`
+}
+
+type ListProductsPublicTrendingRow struct {
+	ID                uuid.UUID      `json:"id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Slug              string         `json:"slug"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Stock             int32          `json:"stock"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         sql.NullTime   `json:"deleted_at"`
+	DiscountPrice     sql.NullString `json:"discount_price"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	SearchVector      interface{}    `json:"search_vector"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Status            string         `json:"status"`
+	PublishAt         sql.NullTime   `json:"publish_at"`
+	CategoryName      string         `json:"category_name"`
+	SearchRank        float64        `json:"search_rank"`
+	NameHighlight     sql.NullString `json:"name_highlight"`
+	SearchSnippet     sql.NullString `json:"search_snippet"`
+	TotalCount        int64          `json:"total_count"`
+}
+
+func (q *Queries) ListProductsPublicTrending(ctx context.Context, arg ListProductsPublicTrendingParams) ([]ListProductsPublicTrendingRow, error) {
+	rows, err := q.query(ctx, q.listProductsPublicTrendingStmt, listProductsPublicTrending,
+		arg.Limit,
+		arg.Offset,
+		pq.Array(arg.CategoryIds),
+		arg.Search,
+		arg.BrandSlug,
+		arg.MinPrice,
+		arg.MaxPrice,
+		pq.Array(arg.AttrCodes),
+		pq.Array(arg.AttrValues),
+		arg.MinRating,
+		arg.InStock,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductsPublicTrendingRow
+	for rows.Next() {
+		var i ListProductsPublicTrendingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Slug,
+			&i.Description,
+			&i.Price,
+			&i.Stock,
+			&i.Sku,
+			&i.ImageUrl,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DiscountPrice,
+			&i.BrandID,
+			&i.MaxQtyPerOrder,
+			&i.SearchVector,
+			&i.LowStockThreshold,
+			&i.Status,
+			&i.PublishAt,
+			&i.CategoryName,
+			&i.SearchRank,
+			&i.NameHighlight,
+			&i.SearchSnippet,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishDueProducts = `-- name: PublishDueProducts :execrows
+UPDATE products
+SET status = 'PUBLISHED', updated_at = NOW()
+WHERE status = 'SCHEDULED'
+  AND publish_at <= $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) PublishDueProducts(ctx context.Context, publishAt sql.NullTime) (int64, error) {
+	result, err := q.exec(ctx, q.publishDueProductsStmt, publishDueProducts, publishAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreProduct = `-- name: RestoreProduct :one
+UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id, max_qty_per_order, search_vector, low_stock_threshold, status, publish_at
+`
+
+func (q *Queries) RestoreProduct(ctx context.Context, id uuid.UUID) (Product, error) {
+	row := q.queryRow(ctx, q.restoreProductStmt, restoreProduct, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Slug,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.Sku,
+		&i.ImageUrl,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DiscountPrice,
+		&i.BrandID,
+		&i.MaxQtyPerOrder,
+		&i.SearchVector,
+		&i.LowStockThreshold,
+		&i.Status,
+		&i.PublishAt,
+	)
+	return i, err
+}
+
+const setProductImageURL = `-- name: SetProductImageURL :exec
+UPDATE products
+SET image_url = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type SetProductImageURLParams struct {
+	ID       uuid.UUID      `json:"id"`
+	ImageUrl sql.NullString `json:"image_url"`
+}
+
+func (q *Queries) SetProductImageURL(ctx context.Context, arg SetProductImageURLParams) error {
+	_, err := q.exec(ctx, q.setProductImageURLStmt, setProductImageURL, arg.ID, arg.ImageUrl)
+	return err
+}
+
+const setProductPublication = `-- name: SetProductPublication :one
+UPDATE products
+SET status = $2, publish_at = $3, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id, max_qty_per_order, search_vector, low_stock_threshold, status, publish_at
+`
+
+type SetProductPublicationParams struct {
+	ID        uuid.UUID    `json:"id"`
+	Status    string       `json:"status"`
+	PublishAt sql.NullTime `json:"publish_at"`
+}
+
+func (q *Queries) SetProductPublication(ctx context.Context, arg SetProductPublicationParams) (Product, error) {
+	row := q.queryRow(ctx, q.setProductPublicationStmt, setProductPublication, arg.ID, arg.Status, arg.PublishAt)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Slug,
+		&i.Description,
+		&i.Price,
+		&i.Stock,
+		&i.Sku,
 		&i.ImageUrl,
 		&i.IsActive,
 		&i.CreatedAt,
@@ -527,6 +1913,11 @@ func (q *Queries) RestoreProduct(ctx context.Context, id uuid.UUID) (Product, er
 		&i.DeletedAt,
 		&i.DiscountPrice,
 		&i.BrandID,
+		&i.MaxQtyPerOrder,
+		&i.SearchVector,
+		&i.LowStockThreshold,
+		&i.Status,
+		&i.PublishAt,
 	)
 	return i, err
 }
@@ -540,6 +1931,82 @@ func (q *Queries) SoftDeleteProduct(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const suggestSearchTerms = `-- name: SuggestSearchTerms :many
+(
+  SELECT 'product'::text AS kind, p.name, p.slug,
+    word_similarity($1::text, p.name)::float8 AS score
+  FROM products p
+  WHERE p.deleted_at IS NULL
+    AND p.is_active = true
+    AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW()))
+    AND (p.name ILIKE $1::text || '%' OR $1::text <% p.name)
+  ORDER BY (p.name ILIKE $1::text || '%') DESC, score DESC, p.name
+  LIMIT $2::int
+)
+UNION ALL
+(
+  SELECT 'brand'::text AS kind, b.name, b.slug,
+    word_similarity($1::text, b.name)::float8 AS score
+  FROM brands b
+  WHERE b.deleted_at IS NULL
+    AND b.is_active = true
+    AND (b.name ILIKE $1::text || '%' OR $1::text <% b.name)
+  ORDER BY (b.name ILIKE $1::text || '%') DESC, score DESC, b.name
+  LIMIT $2::int
+)
+UNION ALL
+(
+  SELECT 'category'::text AS kind, c.name, c.slug,
+    word_similarity($1::text, c.name)::float8 AS score
+  FROM categories c
+  WHERE c.deleted_at IS NULL
+    AND c.is_active = true
+    AND (c.name ILIKE $1::text || '%' OR $1::text <% c.name)
+  ORDER BY (c.name ILIKE $1::text || '%') DESC, score DESC, c.name
+  LIMIT $2::int
+)
+`
+
+type SuggestSearchTermsParams struct {
+	Query        string `json:"query"`
+	LimitPerKind int32  `json:"limit_per_kind"`
+}
+
+type SuggestSearchTermsRow struct {
+	Kind  string  `json:"kind"`
+	Name  string  `json:"name"`
+	Slug  string  `json:"slug"`
+	Score float64 `json:"score"`
+}
+
+func (q *Queries) SuggestSearchTerms(ctx context.Context, arg SuggestSearchTermsParams) ([]SuggestSearchTermsRow, error) {
+	rows, err := q.query(ctx, q.suggestSearchTermsStmt, suggestSearchTerms, arg.Query, arg.LimitPerKind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SuggestSearchTermsRow
+	for rows.Next() {
+		var i SuggestSearchTermsRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Name,
+			&i.Slug,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateProduct = `-- name: UpdateProduct :one
 UPDATE products
 SET 
@@ -548,26 +2015,30 @@ SET
     name = $4,
     description = $5,
     price = $6,
-    stock = $7,
-    sku = $8,
-    image_url = $9,
-    is_active = $10,
+    sku = $7,
+    image_url = $8,
+    is_active = $9,
+    max_qty_per_order = $10,
+    low_stock_threshold = $11,
+    slug = $12,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id
+RETURNING id, category_id, name, slug, description, price, stock, sku, image_url, is_active, created_at, updated_at, deleted_at, discount_price, brand_id, max_qty_per_order, search_vector, low_stock_threshold, status, publish_at
 `
 
 type UpdateProductParams struct {
-	ID          uuid.UUID      `json:"id"`
-	BrandID     uuid.NullUUID  `json:"brand_id"`
-	CategoryID  uuid.UUID      `json:"category_id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
-	Price       string         `json:"price"`
-	Stock       int32          `json:"stock"`
-	Sku         sql.NullString `json:"sku"`
-	ImageUrl    sql.NullString `json:"image_url"`
-	IsActive    sql.NullBool   `json:"is_active"`
+	ID                uuid.UUID      `json:"id"`
+	BrandID           uuid.NullUUID  `json:"brand_id"`
+	CategoryID        uuid.UUID      `json:"category_id"`
+	Name              string         `json:"name"`
+	Description       sql.NullString `json:"description"`
+	Price             string         `json:"price"`
+	Sku               sql.NullString `json:"sku"`
+	ImageUrl          sql.NullString `json:"image_url"`
+	IsActive          sql.NullBool   `json:"is_active"`
+	MaxQtyPerOrder    sql.NullInt32  `json:"max_qty_per_order"`
+	LowStockThreshold int32          `json:"low_stock_threshold"`
+	Slug              string         `json:"slug"`
 }
 
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (Product, error) {
@@ -578,10 +2049,12 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		arg.Name,
 		arg.Description,
 		arg.Price,
-		arg.Stock,
 		arg.Sku,
 		arg.ImageUrl,
 		arg.IsActive,
+		arg.MaxQtyPerOrder,
+		arg.LowStockThreshold,
+		arg.Slug,
 	)
 	var i Product
 	err := row.Scan(
@@ -600,6 +2073,78 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		&i.DeletedAt,
 		&i.DiscountPrice,
 		&i.BrandID,
+		&i.MaxQtyPerOrder,
+		&i.SearchVector,
+		&i.LowStockThreshold,
+		&i.Status,
+		&i.PublishAt,
+	)
+	return i, err
+}
+
+const upsertProductBySKU = `-- name: UpsertProductBySKU :one
+INSERT INTO products (brand_id, category_id, name, slug, description, price, discount_price, sku, image_url)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9
+)
+ON CONFLICT (sku) DO UPDATE
+SET brand_id = EXCLUDED.brand_id,
+    category_id = EXCLUDED.category_id,
+    name = EXCLUDED.name,
+    slug = EXCLUDED.slug,
+    description = EXCLUDED.description,
+    price = EXCLUDED.price,
+    discount_price = EXCLUDED.discount_price,
+    image_url = COALESCE(EXCLUDED.image_url, products.image_url),
+    updated_at = NOW()
+-- produk yang sudah dihapus tidak dihidupkan lagi lewat import (tidak ada baris yang dikembalikan)
+WHERE products.deleted_at IS NULL
+RETURNING id, stock, (xmax = 0)::bool AS inserted
+`
+
+type UpsertProductBySKUParams struct {
+	BrandID       uuid.NullUUID  `json:"brand_id"`
+	CategoryID    uuid.UUID      `json:"category_id"`
+	Name          string         `json:"name"`
+	Slug          string         `json:"slug"`
+	Description   sql.NullString `json:"description"`
+	Price         string         `json:"price"`
+	DiscountPrice sql.NullString `json:"discount_price"`
+	Sku           sql.NullString `json:"sku"`
+	ImageUrl      sql.NullString `json:"image_url"`
+}
+
+type UpsertProductBySKURow struct {
+	ID       uuid.UUID `json:"id"`
+	Stock    int32     `json:"stock"`
+	Inserted bool      `json:"inserted"`
+}
+
+func (q *Queries) UpsertProductBySKU(ctx context.Context, arg UpsertProductBySKUParams) (UpsertProductBySKURow, error) {
+	row := q.queryRow(ctx, q.upsertProductBySKUStmt, upsertProductBySKU,
+		arg.BrandID,
+		arg.CategoryID,
+		arg.Name,
+		arg.Slug,
+		arg.Description,
+		arg.Price,
+		arg.DiscountPrice,
+		arg.Sku,
+		arg.ImageUrl,
+	)
+	var i UpsertProductBySKURow
+	err := row.Scan(
+		&i.ID,
+		&i.Stock,
+		&i.Inserted,
 	)
 	return i, err
 }
@@ -274,6 +274,149 @@ func (q *Queries) GetReviewsByProductID(ctx context.Context, arg GetReviewsByPro
 	return items, nil
 }
 
+const getReviewsByProductIDCreatedAsc = `-- name: GetReviewsByProductIDCreatedAsc :many
+SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, u.name as user_name
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+WHERE r.product_id = $2 AND r.deleted_at IS NULL
+  AND (r.created_at, r.id) > ($3::timestamp, $4::uuid)
+ORDER BY r.created_at ASC, r.id ASC
+LIMIT $1
+`
+
+type GetReviewsByProductIDCreatedAscParams struct {
+	Limit           int32     `json:"limit"`
+	ProductID       uuid.UUID `json:"product_id"`
+	CursorCreatedAt time.Time `json:"cursor_created_at"`
+	CursorID        uuid.UUID `json:"cursor_id"`
+}
+
+type GetReviewsByProductIDCreatedAscRow struct {
+	ID                 uuid.UUID    `json:"id"`
+	UserID             uuid.UUID    `json:"user_id"`
+	ProductID          uuid.UUID    `json:"product_id"`
+	OrderID            uuid.UUID    `json:"order_id"`
+	Rating             int32        `json:"rating"`
+	Comment            string       `json:"comment"`
+	IsVerifiedPurchase bool         `json:"is_verified_purchase"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+	DeletedAt          sql.NullTime `json:"deleted_at"`
+	UserName           string       `json:"user_name"`
+}
+
+func (q *Queries) GetReviewsByProductIDCreatedAsc(ctx context.Context, arg GetReviewsByProductIDCreatedAscParams) ([]GetReviewsByProductIDCreatedAscRow, error) {
+	rows, err := q.query(ctx, q.getReviewsByProductIDCreatedAscStmt, getReviewsByProductIDCreatedAsc,
+		arg.Limit,
+		arg.ProductID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewsByProductIDCreatedAscRow
+	for rows.Next() {
+		var i GetReviewsByProductIDCreatedAscRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.OrderID,
+			&i.Rating,
+			&i.Comment,
+			&i.IsVerifiedPurchase,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewsByProductIDCreatedDesc = `-- name: GetReviewsByProductIDCreatedDesc :many
+SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, u.name as user_name
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+WHERE r.product_id = $2 AND r.deleted_at IS NULL
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (r.created_at, r.id) < ($3::timestamp, $4::uuid)
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $1
+`
+
+type GetReviewsByProductIDCreatedDescParams struct {
+	Limit           int32     `json:"limit"`
+	ProductID       uuid.UUID `json:"product_id"`
+	CursorCreatedAt time.Time `json:"cursor_created_at"`
+	CursorID        uuid.UUID `json:"cursor_id"`
+}
+
+type GetReviewsByProductIDCreatedDescRow struct {
+	ID                 uuid.UUID    `json:"id"`
+	UserID             uuid.UUID    `json:"user_id"`
+	ProductID          uuid.UUID    `json:"product_id"`
+	OrderID            uuid.UUID    `json:"order_id"`
+	Rating             int32        `json:"rating"`
+	Comment            string       `json:"comment"`
+	IsVerifiedPurchase bool         `json:"is_verified_purchase"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+	DeletedAt          sql.NullTime `json:"deleted_at"`
+	UserName           string       `json:"user_name"`
+}
+
+func (q *Queries) GetReviewsByProductIDCreatedDesc(ctx context.Context, arg GetReviewsByProductIDCreatedDescParams) ([]GetReviewsByProductIDCreatedDescRow, error) {
+	rows, err := q.query(ctx, q.getReviewsByProductIDCreatedDescStmt, getReviewsByProductIDCreatedDesc,
+		arg.Limit,
+		arg.ProductID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewsByProductIDCreatedDescRow
+	for rows.Next() {
+		var i GetReviewsByProductIDCreatedDescRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.OrderID,
+			&i.Rating,
+			&i.Comment,
+			&i.IsVerifiedPurchase,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getReviewsByUserID = `-- name: GetReviewsByUserID :many
 SELECT r.id, r.user_id, r.product_id, r.order_id, r.rating, r.comment, r.is_verified_purchase, r.created_at, r.updated_at, r.deleted_at, p.name as product_name, p.slug as product_slug
 FROM reviews r
@@ -150,6 +150,47 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (GetUserByIDRow
 	return i, err
 }
 
+const listActiveAdmins = `-- name: ListActiveAdmins :many
+SELECT id, name, email
+FROM users
+WHERE role IN ('ADMIN', 'SUPERADMIN')
+  AND is_active = true
+ORDER BY created_at ASC
+`
+
+type ListActiveAdminsRow struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+}
+
+func (q *Queries) ListActiveAdmins(ctx context.Context) ([]ListActiveAdminsRow, error) {
+	rows, err := q.query(ctx, q.listActiveAdminsStmt, listActiveAdmins)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveAdminsRow
+	for rows.Next() {
+		var i ListActiveAdminsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCustomers = `-- name: ListCustomers :many
 SELECT 
     id, 
@@ -15,18 +15,19 @@ import (
 )
 
 const addWishlistItem = `-- name: AddWishlistItem :exec
-INSERT INTO wishlist_items (wishlist_id, product_id)
-VALUES ($1, $2)
-ON CONFLICT (wishlist_id, product_id) DO NOTHING
+INSERT INTO wishlist_items (wishlist_id, product_id, variant_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (wishlist_id, product_id, variant_id) DO NOTHING
 `
 
 type AddWishlistItemParams struct {
-	WishlistID uuid.UUID `json:"wishlist_id"`
-	ProductID  uuid.UUID `json:"product_id"`
+	WishlistID uuid.UUID     `json:"wishlist_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) AddWishlistItem(ctx context.Context, arg AddWishlistItemParams) error {
-	_, err := q.exec(ctx, q.addWishlistItemStmt, addWishlistItem, arg.WishlistID, arg.ProductID)
+	_, err := q.exec(ctx, q.addWishlistItemStmt, addWishlistItem, arg.WishlistID, arg.ProductID, arg.VariantID)
 	return err
 }
 
@@ -34,16 +35,18 @@ const checkWishlistItemExists = `-- name: CheckWishlistItemExists :one
 SELECT EXISTS(
     SELECT 1 FROM wishlist_items
     WHERE wishlist_id = $1 AND product_id = $2
+      AND variant_id IS NOT DISTINCT FROM $3
 ) AS exists
 `
 
 type CheckWishlistItemExistsParams struct {
-	WishlistID uuid.UUID `json:"wishlist_id"`
-	ProductID  uuid.UUID `json:"product_id"`
+	WishlistID uuid.UUID     `json:"wishlist_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) CheckWishlistItemExists(ctx context.Context, arg CheckWishlistItemExistsParams) (bool, error) {
-	row := q.queryRow(ctx, q.checkWishlistItemExistsStmt, checkWishlistItemExists, arg.WishlistID, arg.ProductID)
+	row := q.queryRow(ctx, q.checkWishlistItemExistsStmt, checkWishlistItemExists, arg.WishlistID, arg.ProductID, arg.VariantID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -52,15 +55,17 @@ func (q *Queries) CheckWishlistItemExists(ctx context.Context, arg CheckWishlist
 const deleteWishlistItem = `-- name: DeleteWishlistItem :exec
 DELETE FROM wishlist_items
 WHERE wishlist_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3
 `
 
 type DeleteWishlistItemParams struct {
-	WishlistID uuid.UUID `json:"wishlist_id"`
-	ProductID  uuid.UUID `json:"product_id"`
+	WishlistID uuid.UUID     `json:"wishlist_id"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	VariantID  uuid.NullUUID `json:"variant_id"`
 }
 
 func (q *Queries) DeleteWishlistItem(ctx context.Context, arg DeleteWishlistItemParams) error {
-	_, err := q.exec(ctx, q.deleteWishlistItemStmt, deleteWishlistItem, arg.WishlistID, arg.ProductID)
+	_, err := q.exec(ctx, q.deleteWishlistItemStmt, deleteWishlistItem, arg.WishlistID, arg.ProductID, arg.VariantID)
 	return err
 }
 
@@ -100,7 +105,7 @@ func (q *Queries) GetWishlistByUserID(ctx context.Context, userID uuid.UUID) (Wi
 }
 
 const getWishlistItems = `-- name: GetWishlistItems :many
-SELECT wi.id, wi.wishlist_id, wi.product_id, wi.created_at, wi.updated_at, p.name, p.price, p.stock, p.image_url
+SELECT wi.id, wi.wishlist_id, wi.product_id, wi.created_at, wi.updated_at, wi.variant_id, p.name, p.price, p.stock, p.image_url
 FROM wishlist_items wi
 JOIN products p ON wi.product_id = p.id
 WHERE wi.wishlist_id = $1
@@ -113,6 +118,7 @@ type GetWishlistItemsRow struct {
 	ProductID  uuid.UUID      `json:"product_id"`
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
+	VariantID  uuid.NullUUID  `json:"variant_id"`
 	Name       string         `json:"name"`
 	Price      string         `json:"price"`
 	Stock      int32          `json:"stock"`
@@ -134,6 +140,7 @@ func (q *Queries) GetWishlistItems(ctx context.Context, wishlistID uuid.UUID) ([
 			&i.ProductID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.VariantID,
 			&i.Name,
 			&i.Price,
 			&i.Stock,
@@ -163,6 +170,7 @@ SELECT
             jsonb_agg(
                 jsonb_build_object(
                     'id', wi.id,
+                    'variantId', wi.variant_id,
                     'addedAt', wi.created_at,
                     'product', jsonb_build_object(
                         'id', p.id,
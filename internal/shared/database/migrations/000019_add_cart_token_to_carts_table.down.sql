@@ -0,0 +1,19 @@
+-- 1. Hapus cart guest (tidak bisa memenuhi NOT NULL user_id)
+DELETE FROM carts WHERE user_id IS NULL;
+
+-- 2. Drop index
+DROP INDEX IF EXISTS idx_carts_guest_updated_at;
+
+-- 3. Drop constraints
+ALTER TABLE carts
+DROP CONSTRAINT IF EXISTS carts_owner_check;
+
+ALTER TABLE carts
+DROP CONSTRAINT IF EXISTS carts_cart_token_unique;
+
+-- 4. Drop column
+ALTER TABLE carts
+DROP COLUMN IF EXISTS cart_token;
+
+ALTER TABLE carts
+ALTER COLUMN user_id SET NOT NULL;
@@ -0,0 +1,18 @@
+-- Cart anonim (guest) tidak punya user_id, diidentifikasi lewat cart_token
+ALTER TABLE carts
+ALTER COLUMN user_id DROP NOT NULL;
+
+ALTER TABLE carts
+ADD COLUMN cart_token VARCHAR(64);
+
+ALTER TABLE carts
+ADD CONSTRAINT carts_cart_token_unique UNIQUE (cart_token);
+
+ALTER TABLE carts
+ADD CONSTRAINT carts_owner_check
+CHECK (user_id IS NOT NULL OR cart_token IS NOT NULL);
+
+-- Dipakai worker untuk membersihkan cart guest yang sudah lama tidak disentuh
+CREATE INDEX idx_carts_guest_updated_at
+ON carts(updated_at)
+WHERE user_id IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cart_items_updated_at;
+
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,13 @@
+CREATE TABLE notification_preferences (
+    user_id UUID PRIMARY KEY,
+    cart_reminder_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    cart_reminder_sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT notification_preferences_user_id_fk
+        FOREIGN KEY (user_id) REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+-- Dipakai worker abandoned cart untuk mencari item yang lama tidak disentuh
+CREATE INDEX idx_cart_items_updated_at ON cart_items(updated_at);
@@ -0,0 +1,4 @@
+-- Item yang disimpan untuk nanti tetap berada di cart,
+-- tapi tidak ikut dihitung (count, total checkout, clear setelah checkout)
+ALTER TABLE cart_items
+ADD COLUMN IF NOT EXISTS saved_for_later BOOLEAN NOT NULL DEFAULT FALSE;
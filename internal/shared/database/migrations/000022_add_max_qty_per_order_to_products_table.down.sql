@@ -0,0 +1,5 @@
+ALTER TABLE products
+DROP CONSTRAINT IF EXISTS products_max_qty_per_order_check;
+
+ALTER TABLE products
+DROP COLUMN IF EXISTS max_qty_per_order;
@@ -0,0 +1,7 @@
+-- Batas pembelian per produk dalam satu order (NULL = tidak dibatasi)
+ALTER TABLE products
+ADD COLUMN IF NOT EXISTS max_qty_per_order INTEGER;
+
+ALTER TABLE products
+ADD CONSTRAINT products_max_qty_per_order_check
+CHECK (max_qty_per_order IS NULL OR max_qty_per_order > 0);
@@ -0,0 +1,34 @@
+-- 1. Order item
+ALTER TABLE order_items
+DROP COLUMN IF EXISTS variant_id;
+
+-- 2. Wishlist item (hapus item variant agar unique lama bisa dipasang lagi)
+DELETE FROM wishlist_items WHERE variant_id IS NOT NULL;
+
+ALTER TABLE wishlist_items
+DROP CONSTRAINT IF EXISTS uniq_wishlist_item_variant;
+
+ALTER TABLE wishlist_items
+DROP COLUMN IF EXISTS variant_id;
+
+ALTER TABLE wishlist_items
+ADD CONSTRAINT uniq_wishlist_product UNIQUE (wishlist_id, product_id);
+
+-- 3. Cart item
+DELETE FROM cart_items WHERE variant_id IS NOT NULL;
+
+ALTER TABLE cart_items
+DROP CONSTRAINT IF EXISTS uniq_cart_item_variant;
+
+ALTER TABLE cart_items
+DROP COLUMN IF EXISTS variant_id;
+
+ALTER TABLE cart_items
+ADD CONSTRAINT uniq_cart_book UNIQUE (cart_id, product_id);
+
+-- 4. Tabel variant
+DROP TABLE IF EXISTS product_variant_values;
+DROP INDEX IF EXISTS idx_product_variants_product;
+DROP TABLE IF EXISTS product_variants;
+DROP TABLE IF EXISTS product_option_values;
+DROP TABLE IF EXISTS product_options;
@@ -0,0 +1,64 @@
+-- 1. Option type per produk (mis. "Warna", "Storage", "RAM")
+CREATE TABLE product_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uniq_product_option_name UNIQUE (product_id, name)
+);
+
+-- 2. Nilai dari option type (mis. "Hitam", "256GB")
+CREATE TABLE product_option_values (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    option_id UUID NOT NULL REFERENCES product_options(id) ON DELETE CASCADE,
+    value VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uniq_product_option_value UNIQUE (option_id, value)
+);
+
+-- 3. Variant = kombinasi option value, punya SKU, harga & stok sendiri
+CREATE TABLE product_variants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    sku VARCHAR(100) NOT NULL UNIQUE,
+    price DECIMAL(12,2) NOT NULL,
+    discount_price DECIMAL(12,2),
+    stock INTEGER NOT NULL DEFAULT 0,
+    image_url TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_product_variants_product ON product_variants(product_id);
+
+CREATE TABLE product_variant_values (
+    variant_id UUID NOT NULL REFERENCES product_variants(id) ON DELETE CASCADE,
+    option_value_id UUID NOT NULL REFERENCES product_option_values(id) ON DELETE CASCADE,
+    PRIMARY KEY (variant_id, option_value_id)
+);
+
+-- 4. Cart, wishlist & order item bisa menunjuk ke variant tertentu
+ALTER TABLE cart_items
+ADD COLUMN variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE;
+
+ALTER TABLE cart_items
+DROP CONSTRAINT IF EXISTS uniq_cart_book;
+
+ALTER TABLE cart_items
+ADD CONSTRAINT uniq_cart_item_variant
+UNIQUE NULLS NOT DISTINCT (cart_id, product_id, variant_id);
+
+ALTER TABLE wishlist_items
+ADD COLUMN variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE;
+
+ALTER TABLE wishlist_items
+DROP CONSTRAINT IF EXISTS uniq_wishlist_product;
+
+ALTER TABLE wishlist_items
+ADD CONSTRAINT uniq_wishlist_item_variant
+UNIQUE NULLS NOT DISTINCT (wishlist_id, product_id, variant_id);
+
+ALTER TABLE order_items
+ADD COLUMN variant_id UUID REFERENCES product_variants(id);
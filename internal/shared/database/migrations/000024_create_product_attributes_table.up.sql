@@ -0,0 +1,29 @@
+-- 1. Schema atribut per kategori (mis. Smartphone: Layar, Chipset, Baterai, 5G)
+CREATE TABLE category_attributes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    data_type VARCHAR(20) NOT NULL DEFAULT 'text',
+    unit VARCHAR(20),
+    is_filterable BOOLEAN NOT NULL DEFAULT TRUE,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_category_attribute_type CHECK (data_type IN ('text', 'number', 'boolean')),
+    -- code dipakai sebagai key filter: ?attr[ram]=8GB
+    CONSTRAINT uniq_category_attribute_code UNIQUE (category_id, code)
+);
+
+-- 2. Nilai atribut per produk, hanya satu kolom value yang terisi sesuai data_type
+CREATE TABLE product_attribute_values (
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    attribute_id UUID NOT NULL REFERENCES category_attributes(id) ON DELETE CASCADE,
+    value_text TEXT,
+    value_number DECIMAL(14,4),
+    value_boolean BOOLEAN,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product_id, attribute_id)
+);
+
+CREATE INDEX idx_product_attribute_values_attribute ON product_attribute_values(attribute_id);
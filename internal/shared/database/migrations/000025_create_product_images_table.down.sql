@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS uniq_product_images_primary;
+DROP INDEX IF EXISTS idx_product_images_product;
+DROP TABLE IF EXISTS product_images;
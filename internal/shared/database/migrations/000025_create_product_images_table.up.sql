@@ -0,0 +1,22 @@
+-- Galeri gambar produk. products.image_url tetap disimpan sebagai thumbnail (= gambar primary)
+CREATE TABLE product_images (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    image_url TEXT NOT NULL,
+    alt_text VARCHAR(255),
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_images_product ON product_images(product_id, sort_order);
+
+-- Maksimal satu gambar primary per produk
+CREATE UNIQUE INDEX uniq_product_images_primary ON product_images(product_id) WHERE is_primary;
+
+-- Backfill: gambar tunggal yang sudah ada menjadi gambar primary di galeri
+INSERT INTO product_images (product_id, image_url, sort_order, is_primary)
+SELECT id, image_url, 0, TRUE
+FROM products
+WHERE image_url IS NOT NULL
+  AND image_url <> '';
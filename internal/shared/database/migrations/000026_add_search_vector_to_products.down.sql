@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_products_name_trgm;
+DROP INDEX IF EXISTS idx_products_search_vector;
+
+DROP TRIGGER IF EXISTS trg_categories_search_vector ON categories;
+DROP TRIGGER IF EXISTS trg_brands_search_vector ON brands;
+DROP TRIGGER IF EXISTS trg_products_search_vector ON products;
+
+DROP FUNCTION IF EXISTS products_refresh_search_vector_by_category();
+DROP FUNCTION IF EXISTS products_refresh_search_vector_by_brand();
+DROP FUNCTION IF EXISTS products_search_vector_trigger();
+DROP FUNCTION IF EXISTS products_build_search_vector(TEXT, TEXT, TEXT, UUID, UUID);
+
+ALTER TABLE products DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,69 @@
+-- Full-text search produk. Trigram dipakai untuk toleransi typo ("iphon" -> "iPhone")
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE products
+ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+-- Dokumen pencarian: nama & SKU (A) > brand (B) > kategori (C) > deskripsi (D).
+-- Brand & kategori ada di tabel lain sehingga tidak bisa memakai GENERATED column, diisi lewat trigger.
+CREATE OR REPLACE FUNCTION products_build_search_vector(
+    p_name TEXT,
+    p_sku TEXT,
+    p_description TEXT,
+    p_brand_id UUID,
+    p_category_id UUID
+) RETURNS tsvector AS $$
+    SELECT
+        setweight(to_tsvector('simple', COALESCE(p_name, '')), 'A') ||
+        setweight(to_tsvector('simple', COALESCE(p_sku, '')), 'A') ||
+        setweight(to_tsvector('simple', COALESCE((SELECT name FROM brands WHERE id = p_brand_id), '')), 'B') ||
+        setweight(to_tsvector('simple', COALESCE((SELECT name FROM categories WHERE id = p_category_id), '')), 'C') ||
+        setweight(to_tsvector('simple', COALESCE(p_description, '')), 'D');
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION products_search_vector_trigger() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector := products_build_search_vector(NEW.name, NEW.sku, NEW.description, NEW.brand_id, NEW.category_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_search_vector
+BEFORE INSERT OR UPDATE OF name, sku, description, brand_id, category_id ON products
+FOR EACH ROW EXECUTE FUNCTION products_search_vector_trigger();
+
+-- Rename brand / kategori harus ikut memperbarui dokumen produk terkait
+CREATE OR REPLACE FUNCTION products_refresh_search_vector_by_brand() RETURNS trigger AS $$
+BEGIN
+    UPDATE products
+    SET search_vector = products_build_search_vector(name, sku, description, brand_id, category_id)
+    WHERE brand_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION products_refresh_search_vector_by_category() RETURNS trigger AS $$
+BEGIN
+    UPDATE products
+    SET search_vector = products_build_search_vector(name, sku, description, brand_id, category_id)
+    WHERE category_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_brands_search_vector
+AFTER UPDATE OF name ON brands
+FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION products_refresh_search_vector_by_brand();
+
+CREATE TRIGGER trg_categories_search_vector
+AFTER UPDATE OF name ON categories
+FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name)
+EXECUTE FUNCTION products_refresh_search_vector_by_category();
+
+-- Backfill produk yang sudah ada
+UPDATE products
+SET search_vector = products_build_search_vector(name, sku, description, brand_id, category_id);
+
+CREATE INDEX idx_products_search_vector ON products USING GIN (search_vector);
+CREATE INDEX idx_products_name_trgm ON products USING GIN (name gin_trgm_ops);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_categories_name_trgm;
+DROP INDEX IF EXISTS idx_brands_name_trgm;
@@ -0,0 +1,4 @@
+-- Index trigram untuk autocomplete /products/suggest (prefix ILIKE & word similarity).
+-- products.name sudah punya idx_products_name_trgm dari migrasi full-text search.
+CREATE INDEX IF NOT EXISTS idx_brands_name_trgm ON brands USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_categories_name_trgm ON categories USING GIN (name gin_trgm_ops);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_product_import_jobs_created_at;
+DROP TABLE IF EXISTS product_import_jobs;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_stock_movements_reference;
+DROP INDEX IF EXISTS idx_stock_movements_product;
+DROP TABLE IF EXISTS stock_movements;
@@ -0,0 +1,30 @@
+-- Ledger mutasi stok (append-only). products.stock / product_variants.stock adalah saldo
+-- yang selalu diubah bersamaan dengan insert ke tabel ini (lihat query ApplyStockMovement)
+CREATE TABLE stock_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE, -- NULL = stok level produk
+    delta INTEGER NOT NULL,
+    balance_after INTEGER NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    reference_id UUID, -- order ID / import job ID, tergantung reason
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_stock_movements_reason CHECK (reason IN ('SALE', 'CANCELLATION', 'REFUND', 'ADJUSTMENT', 'IMPORT')),
+    CONSTRAINT chk_stock_movements_delta CHECK (delta <> 0)
+);
+
+CREATE INDEX idx_stock_movements_product ON stock_movements(product_id, created_at DESC);
+CREATE INDEX idx_stock_movements_reference ON stock_movements(reference_id) WHERE reference_id IS NOT NULL;
+
+-- Saldo awal: stok yang sudah ada sebelum ledger dicatat sebagai satu adjustment
+INSERT INTO stock_movements (product_id, delta, balance_after, reason, note)
+SELECT id, stock, stock, 'ADJUSTMENT', 'Opening balance'
+FROM products
+WHERE stock <> 0;
+
+INSERT INTO stock_movements (product_id, variant_id, delta, balance_after, reason, note)
+SELECT product_id, id, stock, stock, 'ADJUSTMENT', 'Opening balance'
+FROM product_variants
+WHERE stock <> 0;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_stock_notifications_pending;
+DROP TABLE IF EXISTS stock_notifications;
+
+ALTER TABLE products
+DROP CONSTRAINT IF EXISTS products_low_stock_threshold_check;
+
+ALTER TABLE products
+DROP COLUMN IF EXISTS low_stock_threshold;
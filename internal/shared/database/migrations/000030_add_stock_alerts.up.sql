@@ -0,0 +1,24 @@
+-- Batas stok menipis per produk (0 = alert nonaktif). Berlaku untuk saldo produk maupun tiap variant.
+ALTER TABLE products
+ADD COLUMN IF NOT EXISTS low_stock_threshold INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE products
+ADD CONSTRAINT products_low_stock_threshold_check
+CHECK (low_stock_threshold >= 0);
+
+-- Langganan "kabari saya saat tersedia". notified_at terisi = email sudah terkirim,
+-- subscribe ulang mengosongkannya kembali.
+CREATE TABLE stock_notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE, -- NULL = stok level produk
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    notified_at TIMESTAMP,
+    -- variant_id NULL dianggap sama, jadi satu user hanya punya satu langganan per produk/variant
+    CONSTRAINT uq_stock_notifications_target UNIQUE NULLS NOT DISTINCT (user_id, product_id, variant_id)
+);
+
+CREATE INDEX idx_stock_notifications_pending
+ON stock_notifications(product_id, variant_id)
+WHERE notified_at IS NULL;
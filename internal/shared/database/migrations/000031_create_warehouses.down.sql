@@ -0,0 +1,25 @@
+DROP INDEX IF EXISTS idx_orders_warehouse_id;
+
+ALTER TABLE orders
+DROP COLUMN IF EXISTS warehouse_id;
+
+DELETE FROM stock_movements WHERE reason = 'TRANSFER';
+
+ALTER TABLE stock_movements
+DROP CONSTRAINT IF EXISTS chk_stock_movements_reason;
+
+ALTER TABLE stock_movements
+ADD CONSTRAINT chk_stock_movements_reason
+CHECK (reason IN ('SALE', 'CANCELLATION', 'REFUND', 'ADJUSTMENT', 'IMPORT'));
+
+ALTER TABLE stock_movements
+DROP COLUMN IF EXISTS warehouse_id;
+
+DROP INDEX IF EXISTS idx_warehouse_transfers_created;
+DROP TABLE IF EXISTS warehouse_transfers;
+
+DROP INDEX IF EXISTS idx_warehouse_stocks_product;
+DROP TABLE IF EXISTS warehouse_stocks;
+
+DROP INDEX IF EXISTS uq_warehouses_default;
+DROP TABLE IF EXISTS warehouses;
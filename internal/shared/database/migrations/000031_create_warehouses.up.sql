@@ -0,0 +1,92 @@
+-- Lokasi gudang. Gudang default menerima stok yang tidak menyebut gudang (create produk, import, dll).
+-- priority = urutan fallback fulfilment bila tidak ada gudang di provinsi tujuan (kecil = didahulukan).
+CREATE TABLE warehouses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(20) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    province VARCHAR(100) NOT NULL,
+    city VARCHAR(100),
+    address TEXT,
+    priority INTEGER NOT NULL DEFAULT 0,
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_warehouses_default_active CHECK (NOT is_default OR is_active)
+);
+
+-- Hanya boleh ada satu gudang default
+CREATE UNIQUE INDEX uq_warehouses_default ON warehouses(is_default) WHERE is_default;
+
+INSERT INTO warehouses (code, name, province, city, is_default)
+VALUES ('JKT', 'Gudang Jakarta', 'DKI Jakarta', 'Jakarta', true);
+
+-- Saldo stok per gudang. products.stock / product_variants.stock tetap menjadi total semua gudang,
+-- keduanya diubah bersamaan lewat query ApplyStockMovement.
+CREATE TABLE warehouse_stocks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE RESTRICT,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE, -- NULL = stok level produk
+    stock INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_warehouse_stocks_stock CHECK (stock >= 0),
+    CONSTRAINT uq_warehouse_stocks_target UNIQUE NULLS NOT DISTINCT (warehouse_id, product_id, variant_id)
+);
+
+CREATE INDEX idx_warehouse_stocks_product ON warehouse_stocks(product_id);
+
+-- Sampai sekarang semua stok ada di satu gudang (default)
+INSERT INTO warehouse_stocks (warehouse_id, product_id, stock)
+SELECT w.id, p.id, p.stock
+FROM products p, warehouses w
+WHERE w.is_default AND p.stock > 0;
+
+INSERT INTO warehouse_stocks (warehouse_id, product_id, variant_id, stock)
+SELECT w.id, v.product_id, v.id, v.stock
+FROM product_variants v, warehouses w
+WHERE w.is_default AND v.stock > 0;
+
+-- Riwayat perpindahan stok antar gudang; mutasinya tercatat di stock_movements (reason TRANSFER)
+-- dengan reference_id = id transfer
+CREATE TABLE warehouse_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE RESTRICT,
+    to_warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE RESTRICT,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id UUID REFERENCES product_variants(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    note TEXT,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_warehouse_transfers_quantity CHECK (quantity > 0),
+    CONSTRAINT chk_warehouse_transfers_route CHECK (from_warehouse_id <> to_warehouse_id)
+);
+
+CREATE INDEX idx_warehouse_transfers_created ON warehouse_transfers(created_at DESC);
+
+-- Ledger mencatat gudang tiap mutasi
+ALTER TABLE stock_movements
+ADD COLUMN warehouse_id UUID REFERENCES warehouses(id) ON DELETE RESTRICT;
+
+UPDATE stock_movements
+SET warehouse_id = (SELECT id FROM warehouses WHERE is_default);
+
+ALTER TABLE stock_movements
+ALTER COLUMN warehouse_id SET NOT NULL;
+
+ALTER TABLE stock_movements
+DROP CONSTRAINT chk_stock_movements_reason;
+
+ALTER TABLE stock_movements
+ADD CONSTRAINT chk_stock_movements_reason
+CHECK (reason IN ('SALE', 'CANCELLATION', 'REFUND', 'ADJUSTMENT', 'IMPORT', 'TRANSFER'));
+
+-- Gudang pengirim order (dipilih saat checkout)
+ALTER TABLE orders
+ADD COLUMN warehouse_id UUID REFERENCES warehouses(id) ON DELETE SET NULL;
+
+UPDATE orders
+SET warehouse_id = (SELECT id FROM warehouses WHERE is_default);
+
+CREATE INDEX idx_orders_warehouse_id ON orders(warehouse_id);
@@ -0,0 +1,50 @@
+-- Jadwal perubahan harga produk, diterapkan oleh worker saat starts_at tercapai.
+-- previous_* diisi saat jadwal diterapkan agar harga bisa dikembalikan saat ends_at.
+CREATE TABLE product_price_schedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL(12,2) NOT NULL,
+    discount_price DECIMAL(12,2), -- NULL = tanpa harga diskon
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP, -- NULL = harga baru berlaku permanen
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    previous_price DECIMAL(12,2),
+    previous_discount_price DECIMAL(12,2),
+    note TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    applied_at TIMESTAMP,
+    ended_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT product_price_schedules_price_check CHECK (price > 0),
+    CONSTRAINT product_price_schedules_discount_check CHECK (discount_price IS NULL OR (discount_price > 0 AND discount_price < price)),
+    CONSTRAINT product_price_schedules_period_check CHECK (ends_at IS NULL OR ends_at > starts_at),
+    CONSTRAINT product_price_schedules_status_check CHECK (status IN ('PENDING', 'ACTIVE', 'COMPLETED', 'CANCELLED', 'EXPIRED'))
+);
+
+CREATE INDEX idx_product_price_schedules_product ON product_price_schedules(product_id, starts_at DESC);
+
+-- Dipakai worker untuk mencari jadwal yang jatuh tempo
+CREATE INDEX idx_product_price_schedules_due ON product_price_schedules(starts_at) WHERE status = 'PENDING';
+CREATE INDEX idx_product_price_schedules_ending ON product_price_schedules(ends_at) WHERE status = 'ACTIVE';
+
+-- Riwayat harga efektif produk; satu baris per perubahan (bukan per request).
+-- Dipakai untuk "harga terendah 30 hari terakhir".
+CREATE TABLE product_price_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL(12,2) NOT NULL,
+    discount_price DECIMAL(12,2),
+    source VARCHAR(20) NOT NULL,
+    schedule_id UUID REFERENCES product_price_schedules(id) ON DELETE SET NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT product_price_history_source_check CHECK (source IN ('INITIAL', 'MANUAL', 'IMPORT', 'SCHEDULE', 'SCHEDULE_END'))
+);
+
+CREATE INDEX idx_product_price_history_product ON product_price_history(product_id, created_at DESC);
+
+-- Harga saat ini menjadi titik awal riwayat
+INSERT INTO product_price_history (product_id, price, discount_price, source, created_at)
+SELECT id, price, discount_price, 'INITIAL', updated_at
+FROM products;
@@ -0,0 +1,13 @@
+-- Pasangan produk yang sering dibeli bersama, dihitung ulang berkala oleh worker
+-- dari order_items pada order COMPLETED. Setiap pasangan disimpan dua arah.
+CREATE TABLE product_co_purchases (
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    related_product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    order_count INTEGER NOT NULL,
+    last_ordered_at TIMESTAMP NOT NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product_id, related_product_id),
+    CONSTRAINT product_co_purchases_distinct_check CHECK (product_id <> related_product_id)
+);
+
+CREATE INDEX idx_product_co_purchases_rank ON product_co_purchases(product_id, order_count DESC);
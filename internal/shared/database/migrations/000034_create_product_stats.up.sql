@@ -0,0 +1,16 @@
+-- Counter popularitas yang didenormalisasi untuk sort listing (best_selling, top_rated, trending).
+-- Diisi ulang berkala oleh worker, jadi listing cukup join + index tanpa agregasi per request.
+CREATE TABLE product_stats (
+    product_id UUID PRIMARY KEY REFERENCES products(id) ON DELETE CASCADE,
+    sold_count INTEGER NOT NULL DEFAULT 0,
+    rating_avg NUMERIC(3, 2) NOT NULL DEFAULT 0,
+    rating_count INTEGER NOT NULL DEFAULT 0,
+    recent_sold_count INTEGER NOT NULL DEFAULT 0,
+    recent_view_count INTEGER NOT NULL DEFAULT 0,
+    trending_score NUMERIC(14, 4) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_stats_sold ON product_stats(sold_count DESC);
+CREATE INDEX idx_product_stats_rating ON product_stats(rating_avg DESC, rating_count DESC);
+CREATE INDEX idx_product_stats_trending ON product_stats(trending_score DESC);
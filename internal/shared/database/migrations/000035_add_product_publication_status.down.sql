@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_scheduled_publish;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_scheduled_publish_at_check,
+    DROP CONSTRAINT IF EXISTS products_status_check,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
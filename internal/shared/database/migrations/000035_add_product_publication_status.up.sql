@@ -0,0 +1,14 @@
+-- Status publikasi produk. is_active tetap dipakai sebagai saklar sembunyikan cepat,
+-- produk tampil publik jika aktif DAN (PUBLISHED atau SCHEDULED yang publish_at-nya sudah lewat).
+ALTER TABLE products
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'PUBLISHED',
+    ADD COLUMN publish_at TIMESTAMP NULL;
+
+ALTER TABLE products
+    ADD CONSTRAINT products_status_check CHECK (status IN ('DRAFT', 'SCHEDULED', 'PUBLISHED', 'ARCHIVED')),
+    ADD CONSTRAINT products_scheduled_publish_at_check CHECK (status <> 'SCHEDULED' OR publish_at IS NOT NULL);
+
+-- Produk lama dianggap sudah terbit sejak dibuat
+UPDATE products SET publish_at = created_at;
+
+CREATE INDEX idx_products_scheduled_publish ON products(publish_at) WHERE status = 'SCHEDULED';
@@ -0,0 +1,9 @@
+-- Hirarki kategori (mis. Accessories > Charger). NULL = kategori root.
+-- Siklus dicegah di service; constraint di bawah hanya menolak parent ke diri sendiri.
+ALTER TABLE categories
+    ADD COLUMN parent_id UUID NULL REFERENCES categories(id) ON DELETE RESTRICT;
+
+ALTER TABLE categories
+    ADD CONSTRAINT categories_parent_not_self_check CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX idx_categories_parent_id ON categories(parent_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,12 @@
+-- Slug lama produk/brand/kategori setelah rename. Dipakai untuk redirect 301 ke slug kanonik
+-- dan mencegah entitas lain memakai ulang slug yang pernah beredar (link Google, sosial media).
+CREATE TABLE slug_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('product', 'brand', 'category')),
+    entity_id UUID NOT NULL,
+    slug VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT slug_history_entity_slug_key UNIQUE (entity_type, slug)
+);
+
+CREATE INDEX idx_slug_history_entity ON slug_history(entity_type, entity_id);
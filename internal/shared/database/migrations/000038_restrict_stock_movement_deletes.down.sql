@@ -0,0 +1,9 @@
+ALTER TABLE stock_movements
+    DROP CONSTRAINT stock_movements_variant_id_fkey,
+    ADD CONSTRAINT stock_movements_variant_id_fkey
+        FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE CASCADE;
+
+ALTER TABLE stock_movements
+    DROP CONSTRAINT stock_movements_product_id_fkey,
+    ADD CONSTRAINT stock_movements_product_id_fkey
+        FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
@@ -0,0 +1,12 @@
+-- Ledger stok append-only: hapus permanen produk/varian tidak boleh ikut menghapus riwayatnya.
+-- Produk dengan riwayat mutasi harus di-soft-delete; varian yang dihapus permanen meninggalkan
+-- mutasinya dengan variant_id NULL (tetap tercatat di level produk).
+ALTER TABLE stock_movements
+    DROP CONSTRAINT stock_movements_product_id_fkey,
+    ADD CONSTRAINT stock_movements_product_id_fkey
+        FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE RESTRICT;
+
+ALTER TABLE stock_movements
+    DROP CONSTRAINT stock_movements_variant_id_fkey,
+    ADD CONSTRAINT stock_movements_variant_id_fkey
+        FOREIGN KEY (variant_id) REFERENCES product_variants(id) ON DELETE SET NULL;
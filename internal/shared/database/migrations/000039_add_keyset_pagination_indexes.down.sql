@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_reviews_keyset_product_created;
+DROP INDEX IF EXISTS idx_orders_keyset_created;
+DROP INDEX IF EXISTS idx_orders_keyset_user_placed;
+DROP INDEX IF EXISTS idx_products_keyset_price;
+DROP INDEX IF EXISTS idx_products_keyset_created;
@@ -0,0 +1,10 @@
+-- Index untuk query keyset (cursor): urutan index sama dengan ORDER BY sehingga halaman
+-- berikutnya dibaca langsung dari posisi cursor tanpa sort ulang. Arah sebaliknya (prev)
+-- memakai index yang sama dengan scan mundur.
+CREATE INDEX idx_products_keyset_created ON products(created_at DESC, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX idx_products_keyset_price ON products(price, created_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_orders_keyset_user_placed ON orders(user_id, placed_at, id);
+CREATE INDEX idx_orders_keyset_created ON orders(created_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_reviews_keyset_product_created ON reviews(product_id, created_at, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP FUNCTION IF EXISTS public_products(UUID[], TEXT, TEXT, NUMERIC, NUMERIC, TEXT[], TEXT[], NUMERIC, BOOLEAN);
+DROP FUNCTION IF EXISTS published_products(TEXT, TEXT[], TEXT[]);
@@ -0,0 +1,93 @@
+-- Filter listing publik di satu tempat. Sebelumnya predikat yang sama disalin ke setiap query
+-- listing (offset, keyset, popularitas, facet) sehingga perubahan filter mudah tertinggal di salah satunya.
+-- Fungsi SQL STABLE satu SELECT di-inline planner, jadi index & ORDER BY query pemanggil tetap dipakai.
+
+-- Produk yang tampil di katalog publik + filter yang juga dipakai facet (search & atribut)
+CREATE OR REPLACE FUNCTION published_products(
+    p_search TEXT,
+    p_attr_codes TEXT[],
+    p_attr_values TEXT[]
+) RETURNS SETOF products AS $$
+    SELECT p.*
+    FROM products p
+    WHERE
+        p.deleted_at IS NULL
+        AND p.is_active = true
+        -- Hanya yang sudah terbit (DRAFT/ARCHIVED & jadwal yang belum tiba disembunyikan)
+        AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW()))
+
+        -- Full-text (nama, deskripsi, brand, kategori, SKU) + trigram untuk typo ("iphon", "samsng")
+        AND (
+            p_search IS NULL
+            OR p.search_vector @@ websearch_to_tsquery('simple', p_search)
+            OR p_search <% p.name
+            OR p.name ILIKE '%' || p_search || '%'
+        )
+
+        -- Filter atribut: ?attr[ram]=8GB&attr[5g]=true
+        -- Pasangan (code, value) dikirim sebagai dua array sejajar. Nilai dalam satu code = OR,
+        -- antar code = AND, jadi jumlah code yang cocok harus sama dengan jumlah code yang diminta.
+        -- Hanya atribut is_filterable yang bisa dipakai sebagai filter.
+        AND (
+            COALESCE(cardinality(p_attr_codes), 0) = 0
+            OR (
+                SELECT COUNT(DISTINCT ca.code)
+                FROM product_attribute_values pav
+                JOIN category_attributes ca ON ca.id = pav.attribute_id
+                JOIN unnest(p_attr_codes, p_attr_values) AS f(code, value)
+                    ON f.code = ca.code
+                WHERE pav.product_id = p.id
+                    AND ca.category_id = p.category_id
+                    AND ca.is_filterable = true
+                    AND (
+                        LOWER(f.value) = LOWER(COALESCE(pav.value_text, trim_scale(pav.value_number)::text, pav.value_boolean::text))
+                        -- angka boleh ditulis dengan satuan: 8GB / 8 GB
+                        OR LOWER(REPLACE(f.value, ' ', '')) = LOWER(trim_scale(pav.value_number)::text || COALESCE(ca.unit, ''))
+                    )
+            ) = (SELECT COUNT(DISTINCT c) FROM unnest(p_attr_codes) AS c)
+        );
+$$ LANGUAGE sql STABLE;
+
+-- Seluruh filter listing publik (dipakai ListProductsPublic*, termasuk keyset & popularitas)
+CREATE OR REPLACE FUNCTION public_products(
+    p_category_ids UUID[],
+    p_search TEXT,
+    p_brand_slug TEXT,
+    p_min_price NUMERIC,
+    p_max_price NUMERIC,
+    p_attr_codes TEXT[],
+    p_attr_values TEXT[],
+    p_min_rating NUMERIC,
+    p_in_stock BOOLEAN
+) RETURNS SETOF products AS $$
+    SELECT p.*
+    FROM published_products(p_search, p_attr_codes, p_attr_values) p
+    WHERE
+        (
+            COALESCE(cardinality(p_category_ids), 0) = 0
+            OR p.category_id = ANY(p_category_ids)
+        )
+
+        AND (
+            p_brand_slug IS NULL
+            OR EXISTS (SELECT 1 FROM brands b WHERE b.id = p.brand_id AND b.slug = p_brand_slug)
+        )
+
+        AND p.price >= p_min_price
+        AND p.price <= p_max_price
+
+        -- Rating rata-rata minimal (0 = tanpa filter)
+        AND (
+            p_min_rating = 0
+            OR (
+                SELECT COALESCE(AVG(r.rating), 0)
+                FROM reviews r
+                WHERE r.product_id = p.id AND r.deleted_at IS NULL
+            ) >= p_min_rating
+        )
+
+        AND (
+            p_in_stock IS NULL
+            OR (p.stock > 0) = p_in_stock
+        );
+$$ LANGUAGE sql STABLE;
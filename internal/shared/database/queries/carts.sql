@@ -10,36 +10,48 @@ FROM carts
 WHERE user_id = $1
 LIMIT 1;
 
+-- Kunci row cart selama transaksi agar cek batas qty & perubahan item tidak balapan
+-- name: LockCart :one
+SELECT id
+FROM carts
+WHERE id = $1
+FOR UPDATE;
+
 -- name: GetCartItemByCartAndProduct :one
 SELECT *
 FROM cart_items
 WHERE cart_id = $1
   AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3
 LIMIT 1;
 
 
 -- name: CountCartItems :one
 SELECT COALESCE(SUM(quantity), 0)::bigint
 FROM cart_items
-WHERE cart_id = $1;
+WHERE cart_id = $1
+  AND saved_for_later = false;
 
 -- name: AddCartItem :exec
-INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (cart_id, product_id)
+INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add, variant_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (cart_id, product_id, variant_id)
 DO UPDATE SET
   quantity = cart_items.quantity + EXCLUDED.quantity,
+  saved_for_later = false,
   updated_at = NOW();
 
 -- name: UpdateCartItemQty :one
 UPDATE cart_items
 SET quantity = $3, updated_at = NOW()
 WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $4
 RETURNING *;
 
 -- name: DeleteCartItem :exec
 DELETE FROM cart_items
-WHERE cart_id = $1 AND product_id = $2;
+WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3;
 
 -- name: DeleteCart :exec
 DELETE FROM carts
@@ -51,13 +63,23 @@ SELECT
     ci.product_id,
     p.name AS product_name,
     p.slug AS product_slug,
-    p.image_url AS product_image_url,
+    COALESCE(v.image_url, p.image_url) AS product_image_url,
+    ci.variant_id,
+    (
+        SELECT string_agg(o.name || ': ' || ov.value, ', ' ORDER BY o.created_at)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = ci.variant_id
+    )::text AS variant_name,
     ci.quantity,
     ci.price_at_add,
-    ci.created_at
+    ci.created_at,
+    ci.saved_for_later
 FROM carts c
 JOIN cart_items ci ON ci.cart_id = c.id
 JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
 WHERE c.user_id = $1
 ORDER BY ci.created_at DESC;
 
@@ -65,11 +87,25 @@ ORDER BY ci.created_at DESC;
 DELETE FROM cart_items
 WHERE cart_id = $1;
 
+-- name: DeleteActiveCartItems :exec
+DELETE FROM cart_items
+WHERE cart_id = $1
+  AND saved_for_later = false;
+
+-- name: SetCartItemSavedForLater :one
+UPDATE cart_items
+SET saved_for_later = $3,
+    updated_at = NOW()
+WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $4
+RETURNING *;
+
 -- name: IncrementCartItemQty :one
 UPDATE cart_items
 SET quantity = quantity + 1,
     updated_at = NOW()
 WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3
 RETURNING *;
 
 -- name: DecrementCartItemQty :one
@@ -77,4 +113,172 @@ UPDATE cart_items
 SET quantity = quantity - 1,
     updated_at = NOW()
 WHERE cart_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3
+RETURNING *;
+
+-- name: CreateGuestCart :one
+INSERT INTO carts (cart_token)
+VALUES ($1)
 RETURNING *;
+
+-- name: GetCartByToken :one
+SELECT *
+FROM carts
+WHERE cart_token = $1
+  AND user_id IS NULL
+LIMIT 1;
+
+-- name: GetCartDetailByToken :many
+SELECT
+    ci.id,
+    ci.product_id,
+    p.name AS product_name,
+    p.slug AS product_slug,
+    COALESCE(v.image_url, p.image_url) AS product_image_url,
+    ci.variant_id,
+    (
+        SELECT string_agg(o.name || ': ' || ov.value, ', ' ORDER BY o.created_at)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = ci.variant_id
+    )::text AS variant_name,
+    ci.quantity,
+    ci.price_at_add,
+    ci.created_at
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE c.cart_token = $1
+  AND c.user_id IS NULL
+ORDER BY ci.created_at DESC;
+
+-- name: ListCartItemsWithStock :many
+SELECT
+    ci.product_id,
+    ci.variant_id,
+    ci.quantity,
+    ci.price_at_add,
+    COALESCE(v.stock, p.stock)::int AS stock
+FROM cart_items ci
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE ci.cart_id = $1
+  AND p.deleted_at IS NULL
+  AND v.deleted_at IS NULL;
+
+-- name: UpsertCartItemQty :exec
+INSERT INTO cart_items (cart_id, product_id, quantity, price_at_add, variant_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (cart_id, product_id, variant_id)
+DO UPDATE SET
+  quantity = EXCLUDED.quantity,
+  -- hasil merge selalu aktif, termasuk baris user yang sebelumnya disimpan untuk nanti
+  saved_for_later = false,
+  updated_at = NOW();
+
+-- name: TouchCart :exec
+UPDATE carts
+SET updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteStaleGuestCarts :execrows
+DELETE FROM carts
+WHERE user_id IS NULL
+  AND updated_at < $1;
+
+-- name: ListAbandonedCarts :many
+SELECT
+    c.id AS cart_id,
+    c.user_id,
+    MAX(ci.updated_at)::timestamp AS last_activity_at
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id AND ci.saved_for_later = false
+JOIN users u ON u.id = c.user_id
+LEFT JOIN notification_preferences np ON np.user_id = c.user_id
+WHERE c.user_id IS NOT NULL
+  AND u.is_active = true
+  AND (np.user_id IS NULL OR np.cart_reminder_enabled = true)
+  AND (
+    np.cart_reminder_sent_at IS NULL
+    OR np.cart_reminder_sent_at < sqlc.arg('cooldown_before')::timestamp
+  )
+GROUP BY c.id, c.user_id, np.cart_reminder_sent_at
+HAVING MAX(ci.updated_at) < sqlc.arg('idle_before')::timestamp
+  -- hanya kirim lagi jika cart disentuh setelah pengingat terakhir
+  AND (
+    np.cart_reminder_sent_at IS NULL
+    OR np.cart_reminder_sent_at < MAX(ci.updated_at)
+  )
+  -- user belum checkout sejak terakhir menyentuh cart
+  AND NOT EXISTS (
+    SELECT 1
+    FROM orders o
+    WHERE o.user_id = c.user_id
+      AND o.created_at >= MAX(ci.updated_at)
+  )
+ORDER BY last_activity_at ASC
+LIMIT sqlc.arg('row_limit')::int;
+
+-- name: ListCartReminderItems :many
+SELECT
+    p.name AS product_name,
+    ci.quantity,
+    COALESCE(v.price, p.price)::decimal AS price,
+    CASE WHEN v.id IS NULL THEN p.discount_price ELSE v.discount_price END AS discount_price
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE c.user_id = $1
+  AND ci.saved_for_later = false
+  AND p.deleted_at IS NULL
+ORDER BY ci.created_at DESC;
+
+-- name: GetCartValidation :many
+SELECT
+    ci.id,
+    ci.product_id,
+    p.name AS product_name,
+    p.slug AS product_slug,
+    ci.quantity,
+    ci.price_at_add,
+    -- variant punya harga, stok & status sendiri
+    COALESCE(v.price, p.price)::decimal AS current_price,
+    CASE WHEN v.id IS NULL THEN p.discount_price ELSE v.discount_price END AS current_discount_price,
+    COALESCE(v.stock, p.stock)::int AS stock,
+    -- produk yang belum terbit diperlakukan seperti nonaktif
+    (p.is_active AND COALESCE(v.is_active, true)
+      AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW())))::bool AS is_active,
+    COALESCE(v.deleted_at, p.deleted_at)::timestamp AS deleted_at,
+    p.max_qty_per_order,
+    ci.variant_id,
+    (
+        SELECT string_agg(o.name || ': ' || ov.value, ', ' ORDER BY o.created_at)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = ci.variant_id
+    )::text AS variant_name
+FROM carts c
+JOIN cart_items ci ON ci.cart_id = c.id
+JOIN products p ON ci.product_id = p.id
+LEFT JOIN product_variants v ON ci.variant_id = v.id
+WHERE c.user_id = $1
+  AND ci.saved_for_later = false
+ORDER BY ci.created_at DESC;
+
+-- name: GetProductPurchaseLimit :one
+SELECT max_qty_per_order
+FROM products
+WHERE id = $1
+  AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: SumCartProductQty :one
+SELECT COALESCE(SUM(quantity), 0)::bigint
+FROM cart_items
+WHERE cart_id = $1
+  AND product_id = $2
+  AND saved_for_later = false;
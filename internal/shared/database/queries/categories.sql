@@ -46,13 +46,13 @@ SELECT * FROM categories WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
 SELECT * FROM categories WHERE slug = $1 AND deleted_at IS NULL LIMIT 1;
 
 -- name: CreateCategory :one
-INSERT INTO categories (name, slug, description, image_url)
-VALUES ($1, $2, $3, $4)
+INSERT INTO categories (name, slug, description, image_url, parent_id)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: UpdateCategory :one
 UPDATE categories 
-SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, updated_at = NOW()
+SET name = $2, slug = $3, description = $4, image_url = $5, is_active = $6, parent_id = $7, updated_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
@@ -61,3 +61,61 @@ UPDATE categories SET deleted_at = NOW() WHERE id = $1;
 
 -- name: RestoreCategory :one
 UPDATE categories SET deleted_at = NULL WHERE id = $1 RETURNING *;
+
+-- Kunci kategori yang terlibat saat pindah parent / restore; urut id agar urutan lock konsisten
+-- name: LockCategoriesForUpdate :many
+SELECT id FROM categories
+WHERE id = ANY(sqlc.arg('ids')::uuid[]) AND deleted_at IS NULL
+ORDER BY id
+FOR UPDATE;
+
+-- name: ListCategoryTree :many
+SELECT id, parent_id, name, slug, image_url
+FROM categories
+WHERE deleted_at IS NULL
+  AND is_active = true
+ORDER BY name ASC;
+
+-- Urut dari root sampai kategori itu sendiri (dipakai untuk breadcrumb & cek siklus).
+-- Batas kedalaman menjaga query tetap berhenti jika data lama sudah terlanjur bersiklus.
+-- name: ListCategoryAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT c.id, c.parent_id, c.name, c.slug, 0 AS depth
+    FROM categories c
+    WHERE c.id = $1 AND c.deleted_at IS NULL
+    UNION ALL
+    SELECT p.id, p.parent_id, p.name, p.slug, a.depth + 1
+    FROM categories p
+    JOIN ancestors a ON p.id = a.parent_id
+    WHERE p.deleted_at IS NULL AND a.depth < 32
+)
+SELECT id, name, slug
+FROM ancestors
+ORDER BY depth DESC;
+
+-- Kategori yang diminta beserta seluruh turunannya (filter produk publik)
+-- Slug lama (slug_history) tetap di-resolve agar URL filter lama tidak kosong
+-- name: GetCategoryDescendantIDsBySlugs :many
+WITH RECURSIVE tree AS (
+    SELECT id
+    FROM categories
+    WHERE (
+        slug = ANY($1::text[])
+        OR id IN (
+            SELECT sh.entity_id FROM slug_history sh
+            WHERE sh.entity_type = 'category' AND sh.slug = ANY($1::text[])
+        )
+    )
+      AND deleted_at IS NULL
+    UNION
+    SELECT c.id
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+    WHERE c.deleted_at IS NULL
+)
+SELECT id FROM tree;
+
+-- name: CountCategoryDependents :one
+SELECT
+    (SELECT COUNT(*) FROM categories ch WHERE ch.parent_id = $1 AND ch.deleted_at IS NULL) AS child_count,
+    (SELECT COUNT(*) FROM products p WHERE p.category_id = $1 AND p.deleted_at IS NULL) AS product_count;
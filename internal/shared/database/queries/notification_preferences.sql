@@ -0,0 +1,22 @@
+-- name: GetNotificationPreferences :one
+SELECT *
+FROM notification_preferences
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: UpsertCartReminderPreference :one
+INSERT INTO notification_preferences (user_id, cart_reminder_enabled)
+VALUES ($1, $2)
+ON CONFLICT (user_id)
+DO UPDATE SET
+  cart_reminder_enabled = EXCLUDED.cart_reminder_enabled,
+  updated_at = NOW()
+RETURNING *;
+
+-- name: MarkCartReminderSent :exec
+INSERT INTO notification_preferences (user_id, cart_reminder_sent_at)
+VALUES ($1, NOW())
+ON CONFLICT (user_id)
+DO UPDATE SET
+  cart_reminder_sent_at = NOW(),
+  updated_at = NOW();
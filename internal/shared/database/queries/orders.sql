@@ -2,14 +2,14 @@
 INSERT INTO orders (
     order_number, user_id, status, address_id, address_snapshot, 
     subtotal_price, shipping_price, total_price, note, 
-    snap_token, snap_redirect_url, snap_token_expired_at, placed_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW())
+    snap_token, snap_redirect_url, snap_token_expired_at, warehouse_id, placed_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW())
 RETURNING *;
 
 -- name: CreateOrderItem :exec
 INSERT INTO order_items (
-    order_id, product_id, name_snapshot, unit_price, quantity, total_price
-) VALUES ($1, $2, $3, $4, $5, $6);
+    order_id, product_id, name_snapshot, unit_price, quantity, total_price, variant_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: ListOrders :many
 SELECT 
@@ -26,6 +26,7 @@ SELECT
                 jsonb_build_object(
                     'id', oi.id,
                     'productId', oi.product_id,
+                    'variantId', oi.variant_id,
                     'productSlug', p.slug,
                     'productImageUrl', p.image_url,
                     'nameSnapshot', oi.name_snapshot,
@@ -50,9 +51,101 @@ WHERE o.user_id = sqlc.arg('user_id')
       sqlc.narg('search')::text IS NULL
       OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%'
   )
-ORDER BY o.placed_at DESC
+ORDER BY
+  o.placed_at DESC,
+  o.id DESC
 LIMIT $1 OFFSET $2;
 
+-- Keyset (cursor) riwayat order customer, tanpa COUNT total (index idx_orders_keyset_user_placed).
+-- Desc = halaman berikutnya, Asc = halaman sebelumnya. Halaman pertama memakai cursor batas.
+-- name: ListOrdersByPlacedDesc :many
+SELECT 
+    o.id,
+    o.order_number,
+    o.status,
+    o.total_price,
+    o.placed_at,
+    o.user_id,
+    (
+        SELECT COALESCE(
+            jsonb_agg(
+                jsonb_build_object(
+                    'id', oi.id,
+                    'productId', oi.product_id,
+                    'variantId', oi.variant_id,
+                    'productSlug', p.slug,
+                    'productImageUrl', p.image_url,
+                    'nameSnapshot', oi.name_snapshot,
+                    'unitPrice', oi.unit_price,
+                    'quantity', oi.quantity,
+                    'subtotal', oi.total_price
+                )
+            ),
+            '[]'::jsonb
+        )
+        FROM order_items oi
+        LEFT JOIN products p ON oi.product_id = p.id -- Join ke tabel produk
+        WHERE oi.order_id = o.id
+    )::jsonb AS items_json
+FROM orders o
+WHERE o.user_id = sqlc.arg('user_id')
+  AND (
+      sqlc.narg('status')::text IS NULL
+      OR o.status = sqlc.narg('status')::text
+  )
+  AND (
+      sqlc.narg('search')::text IS NULL
+      OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%'
+  )
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.placed_at, o.id) < (sqlc.arg('cursor_placed_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY o.placed_at DESC, o.id DESC
+LIMIT $1;
+
+-- name: ListOrdersByPlacedAsc :many
+SELECT 
+    o.id,
+    o.order_number,
+    o.status,
+    o.total_price,
+    o.placed_at,
+    o.user_id,
+    (
+        SELECT COALESCE(
+            jsonb_agg(
+                jsonb_build_object(
+                    'id', oi.id,
+                    'productId', oi.product_id,
+                    'variantId', oi.variant_id,
+                    'productSlug', p.slug,
+                    'productImageUrl', p.image_url,
+                    'nameSnapshot', oi.name_snapshot,
+                    'unitPrice', oi.unit_price,
+                    'quantity', oi.quantity,
+                    'subtotal', oi.total_price
+                )
+            ),
+            '[]'::jsonb
+        )
+        FROM order_items oi
+        LEFT JOIN products p ON oi.product_id = p.id -- Join ke tabel produk
+        WHERE oi.order_id = o.id
+    )::jsonb AS items_json
+FROM orders o
+WHERE o.user_id = sqlc.arg('user_id')
+  AND (
+      sqlc.narg('status')::text IS NULL
+      OR o.status = sqlc.narg('status')::text
+  )
+  AND (
+      sqlc.narg('search')::text IS NULL
+      OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%'
+  )
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.placed_at, o.id) > (sqlc.arg('cursor_placed_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY o.placed_at ASC, o.id ASC
+LIMIT $1;
+
 
 
 -- name: ListOrdersAdmin :many
@@ -67,15 +160,74 @@ SELECT
     o.subtotal_price,
     o.shipping_price,
     u.name AS user_name,
+    o.warehouse_id,
+    w.name AS warehouse_name,
     COUNT(*) OVER() AS total_count
 FROM orders o
 INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
 WHERE o.deleted_at IS NULL
   AND (sqlc.narg('status')::text IS NULL OR o.status = sqlc.narg('status')::text)
   AND (sqlc.narg('search')::text IS NULL OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%')
-ORDER BY o.created_at DESC
+  AND (sqlc.narg('warehouse_id')::uuid IS NULL OR o.warehouse_id = sqlc.narg('warehouse_id')::uuid)
+ORDER BY
+  o.created_at DESC,
+  o.id DESC
 LIMIT $1 OFFSET $2;
 
+-- Keyset (cursor) daftar order admin (index idx_orders_keyset_created)
+-- name: ListOrdersAdminByCreatedDesc :many
+SELECT 
+    o.id, 
+    o.order_number, 
+    o.total_price, 
+    o.status, 
+    o.created_at, 
+    o.placed_at,
+    o.user_id,
+    o.subtotal_price,
+    o.shipping_price,
+    u.name AS user_name,
+    o.warehouse_id,
+    w.name AS warehouse_name
+FROM orders o
+INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
+WHERE o.deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR o.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('search')::text IS NULL OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%')
+  AND (sqlc.narg('warehouse_id')::uuid IS NULL OR o.warehouse_id = sqlc.narg('warehouse_id')::uuid)
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.created_at, o.id) < (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT $1;
+
+-- name: ListOrdersAdminByCreatedAsc :many
+SELECT 
+    o.id, 
+    o.order_number, 
+    o.total_price, 
+    o.status, 
+    o.created_at, 
+    o.placed_at,
+    o.user_id,
+    o.subtotal_price,
+    o.shipping_price,
+    u.name AS user_name,
+    o.warehouse_id,
+    w.name AS warehouse_name
+FROM orders o
+INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
+WHERE o.deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR o.status = sqlc.narg('status')::text)
+  AND (sqlc.narg('search')::text IS NULL OR o.order_number ILIKE '%' || sqlc.narg('search')::text || '%')
+  AND (sqlc.narg('warehouse_id')::uuid IS NULL OR o.warehouse_id = sqlc.narg('warehouse_id')::uuid)
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (o.created_at, o.id) > (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY o.created_at ASC, o.id ASC
+LIMIT $1;
+
 -- name: GetOrderByID :one
 SELECT 
     o.id,
@@ -99,6 +251,8 @@ SELECT
     o.snap_token,
     o.snap_redirect_url,
     o.snap_token_expired_at,
+    o.warehouse_id,
+    w.name AS warehouse_name,
     -- Tambahkan objek customer di sini
     jsonb_build_object(
         'email', u.email,
@@ -110,6 +264,7 @@ SELECT
                 jsonb_build_object(
                     'id', oi.id,
                     'productId', oi.product_id,
+                    'variantId', oi.variant_id,
                     'productSlug', p.slug,
                     'productImageUrl', p.image_url,
                     'nameSnapshot', oi.name_snapshot,
@@ -127,6 +282,7 @@ SELECT
 FROM orders o
 -- Join ke tabel users
 INNER JOIN users u ON o.user_id = u.id
+LEFT JOIN warehouses w ON w.id = o.warehouse_id
 WHERE o.id = $1 
   AND o.deleted_at IS NULL
 LIMIT 1;
@@ -183,7 +339,8 @@ SELECT
     oi.name_snapshot, 
     oi.unit_price, 
     oi.quantity, 
-    oi.total_price
+    oi.total_price,
+    oi.variant_id
 FROM order_items oi
 LEFT JOIN products p ON oi.product_id = p.id
 WHERE oi.order_id = $1;
@@ -0,0 +1,66 @@
+-- name: ListCategoryAttributes :many
+SELECT *
+FROM category_attributes
+WHERE category_id = $1
+ORDER BY sort_order ASC, name ASC;
+
+-- name: GetCategoryAttributeByID :one
+SELECT *
+FROM category_attributes
+WHERE id = $1
+  AND category_id = $2
+LIMIT 1;
+
+-- name: CreateCategoryAttribute :one
+INSERT INTO category_attributes (category_id, code, name, data_type, unit, is_filterable, sort_order)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: UpdateCategoryAttribute :one
+UPDATE category_attributes
+SET
+    name = $3,
+    unit = $4,
+    is_filterable = $5,
+    sort_order = $6,
+    updated_at = NOW()
+WHERE id = $1
+  AND category_id = $2
+RETURNING *;
+
+-- name: DeleteCategoryAttribute :execrows
+DELETE FROM category_attributes
+WHERE id = $1
+  AND category_id = $2;
+
+-- name: UpsertProductAttributeValue :exec
+INSERT INTO product_attribute_values (product_id, attribute_id, value_text, value_number, value_boolean)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (product_id, attribute_id) DO UPDATE
+SET value_text = EXCLUDED.value_text,
+    value_number = EXCLUDED.value_number,
+    value_boolean = EXCLUDED.value_boolean,
+    updated_at = NOW();
+
+-- name: DeleteProductAttributeValue :exec
+DELETE FROM product_attribute_values
+WHERE product_id = $1
+  AND attribute_id = $2;
+
+-- name: ListProductAttributeValues :many
+SELECT
+    ca.id AS attribute_id,
+    ca.code,
+    ca.name,
+    ca.data_type,
+    ca.unit,
+    pav.value_text,
+    pav.value_number,
+    pav.value_boolean
+FROM product_attribute_values pav
+JOIN category_attributes ca ON ca.id = pav.attribute_id
+JOIN products p ON p.id = pav.product_id
+WHERE pav.product_id = $1
+  -- abaikan nilai sisa dari kategori lama jika kategori produk diganti
+  AND ca.category_id = p.category_id
+ORDER BY ca.sort_order ASC, ca.name ASC;
@@ -0,0 +1,60 @@
+-- name: ListProductImages :many
+SELECT *
+FROM product_images
+WHERE product_id = $1
+ORDER BY sort_order ASC, created_at ASC;
+
+-- name: GetProductImageByID :one
+SELECT *
+FROM product_images
+WHERE id = $1
+  AND product_id = $2
+LIMIT 1;
+
+-- name: GetNextProductImageSortOrder :one
+SELECT (COALESCE(MAX(sort_order), -1) + 1)::int AS next_sort_order
+FROM product_images
+WHERE product_id = $1;
+
+-- name: CreateProductImage :one
+INSERT INTO product_images (product_id, image_url, alt_text, sort_order, is_primary)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpsertPrimaryProductImage :exec
+INSERT INTO product_images (product_id, image_url, sort_order, is_primary)
+VALUES ($1, $2, 0, TRUE)
+-- gambar utama dari create/update produk menggantikan primary yang lama
+ON CONFLICT (product_id) WHERE is_primary
+DO UPDATE SET image_url = EXCLUDED.image_url;
+
+-- name: UpdateProductImageAltText :one
+UPDATE product_images
+SET alt_text = $3
+WHERE id = $1
+  AND product_id = $2
+RETURNING *;
+
+-- name: UpdateProductImageSortOrder :execrows
+UPDATE product_images
+SET sort_order = $3
+WHERE id = $1
+  AND product_id = $2;
+
+-- name: ClearPrimaryProductImage :exec
+UPDATE product_images
+SET is_primary = FALSE
+WHERE product_id = $1
+  AND is_primary;
+
+-- name: SetPrimaryProductImage :one
+UPDATE product_images
+SET is_primary = TRUE
+WHERE id = $1
+  AND product_id = $2
+RETURNING *;
+
+-- name: DeleteProductImage :execrows
+DELETE FROM product_images
+WHERE id = $1
+  AND product_id = $2;
@@ -0,0 +1,51 @@
+-- name: CreateProductImportJob :one
+INSERT INTO product_import_jobs (dry_run, filename, total_rows, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetProductImportJob :one
+SELECT * FROM product_import_jobs
+WHERE id = $1;
+
+-- name: StartProductImportJob :exec
+UPDATE product_import_jobs
+SET status = 'PROCESSING',
+    started_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateProductImportJobProgress :exec
+UPDATE product_import_jobs
+SET processed_rows = $2,
+    created_count = $3,
+    updated_count = $4,
+    failed_count = $5
+WHERE id = $1;
+
+-- name: FinishProductImportJob :exec
+UPDATE product_import_jobs
+SET status = $2,
+    processed_rows = $3,
+    created_count = $4,
+    updated_count = $5,
+    failed_count = $6,
+    errors = $7,
+    message = $8,
+    finished_at = NOW()
+WHERE id = $1;
+
+-- name: FailProductImportJob :exec
+UPDATE product_import_jobs
+SET status = 'FAILED',
+    message = $2,
+    finished_at = NOW()
+WHERE id = $1
+  AND status IN ('PENDING', 'PROCESSING');
+
+-- Job yang goroutine-nya mati (mis. API restart) tidak akan pernah selesai
+-- name: FailStaleProductImportJobs :execrows
+UPDATE product_import_jobs
+SET status = 'FAILED',
+    message = 'Import interrupted, please retry',
+    finished_at = NOW()
+WHERE status IN ('PENDING', 'PROCESSING')
+  AND COALESCE(started_at, created_at) < sqlc.arg('started_before')::timestamp;
@@ -0,0 +1,154 @@
+-- name: CreatePriceSchedule :one
+INSERT INTO product_price_schedules (product_id, price, discount_price, starts_at, ends_at, note, created_by)
+VALUES (
+  sqlc.arg('product_id'),
+  sqlc.arg('price'),
+  sqlc.narg('discount_price'),
+  sqlc.arg('starts_at'),
+  sqlc.narg('ends_at'),
+  sqlc.narg('note'),
+  sqlc.narg('created_by')
+)
+RETURNING *;
+
+-- Jadwal PENDING/ACTIVE yang periodenya beririsan; ends_at NULL dianggap tanpa batas
+-- name: CountOverlappingPriceSchedules :one
+SELECT COUNT(*)
+FROM product_price_schedules
+WHERE product_id = sqlc.arg('product_id')
+  AND status IN ('PENDING', 'ACTIVE')
+  AND starts_at < COALESCE(sqlc.narg('ends_at')::timestamp, 'infinity'::timestamp)
+  AND COALESCE(ends_at, 'infinity'::timestamp) > sqlc.arg('starts_at')::timestamp;
+
+-- name: GetPriceSchedule :one
+SELECT *
+FROM product_price_schedules
+WHERE id = $1 AND product_id = $2;
+
+-- name: ListPriceSchedules :many
+SELECT
+  s.*,
+  u.name AS created_by_name,
+  COUNT(*) OVER() AS total_count
+FROM product_price_schedules s
+LEFT JOIN users u ON u.id = s.created_by
+WHERE s.product_id = sqlc.arg('product_id')
+  AND (sqlc.narg('status')::text IS NULL OR s.status = sqlc.narg('status')::text)
+ORDER BY s.starts_at DESC, s.created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CancelPendingPriceSchedule :one
+UPDATE product_price_schedules
+SET status = 'CANCELLED',
+    ended_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'PENDING'
+RETURNING *;
+
+-- Jadwal yang sudah waktunya diterapkan (periode yang sudah lewat ditangani ExpirePriceSchedules)
+-- name: ListDuePriceSchedules :many
+SELECT *
+FROM product_price_schedules
+WHERE status = 'PENDING'
+  AND starts_at <= sqlc.arg('now')::timestamp
+  AND (ends_at IS NULL OR ends_at > sqlc.arg('now')::timestamp)
+ORDER BY starts_at
+LIMIT sqlc.arg('row_limit');
+
+-- name: ListEndingPriceSchedules :many
+SELECT *
+FROM product_price_schedules
+WHERE status = 'ACTIVE'
+  AND ends_at <= sqlc.arg('now')::timestamp
+ORDER BY ends_at
+LIMIT sqlc.arg('row_limit');
+
+-- Jadwal PENDING yang periodenya terlewat (mis. worker mati) tidak diterapkan sama sekali
+-- name: ExpirePriceSchedules :execrows
+UPDATE product_price_schedules
+SET status = 'EXPIRED',
+    updated_at = NOW()
+WHERE status = 'PENDING'
+  AND ends_at <= sqlc.arg('now')::timestamp;
+
+-- Klaim jadwal sekaligus menyimpan harga sebelumnya. Status PENDING di WHERE mencegah
+-- jadwal diterapkan dua kali bila worker berjalan paralel.
+-- Jadwal tanpa ends_at langsung COMPLETED karena tidak ada yang perlu dikembalikan.
+-- name: StartPriceSchedule :one
+UPDATE product_price_schedules s
+SET status = CASE WHEN s.ends_at IS NULL THEN 'COMPLETED' ELSE 'ACTIVE' END,
+    previous_price = p.price,
+    previous_discount_price = p.discount_price,
+    applied_at = NOW(),
+    updated_at = NOW()
+FROM products p
+WHERE s.id = $1
+  AND s.status = 'PENDING'
+  AND p.id = s.product_id
+RETURNING s.*;
+
+-- name: FinishPriceSchedule :one
+UPDATE product_price_schedules
+SET status = sqlc.arg('status'),
+    ended_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status = 'ACTIVE'
+RETURNING *;
+
+-- name: SetProductPrice :exec
+UPDATE products
+SET price = sqlc.arg('price'),
+    discount_price = sqlc.narg('discount_price'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id');
+
+-- Harga dikembalikan hanya jika masih sama dengan harga jadwal;
+-- perubahan manual oleh admin selama periode jadwal tidak ditimpa.
+-- name: RevertScheduledPrice :execrows
+UPDATE products p
+SET price = s.previous_price,
+    discount_price = s.previous_discount_price,
+    updated_at = NOW()
+FROM product_price_schedules s
+WHERE s.id = $1
+  AND p.id = s.product_id
+  AND s.previous_price IS NOT NULL
+  AND p.price = s.price
+  AND p.discount_price IS NOT DISTINCT FROM s.discount_price;
+
+-- Snapshot harga produk saat ini ke riwayat, dilewati jika sama dengan entri terakhir
+-- name: RecordPriceChange :exec
+INSERT INTO product_price_history (product_id, price, discount_price, source, schedule_id, changed_by)
+SELECT p.id, p.price, p.discount_price, sqlc.arg('source')::text, sqlc.narg('schedule_id')::uuid, sqlc.narg('changed_by')::uuid
+FROM products p
+WHERE p.id = sqlc.arg('product_id')
+  AND NOT EXISTS (
+    SELECT 1
+    FROM (
+      SELECT h.price, h.discount_price
+      FROM product_price_history h
+      WHERE h.product_id = p.id
+      ORDER BY h.created_at DESC, h.id DESC
+      LIMIT 1
+    ) last
+    WHERE last.price = p.price
+      AND last.discount_price IS NOT DISTINCT FROM p.discount_price
+  );
+
+-- name: ListPriceHistory :many
+SELECT
+  h.id,
+  h.product_id,
+  h.price,
+  h.discount_price,
+  h.source,
+  h.schedule_id,
+  h.changed_by,
+  u.name AS changed_by_name,
+  h.created_at,
+  COUNT(*) OVER() AS total_count
+FROM product_price_history h
+LEFT JOIN users u ON u.id = h.changed_by
+WHERE h.product_id = sqlc.arg('product_id')
+ORDER BY h.created_at DESC, h.id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
@@ -0,0 +1,88 @@
+-- Produk terkait: kategori dan/atau brand yang sama. Kecocokan kategori lebih berbobot
+-- dari brand, lalu diurutkan berdasarkan selisih harga terkecil.
+-- name: ListRelatedProducts :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  c.name AS category_name
+FROM products p
+JOIN categories c ON c.id = p.category_id
+WHERE p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW()))
+  AND p.id <> sqlc.arg('product_id')
+  AND (p.category_id = sqlc.arg('category_id') OR p.brand_id = sqlc.narg('brand_id'))
+ORDER BY
+  (p.category_id = sqlc.arg('category_id'))::int * 2 + COALESCE((p.brand_id = sqlc.narg('brand_id'))::int, 0) DESC,
+  ABS(p.price - sqlc.arg('price')::numeric),
+  p.id
+LIMIT sqlc.arg('limit');
+
+-- name: ListBoughtTogether :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  c.name AS category_name,
+  cp.order_count
+FROM product_co_purchases cp
+JOIN products p ON p.id = cp.related_product_id
+JOIN categories c ON c.id = p.category_id
+WHERE cp.product_id = sqlc.arg('product_id')
+  AND p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW()))
+ORDER BY cp.order_count DESC, cp.last_ordered_at DESC
+LIMIT sqlc.arg('limit');
+
+-- name: DeleteProductCoPurchases :exec
+DELETE FROM product_co_purchases;
+
+-- Hitung ulang co-occurrence dari order COMPLETED sejak "since".
+-- Hanya pasangan dengan minimal min_orders order, dan per produk disimpan per_product pasangan teratas.
+-- name: InsertProductCoPurchases :execrows
+INSERT INTO product_co_purchases (product_id, related_product_id, order_count, last_ordered_at)
+SELECT ranked.product_id, ranked.related_product_id, ranked.order_count, ranked.last_ordered_at
+FROM (
+  SELECT
+    a.product_id,
+    b.product_id AS related_product_id,
+    COUNT(DISTINCT a.order_id)::int AS order_count,
+    MAX(o.created_at) AS last_ordered_at,
+    ROW_NUMBER() OVER (
+      PARTITION BY a.product_id
+      ORDER BY COUNT(DISTINCT a.order_id) DESC, MAX(o.created_at) DESC
+    ) AS pair_rank
+  FROM order_items a
+  JOIN order_items b ON b.order_id = a.order_id AND b.product_id <> a.product_id
+  JOIN orders o ON o.id = a.order_id
+  WHERE o.status = 'COMPLETED'
+    AND o.created_at >= sqlc.arg('since')
+  GROUP BY a.product_id, b.product_id
+  HAVING COUNT(DISTINCT a.order_id) >= sqlc.arg('min_orders')::int
+) ranked
+WHERE ranked.pair_rank <= sqlc.arg('per_product')::int;
+
+-- Ringkasan produk untuk daftar "terakhir dilihat"; urutan mengikuti ids di service
+-- name: ListProductSummariesByIDs :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  c.name AS category_name
+FROM products p
+JOIN categories c ON c.id = p.category_id
+WHERE p.id = ANY(sqlc.arg('ids')::uuid[])
+  AND p.deleted_at IS NULL
+  AND p.is_active = true
+  AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW()));
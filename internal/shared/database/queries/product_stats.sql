@@ -0,0 +1,48 @@
+-- Hitung ulang semua counter popularitas dalam satu statement.
+-- Penjualan hanya dari order COMPLETED; "recent" = sejak since (default 7 hari terakhir).
+-- View per produk dihitung di Redis lalu dikirim sebagai dua array sejajar.
+-- trending_score: 1 unit terjual setara 10 view.
+-- name: RefreshProductStats :execrows
+INSERT INTO product_stats (
+  product_id, sold_count, rating_avg, rating_count,
+  recent_sold_count, recent_view_count, trending_score, updated_at
+)
+SELECT
+  p.id,
+  COALESCE(s.sold_count, 0),
+  COALESCE(r.rating_avg, 0),
+  COALESCE(r.rating_count, 0),
+  COALESCE(s.recent_sold_count, 0),
+  COALESCE(v.view_count, 0),
+  COALESCE(s.recent_sold_count, 0) * 10 + COALESCE(v.view_count, 0),
+  NOW()
+FROM products p
+LEFT JOIN (
+  SELECT
+    oi.product_id,
+    SUM(oi.quantity)::int AS sold_count,
+    COALESCE(SUM(oi.quantity) FILTER (
+      WHERE COALESCE(o.completed_at, o.created_at) >= sqlc.arg('since')
+    ), 0)::int AS recent_sold_count
+  FROM order_items oi
+  JOIN orders o ON o.id = oi.order_id
+  WHERE o.status = 'COMPLETED'
+  GROUP BY oi.product_id
+) s ON s.product_id = p.id
+LEFT JOIN (
+  SELECT product_id, ROUND(AVG(rating), 2) AS rating_avg, COUNT(*)::int AS rating_count
+  FROM reviews
+  WHERE deleted_at IS NULL
+  GROUP BY product_id
+) r ON r.product_id = p.id
+LEFT JOIN unnest(sqlc.arg('view_product_ids')::uuid[], sqlc.arg('view_counts')::int[]) AS v(product_id, view_count)
+  ON v.product_id = p.id
+WHERE p.deleted_at IS NULL
+ON CONFLICT (product_id) DO UPDATE SET
+  sold_count = EXCLUDED.sold_count,
+  rating_avg = EXCLUDED.rating_avg,
+  rating_count = EXCLUDED.rating_count,
+  recent_sold_count = EXCLUDED.recent_sold_count,
+  recent_view_count = EXCLUDED.recent_view_count,
+  trending_score = EXCLUDED.trending_score,
+  updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,104 @@
+-- name: UpsertProductOption :one
+INSERT INTO product_options (product_id, name)
+VALUES ($1, $2)
+ON CONFLICT (product_id, name) DO UPDATE SET name = EXCLUDED.name
+RETURNING *;
+
+-- name: UpsertProductOptionValue :one
+INSERT INTO product_option_values (option_id, value)
+VALUES ($1, $2)
+ON CONFLICT (option_id, value) DO UPDATE SET value = EXCLUDED.value
+RETURNING *;
+
+-- Stok variant (awal maupun perubahan) dicatat lewat ApplyStockMovement
+-- name: CreateProductVariant :one
+INSERT INTO product_variants (product_id, sku, price, discount_price, image_url)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: AddProductVariantValue :exec
+INSERT INTO product_variant_values (variant_id, option_value_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UpdateProductVariant :one
+UPDATE product_variants
+SET
+    sku = $3,
+    price = $4,
+    discount_price = $5,
+    image_url = $6,
+    is_active = $7,
+    updated_at = NOW()
+WHERE id = $1
+  AND product_id = $2
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetProductVariantByID :one
+SELECT *
+FROM product_variants
+WHERE id = $1
+  AND product_id = $2
+  AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: SoftDeleteProductVariant :execrows
+UPDATE product_variants
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND product_id = $2
+  AND deleted_at IS NULL;
+
+-- name: ListProductVariants :many
+SELECT
+    v.id,
+    v.product_id,
+    v.sku,
+    v.price,
+    v.discount_price,
+    v.stock,
+    v.image_url,
+    v.is_active,
+    v.created_at,
+    v.updated_at,
+    COALESCE((
+        SELECT jsonb_object_agg(o.name, ov.value)
+        FROM product_variant_values pvv
+        JOIN product_option_values ov ON ov.id = pvv.option_value_id
+        JOIN product_options o ON o.id = ov.option_id
+        WHERE pvv.variant_id = v.id
+    ), '{}'::jsonb)::jsonb AS options
+FROM product_variants v
+WHERE v.product_id = $1
+  AND v.deleted_at IS NULL
+ORDER BY v.created_at ASC;
+
+-- name: ListProductOptions :many
+SELECT
+    o.id,
+    o.name,
+    jsonb_agg(ov.value ORDER BY ov.created_at)::jsonb AS option_values
+FROM product_options o
+JOIN product_option_values ov ON ov.option_id = o.id
+WHERE o.product_id = $1
+  -- hanya nilai yang dipakai variant aktif
+  AND EXISTS (
+    SELECT 1
+    FROM product_variant_values pvv
+    JOIN product_variants v ON v.id = pvv.variant_id
+    WHERE pvv.option_value_id = ov.id
+      AND v.deleted_at IS NULL
+      AND v.is_active = true
+  )
+GROUP BY o.id, o.name, o.created_at
+ORDER BY o.created_at ASC;
+
+-- name: ProductHasVariants :one
+SELECT EXISTS (
+    SELECT 1
+    FROM product_variants
+    WHERE product_id = $1
+      AND deleted_at IS NULL
+) AS has_variants;
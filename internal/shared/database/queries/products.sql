@@ -2,43 +2,380 @@
 SELECT 
   p.*, 
   c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
   count(*) OVER() AS total_count
-FROM products p
+-- Semua filter listing publik ada di fungsi public_products (migration 000040)
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
 JOIN categories c ON p.category_id = c.id
-LEFT JOIN brands b ON p.brand_id = b.id
-WHERE 
-  p.deleted_at IS NULL
-  AND p.is_active = true
-  
-  -- Perbaikan filter kategori
-  AND (
-    COALESCE(cardinality(sqlc.narg('category_ids')::uuid[]), 0) = 0
-    OR p.category_id = ANY(sqlc.narg('category_ids')::uuid[])
-  )
-
-  AND (
-    sqlc.narg('search')::text IS NULL
-    OR p.name ILIKE '%' || sqlc.narg('search')::text || '%'
-  )
-
-  AND (
-    sqlc.narg('brand_slug')::text IS NULL
-    OR b.slug = sqlc.narg('brand_slug')::text
-  )
-
-  -- Pastikan casting aman
-  AND p.price >= sqlc.arg('min_price')::numeric
-  AND p.price <= sqlc.arg('max_price')::numeric
-
+-- Urutan selalu diakhiri id agar stabil antar halaman
 ORDER BY 
+  CASE WHEN LOWER(sqlc.arg('sort_by')::text) = 'relevance' THEN
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name)
+  END DESC NULLS LAST,
   CASE WHEN LOWER(sqlc.arg('sort_by')::text) = 'newest' THEN p.created_at END DESC,
   CASE WHEN LOWER(sqlc.arg('sort_by')::text) = 'oldest' THEN p.created_at END ASC,
   CASE WHEN LOWER(sqlc.arg('sort_by')::text) = 'price_high' THEN p.price END DESC,
   CASE WHEN LOWER(sqlc.arg('sort_by')::text) = 'price_low' THEN p.price END ASC,
-  p.created_at DESC
+  p.created_at DESC,
+  p.id DESC
+LIMIT $1 OFFSET $2;
+
+-- Sort popularitas: satu query per sort supaya ORDER BY bisa dilayani index product_stats
+-- (CASE di ORDER BY ListProductsPublic memaksa sort seluruh hasil filter).
+-- name: ListProductsPublicBestSelling :many
+SELECT 
+  p.*, 
+  c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
+  count(*) OVER() AS total_count
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- INNER JOIN: produk baru ikut setelah refresh worker berikutnya
+JOIN product_stats ps ON ps.product_id = p.id
+-- Urutan mengikuti index idx_product_stats_*, diakhiri id agar stabil antar halaman
+ORDER BY ps.sold_count DESC, p.id
+LIMIT $1 OFFSET $2;
+
+-- name: ListProductsPublicTopRated :many
+SELECT 
+  p.*, 
+  c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
+  count(*) OVER() AS total_count
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- INNER JOIN: produk baru ikut setelah refresh worker berikutnya
+JOIN product_stats ps ON ps.product_id = p.id
+-- Urutan mengikuti index idx_product_stats_*, diakhiri id agar stabil antar halaman
+ORDER BY ps.rating_avg DESC, ps.rating_count DESC, p.id
+LIMIT $1 OFFSET $2;
+
+-- name: ListProductsPublicTrending :many
+SELECT 
+  p.*, 
+  c.name AS category_name,
+  -- Skor relevansi: bobot full-text + kemiripan trigram nama (toleransi typo), 0 jika tanpa search
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  -- Highlight hasil pencarian, NULL jika tanpa search
+  -- Kata yang cocok ditandai \x01..\x02, aplikasi meng-escape teks lalu menggantinya dengan <mark>
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet,
+  count(*) OVER() AS total_count
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- INNER JOIN: produk baru ikut setelah refresh worker berikutnya
+JOIN product_stats ps ON ps.product_id = p.id
+-- Urutan mengikuti index idx_product_stats_*, diakhiri id agar stabil antar halaman
+ORDER BY ps.trending_score DESC, p.id
 LIMIT $1 OFFSET $2;
 
 
+-- Keyset (cursor) listing publik: satu query per arah urutan agar predikat baris dan ORDER BY
+-- sama persis dengan index (idx_products_keyset_created / idx_products_keyset_price).
+-- Tanpa COUNT total; halaman pertama memakai cursor batas (lihat cursor.Bound).
+-- Desc = newest (next) / oldest (prev), Asc = kebalikannya.
+-- name: ListProductsPublicByCreatedDesc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.created_at, p.id) < (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT $1;
+
+-- name: ListProductsPublicByCreatedAsc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.created_at, p.id) > (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY p.created_at ASC, p.id ASC
+LIMIT $1;
+
+-- Desc = price_high (next) / price_low (prev), Asc = kebalikannya
+-- name: ListProductsPublicByPriceDesc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.price, p.created_at, p.id) < (sqlc.arg('cursor_price')::numeric, sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY p.price DESC, p.created_at DESC, p.id DESC
+LIMIT $1;
+
+-- name: ListProductsPublicByPriceAsc :many
+SELECT
+  p.id,
+  p.category_id,
+  p.name,
+  p.slug,
+  p.price,
+  p.image_url,
+  p.created_at,
+  c.name AS category_name,
+  COALESCE(
+    ts_rank_cd(p.search_vector, websearch_to_tsquery('simple', sqlc.narg('search')::text))
+    + word_similarity(sqlc.narg('search')::text, p.name),
+    0
+  )::float8 AS search_rank,
+  ts_headline('simple', p.name, websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, HighlightAll=true') AS name_highlight,
+  ts_headline('simple', COALESCE(p.description, ''), websearch_to_tsquery('simple', sqlc.narg('search')::text),
+    E'StartSel=\x01, StopSel=\x02, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" ... "') AS search_snippet
+FROM public_products(
+  sqlc.narg('category_ids')::uuid[],
+  sqlc.narg('search')::text,
+  sqlc.narg('brand_slug')::text,
+  sqlc.arg('min_price')::numeric,
+  sqlc.arg('max_price')::numeric,
+  sqlc.arg('attr_codes')::text[],
+  sqlc.arg('attr_values')::text[],
+  sqlc.arg('min_rating')::numeric,
+  sqlc.narg('in_stock')::bool
+) p
+JOIN categories c ON p.category_id = c.id
+-- Posisi cursor: row comparison biasa agar bisa dilayani index
+WHERE (p.price, p.created_at, p.id) > (sqlc.arg('cursor_price')::numeric, sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY p.price ASC, p.created_at ASC, p.id ASC
+LIMIT $1;
+
+
+-- Facet sidebar untuk listing publik. Setiap facet dihitung dengan semua filter aktif
+-- KECUALI filter miliknya sendiri, agar user tetap melihat opsi lain (mis. brand lain saat ?brandSlug=apple).
+-- Search & atribut selalu diterapkan (published_products). Bucket harga dikirim dari aplikasi sebagai dua array sejajar.
+-- name: GetProductFacets :many
+WITH base AS (
+  SELECT
+    p.id,
+    p.category_id,
+    p.brand_id,
+    p.price,
+    p.stock,
+    COALESCE(rt.avg_rating, 0)::numeric AS avg_rating,
+    (
+      COALESCE(cardinality(sqlc.narg('category_ids')::uuid[]), 0) = 0
+      OR p.category_id = ANY(sqlc.narg('category_ids')::uuid[])
+    ) AS match_category,
+    (sqlc.narg('brand_slug')::text IS NULL OR b.slug = sqlc.narg('brand_slug')::text) AS match_brand,
+    (p.price >= sqlc.arg('min_price')::numeric AND p.price <= sqlc.arg('max_price')::numeric) AS match_price,
+    (sqlc.arg('min_rating')::numeric = 0 OR COALESCE(rt.avg_rating, 0) >= sqlc.arg('min_rating')::numeric) AS match_rating,
+    (sqlc.narg('in_stock')::bool IS NULL OR (p.stock > 0) = sqlc.narg('in_stock')::bool) AS match_stock
+  FROM published_products(
+    sqlc.narg('search')::text,
+    sqlc.arg('attr_codes')::text[],
+    sqlc.arg('attr_values')::text[]
+  ) p
+  LEFT JOIN brands b ON p.brand_id = b.id
+  LEFT JOIN (
+    SELECT r.product_id, AVG(r.rating) AS avg_rating
+    FROM reviews r
+    WHERE r.deleted_at IS NULL
+    GROUP BY r.product_id
+  ) rt ON rt.product_id = p.id
+)
+SELECT 'brand'::text AS facet, br.slug::text AS key, br.name::text AS label, COUNT(*)::bigint AS count
+FROM base
+JOIN brands br ON br.id = base.brand_id
+WHERE match_category AND match_price AND match_rating AND match_stock
+GROUP BY br.slug, br.name
+
+UNION ALL
+
+SELECT 'category'::text, c.slug::text, c.name::text, COUNT(*)::bigint
+FROM base
+JOIN categories c ON c.id = base.category_id
+WHERE match_brand AND match_price AND match_rating AND match_stock
+GROUP BY c.slug, c.name
+
+UNION ALL
+
+-- key = urutan bucket (1-based), label disusun di aplikasi
+SELECT 'price'::text, bucket.idx::text, ''::text, COUNT(base.id)::bigint
+FROM unnest(sqlc.arg('price_bucket_mins')::numeric[], sqlc.arg('price_bucket_maxs')::numeric[])
+  WITH ORDINALITY AS bucket(min_price, max_price, idx)
+LEFT JOIN base
+  ON base.price >= bucket.min_price
+  AND base.price < bucket.max_price
+  AND match_category AND match_brand AND match_rating AND match_stock
+GROUP BY bucket.idx
+
+UNION ALL
+
+-- Rating band kumulatif: "4 ke atas", "3 ke atas", dst.
+SELECT 'rating'::text, band.min_rating::text, ''::text, COUNT(base.id)::bigint
+FROM generate_series(1, 4) AS band(min_rating)
+LEFT JOIN base
+  ON base.avg_rating >= band.min_rating
+  AND match_category AND match_brand AND match_price AND match_stock
+GROUP BY band.min_rating
+
+UNION ALL
+
+SELECT 'stock'::text, CASE WHEN base.stock > 0 THEN 'in_stock' ELSE 'out_of_stock' END, ''::text, COUNT(*)::bigint
+FROM base
+WHERE match_category AND match_brand AND match_price AND match_rating
+GROUP BY 2;
+
 -- name: ListProductsAdmin :many
 SELECT
     p.*,
@@ -123,17 +460,34 @@ WHERE slug = ANY($1::text[])
 AND deleted_at IS NULL;
 
 
+-- lowest_price_30d: harga efektif (diskon jika ada) terendah dalam 30 hari terakhir,
+-- termasuk harga yang masih berlaku saat periode 30 hari dimulai
 -- name: GetProductBySlug :one
-SELECT p.*, c.name as category_name 
+SELECT p.*, c.name as category_name,
+  LEAST(
+    COALESCE(p.discount_price, p.price),
+    COALESCE((
+      SELECT MIN(COALESCE(h.discount_price, h.price))
+      FROM product_price_history h
+      WHERE h.product_id = p.id
+        AND h.created_at >= COALESCE((
+          SELECT MAX(prev.created_at)
+          FROM product_price_history prev
+          WHERE prev.product_id = p.id AND prev.created_at <= NOW() - INTERVAL '30 days'
+        ), NOW() - INTERVAL '30 days')
+    ), COALESCE(p.discount_price, p.price))
+  )::text AS lowest_price_30d
 FROM products p
 JOIN categories c ON p.category_id = c.id
 WHERE p.slug = $1 AND p.deleted_at IS NULL LIMIT 1;
 
+-- Produk baru selalu mulai dari stok 0, stok awal dicatat lewat ApplyStockMovement
 -- name: CreateProduct :one
-INSERT INTO products (brand_id, category_id, name, slug, description, price, stock, sku, image_url)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO products (brand_id, category_id, name, slug, description, price, sku, image_url, max_qty_per_order, low_stock_threshold, status, publish_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
+-- Stok tidak diubah di sini, perubahan stok wajib lewat ApplyStockMovement (ledger)
 -- name: UpdateProduct :one
 UPDATE products
 SET 
@@ -142,10 +496,12 @@ SET
     name = $4,
     description = $5,
     price = $6,
-    stock = $7,
-    sku = $8,
-    image_url = $9,
-    is_active = $10,
+    sku = $7,
+    image_url = $8,
+    is_active = $9,
+    max_qty_per_order = $10,
+    low_stock_threshold = $11,
+    slug = $12,
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
@@ -153,5 +509,144 @@ RETURNING *;
 -- name: SoftDeleteProduct :exec
 UPDATE products SET deleted_at = NOW() WHERE id = $1;
 
+-- name: SetProductImageURL :exec
+UPDATE products
+SET image_url = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
 -- name: RestoreProduct :one
 UPDATE products SET deleted_at = NULL WHERE id = $1 RETURNING *;
+
+-- Autocomplete search box: prefix match diprioritaskan, trigram menangani typo.
+-- Masing-masing jenis dibatasi limit_per_kind agar hasil produk tidak menenggelamkan brand/kategori.
+-- name: SuggestSearchTerms :many
+(
+  SELECT 'product'::text AS kind, p.name, p.slug,
+    word_similarity(sqlc.arg('query')::text, p.name)::float8 AS score
+  FROM products p
+  WHERE p.deleted_at IS NULL
+    AND p.is_active = true
+    AND (p.status = 'PUBLISHED' OR (p.status = 'SCHEDULED' AND p.publish_at <= NOW()))
+    AND (p.name ILIKE sqlc.arg('query')::text || '%' OR sqlc.arg('query')::text <% p.name)
+  ORDER BY (p.name ILIKE sqlc.arg('query')::text || '%') DESC, score DESC, p.name
+  LIMIT sqlc.arg('limit_per_kind')::int
+)
+UNION ALL
+(
+  SELECT 'brand'::text AS kind, b.name, b.slug,
+    word_similarity(sqlc.arg('query')::text, b.name)::float8 AS score
+  FROM brands b
+  WHERE b.deleted_at IS NULL
+    AND b.is_active = true
+    AND (b.name ILIKE sqlc.arg('query')::text || '%' OR sqlc.arg('query')::text <% b.name)
+  ORDER BY (b.name ILIKE sqlc.arg('query')::text || '%') DESC, score DESC, b.name
+  LIMIT sqlc.arg('limit_per_kind')::int
+)
+UNION ALL
+(
+  SELECT 'category'::text AS kind, c.name, c.slug,
+    word_similarity(sqlc.arg('query')::text, c.name)::float8 AS score
+  FROM categories c
+  WHERE c.deleted_at IS NULL
+    AND c.is_active = true
+    AND (c.name ILIKE sqlc.arg('query')::text || '%' OR sqlc.arg('query')::text <% c.name)
+  ORDER BY (c.name ILIKE sqlc.arg('query')::text || '%') DESC, score DESC, c.name
+  LIMIT sqlc.arg('limit_per_kind')::int
+);
+
+-- Import CSV: upsert berdasarkan SKU. Produk yang pernah dihapus (soft delete) dengan SKU sama ikut dipulihkan.
+-- Slug hanya dibuat saat insert agar URL produk lama tidak berubah.
+-- Stok tidak disentuh di sini: selisihnya dicatat lewat ApplyStockMovement (reason IMPORT),
+-- kolom stock yang dikembalikan adalah saldo sebelum import (0 untuk produk baru).
+-- name: UpsertProductBySKU :one
+INSERT INTO products (brand_id, category_id, name, slug, description, price, discount_price, sku, image_url)
+VALUES (
+  sqlc.arg('brand_id'),
+  sqlc.arg('category_id'),
+  sqlc.arg('name'),
+  sqlc.arg('slug'),
+  sqlc.narg('description'),
+  sqlc.arg('price'),
+  sqlc.narg('discount_price'),
+  sqlc.arg('sku'),
+  sqlc.narg('image_url')
+)
+ON CONFLICT (sku) DO UPDATE
+SET brand_id = EXCLUDED.brand_id,
+    category_id = EXCLUDED.category_id,
+    name = EXCLUDED.name,
+    slug = EXCLUDED.slug,
+    description = EXCLUDED.description,
+    price = EXCLUDED.price,
+    discount_price = EXCLUDED.discount_price,
+    image_url = COALESCE(EXCLUDED.image_url, products.image_url),
+    updated_at = NOW()
+-- produk yang sudah dihapus tidak dihidupkan lagi lewat import (tidak ada baris yang dikembalikan)
+WHERE products.deleted_at IS NULL
+RETURNING id, stock, (xmax = 0)::bool AS inserted;
+
+-- Produk lama untuk baris import; dikunci agar rename & riwayat slug konsisten
+-- name: GetProductImportTargetBySKU :one
+SELECT id, name, slug, deleted_at
+FROM products
+WHERE sku = $1
+FOR UPDATE;
+
+-- Stok terkini untuk form edit produk; dikunci agar selisih adjustment dihitung dari saldo terbaru
+-- name: GetProductStockForUpdate :one
+SELECT stock
+FROM products
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: ListExistingProductSKUs :many
+SELECT sku::text AS sku, (deleted_at IS NOT NULL)::bool AS deleted
+FROM products
+WHERE sku = ANY(sqlc.arg('skus')::text[]);
+
+-- name: ListBrandRefsBySlugs :many
+SELECT id, slug
+FROM brands
+WHERE slug = ANY(sqlc.arg('slugs')::text[])
+  AND deleted_at IS NULL;
+
+-- name: ListCategoryRefsBySlugs :many
+SELECT id, slug
+FROM categories
+WHERE slug = ANY(sqlc.arg('slugs')::text[])
+  AND deleted_at IS NULL;
+
+-- Kolom sama dengan format import agar file hasil export bisa langsung di-import ulang
+-- name: ListProductsForExport :many
+SELECT
+  p.name,
+  p.sku,
+  b.slug AS brand_slug,
+  c.slug AS category_slug,
+  p.price,
+  p.discount_price,
+  p.stock,
+  p.description,
+  p.image_url
+FROM products p
+JOIN categories c ON p.category_id = c.id
+LEFT JOIN brands b ON p.brand_id = b.id
+WHERE p.deleted_at IS NULL
+ORDER BY p.created_at ASC, p.id ASC;
+
+-- Ubah status publikasi (DRAFT/SCHEDULED/PUBLISHED/ARCHIVED)
+-- name: SetProductPublication :one
+UPDATE products
+SET status = $2, publish_at = $3, updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- Terbitkan produk SCHEDULED yang publish_at-nya sudah lewat (listing publik sudah
+-- menghormati publish_at, ini merapikan status & memicu invalidasi cache)
+-- name: PublishDueProducts :execrows
+UPDATE products
+SET status = 'PUBLISHED', updated_at = NOW()
+WHERE status = 'SCHEDULED'
+  AND publish_at <= $1
+  AND deleted_at IS NULL;
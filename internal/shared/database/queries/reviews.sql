@@ -18,6 +18,25 @@ WHERE r.product_id = $1 AND r.deleted_at IS NULL
 ORDER BY r.created_at DESC
 LIMIT $2 OFFSET $3;
 
+-- name: GetReviewsByProductIDCreatedDesc :many
+SELECT r.*, u.name as user_name
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+WHERE r.product_id = sqlc.arg('product_id') AND r.deleted_at IS NULL
+  -- Posisi cursor: row comparison biasa agar bisa dilayani index
+  AND (r.created_at, r.id) < (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $1;
+
+-- name: GetReviewsByProductIDCreatedAsc :many
+SELECT r.*, u.name as user_name
+FROM reviews r
+JOIN users u ON r.user_id = u.id
+WHERE r.product_id = sqlc.arg('product_id') AND r.deleted_at IS NULL
+  AND (r.created_at, r.id) > (sqlc.arg('cursor_created_at')::timestamp, sqlc.arg('cursor_id')::uuid)
+ORDER BY r.created_at ASC, r.id ASC
+LIMIT $1;
+
 -- name: GetReviewsByUserID :many
 SELECT r.*, p.name as product_name, p.slug as product_slug
 FROM reviews r
@@ -0,0 +1,23 @@
+-- name: GetSlugHistoryOwner :one
+SELECT entity_id
+FROM slug_history
+WHERE entity_type = $1 AND slug = $2
+LIMIT 1;
+
+-- Slug historis milik entitas lain tidak boleh dipakai ulang; milik sendiri boleh diklaim kembali
+-- name: IsSlugRetired :one
+SELECT EXISTS (
+    SELECT 1
+    FROM slug_history
+    WHERE entity_type = $1 AND slug = $2 AND entity_id <> $3
+) AS retired;
+
+-- name: InsertSlugHistory :exec
+INSERT INTO slug_history (entity_type, entity_id, slug)
+VALUES ($1, $2, $3)
+ON CONFLICT (entity_type, slug) DO NOTHING;
+
+-- Slug yang dipakai lagi oleh pemiliknya keluar dari riwayat
+-- name: DeleteSlugHistory :exec
+DELETE FROM slug_history
+WHERE entity_type = $1 AND entity_id = $2 AND slug = $3;
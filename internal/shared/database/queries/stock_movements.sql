@@ -0,0 +1,116 @@
+-- Mutasi stok + update saldo dalam satu statement, jadi ledger & saldo tidak mungkin beda.
+-- Saldo yang berubah: warehouse_stocks gudang terkait + total di product_variants.stock (variant_id diisi)
+-- atau products.stock. warehouse_id NULL = gudang default.
+-- Tidak ada baris (sql.ErrNoRows) = produk/variant/gudang tidak ada atau saldo akan minus.
+-- low_stock_threshold ikut dikembalikan untuk deteksi alert stok menipis / kembali tersedia.
+-- name: ApplyStockMovement :one
+WITH target_warehouse AS (
+    SELECT id FROM warehouses
+    WHERE id = COALESCE(sqlc.narg('warehouse_id')::uuid, (SELECT id FROM warehouses WHERE is_default))
+),
+warehouse_balance AS (
+    INSERT INTO warehouse_stocks (warehouse_id, product_id, variant_id, stock)
+    SELECT tw.id, sqlc.arg('product_id'), sqlc.narg('variant_id')::uuid, GREATEST(sqlc.arg('delta')::int, 0)
+    FROM target_warehouse tw
+    WHERE (
+        sqlc.narg('variant_id')::uuid IS NULL
+        OR EXISTS (
+            SELECT 1 FROM product_variants
+            WHERE id = sqlc.narg('variant_id')::uuid AND product_id = sqlc.arg('product_id')
+        )
+      )
+      -- Pengurangan hanya dari gudang yang memang menyimpan barangnya
+      AND (
+        sqlc.arg('delta')::int > 0
+        OR EXISTS (
+            SELECT 1 FROM warehouse_stocks ws
+            WHERE ws.warehouse_id = tw.id
+              AND ws.product_id = sqlc.arg('product_id')
+              AND ws.variant_id IS NOT DISTINCT FROM sqlc.narg('variant_id')::uuid
+        )
+      )
+    ON CONFLICT (warehouse_id, product_id, variant_id)
+    DO UPDATE SET
+        stock = warehouse_stocks.stock + sqlc.arg('delta')::int,
+        updated_at = NOW()
+    WHERE warehouse_stocks.stock + sqlc.arg('delta')::int >= 0
+    RETURNING warehouse_id
+),
+product_balance AS (
+    UPDATE products
+    SET stock = stock + sqlc.arg('delta')::int,
+        updated_at = NOW()
+    WHERE id = sqlc.arg('product_id')
+      AND sqlc.narg('variant_id')::uuid IS NULL
+      AND stock + sqlc.arg('delta')::int >= 0
+      AND EXISTS (SELECT 1 FROM warehouse_balance)
+    RETURNING stock, low_stock_threshold
+),
+variant_balance AS (
+    UPDATE product_variants v
+    SET stock = v.stock + sqlc.arg('delta')::int,
+        updated_at = NOW()
+    FROM products p
+    WHERE v.id = sqlc.narg('variant_id')::uuid
+      AND v.product_id = sqlc.arg('product_id')
+      AND p.id = v.product_id
+      AND v.stock + sqlc.arg('delta')::int >= 0
+      AND EXISTS (SELECT 1 FROM warehouse_balance)
+    RETURNING v.stock, p.low_stock_threshold
+),
+balance AS (
+    SELECT stock, low_stock_threshold FROM product_balance
+    UNION ALL
+    SELECT stock, low_stock_threshold FROM variant_balance
+),
+movement AS (
+    INSERT INTO stock_movements (product_id, variant_id, warehouse_id, delta, balance_after, reason, reference_id, actor_id, note)
+    SELECT
+        sqlc.arg('product_id'),
+        sqlc.narg('variant_id')::uuid,
+        warehouse_balance.warehouse_id,
+        sqlc.arg('delta')::int,
+        balance.stock,
+        sqlc.arg('reason'),
+        sqlc.narg('reference_id'),
+        sqlc.narg('actor_id'),
+        sqlc.narg('note')
+    FROM balance, warehouse_balance
+    RETURNING *
+)
+SELECT movement.*, balance.low_stock_threshold
+FROM movement, balance;
+
+-- name: ListStockMovements :many
+SELECT
+    sm.id,
+    sm.product_id,
+    sm.variant_id,
+    pv.sku AS variant_sku,
+    sm.delta,
+    sm.balance_after,
+    sm.reason,
+    sm.reference_id,
+    sm.actor_id,
+    u.name AS actor_name,
+    sm.note,
+    sm.created_at,
+    sm.warehouse_id,
+    w.name AS warehouse_name,
+    COUNT(*) OVER() AS total_count
+FROM stock_movements sm
+INNER JOIN warehouses w ON w.id = sm.warehouse_id
+LEFT JOIN product_variants pv ON pv.id = sm.variant_id
+LEFT JOIN users u ON u.id = sm.actor_id
+WHERE sm.product_id = $1
+  AND (sqlc.narg('reason')::text IS NULL OR sm.reason = sqlc.narg('reason')::text)
+  AND (sqlc.narg('warehouse_id')::uuid IS NULL OR sm.warehouse_id = sqlc.narg('warehouse_id')::uuid)
+ORDER BY sm.created_at DESC, sm.id DESC
+LIMIT $2 OFFSET $3;
+
+-- Mutasi milik satu referensi (mis. SALE sebuah order), dipakai untuk mengembalikan stok saat order batal
+-- name: ListStockMovementsByReference :many
+SELECT * FROM stock_movements
+WHERE reference_id = $1
+  AND reason = $2
+ORDER BY created_at, id;
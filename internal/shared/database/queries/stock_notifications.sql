@@ -0,0 +1,34 @@
+-- Subscribe ulang setelah pernah dikirimi email = langganan aktif lagi
+-- name: UpsertStockNotification :one
+INSERT INTO stock_notifications (user_id, product_id, variant_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, product_id, variant_id)
+DO UPDATE SET notified_at = NULL,
+    created_at = NOW()
+RETURNING *;
+
+-- name: DeleteStockNotification :execrows
+DELETE FROM stock_notifications
+WHERE user_id = $1
+  AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM sqlc.narg('variant_id')::uuid;
+
+-- Penerima email PRODUCT_BACK_IN_STOCK (hanya user aktif)
+-- name: ListPendingStockNotifications :many
+SELECT
+    sn.id,
+    sn.user_id,
+    u.email,
+    u.name
+FROM stock_notifications sn
+JOIN users u ON u.id = sn.user_id
+WHERE sn.product_id = $1
+  AND sn.variant_id IS NOT DISTINCT FROM sqlc.narg('variant_id')::uuid
+  AND sn.notified_at IS NULL
+  AND u.is_active = true
+ORDER BY sn.created_at ASC;
+
+-- name: MarkStockNotificationSent :exec
+UPDATE stock_notifications
+SET notified_at = NOW()
+WHERE id = $1;
@@ -78,3 +78,11 @@ SET
     updated_at = NOW()
 WHERE id = $1 AND role = 'CUSTOMER'
 RETURNING id, name, email, phone, is_active, updated_at;
+
+-- Penerima alert operasional (mis. PRODUCT_LOW_STOCK)
+-- name: ListActiveAdmins :many
+SELECT id, name, email
+FROM users
+WHERE role IN ('ADMIN', 'SUPERADMIN')
+  AND is_active = true
+ORDER BY created_at ASC;
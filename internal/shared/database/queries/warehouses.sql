@@ -0,0 +1,123 @@
+-- name: CreateWarehouse :one
+INSERT INTO warehouses (code, name, province, city, address, priority, is_active)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: UpdateWarehouse :one
+UPDATE warehouses
+SET code = $2,
+    name = $3,
+    province = $4,
+    city = $5,
+    address = $6,
+    priority = $7,
+    is_active = $8,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: GetWarehouseByID :one
+SELECT * FROM warehouses
+WHERE id = $1;
+
+-- name: ListWarehouses :many
+SELECT * FROM warehouses
+ORDER BY is_default DESC, priority, name;
+
+-- Gudang default dipindah dalam satu transaksi: lepas yang lama dulu (unique index uq_warehouses_default)
+-- name: ClearDefaultWarehouse :exec
+UPDATE warehouses
+SET is_default = false,
+    updated_at = NOW()
+WHERE is_default = true;
+
+-- name: SetDefaultWarehouse :one
+UPDATE warehouses
+SET is_default = true,
+    updated_at = NOW()
+WHERE id = $1
+  AND is_active = true
+RETURNING *;
+
+-- name: ListWarehouseStocks :many
+SELECT
+    ws.warehouse_id,
+    w.code AS warehouse_code,
+    w.name AS warehouse_name,
+    ws.product_id,
+    p.name AS product_name,
+    ws.variant_id,
+    pv.sku AS variant_sku,
+    ws.stock,
+    ws.updated_at,
+    COUNT(*) OVER() AS total_count
+FROM warehouse_stocks ws
+INNER JOIN warehouses w ON w.id = ws.warehouse_id
+INNER JOIN products p ON p.id = ws.product_id
+LEFT JOIN product_variants pv ON pv.id = ws.variant_id
+WHERE (sqlc.narg('warehouse_id')::uuid IS NULL OR ws.warehouse_id = sqlc.narg('warehouse_id')::uuid)
+  AND (sqlc.narg('product_id')::uuid IS NULL OR ws.product_id = sqlc.narg('product_id')::uuid)
+ORDER BY p.name, pv.sku NULLS FIRST, w.priority, w.name
+LIMIT $1 OFFSET $2;
+
+-- name: CreateWarehouseTransfer :one
+INSERT INTO warehouse_transfers (from_warehouse_id, to_warehouse_id, product_id, variant_id, quantity, note, actor_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListWarehouseTransfers :many
+SELECT
+    t.id,
+    t.from_warehouse_id,
+    fw.name AS from_warehouse_name,
+    t.to_warehouse_id,
+    tw.name AS to_warehouse_name,
+    t.product_id,
+    p.name AS product_name,
+    t.variant_id,
+    pv.sku AS variant_sku,
+    t.quantity,
+    t.note,
+    t.actor_id,
+    u.name AS actor_name,
+    t.created_at,
+    COUNT(*) OVER() AS total_count
+FROM warehouse_transfers t
+INNER JOIN warehouses fw ON fw.id = t.from_warehouse_id
+INNER JOIN warehouses tw ON tw.id = t.to_warehouse_id
+INNER JOIN products p ON p.id = t.product_id
+LEFT JOIN product_variants pv ON pv.id = t.variant_id
+LEFT JOIN users u ON u.id = t.actor_id
+WHERE (
+    sqlc.narg('warehouse_id')::uuid IS NULL
+    OR t.from_warehouse_id = sqlc.narg('warehouse_id')::uuid
+    OR t.to_warehouse_id = sqlc.narg('warehouse_id')::uuid
+  )
+  AND (sqlc.narg('product_id')::uuid IS NULL OR t.product_id = sqlc.narg('product_id')::uuid)
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT $1 OFFSET $2;
+
+-- Gudang aktif yang sanggup memenuhi SEMUA item order (variant_ids pakai uuid nol untuk item tanpa variant).
+-- Urutan: gudang di provinsi tujuan dulu, lalu priority.
+-- name: ListFulfilmentWarehouses :many
+WITH items AS (
+    SELECT *
+    FROM UNNEST(
+        sqlc.arg('product_ids')::uuid[],
+        sqlc.arg('variant_ids')::uuid[],
+        sqlc.arg('quantities')::int[]
+    ) AS t(product_id, variant_id, quantity)
+)
+SELECT w.id, w.code, w.name, w.province
+FROM warehouses w
+WHERE w.is_active = true
+  AND NOT EXISTS (
+    SELECT 1
+    FROM items i
+    LEFT JOIN warehouse_stocks ws
+      ON ws.warehouse_id = w.id
+     AND ws.product_id = i.product_id
+     AND COALESCE(ws.variant_id, '00000000-0000-0000-0000-000000000000'::uuid) = i.variant_id
+    WHERE COALESCE(ws.stock, 0) < i.quantity
+  )
+ORDER BY (LOWER(w.province) = LOWER(sqlc.arg('province')::text)) DESC, w.priority, w.created_at;
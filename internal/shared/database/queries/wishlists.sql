@@ -8,9 +8,9 @@ RETURNING *;
 SELECT * FROM wishlists WHERE user_id = $1 LIMIT 1;
 
 -- name: AddWishlistItem :exec
-INSERT INTO wishlist_items (wishlist_id, product_id)
-VALUES ($1, $2)
-ON CONFLICT (wishlist_id, product_id) DO NOTHING;
+INSERT INTO wishlist_items (wishlist_id, product_id, variant_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (wishlist_id, product_id, variant_id) DO NOTHING;
 
 -- name: GetWishlistItems :many
 SELECT wi.*, p.name, p.price, p.stock, p.image_url
@@ -21,12 +21,14 @@ ORDER BY wi.created_at DESC;
 
 -- name: DeleteWishlistItem :exec
 DELETE FROM wishlist_items
-WHERE wishlist_id = $1 AND product_id = $2;
+WHERE wishlist_id = $1 AND product_id = $2
+  AND variant_id IS NOT DISTINCT FROM $3;
 
 -- name: CheckWishlistItemExists :one
 SELECT EXISTS(
     SELECT 1 FROM wishlist_items
     WHERE wishlist_id = $1 AND product_id = $2
+      AND variant_id IS NOT DISTINCT FROM $3
 ) AS exists;
 
 -- name: GetWishlistWithItems :one
@@ -40,6 +42,7 @@ SELECT
             jsonb_agg(
                 jsonb_build_object(
                     'id', wi.id,
+                    'variantId', wi.variant_id,
                     'addedAt', wi.created_at,
                     'product', jsonb_build_object(
                         'id', p.id,
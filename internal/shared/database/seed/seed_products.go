@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"go-gadget-api/internal/pkg/constants"
 	"go-gadget-api/internal/shared/database/dbgen"
 	"log"
 	"strings"
@@ -76,12 +77,11 @@ func SeedAll(db *sql.DB) error {
 			continue
 		}
 
-		_, err := q.CreateProduct(ctx, dbgen.CreateProductParams{
+		product, err := q.CreateProduct(ctx, dbgen.CreateProductParams{
 			CategoryID:  catID,
 			Name:        p.Name,
 			Slug:        strings.ToLower(strings.ReplaceAll(p.Name, " ", "-")) + "-" + uuid.New().String()[:4],
 			Price:       fmt.Sprintf("%.2f", p.Price),
-			Stock:       10,
 			Description: sql.NullString{String: "High quality " + p.Name, Valid: true},
 			Sku:         sql.NullString{String: "SKU-" + uuid.New().String()[:8], Valid: true},
 			ImageUrl:    sql.NullString{String: "https://picsum.photos/400", Valid: true},
@@ -90,6 +90,18 @@ func SeedAll(db *sql.DB) error {
 		if err != nil {
 			log.Printf("Gagal insert produk %s: %v\n", p.Name, err)
 			// Kita lanjut saja ke produk berikutnya jika satu gagal
+			continue
+		}
+
+		// Stok awal lewat ledger agar saldo = jumlah mutasi
+		_, err = q.ApplyStockMovement(ctx, dbgen.ApplyStockMovementParams{
+			Delta:     10,
+			ProductID: product.ID,
+			Reason:    constants.StockReasonAdjustment,
+			Note:      sql.NullString{String: "Seed", Valid: true},
+		})
+		if err != nil {
+			log.Printf("Gagal set stok produk %s: %v\n", p.Name, err)
 		}
 	}
 
@@ -0,0 +1,64 @@
+package warehouseerrors
+
+import (
+	"go-gadget-api/internal/pkg/apperror"
+	"net/http"
+)
+
+var (
+	ErrInvalidWarehouseID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid warehouse ID",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidProductID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid product ID",
+		http.StatusBadRequest,
+	)
+
+	ErrWarehouseNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"Warehouse not found",
+		http.StatusNotFound,
+	)
+
+	ErrWarehouseCodeExists = apperror.New(
+		apperror.CodeConflict,
+		"Warehouse code already exists",
+		http.StatusConflict,
+	)
+
+	// Gudang default menerima stok tanpa lokasi (create produk, import), jadi harus selalu aktif
+	ErrDefaultWarehouseInactive = apperror.New(
+		apperror.CodeInvalidInput,
+		"Default warehouse cannot be deactivated",
+		http.StatusBadRequest,
+	)
+
+	ErrWarehouseInactive = apperror.New(
+		apperror.CodeInvalidInput,
+		"Warehouse is not active",
+		http.StatusBadRequest,
+	)
+
+	ErrSameWarehouse = apperror.New(
+		apperror.CodeInvalidInput,
+		"Source and destination warehouse must be different",
+		http.StatusBadRequest,
+	)
+
+	// Stok gudang asal tidak cukup (atau produk/variant tidak ada di gudang tersebut)
+	ErrInsufficientStock = apperror.New(
+		apperror.CodeConflict,
+		"Insufficient stock in source warehouse",
+		http.StatusConflict,
+	)
+
+	ErrWarehouseFailed = apperror.New(
+		apperror.CodeInternalError,
+		"Failed to process warehouse operation",
+		http.StatusInternalServerError,
+	)
+)
@@ -0,0 +1,93 @@
+package warehouse
+
+import "time"
+
+// --- REQUEST DTO ---
+
+type CreateWarehouseRequest struct {
+	Code     string `json:"code" validate:"required,alphanum,min=2,max=20"`
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	Province string `json:"province" validate:"required,max=100"`
+	City     string `json:"city" validate:"max=100"`
+	Address  string `json:"address" validate:"max=500"`
+	Priority int32  `json:"priority" validate:"min=0"`
+}
+
+type UpdateWarehouseRequest struct {
+	Code     string `json:"code" validate:"required,alphanum,min=2,max=20"`
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	Province string `json:"province" validate:"required,max=100"`
+	City     string `json:"city" validate:"max=100"`
+	Address  string `json:"address" validate:"max=500"`
+	Priority int32  `json:"priority" validate:"min=0"`
+	IsActive *bool  `json:"isActive"`
+}
+
+type ListStocksRequest struct {
+	Page        int
+	Limit       int
+	WarehouseID string // kosong = semua gudang
+	ProductID   string // kosong = semua produk
+}
+
+// TransferRequest memindahkan stok antar gudang; total stok produk tidak berubah
+type TransferRequest struct {
+	FromWarehouseID string `json:"fromWarehouseId" validate:"required,uuid"`
+	ToWarehouseID   string `json:"toWarehouseId" validate:"required,uuid"`
+	ProductID       string `json:"productId" validate:"required,uuid"`
+	VariantID       string `json:"variantId" validate:"omitempty,uuid"`
+	Quantity        int32  `json:"quantity" validate:"required,min=1"`
+	Note            string `json:"note" validate:"max=500"`
+}
+
+type ListTransfersRequest struct {
+	Page        int
+	Limit       int
+	WarehouseID string // asal atau tujuan
+	ProductID   string
+}
+
+// --- RESPONSE DTO ---
+
+type WarehouseResponse struct {
+	ID        string    `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Province  string    `json:"province"`
+	City      string    `json:"city,omitempty"`
+	Address   string    `json:"address,omitempty"`
+	Priority  int32     `json:"priority"`
+	IsDefault bool      `json:"isDefault"`
+	IsActive  bool      `json:"isActive"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type WarehouseStockResponse struct {
+	WarehouseID   string    `json:"warehouseId"`
+	WarehouseCode string    `json:"warehouseCode"`
+	WarehouseName string    `json:"warehouseName"`
+	ProductID     string    `json:"productId"`
+	ProductName   string    `json:"productName"`
+	VariantID     string    `json:"variantId,omitempty"`
+	VariantSKU    string    `json:"variantSku,omitempty"`
+	Stock         int32     `json:"stock"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type TransferResponse struct {
+	ID                string    `json:"id"`
+	FromWarehouseID   string    `json:"fromWarehouseId"`
+	FromWarehouseName string    `json:"fromWarehouseName,omitempty"`
+	ToWarehouseID     string    `json:"toWarehouseId"`
+	ToWarehouseName   string    `json:"toWarehouseName,omitempty"`
+	ProductID         string    `json:"productId"`
+	ProductName       string    `json:"productName,omitempty"`
+	VariantID         string    `json:"variantId,omitempty"`
+	VariantSKU        string    `json:"variantSku,omitempty"`
+	Quantity          int32     `json:"quantity"`
+	Note              string    `json:"note,omitempty"`
+	ActorID           string    `json:"actorId,omitempty"`
+	ActorName         string    `json:"actorName,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
@@ -0,0 +1,148 @@
+package warehouse
+
+import (
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{service: s}
+}
+
+// GET /admin/warehouses
+func (h *Handler) List(c *gin.Context) {
+	res, err := h.service.List(c.Request.Context())
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// POST /admin/warehouses
+func (h *Handler) Create(c *gin.Context) {
+	var req CreateWarehouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data gudang tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.service.Create(c.Request.Context(), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// PATCH /admin/warehouses/:id
+func (h *Handler) Update(c *gin.Context) {
+	var req UpdateWarehouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data gudang tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.service.Update(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// PATCH /admin/warehouses/:id/default
+func (h *Handler) SetDefault(c *gin.Context) {
+	res, err := h.service.SetDefault(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusOK, res, nil)
+}
+
+// GET /admin/warehouses/stocks?warehouseId=&productId=
+func (h *Handler) ListStocks(c *gin.Context) {
+	page, limit := pageParams(c)
+
+	data, total, err := h.service.ListStocks(c.Request.Context(), ListStocksRequest{
+		Page:        page,
+		Limit:       limit,
+		WarehouseID: c.Query("warehouseId"),
+		ProductID:   c.Query("productId"),
+	})
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	meta := response.NewPaginationMeta(total, page, limit)
+	response.Success(c, http.StatusOK, data, &meta)
+}
+
+// POST /admin/warehouses/transfers
+func (h *Handler) Transfer(c *gin.Context) {
+	var req TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "INVALID_INPUT", "Data transfer stok tidak valid", err.Error())
+		return
+	}
+
+	res, err := h.service.Transfer(c.Request.Context(), c.GetString("user_id"), req)
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, res, nil)
+}
+
+// GET /admin/warehouses/transfers?warehouseId=&productId=
+func (h *Handler) ListTransfers(c *gin.Context) {
+	page, limit := pageParams(c)
+
+	data, total, err := h.service.ListTransfers(c.Request.Context(), ListTransfersRequest{
+		Page:        page,
+		Limit:       limit,
+		WarehouseID: c.Query("warehouseId"),
+		ProductID:   c.Query("productId"),
+	})
+	if err != nil {
+		httpErr := apperror.ToHTTP(err)
+		response.Error(c, httpErr.Status, httpErr.Code, httpErr.Message, nil)
+		return
+	}
+
+	meta := response.NewPaginationMeta(total, page, limit)
+	response.Success(c, http.StatusOK, data, &meta)
+}
+
+func pageParams(c *gin.Context) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
@@ -0,0 +1,171 @@
+package warehouse_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-gadget-api/internal/warehouse"
+	warehouseerrors "go-gadget-api/internal/warehouse/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== FAKE SERVICE ====================
+
+type fakeWarehouseService struct {
+	ListFn          func(ctx context.Context) ([]warehouse.WarehouseResponse, error)
+	CreateFn        func(ctx context.Context, req warehouse.CreateWarehouseRequest) (warehouse.WarehouseResponse, error)
+	UpdateFn        func(ctx context.Context, id string, req warehouse.UpdateWarehouseRequest) (warehouse.WarehouseResponse, error)
+	SetDefaultFn    func(ctx context.Context, id string) (warehouse.WarehouseResponse, error)
+	ListStocksFn    func(ctx context.Context, req warehouse.ListStocksRequest) ([]warehouse.WarehouseStockResponse, int64, error)
+	TransferFn      func(ctx context.Context, actorID string, req warehouse.TransferRequest) (warehouse.TransferResponse, error)
+	ListTransfersFn func(ctx context.Context, req warehouse.ListTransfersRequest) ([]warehouse.TransferResponse, int64, error)
+}
+
+func (f *fakeWarehouseService) List(ctx context.Context) ([]warehouse.WarehouseResponse, error) {
+	return f.ListFn(ctx)
+}
+func (f *fakeWarehouseService) Create(ctx context.Context, req warehouse.CreateWarehouseRequest) (warehouse.WarehouseResponse, error) {
+	return f.CreateFn(ctx, req)
+}
+func (f *fakeWarehouseService) Update(ctx context.Context, id string, req warehouse.UpdateWarehouseRequest) (warehouse.WarehouseResponse, error) {
+	return f.UpdateFn(ctx, id, req)
+}
+func (f *fakeWarehouseService) SetDefault(ctx context.Context, id string) (warehouse.WarehouseResponse, error) {
+	return f.SetDefaultFn(ctx, id)
+}
+func (f *fakeWarehouseService) ListStocks(ctx context.Context, req warehouse.ListStocksRequest) ([]warehouse.WarehouseStockResponse, int64, error) {
+	return f.ListStocksFn(ctx, req)
+}
+func (f *fakeWarehouseService) Transfer(ctx context.Context, actorID string, req warehouse.TransferRequest) (warehouse.TransferResponse, error) {
+	return f.TransferFn(ctx, actorID, req)
+}
+func (f *fakeWarehouseService) ListTransfers(ctx context.Context, req warehouse.ListTransfersRequest) ([]warehouse.TransferResponse, int64, error) {
+	return f.ListTransfersFn(ctx, req)
+}
+
+// ==================== HELPERS ====================
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return gin.New()
+}
+
+func TestWarehouseHandler_List(t *testing.T) {
+	svc := &fakeWarehouseService{
+		ListFn: func(ctx context.Context) ([]warehouse.WarehouseResponse, error) {
+			return []warehouse.WarehouseResponse{{Code: "JKT", IsDefault: true}, {Code: "SBY"}}, nil
+		},
+	}
+	r := setupTestRouter()
+	r.GET("/admin/warehouses", warehouse.NewHandler(svc).List)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/warehouses", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"SBY"`)
+}
+
+func TestWarehouseHandler_SetDefault(t *testing.T) {
+	id := uuid.NewString()
+	svc := &fakeWarehouseService{
+		SetDefaultFn: func(ctx context.Context, gotID string) (warehouse.WarehouseResponse, error) {
+			assert.Equal(t, id, gotID)
+			return warehouse.WarehouseResponse{}, warehouseerrors.ErrWarehouseInactive
+		},
+	}
+	r := setupTestRouter()
+	r.PATCH("/admin/warehouses/:id/default", warehouse.NewHandler(svc).SetDefault)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, "/admin/warehouses/"+id+"/default", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWarehouseHandler_ListStocks(t *testing.T) {
+	warehouseID := uuid.NewString()
+	svc := &fakeWarehouseService{
+		ListStocksFn: func(ctx context.Context, req warehouse.ListStocksRequest) ([]warehouse.WarehouseStockResponse, int64, error) {
+			assert.Equal(t, warehouseID, req.WarehouseID)
+			assert.Equal(t, 2, req.Page)
+			assert.Equal(t, 20, req.Limit)
+			return []warehouse.WarehouseStockResponse{{WarehouseCode: "SBY", Stock: 3}}, 21, nil
+		},
+	}
+	r := setupTestRouter()
+	r.GET("/admin/warehouses/stocks", warehouse.NewHandler(svc).ListStocks)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/warehouses/stocks?warehouseId="+warehouseID+"&page=2&limit=500", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"totalPages":2`)
+}
+
+func TestWarehouseHandler_Transfer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adminID := uuid.NewString()
+		svc := &fakeWarehouseService{
+			TransferFn: func(ctx context.Context, actorID string, req warehouse.TransferRequest) (warehouse.TransferResponse, error) {
+				assert.Equal(t, adminID, actorID)
+				assert.Equal(t, int32(5), req.Quantity)
+				return warehouse.TransferResponse{ID: uuid.NewString(), Quantity: req.Quantity}, nil
+			},
+		}
+		r := setupTestRouter()
+		r.POST("/admin/warehouses/transfers", func(c *gin.Context) {
+			c.Set("user_id", adminID)
+			c.Next()
+		}, warehouse.NewHandler(svc).Transfer)
+
+		body, _ := json.Marshal(map[string]any{
+			"fromWarehouseId": uuid.NewString(),
+			"toWarehouseId":   uuid.NewString(),
+			"productId":       uuid.NewString(),
+			"quantity":        5,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/warehouses/transfers", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("insufficient_stock", func(t *testing.T) {
+		svc := &fakeWarehouseService{
+			TransferFn: func(ctx context.Context, actorID string, req warehouse.TransferRequest) (warehouse.TransferResponse, error) {
+				return warehouse.TransferResponse{}, warehouseerrors.ErrInsufficientStock
+			},
+		}
+		r := setupTestRouter()
+		r.POST("/admin/warehouses/transfers", warehouse.NewHandler(svc).Transfer)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/warehouses/transfers", bytes.NewBufferString(`{"quantity":1}`))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("invalid_json", func(t *testing.T) {
+		r := setupTestRouter()
+		r.POST("/admin/warehouses/transfers", warehouse.NewHandler(&fakeWarehouseService{}).Transfer)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/warehouses/transfers", bytes.NewBufferString(`{`))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,83 @@
+package warehouse
+
+import (
+	"context"
+	"database/sql"
+	"go-gadget-api/internal/shared/database/dbgen"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=warehouse_repo.go -destination=../mock/warehouse/warehouse_repo_mock.go -package=mock
+type Repository interface {
+	WithTx(tx dbgen.DBTX) Repository
+	List(ctx context.Context) ([]dbgen.Warehouse, error)
+	GetByID(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error)
+	Create(ctx context.Context, arg dbgen.CreateWarehouseParams) (dbgen.Warehouse, error)
+	Update(ctx context.Context, arg dbgen.UpdateWarehouseParams) (dbgen.Warehouse, error)
+	ClearDefault(ctx context.Context) error
+	SetDefault(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error)
+
+	// Stok per gudang & transfer
+	ListStocks(ctx context.Context, arg dbgen.ListWarehouseStocksParams) ([]dbgen.ListWarehouseStocksRow, error)
+	CreateTransfer(ctx context.Context, arg dbgen.CreateWarehouseTransferParams) (dbgen.WarehouseTransfer, error)
+	ListTransfers(ctx context.Context, arg dbgen.ListWarehouseTransfersParams) ([]dbgen.ListWarehouseTransfersRow, error)
+	ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error)
+}
+
+type repository struct {
+	queries *dbgen.Queries
+}
+
+func NewRepository(q *dbgen.Queries) Repository {
+	return &repository{queries: q}
+}
+
+func (r *repository) WithTx(tx dbgen.DBTX) Repository {
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		return &repository{
+			queries: r.queries.WithTx(sqlTx),
+		}
+	}
+	return r
+}
+
+func (r *repository) List(ctx context.Context) ([]dbgen.Warehouse, error) {
+	return r.queries.ListWarehouses(ctx)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error) {
+	return r.queries.GetWarehouseByID(ctx, id)
+}
+
+func (r *repository) Create(ctx context.Context, arg dbgen.CreateWarehouseParams) (dbgen.Warehouse, error) {
+	return r.queries.CreateWarehouse(ctx, arg)
+}
+
+func (r *repository) Update(ctx context.Context, arg dbgen.UpdateWarehouseParams) (dbgen.Warehouse, error) {
+	return r.queries.UpdateWarehouse(ctx, arg)
+}
+
+func (r *repository) ClearDefault(ctx context.Context) error {
+	return r.queries.ClearDefaultWarehouse(ctx)
+}
+
+func (r *repository) SetDefault(ctx context.Context, id uuid.UUID) (dbgen.Warehouse, error) {
+	return r.queries.SetDefaultWarehouse(ctx, id)
+}
+
+func (r *repository) ListStocks(ctx context.Context, arg dbgen.ListWarehouseStocksParams) ([]dbgen.ListWarehouseStocksRow, error) {
+	return r.queries.ListWarehouseStocks(ctx, arg)
+}
+
+func (r *repository) CreateTransfer(ctx context.Context, arg dbgen.CreateWarehouseTransferParams) (dbgen.WarehouseTransfer, error) {
+	return r.queries.CreateWarehouseTransfer(ctx, arg)
+}
+
+func (r *repository) ListTransfers(ctx context.Context, arg dbgen.ListWarehouseTransfersParams) ([]dbgen.ListWarehouseTransfersRow, error) {
+	return r.queries.ListWarehouseTransfers(ctx, arg)
+}
+
+func (r *repository) ApplyStockMovement(ctx context.Context, arg dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+	return r.queries.ApplyStockMovement(ctx, arg)
+}
@@ -0,0 +1,33 @@
+package warehouse
+
+import (
+	"go-gadget-api/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterRoutes(r *gin.RouterGroup, handler *Handler) {
+	// Admin Warehouses (lokasi, stok per gudang & transfer)
+	adminWarehouses := r.Group("/admin/warehouses")
+	adminWarehouses.Use(
+		middleware.AuthMiddleware(),
+		middleware.RoleMiddleware("ADMIN", "SUPERADMIN"),
+	)
+	{
+		// Read Operations (Longgar)
+		readLimit := middleware.RateLimitByUser(10, 20)
+
+		adminWarehouses.GET("", readLimit, handler.List)
+		adminWarehouses.GET("/stocks", readLimit, handler.ListStocks)
+		adminWarehouses.GET("/transfers", readLimit, handler.ListTransfers)
+
+		// Write Operations (Ketat)
+		// Limit 1 rps, burst 3 agar transfer tidak terkirim dua kali akibat double-click.
+		mutationLimit := middleware.RateLimitByUser(1, 3)
+
+		adminWarehouses.POST("", mutationLimit, handler.Create)
+		adminWarehouses.PATCH("/:id", mutationLimit, handler.Update)
+		adminWarehouses.PATCH("/:id/default", mutationLimit, handler.SetDefault)
+		adminWarehouses.POST("/transfers", mutationLimit, handler.Transfer)
+	}
+}
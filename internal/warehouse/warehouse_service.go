@@ -0,0 +1,401 @@
+package warehouse
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-gadget-api/internal/pkg/apperror"
+	"go-gadget-api/internal/pkg/constants"
+	"go-gadget-api/internal/shared/database/dbgen"
+	warehouseerrors "go-gadget-api/internal/warehouse/errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+//go:generate mockgen -source=warehouse_service.go -destination=../mock/warehouse/warehouse_service_mock.go -package=mock
+type Service interface {
+	List(ctx context.Context) ([]WarehouseResponse, error)
+	Create(ctx context.Context, req CreateWarehouseRequest) (WarehouseResponse, error)
+	Update(ctx context.Context, id string, req UpdateWarehouseRequest) (WarehouseResponse, error)
+	SetDefault(ctx context.Context, id string) (WarehouseResponse, error)
+	ListStocks(ctx context.Context, req ListStocksRequest) ([]WarehouseStockResponse, int64, error)
+	Transfer(ctx context.Context, actorID string, req TransferRequest) (TransferResponse, error)
+	ListTransfers(ctx context.Context, req ListTransfersRequest) ([]TransferResponse, int64, error)
+}
+
+type service struct {
+	db       *sql.DB
+	repo     Repository
+	validate *validator.Validate
+}
+
+func NewService(db *sql.DB, repo Repository) Service {
+	return &service{
+		db:       db,
+		repo:     repo,
+		validate: validator.New(),
+	}
+}
+
+func (s *service) List(ctx context.Context) ([]WarehouseResponse, error) {
+	rows, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, warehouseerrors.ErrWarehouseFailed
+	}
+
+	res := make([]WarehouseResponse, 0, len(rows))
+	for _, w := range rows {
+		res = append(res, mapWarehouse(w))
+	}
+	return res, nil
+}
+
+func (s *service) Create(ctx context.Context, req CreateWarehouseRequest) (WarehouseResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return WarehouseResponse{}, apperror.MapValidationError(err)
+	}
+
+	w, err := s.repo.Create(ctx, dbgen.CreateWarehouseParams{
+		Code:     strings.ToUpper(req.Code),
+		Name:     strings.TrimSpace(req.Name),
+		Province: strings.TrimSpace(req.Province),
+		City:     toNullString(req.City),
+		Address:  toNullString(req.Address),
+		Priority: req.Priority,
+		IsActive: true,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return WarehouseResponse{}, warehouseerrors.ErrWarehouseCodeExists
+		}
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+
+	return mapWarehouse(w), nil
+}
+
+func (s *service) Update(ctx context.Context, idStr string, req UpdateWarehouseRequest) (WarehouseResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return WarehouseResponse{}, apperror.MapValidationError(err)
+	}
+
+	current, err := s.getWarehouse(ctx, idStr)
+	if err != nil {
+		return WarehouseResponse{}, err
+	}
+
+	isActive := current.IsActive
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	if current.IsDefault && !isActive {
+		return WarehouseResponse{}, warehouseerrors.ErrDefaultWarehouseInactive
+	}
+
+	w, err := s.repo.Update(ctx, dbgen.UpdateWarehouseParams{
+		ID:       current.ID,
+		Code:     strings.ToUpper(req.Code),
+		Name:     strings.TrimSpace(req.Name),
+		Province: strings.TrimSpace(req.Province),
+		City:     toNullString(req.City),
+		Address:  toNullString(req.Address),
+		Priority: req.Priority,
+		IsActive: isActive,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return WarehouseResponse{}, warehouseerrors.ErrWarehouseCodeExists
+		}
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+
+	return mapWarehouse(w), nil
+}
+
+// SetDefault memindahkan status default; default lama dilepas di transaksi yang sama
+func (s *service) SetDefault(ctx context.Context, idStr string) (WarehouseResponse, error) {
+	current, err := s.getWarehouse(ctx, idStr)
+	if err != nil {
+		return WarehouseResponse{}, err
+	}
+	if !current.IsActive {
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseInactive
+	}
+	if current.IsDefault {
+		return mapWarehouse(current), nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	if err := qtx.ClearDefault(ctx); err != nil {
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+	w, err := qtx.SetDefault(ctx, current.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Dinonaktifkan admin lain di antara pengecekan & update
+			return WarehouseResponse{}, warehouseerrors.ErrWarehouseInactive
+		}
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+
+	if err := tx.Commit(); err != nil {
+		return WarehouseResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+	return mapWarehouse(w), nil
+}
+
+func (s *service) ListStocks(ctx context.Context, req ListStocksRequest) ([]WarehouseStockResponse, int64, error) {
+	warehouseID, err := parseOptionalUUID(req.WarehouseID, warehouseerrors.ErrInvalidWarehouseID)
+	if err != nil {
+		return nil, 0, err
+	}
+	productID, err := parseOptionalUUID(req.ProductID, warehouseerrors.ErrInvalidProductID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.repo.ListStocks(ctx, dbgen.ListWarehouseStocksParams{
+		Limit:       int32(req.Limit),
+		Offset:      int32((req.Page - 1) * req.Limit),
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+	})
+	if err != nil {
+		return nil, 0, warehouseerrors.ErrWarehouseFailed
+	}
+
+	var total int64
+	res := make([]WarehouseStockResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		res = append(res, WarehouseStockResponse{
+			WarehouseID:   r.WarehouseID.String(),
+			WarehouseCode: r.WarehouseCode,
+			WarehouseName: r.WarehouseName,
+			ProductID:     r.ProductID.String(),
+			ProductName:   r.ProductName,
+			VariantID:     nullUUIDString(r.VariantID),
+			VariantSKU:    r.VariantSku.String,
+			Stock:         r.Stock,
+			UpdatedAt:     r.UpdatedAt,
+		})
+	}
+	return res, total, nil
+}
+
+// Transfer memindahkan stok dari satu gudang ke gudang lain sebagai dua mutasi TRANSFER
+// (keluar & masuk) dengan reference_id = id transfer. Total stok produk tidak berubah,
+// jadi tidak ada event alert stok yang dibuat.
+func (s *service) Transfer(ctx context.Context, actorID string, req TransferRequest) (TransferResponse, error) {
+	if err := s.validate.Struct(req); err != nil {
+		return TransferResponse{}, apperror.MapValidationError(err)
+	}
+	if req.FromWarehouseID == req.ToWarehouseID {
+		return TransferResponse{}, warehouseerrors.ErrSameWarehouse
+	}
+
+	from, err := s.getWarehouse(ctx, req.FromWarehouseID)
+	if err != nil {
+		return TransferResponse{}, err
+	}
+	to, err := s.getWarehouse(ctx, req.ToWarehouseID)
+	if err != nil {
+		return TransferResponse{}, err
+	}
+	// Gudang asal boleh nonaktif (mengosongkan gudang yang ditutup), tujuan tidak
+	if !to.IsActive {
+		return TransferResponse{}, warehouseerrors.ErrWarehouseInactive
+	}
+
+	// Format sudah divalidasi
+	productID, _ := uuid.Parse(req.ProductID)
+	var variantID uuid.NullUUID
+	if req.VariantID != "" {
+		vid, _ := uuid.Parse(req.VariantID)
+		variantID = uuid.NullUUID{UUID: vid, Valid: true}
+	}
+	var actor uuid.NullUUID
+	if uid, err := uuid.Parse(actorID); err == nil {
+		actor = uuid.NullUUID{UUID: uid, Valid: true}
+	}
+	note := toNullString(req.Note)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return TransferResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+	defer tx.Rollback()
+
+	qtx := s.repo.WithTx(tx)
+	t, err := qtx.CreateTransfer(ctx, dbgen.CreateWarehouseTransferParams{
+		FromWarehouseID: from.ID,
+		ToWarehouseID:   to.ID,
+		ProductID:       productID,
+		VariantID:       variantID,
+		Quantity:        req.Quantity,
+		Note:            note,
+		ActorID:         actor,
+	})
+	if err != nil {
+		return TransferResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+
+	legs := []struct {
+		warehouseID uuid.UUID
+		delta       int32
+	}{
+		{from.ID, -req.Quantity},
+		{to.ID, req.Quantity},
+	}
+	for _, leg := range legs {
+		_, err := qtx.ApplyStockMovement(ctx, dbgen.ApplyStockMovementParams{
+			WarehouseID: uuid.NullUUID{UUID: leg.warehouseID, Valid: true},
+			ProductID:   productID,
+			VariantID:   variantID,
+			Delta:       leg.delta,
+			Reason:      constants.StockReasonTransfer,
+			ReferenceID: uuid.NullUUID{UUID: t.ID, Valid: true},
+			ActorID:     actor,
+			Note:        note,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return TransferResponse{}, warehouseerrors.ErrInsufficientStock
+			}
+			return TransferResponse{}, warehouseerrors.ErrWarehouseFailed
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return TransferResponse{}, warehouseerrors.ErrWarehouseFailed
+	}
+
+	return TransferResponse{
+		ID:                t.ID.String(),
+		FromWarehouseID:   from.ID.String(),
+		FromWarehouseName: from.Name,
+		ToWarehouseID:     to.ID.String(),
+		ToWarehouseName:   to.Name,
+		ProductID:         t.ProductID.String(),
+		VariantID:         nullUUIDString(t.VariantID),
+		Quantity:          t.Quantity,
+		Note:              t.Note.String,
+		ActorID:           nullUUIDString(t.ActorID),
+		CreatedAt:         t.CreatedAt,
+	}, nil
+}
+
+func (s *service) ListTransfers(ctx context.Context, req ListTransfersRequest) ([]TransferResponse, int64, error) {
+	warehouseID, err := parseOptionalUUID(req.WarehouseID, warehouseerrors.ErrInvalidWarehouseID)
+	if err != nil {
+		return nil, 0, err
+	}
+	productID, err := parseOptionalUUID(req.ProductID, warehouseerrors.ErrInvalidProductID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.repo.ListTransfers(ctx, dbgen.ListWarehouseTransfersParams{
+		Limit:       int32(req.Limit),
+		Offset:      int32((req.Page - 1) * req.Limit),
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+	})
+	if err != nil {
+		return nil, 0, warehouseerrors.ErrWarehouseFailed
+	}
+
+	var total int64
+	res := make([]TransferResponse, 0, len(rows))
+	for _, r := range rows {
+		total = r.TotalCount
+		res = append(res, TransferResponse{
+			ID:                r.ID.String(),
+			FromWarehouseID:   r.FromWarehouseID.String(),
+			FromWarehouseName: r.FromWarehouseName,
+			ToWarehouseID:     r.ToWarehouseID.String(),
+			ToWarehouseName:   r.ToWarehouseName,
+			ProductID:         r.ProductID.String(),
+			ProductName:       r.ProductName,
+			VariantID:         nullUUIDString(r.VariantID),
+			VariantSKU:        r.VariantSku.String,
+			Quantity:          r.Quantity,
+			Note:              r.Note.String,
+			ActorID:           nullUUIDString(r.ActorID),
+			ActorName:         r.ActorName.String,
+			CreatedAt:         r.CreatedAt,
+		})
+	}
+	return res, total, nil
+}
+
+func (s *service) getWarehouse(ctx context.Context, idStr string) (dbgen.Warehouse, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return dbgen.Warehouse{}, warehouseerrors.ErrInvalidWarehouseID
+	}
+
+	w, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dbgen.Warehouse{}, warehouseerrors.ErrWarehouseNotFound
+		}
+		return dbgen.Warehouse{}, warehouseerrors.ErrWarehouseFailed
+	}
+	return w, nil
+}
+
+func mapWarehouse(w dbgen.Warehouse) WarehouseResponse {
+	return WarehouseResponse{
+		ID:        w.ID.String(),
+		Code:      w.Code,
+		Name:      w.Name,
+		Province:  w.Province,
+		City:      w.City.String,
+		Address:   w.Address.String,
+		Priority:  w.Priority,
+		IsDefault: w.IsDefault,
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// parseOptionalUUID: string kosong = filter tidak dipakai
+func parseOptionalUUID(v string, invalidErr error) (uuid.NullUUID, error) {
+	if v == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.NullUUID{}, invalidErr
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+func toNullString(v string) sql.NullString {
+	v = strings.TrimSpace(v)
+	return sql.NullString{String: v, Valid: v != ""}
+}
+
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return id.UUID.String()
+}
+
+// isUniqueViolation mendeteksi pelanggaran unique constraint postgres (kode gudang duplikat)
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
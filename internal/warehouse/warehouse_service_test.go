@@ -0,0 +1,305 @@
+package warehouse_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"go-gadget-api/internal/pkg/constants"
+	"go-gadget-api/internal/shared/database/dbgen"
+	"go-gadget-api/internal/warehouse"
+	warehouseerrors "go-gadget-api/internal/warehouse/errors"
+
+	warehouseMock "go-gadget-api/internal/mock/warehouse"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// ======================= HELPERS =======================
+
+type serviceDeps struct {
+	db      *sql.DB
+	sqlMock sqlmock.Sqlmock
+	service warehouse.Service
+	repo    *warehouseMock.MockRepository
+}
+
+func setupServiceTest(t *testing.T) *serviceDeps {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	db, sqlMock, _ := sqlmock.New()
+	t.Cleanup(func() { db.Close() })
+
+	repo := warehouseMock.NewMockRepository(ctrl)
+
+	return &serviceDeps{
+		db:      db,
+		sqlMock: sqlMock,
+		service: warehouse.NewService(db, repo),
+		repo:    repo,
+	}
+}
+
+func expectTx(t *testing.T, mock sqlmock.Sqlmock, commit bool) {
+	t.Helper()
+	mock.ExpectBegin()
+	if commit {
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+func TestWarehouseService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateWarehouseParams) (dbgen.Warehouse, error) {
+				assert.Equal(t, "SBY", p.Code)
+				assert.Equal(t, "Jawa Timur", p.Province)
+				assert.True(t, p.IsActive)
+				return dbgen.Warehouse{ID: uuid.New(), Code: p.Code, Name: p.Name, Province: p.Province, IsActive: true}, nil
+			})
+
+		res, err := deps.service.Create(ctx, warehouse.CreateWarehouseRequest{
+			Code: "sby", Name: "Gudang Surabaya", Province: "Jawa Timur", City: "Surabaya", Priority: 1,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "SBY", res.Code)
+		assert.False(t, res.IsDefault)
+	})
+
+	t.Run("duplicate_code", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(dbgen.Warehouse{}, &pq.Error{Code: "23505"})
+
+		_, err := deps.service.Create(ctx, warehouse.CreateWarehouseRequest{
+			Code: "JKT", Name: "Gudang Jakarta 2", Province: "DKI Jakarta",
+		})
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrWarehouseCodeExists)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, err := deps.service.Create(ctx, warehouse.CreateWarehouseRequest{Code: "SBY"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWarehouseService_Update(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	inactive := false
+
+	t.Run("cannot_deactivate_default", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.Warehouse{ID: id, IsDefault: true, IsActive: true}, nil)
+
+		_, err := deps.service.Update(ctx, id.String(), warehouse.UpdateWarehouseRequest{
+			Code: "JKT", Name: "Gudang Jakarta", Province: "DKI Jakarta", IsActive: &inactive,
+		})
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrDefaultWarehouseInactive)
+	})
+
+	t.Run("deactivate_non_default", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.Warehouse{ID: id, IsActive: true}, nil)
+		deps.repo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.UpdateWarehouseParams) (dbgen.Warehouse, error) {
+				assert.False(t, p.IsActive)
+				return dbgen.Warehouse{ID: id, Code: p.Code, IsActive: p.IsActive}, nil
+			})
+
+		res, err := deps.service.Update(ctx, id.String(), warehouse.UpdateWarehouseRequest{
+			Code: "SBY", Name: "Gudang Surabaya", Province: "Jawa Timur", IsActive: &inactive,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, res.IsActive)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.Warehouse{}, sql.ErrNoRows)
+
+		_, err := deps.service.Update(ctx, id.String(), warehouse.UpdateWarehouseRequest{
+			Code: "SBY", Name: "Gudang Surabaya", Province: "Jawa Timur",
+		})
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrWarehouseNotFound)
+	})
+}
+
+func TestWarehouseService_SetDefault(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.Warehouse{ID: id, IsActive: true}, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		gomock.InOrder(
+			deps.repo.EXPECT().ClearDefault(gomock.Any()).Return(nil),
+			deps.repo.EXPECT().SetDefault(gomock.Any(), id).Return(dbgen.Warehouse{ID: id, IsActive: true, IsDefault: true}, nil),
+		)
+
+		res, err := deps.service.SetDefault(ctx, id.String())
+
+		require.NoError(t, err)
+		assert.True(t, res.IsDefault)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("inactive_warehouse", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		deps.repo.EXPECT().GetByID(gomock.Any(), id).Return(dbgen.Warehouse{ID: id, IsActive: false}, nil)
+
+		_, err := deps.service.SetDefault(ctx, id.String())
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrWarehouseInactive)
+	})
+}
+
+func TestWarehouseService_Transfer(t *testing.T) {
+	ctx := context.Background()
+	fromID, toID, productID, actorID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	req := warehouse.TransferRequest{
+		FromWarehouseID: fromID.String(),
+		ToWarehouseID:   toID.String(),
+		ProductID:       productID.String(),
+		Quantity:        4,
+		Note:            "Stok awal gudang Surabaya",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		transferID := uuid.New()
+
+		deps.repo.EXPECT().GetByID(gomock.Any(), fromID).Return(dbgen.Warehouse{ID: fromID, Name: "Gudang Jakarta", IsActive: true}, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), toID).Return(dbgen.Warehouse{ID: toID, Name: "Gudang Surabaya", IsActive: true}, nil)
+		expectTx(t, deps.sqlMock, true)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().
+			CreateTransfer(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.CreateWarehouseTransferParams) (dbgen.WarehouseTransfer, error) {
+				assert.Equal(t, int32(4), p.Quantity)
+				assert.Equal(t, uuid.NullUUID{UUID: actorID, Valid: true}, p.ActorID)
+				return dbgen.WarehouseTransfer{
+					ID: transferID, FromWarehouseID: fromID, ToWarehouseID: toID, ProductID: productID, Quantity: p.Quantity,
+				}, nil
+			})
+
+		// Keluar dari gudang asal, lalu masuk ke gudang tujuan
+		var legs []dbgen.ApplyStockMovementParams
+		deps.repo.EXPECT().
+			ApplyStockMovement(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dbgen.ApplyStockMovementParams) (dbgen.ApplyStockMovementRow, error) {
+				legs = append(legs, p)
+				return dbgen.ApplyStockMovementRow{}, nil
+			}).Times(2)
+
+		res, err := deps.service.Transfer(ctx, actorID.String(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, transferID.String(), res.ID)
+		assert.Equal(t, "Gudang Surabaya", res.ToWarehouseName)
+		require.Len(t, legs, 2)
+		assert.Equal(t, fromID, legs[0].WarehouseID.UUID)
+		assert.Equal(t, int32(-4), legs[0].Delta)
+		assert.Equal(t, toID, legs[1].WarehouseID.UUID)
+		assert.Equal(t, int32(4), legs[1].Delta)
+		for _, leg := range legs {
+			assert.Equal(t, constants.StockReasonTransfer, leg.Reason)
+			assert.Equal(t, transferID, leg.ReferenceID.UUID)
+		}
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("insufficient_stock_rolls_back", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByID(gomock.Any(), fromID).Return(dbgen.Warehouse{ID: fromID, IsActive: true}, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), toID).Return(dbgen.Warehouse{ID: toID, IsActive: true}, nil)
+		expectTx(t, deps.sqlMock, false)
+		deps.repo.EXPECT().WithTx(gomock.Any()).Return(deps.repo)
+		deps.repo.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Return(dbgen.WarehouseTransfer{ID: uuid.New()}, nil)
+		deps.repo.EXPECT().ApplyStockMovement(gomock.Any(), gomock.Any()).Return(dbgen.ApplyStockMovementRow{}, sql.ErrNoRows)
+
+		_, err := deps.service.Transfer(ctx, actorID.String(), req)
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrInsufficientStock)
+		assert.NoError(t, deps.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("inactive_destination", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		deps.repo.EXPECT().GetByID(gomock.Any(), fromID).Return(dbgen.Warehouse{ID: fromID, IsActive: true}, nil)
+		deps.repo.EXPECT().GetByID(gomock.Any(), toID).Return(dbgen.Warehouse{ID: toID, IsActive: false}, nil)
+
+		_, err := deps.service.Transfer(ctx, actorID.String(), req)
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrWarehouseInactive)
+	})
+
+	t.Run("same_warehouse", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		same := req
+		same.ToWarehouseID = same.FromWarehouseID
+
+		_, err := deps.service.Transfer(ctx, actorID.String(), same)
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrSameWarehouse)
+	})
+}
+
+func TestWarehouseService_ListStocks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("filter_by_warehouse", func(t *testing.T) {
+		deps := setupServiceTest(t)
+		warehouseID := uuid.New()
+
+		deps.repo.EXPECT().
+			ListStocks(gomock.Any(), dbgen.ListWarehouseStocksParams{
+				Limit:       20,
+				Offset:      0,
+				WarehouseID: uuid.NullUUID{UUID: warehouseID, Valid: true},
+			}).
+			Return([]dbgen.ListWarehouseStocksRow{
+				{WarehouseID: warehouseID, WarehouseCode: "SBY", ProductID: uuid.New(), ProductName: "iPhone 15", Stock: 7, TotalCount: 1},
+			}, nil)
+
+		res, total, err := deps.service.ListStocks(ctx, warehouse.ListStocksRequest{Page: 1, Limit: 20, WarehouseID: warehouseID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, res, 1)
+		assert.Equal(t, int32(7), res[0].Stock)
+	})
+
+	t.Run("invalid_warehouse_id", func(t *testing.T) {
+		deps := setupServiceTest(t)
+
+		_, _, err := deps.service.ListStocks(ctx, warehouse.ListStocksRequest{Page: 1, Limit: 20, WarehouseID: "abc"})
+
+		assert.ErrorIs(t, err, warehouseerrors.ErrInvalidWarehouseID)
+	})
+}
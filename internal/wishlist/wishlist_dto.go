@@ -6,6 +6,7 @@ import "time"
 
 type AddItemRequest struct {
 	ProductID string `json:"productId" binding:"required"`
+	VariantID string `json:"variantId"`
 }
 
 type DeleteItemRequest struct {
@@ -15,8 +16,9 @@ type DeleteItemRequest struct {
 // ==================== RESPONSE STRUCTS ====================
 
 type WishlistItemResponse struct {
-	ID      string                  `json:"id"`
-	Product WishlistProductResponse `json:"product"`
+	ID        string                  `json:"id"`
+	VariantID *string                 `json:"variantId,omitempty"`
+	Product   WishlistProductResponse `json:"product"`
 }
 
 type WishlistProductResponse struct {
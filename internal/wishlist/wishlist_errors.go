@@ -12,6 +12,12 @@ var (
 		http.StatusBadRequest,
 	)
 
+	ErrInvalidVariantID = apperror.New(
+		apperror.CodeInvalidInput,
+		"Invalid variant ID",
+		http.StatusBadRequest,
+	)
+
 	ErrProductNotFound = apperror.New(
 		apperror.CodeNotFound,
 		"Product not found",
@@ -54,7 +54,7 @@ func (h *Handler) Create(c *gin.Context) {
 		return
 	}
 
-	res, err := h.service.Create(c.Request.Context(), userID, req.ProductID)
+	res, err := h.service.Create(c.Request.Context(), userID, req.ProductID, req.VariantID)
 	if err != nil {
 		h.logger.Error("http wishlist create service error",
 			zap.String("user_id", userID),
@@ -96,10 +96,11 @@ func (h *Handler) List(c *gin.Context) {
 	response.Success(c, http.StatusOK, res, nil)
 }
 
-// DELETE /wishlist/items/:productId
+// DELETE /wishlist/items/:productId?variantId=
 func (h *Handler) Delete(c *gin.Context) {
 	userID := getUserIDFromContext(c)
 	productID := c.Param("productId")
+	variantID := c.Query("variantId")
 
 	h.logger.Debug("http wishlist delete request",
 		zap.String("user_id", userID),
@@ -118,7 +119,7 @@ func (h *Handler) Delete(c *gin.Context) {
 		return
 	}
 
-	err := h.service.Delete(c.Request.Context(), userID, productID)
+	err := h.service.Delete(c.Request.Context(), userID, productID, variantID)
 	if err != nil {
 		h.logger.Error("http wishlist delete service error",
 			zap.String("user_id", userID),
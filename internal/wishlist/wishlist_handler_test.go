@@ -20,14 +20,14 @@ import (
 // ==================== FAKE SERVICE ====================
 
 type fakeWishlistService struct {
-	createFunc func(ctx context.Context, userID, productID string) (wishlist.AddItemResponse, error)
+	createFunc func(ctx context.Context, userID, productID, variantID string) (wishlist.AddItemResponse, error)
 	listFunc   func(ctx context.Context, userID string) (wishlist.WishlistResponse, error)
-	deleteFunc func(ctx context.Context, userID, productID string) error
+	deleteFunc func(ctx context.Context, userID, productID, variantID string) error
 }
 
-func (f *fakeWishlistService) Create(ctx context.Context, userID, productID string) (wishlist.AddItemResponse, error) {
+func (f *fakeWishlistService) Create(ctx context.Context, userID, productID, variantID string) (wishlist.AddItemResponse, error) {
 	if f.createFunc != nil {
-		return f.createFunc(ctx, userID, productID)
+		return f.createFunc(ctx, userID, productID, variantID)
 	}
 	return wishlist.AddItemResponse{}, nil
 }
@@ -39,9 +39,9 @@ func (f *fakeWishlistService) List(ctx context.Context, userID string) (wishlist
 	return wishlist.WishlistResponse{}, nil
 }
 
-func (f *fakeWishlistService) Delete(ctx context.Context, userID, productID string) error {
+func (f *fakeWishlistService) Delete(ctx context.Context, userID, productID, variantID string) error {
 	if f.deleteFunc != nil {
-		return f.deleteFunc(ctx, userID, productID)
+		return f.deleteFunc(ctx, userID, productID, variantID)
 	}
 	return nil
 }
@@ -65,7 +65,7 @@ func TestWishlistHandler_Create(t *testing.T) {
 		productID := uuid.New().String()
 
 		svc := &fakeWishlistService{
-			createFunc: func(ctx context.Context, uid, pid string) (wishlist.AddItemResponse, error) {
+			createFunc: func(ctx context.Context, uid, pid, vid string) (wishlist.AddItemResponse, error) {
 				assert.Equal(t, userID, uid)
 				assert.Equal(t, productID, pid)
 
@@ -139,7 +139,7 @@ func TestWishlistHandler_Create(t *testing.T) {
 		userID := uuid.New().String()
 
 		svc := &fakeWishlistService{
-			createFunc: func(ctx context.Context, uID, pID string) (wishlist.AddItemResponse, error) {
+			createFunc: func(ctx context.Context, uID, pID, _ string) (wishlist.AddItemResponse, error) {
 				// Memastikan data yang sampai ke service tetap benar
 				assert.Equal(t, userID, uID)
 				assert.Equal(t, productID, pID)
@@ -173,7 +173,7 @@ func TestWishlistHandler_Create(t *testing.T) {
 
 	t.Run("error_service_internal_error", func(t *testing.T) {
 		svc := &fakeWishlistService{
-			createFunc: func(ctx context.Context, userID, productID string) (wishlist.AddItemResponse, error) {
+			createFunc: func(ctx context.Context, userID, productID, variantID string) (wishlist.AddItemResponse, error) {
 				return wishlist.AddItemResponse{}, errors.New("database error")
 			},
 		}
@@ -328,7 +328,7 @@ func TestWishlistHandler_Delete(t *testing.T) {
 		productID := uuid.New().String()
 
 		svc := &fakeWishlistService{
-			deleteFunc: func(ctx context.Context, uid, pid string) error {
+			deleteFunc: func(ctx context.Context, uid, pid, vid string) error {
 				assert.Equal(t, userID, uid)
 				assert.Equal(t, productID, pid)
 				return nil
@@ -391,7 +391,7 @@ func TestWishlistHandler_Delete(t *testing.T) {
 
 	t.Run("error_item_not_found", func(t *testing.T) {
 		svc := &fakeWishlistService{
-			deleteFunc: func(ctx context.Context, userID, productID string) error {
+			deleteFunc: func(ctx context.Context, userID, productID, variantID string) error {
 				return wishlist.ErrItemNotFound
 			},
 		}
@@ -417,7 +417,7 @@ func TestWishlistHandler_Delete(t *testing.T) {
 
 	t.Run("error_wishlist_not_found", func(t *testing.T) {
 		svc := &fakeWishlistService{
-			deleteFunc: func(ctx context.Context, userID, productID string) error {
+			deleteFunc: func(ctx context.Context, userID, productID, variantID string) error {
 				return wishlist.ErrWishlistNotFound
 			},
 		}
@@ -443,7 +443,7 @@ func TestWishlistHandler_Delete(t *testing.T) {
 
 	t.Run("error_service_internal_error", func(t *testing.T) {
 		svc := &fakeWishlistService{
-			deleteFunc: func(ctx context.Context, userID, productID string) error {
+			deleteFunc: func(ctx context.Context, userID, productID, variantID string) error {
 				return errors.New("database error")
 			},
 		}
@@ -14,10 +14,10 @@ type Repository interface {
 	GetOrCreateWishlist(ctx context.Context, userID uuid.UUID) (dbgen.Wishlist, error)
 	GetWishlistByUserID(ctx context.Context, userID uuid.UUID) (dbgen.Wishlist, error)
 	GetWishlistWithItems(ctx context.Context, userID uuid.UUID) (dbgen.GetWishlistWithItemsRow, error)
-	AddItem(ctx context.Context, wishlistID, productID uuid.UUID) error
+	AddItem(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) error
 	GetItems(ctx context.Context, wishlistID uuid.UUID) ([]dbgen.GetWishlistItemsRow, error)
-	DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID) error
-	CheckItemExists(ctx context.Context, wishlistID, productID uuid.UUID) (bool, error)
+	DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) error
+	CheckItemExists(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) (bool, error)
 }
 
 type repository struct {
@@ -49,10 +49,11 @@ func (r *repository) GetWishlistWithItems(ctx context.Context, userID uuid.UUID)
 	return r.queries.GetWishlistWithItems(ctx, userID)
 }
 
-func (r *repository) AddItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+func (r *repository) AddItem(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	return r.queries.AddWishlistItem(ctx, dbgen.AddWishlistItemParams{
 		WishlistID: wishlistID,
 		ProductID:  productID,
+		VariantID:  variantID,
 	})
 }
 
@@ -60,16 +61,18 @@ func (r *repository) GetItems(ctx context.Context, wishlistID uuid.UUID) ([]dbge
 	return r.queries.GetWishlistItems(ctx, wishlistID)
 }
 
-func (r *repository) DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+func (r *repository) DeleteItem(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	return r.queries.DeleteWishlistItem(ctx, dbgen.DeleteWishlistItemParams{
 		WishlistID: wishlistID,
 		ProductID:  productID,
+		VariantID:  variantID,
 	})
 }
 
-func (r *repository) CheckItemExists(ctx context.Context, wishlistID, productID uuid.UUID) (bool, error) {
+func (r *repository) CheckItemExists(ctx context.Context, wishlistID, productID uuid.UUID, variantID uuid.NullUUID) (bool, error) {
 	return r.queries.CheckWishlistItemExists(ctx, dbgen.CheckWishlistItemExistsParams{
 		WishlistID: wishlistID,
 		ProductID:  productID,
+		VariantID:  variantID,
 	})
 }
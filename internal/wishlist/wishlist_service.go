@@ -13,9 +13,10 @@ import (
 
 //go:generate mockgen -source=wishlist_service.go -destination=../mock/wishlist/wishlist_service_mock.go -package=mock
 type Service interface {
-	Create(ctx context.Context, userID, productID string) (AddItemResponse, error)
+	// variantID opsional, kosong = produk tanpa variant
+	Create(ctx context.Context, userID, productID, variantID string) (AddItemResponse, error)
 	List(ctx context.Context, userID string) (WishlistResponse, error)
-	Delete(ctx context.Context, userID, productID string) error
+	Delete(ctx context.Context, userID, productID, variantID string) error
 }
 
 type service struct {
@@ -30,8 +31,20 @@ func NewService(db *sql.DB, r Repository) Service {
 	}
 }
 
-// Create adds a product to user's wishlist
-func (s *service) Create(ctx context.Context, userID, productID string) (AddItemResponse, error) {
+// parseVariantID: string kosong berarti item tanpa variant
+func parseVariantID(variantID string) (uuid.NullUUID, error) {
+	if variantID == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(variantID)
+	if err != nil {
+		return uuid.NullUUID{}, ErrInvalidVariantID
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+// Create adds a product (or one of its variants) to user's wishlist
+func (s *service) Create(ctx context.Context, userID, productID, variantID string) (AddItemResponse, error) {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return AddItemResponse{}, ErrInvalidProductID
@@ -42,6 +55,11 @@ func (s *service) Create(ctx context.Context, userID, productID string) (AddItem
 		return AddItemResponse{}, ErrInvalidProductID
 	}
 
+	vid, err := parseVariantID(variantID)
+	if err != nil {
+		return AddItemResponse{}, err
+	}
+
 	// 1. Mulai Transaksi
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -59,7 +77,7 @@ func (s *service) Create(ctx context.Context, userID, productID string) (AddItem
 	}
 
 	// 4. Check if item already exists
-	exists, err := qtx.CheckItemExists(ctx, wishlist.ID, pid)
+	exists, err := qtx.CheckItemExists(ctx, wishlist.ID, pid, vid)
 	if err != nil {
 		return AddItemResponse{}, ErrWishlistFailed
 	}
@@ -69,7 +87,7 @@ func (s *service) Create(ctx context.Context, userID, productID string) (AddItem
 	}
 
 	// 5. Add item to wishlist
-	err = qtx.AddItem(ctx, wishlist.ID, pid)
+	err = qtx.AddItem(ctx, wishlist.ID, pid, vid)
 	if err != nil {
 		return AddItemResponse{}, ErrWishlistFailed
 	}
@@ -128,8 +146,8 @@ func (s *service) List(ctx context.Context, userID string) (WishlistResponse, er
 	}, nil
 }
 
-// Delete removes a product from user's wishlist
-func (s *service) Delete(ctx context.Context, userID, productID string) error {
+// Delete removes a product (or one of its variants) from user's wishlist
+func (s *service) Delete(ctx context.Context, userID, productID, variantID string) error {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return ErrInvalidProductID
@@ -140,6 +158,11 @@ func (s *service) Delete(ctx context.Context, userID, productID string) error {
 		return ErrInvalidProductID
 	}
 
+	vid, err := parseVariantID(variantID)
+	if err != nil {
+		return err
+	}
+
 	// 1. Get wishlist
 	wishlist, err := s.repo.GetWishlistByUserID(ctx, uid)
 	if err != nil {
@@ -150,7 +173,7 @@ func (s *service) Delete(ctx context.Context, userID, productID string) error {
 	}
 
 	// 2. Check if item exists
-	exists, err := s.repo.CheckItemExists(ctx, wishlist.ID, pid)
+	exists, err := s.repo.CheckItemExists(ctx, wishlist.ID, pid, vid)
 	if err != nil {
 		return ErrWishlistFailed
 	}
@@ -170,7 +193,7 @@ func (s *service) Delete(ctx context.Context, userID, productID string) error {
 	qtx := s.repo.WithTx(tx)
 
 	// 5. Delete item
-	err = qtx.DeleteItem(ctx, wishlist.ID, pid)
+	err = qtx.DeleteItem(ctx, wishlist.ID, pid, vid)
 	if err != nil {
 		return ErrWishlistFailed
 	}
@@ -51,15 +51,15 @@ func TestWishlistService_Create(t *testing.T) {
 			}, nil)
 
 		repo.EXPECT().
-			CheckItemExists(gomock.Any(), wishlistID, productID).
+			CheckItemExists(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(false, nil)
 
 		repo.EXPECT().
-			AddItem(gomock.Any(), wishlistID, productID).
+			AddItem(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(nil)
 
 		// Execute
-		res, err := svc.Create(ctx, userID.String(), productID.String())
+		res, err := svc.Create(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.NoError(t, err)
@@ -82,11 +82,11 @@ func TestWishlistService_Create(t *testing.T) {
 			Return(dbgen.Wishlist{ID: wishlistID, UserID: userID}, nil)
 
 		repo.EXPECT().
-			CheckItemExists(gomock.Any(), wishlistID, productID).
+			CheckItemExists(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(true, nil)
 
 		// Execute
-		_, err := svc.Create(ctx, userID.String(), productID.String())
+		_, err := svc.Create(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.ErrorIs(t, err, wishlist.ErrItemAlreadyExists)
@@ -97,11 +97,20 @@ func TestWishlistService_Create(t *testing.T) {
 		userID := uuid.New()
 
 		// No transaction expected as validation happens before
-		_, err := svc.Create(ctx, userID.String(), "invalid-uuid")
+		_, err := svc.Create(ctx, userID.String(), "invalid-uuid", "")
 
 		assert.ErrorIs(t, err, wishlist.ErrInvalidProductID)
 	})
 
+	t.Run("error_invalid_variant_id", func(t *testing.T) {
+		userID := uuid.New()
+		productID := uuid.New()
+
+		_, err := svc.Create(ctx, userID.String(), productID.String(), "invalid-uuid")
+
+		assert.ErrorIs(t, err, wishlist.ErrInvalidVariantID)
+	})
+
 	t.Run("error_add_item_failed", func(t *testing.T) {
 		userID := uuid.New()
 		productID := uuid.New()
@@ -117,15 +126,15 @@ func TestWishlistService_Create(t *testing.T) {
 			Return(dbgen.Wishlist{ID: wishlistID, UserID: userID}, nil)
 
 		repo.EXPECT().
-			CheckItemExists(gomock.Any(), wishlistID, productID).
+			CheckItemExists(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(false, nil)
 
 		repo.EXPECT().
-			AddItem(gomock.Any(), wishlistID, productID).
+			AddItem(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(assert.AnError)
 
 		// Execute
-		_, err := svc.Create(ctx, userID.String(), productID.String())
+		_, err := svc.Create(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.ErrorIs(t, err, wishlist.ErrWishlistFailed)
@@ -257,7 +266,7 @@ func TestWishlistService_Delete(t *testing.T) {
 
 		// Mock CheckItemExists (OUTSIDE transaction)
 		repo.EXPECT().
-			CheckItemExists(gomock.Any(), wishlistID, productID).
+			CheckItemExists(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(true, nil)
 
 		// Transaction Mock
@@ -266,13 +275,13 @@ func TestWishlistService_Delete(t *testing.T) {
 		// Mock WithTx and DeleteItem (INSIDE transaction)
 		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
 		repo.EXPECT().
-			DeleteItem(gomock.Any(), wishlistID, productID).
+			DeleteItem(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(nil)
 
 		mock.ExpectCommit()
 
 		// Execute
-		err := svc.Delete(ctx, userID.String(), productID.String())
+		err := svc.Delete(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.NoError(t, err)
@@ -288,7 +297,7 @@ func TestWishlistService_Delete(t *testing.T) {
 			Return(dbgen.Wishlist{}, sql.ErrNoRows)
 
 		// Execute
-		err := svc.Delete(ctx, userID.String(), productID.String())
+		err := svc.Delete(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.ErrorIs(t, err, wishlist.ErrWishlistNotFound)
@@ -304,11 +313,11 @@ func TestWishlistService_Delete(t *testing.T) {
 			Return(dbgen.Wishlist{ID: wishlistID, UserID: userID}, nil)
 
 		repo.EXPECT().
-			CheckItemExists(gomock.Any(), wishlistID, productID).
+			CheckItemExists(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(false, nil)
 
 		// Execute
-		err := svc.Delete(ctx, userID.String(), productID.String())
+		err := svc.Delete(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.ErrorIs(t, err, wishlist.ErrItemNotFound)
@@ -317,7 +326,7 @@ func TestWishlistService_Delete(t *testing.T) {
 	t.Run("error_invalid_product_id", func(t *testing.T) {
 		userID := uuid.New()
 
-		err := svc.Delete(ctx, userID.String(), "invalid-uuid")
+		err := svc.Delete(ctx, userID.String(), "invalid-uuid", "")
 
 		assert.ErrorIs(t, err, wishlist.ErrInvalidProductID)
 	})
@@ -332,7 +341,7 @@ func TestWishlistService_Delete(t *testing.T) {
 			Return(dbgen.Wishlist{ID: wishlistID, UserID: userID}, nil)
 
 		repo.EXPECT().
-			CheckItemExists(gomock.Any(), wishlistID, productID).
+			CheckItemExists(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(true, nil)
 
 		mock.ExpectBegin()
@@ -340,11 +349,11 @@ func TestWishlistService_Delete(t *testing.T) {
 
 		repo.EXPECT().WithTx(gomock.Any()).Return(repo).AnyTimes()
 		repo.EXPECT().
-			DeleteItem(gomock.Any(), wishlistID, productID).
+			DeleteItem(gomock.Any(), wishlistID, productID, uuid.NullUUID{}).
 			Return(assert.AnError)
 
 		// Execute
-		err := svc.Delete(ctx, userID.String(), productID.String())
+		err := svc.Delete(ctx, userID.String(), productID.String(), "")
 
 		// Assert
 		assert.ErrorIs(t, err, wishlist.ErrWishlistFailed)